@@ -0,0 +1,494 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrNilIdeaGenerator indicates nil idea generator
+	ErrNilIdeaGenerator = errors.New("idea generator cannot be nil")
+	// ErrNilTopicRepository indicates nil topic repository
+	ErrNilTopicRepository = errors.New("topic repository cannot be nil")
+	// ErrNilIdeasRepository indicates nil ideas repository
+	ErrNilIdeasRepository = errors.New("ideas repository cannot be nil")
+	// ErrNilLeaseRepository indicates nil lease repository
+	ErrNilLeaseRepository = errors.New("lease repository cannot be nil")
+	// ErrSchedulerRunning indicates the scheduler is already running
+	ErrSchedulerRunning = errors.New("scheduler is already running")
+	// ErrSchedulerNotRunning indicates the scheduler is not running
+	ErrSchedulerNotRunning = errors.New("scheduler is not running")
+)
+
+const (
+	// IdeaSchedulerLease is the name of the lease shared by all idea scheduler replicas
+	IdeaSchedulerLease = "idea-scheduler"
+
+	defaultSchedulerInterval  = 6 * time.Hour
+	defaultSchedulerBatchSize = 100
+	defaultSchedulerRetry     = 5 * time.Minute
+)
+
+// IdeaGenerator generates ideas for a single topic.
+// GenerateIdeasUseCase satisfies this interface.
+type IdeaGenerator interface {
+	GenerateIdeasForTopic(ctx context.Context, topicID string) ([]*entities.Idea, error)
+}
+
+// SchedulerService periodically generates ideas for active topics.
+// Topics that still have enough unused ideas are skipped. When more topics need
+// ideas than fit in one batch, topics are sampled weighted by their priority, so
+// high priority topics are refilled more often without starving the rest.
+// Only the replica holding the scheduler lease generates on a tick, and the first
+// run happens one interval after start, so restarts don't trigger extra runs.
+type SchedulerService struct {
+	topicRepo      interfaces.TopicRepository
+	ideasRepo      interfaces.IdeasRepository
+	leaseRepo      interfaces.LeaseRepository
+	generator      IdeaGenerator
+	logger         *zap.Logger
+	interval       time.Duration
+	batchSize      int
+	maxRetries     int
+	retryDelay     time.Duration
+	minUnusedIdeas int
+	leaseTTL       time.Duration
+	holder         string
+	random         func() float64
+
+	mu      sync.RWMutex
+	running bool
+	cancel  context.CancelFunc
+	done    chan struct{}
+	lastRun time.Time
+	// Metrics
+	runsTotal            int64
+	topicsGeneratedTotal int64
+	ideasGeneratedTotal  int64
+	topicsSkippedTotal   int64
+	failuresTotal        int64
+	pollErrorsTotal      int64
+	leaseMissesTotal     int64
+}
+
+// SchedulerServiceConfig holds idea scheduler configuration
+type SchedulerServiceConfig struct {
+	TopicRepo  interfaces.TopicRepository
+	IdeasRepo  interfaces.IdeasRepository
+	LeaseRepo  interfaces.LeaseRepository
+	Generator  IdeaGenerator
+	Interval   time.Duration
+	BatchSize  int
+	MaxRetries int
+	RetryDelay time.Duration
+	// LeaseTTL defaults to twice the interval, so a crashed holder is replaced within two ticks
+	LeaseTTL time.Duration
+	// Holder identifies this replica in the lease
+	Holder string
+	// MinUnusedIdeas is the backlog at which a topic is skipped.
+	// Zero uses the number of ideas the topic generates per run.
+	MinUnusedIdeas int
+	Logger         *zap.Logger
+	// Random overrides the random source used for priority sampling, mainly for tests
+	Random func() float64
+}
+
+// NewSchedulerService creates a new idea generation scheduler
+func NewSchedulerService(config SchedulerServiceConfig) (*SchedulerService, error) {
+	if config.Generator == nil {
+		return nil, ErrNilIdeaGenerator
+	}
+
+	if config.TopicRepo == nil {
+		return nil, ErrNilTopicRepository
+	}
+
+	if config.IdeasRepo == nil {
+		return nil, ErrNilIdeasRepository
+	}
+
+	if config.LeaseRepo == nil {
+		return nil, ErrNilLeaseRepository
+	}
+
+	if config.Holder == "" {
+		return nil, errors.New("lease holder cannot be empty")
+	}
+
+	logger := config.Logger
+	if logger == nil {
+		logger, _ = zap.NewProduction()
+	}
+
+	interval := config.Interval
+	if interval <= 0 {
+		interval = defaultSchedulerInterval
+	}
+
+	batchSize := config.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultSchedulerBatchSize
+	}
+
+	maxRetries := config.MaxRetries
+	if maxRetries < 0 {
+		maxRetries = 0
+	}
+
+	retryDelay := config.RetryDelay
+	if retryDelay <= 0 {
+		retryDelay = defaultSchedulerRetry
+	}
+
+	leaseTTL := config.LeaseTTL
+	if leaseTTL <= 0 {
+		leaseTTL = 2 * interval
+	}
+
+	random := config.Random
+	if random == nil {
+		random = rand.Float64
+	}
+
+	return &SchedulerService{
+		topicRepo:      config.TopicRepo,
+		ideasRepo:      config.IdeasRepo,
+		leaseRepo:      config.LeaseRepo,
+		generator:      config.Generator,
+		logger:         logger,
+		interval:       interval,
+		batchSize:      batchSize,
+		maxRetries:     maxRetries,
+		retryDelay:     retryDelay,
+		minUnusedIdeas: config.MinUnusedIdeas,
+		leaseTTL:       leaseTTL,
+		holder:         config.Holder,
+		random:         random,
+	}, nil
+}
+
+// Start starts generating ideas in the background
+func (s *SchedulerService) Start(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		return ErrSchedulerRunning
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+	s.done = make(chan struct{})
+	s.running = true
+
+	go s.run(runCtx, s.done)
+
+	s.logger.Info("idea scheduler started",
+		zap.Duration("interval", s.interval),
+		zap.Int("batch_size", s.batchSize),
+		zap.String("holder", s.holder),
+	)
+
+	return nil
+}
+
+// Stop stops the scheduler, waiting for the in-flight run up to shutdownTimeout,
+// and hands the lease over to other replicas
+func (s *SchedulerService) Stop(shutdownTimeout time.Duration) error {
+	s.mu.Lock()
+	if !s.running {
+		s.mu.Unlock()
+		return ErrSchedulerNotRunning
+	}
+	cancel, done := s.cancel, s.done
+	s.running = false
+	s.mu.Unlock()
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(shutdownTimeout):
+		return errors.New("idea scheduler shutdown timeout exceeded")
+	}
+
+	releaseCtx, releaseCancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer releaseCancel()
+	if err := s.leaseRepo.Release(releaseCtx, IdeaSchedulerLease, s.holder); err != nil {
+		s.logger.Warn("failed to release idea scheduler lease", zap.Error(err))
+	}
+
+	s.logger.Info("idea scheduler stopped")
+	return nil
+}
+
+// run generates on every interval, starting one interval after start, until ctx is cancelled
+func (s *SchedulerService) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		s.RunOnce(ctx)
+	}
+}
+
+// RunOnce generates ideas for one batch of topics and returns how many topics got new ideas.
+// Failed topics are retried up to MaxRetries times, RetryDelay apart.
+// It does nothing when another replica holds the scheduler lease.
+func (s *SchedulerService) RunOnce(ctx context.Context) int {
+	acquired, err := s.leaseRepo.Acquire(ctx, IdeaSchedulerLease, s.holder, s.leaseTTL)
+	if err != nil {
+		if ctx.Err() == nil {
+			s.incrementPollErrors()
+			s.logger.Error("failed to acquire idea scheduler lease", zap.Error(err))
+		}
+		return 0
+	}
+
+	if !acquired {
+		s.incrementLeaseMisses()
+		return 0
+	}
+
+	s.recordRun()
+
+	topics, err := s.topicRepo.ListActive(ctx, 0)
+	if err != nil {
+		if ctx.Err() == nil {
+			s.incrementPollErrors()
+			s.logger.Error("failed to list active topics", zap.Error(err))
+		}
+		return 0
+	}
+
+	pending := s.selectByPriority(s.topicsNeedingIdeas(ctx, topics))
+
+	generated := 0
+	for attempt := 0; len(pending) > 0; attempt++ {
+		var failed []*entities.Topic
+		for _, topic := range pending {
+			if ctx.Err() != nil {
+				return generated
+			}
+
+			if s.generateForTopic(ctx, topic) {
+				generated++
+			} else {
+				failed = append(failed, topic)
+			}
+		}
+
+		if len(failed) == 0 {
+			break
+		}
+
+		if attempt >= s.maxRetries {
+			s.addFailures(len(failed))
+			s.logger.Warn("idea generation failed for topics after retries",
+				zap.Int("topics", len(failed)),
+				zap.Int("retries", s.maxRetries),
+			)
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return generated
+		case <-time.After(s.retryDelay):
+		}
+		pending = failed
+	}
+
+	return generated
+}
+
+// topicsNeedingIdeas filters out topics whose unused idea backlog is sufficient
+func (s *SchedulerService) topicsNeedingIdeas(ctx context.Context, topics []*entities.Topic) []*entities.Topic {
+	candidates := make([]*entities.Topic, 0, len(topics))
+	for _, topic := range topics {
+		if ctx.Err() != nil {
+			break
+		}
+
+		unused, err := s.ideasRepo.CountUnusedByTopicID(ctx, topic.ID)
+		if err != nil {
+			s.incrementPollErrors()
+			s.logger.Warn("failed to count unused ideas",
+				zap.String("topic_id", topic.ID),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		if unused >= int64(s.backlogTarget(topic)) {
+			s.incrementSkipped()
+			continue
+		}
+
+		candidates = append(candidates, topic)
+	}
+
+	return candidates
+}
+
+// backlogTarget returns how many unused ideas a topic needs to be skipped
+func (s *SchedulerService) backlogTarget(topic *entities.Topic) int {
+	if s.minUnusedIdeas > 0 {
+		return s.minUnusedIdeas
+	}
+	if topic.Ideas > 0 {
+		return topic.Ideas
+	}
+	return entities.DefaultIdeasCount
+}
+
+// selectByPriority picks up to batchSize topics, sampling without replacement
+// with probability proportional to priority (Efraimidis-Spirakis)
+func (s *SchedulerService) selectByPriority(topics []*entities.Topic) []*entities.Topic {
+	if len(topics) <= s.batchSize {
+		return topics
+	}
+
+	type weightedTopic struct {
+		topic *entities.Topic
+		key   float64
+	}
+
+	weighted := make([]weightedTopic, len(topics))
+	for i, topic := range topics {
+		priority := topic.Priority
+		if priority < entities.MinPriority {
+			priority = entities.MinPriority
+		}
+		if priority > entities.MaxPriority {
+			priority = entities.MaxPriority
+		}
+		weighted[i] = weightedTopic{
+			topic: topic,
+			key:   math.Pow(s.random(), 1/float64(priority)),
+		}
+	}
+
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return weighted[i].key > weighted[j].key
+	})
+
+	selected := make([]*entities.Topic, s.batchSize)
+	for i := range selected {
+		selected[i] = weighted[i].topic
+	}
+	return selected
+}
+
+// generateForTopic generates ideas for a single topic
+func (s *SchedulerService) generateForTopic(ctx context.Context, topic *entities.Topic) bool {
+	ideas, err := s.generator.GenerateIdeasForTopic(ctx, topic.ID)
+	if err != nil {
+		s.logger.Warn("scheduled idea generation failed",
+			zap.String("topic_id", topic.ID),
+			zap.String("user_id", topic.UserID),
+			zap.Error(err),
+		)
+		return false
+	}
+
+	s.incrementGenerated(len(ideas))
+	s.logger.Info("scheduled ideas generated",
+		zap.String("topic_id", topic.ID),
+		zap.String("user_id", topic.UserID),
+		zap.Int("ideas", len(ideas)),
+	)
+
+	return true
+}
+
+// IsRunning returns scheduler running status
+func (s *SchedulerService) IsRunning() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.running
+}
+
+// LastRun returns when the last generation run started
+func (s *SchedulerService) LastRun() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.lastRun
+}
+
+// GetMetrics returns scheduler metrics
+func (s *SchedulerService) GetMetrics() map[string]int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return map[string]int64{
+		"runs_total":             s.runsTotal,
+		"topics_generated_total": s.topicsGeneratedTotal,
+		"ideas_generated_total":  s.ideasGeneratedTotal,
+		"topics_skipped_total":   s.topicsSkippedTotal,
+		"failures_total":         s.failuresTotal,
+		"poll_errors_total":      s.pollErrorsTotal,
+		"lease_misses_total":     s.leaseMissesTotal,
+	}
+}
+
+// recordRun increments runs counter and stamps the last run
+func (s *SchedulerService) recordRun() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.runsTotal++
+	s.lastRun = time.Now()
+}
+
+// incrementGenerated increments generated topics and ideas counters
+func (s *SchedulerService) incrementGenerated(ideas int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.topicsGeneratedTotal++
+	s.ideasGeneratedTotal += int64(ideas)
+}
+
+// incrementSkipped increments skipped topics counter
+func (s *SchedulerService) incrementSkipped() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.topicsSkippedTotal++
+}
+
+// addFailures increments failed topics counter
+func (s *SchedulerService) addFailures(count int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failuresTotal += int64(count)
+}
+
+// incrementPollErrors increments repository errors counter
+func (s *SchedulerService) incrementPollErrors() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pollErrorsTotal++
+}
+
+// incrementLeaseMisses increments runs skipped because another replica holds the lease
+func (s *SchedulerService) incrementLeaseMisses() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.leaseMissesTotal++
+}
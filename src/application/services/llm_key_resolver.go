@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"go.uber.org/zap"
+)
+
+// SecretDecrypter decrypts secrets stored at rest
+type SecretDecrypter interface {
+	DecryptSecret(ciphertext string) (string, error)
+}
+
+// UserLLMKeyResolver looks up the API keys users store for LLM providers.
+// Keys are kept in User.APIKeys by provider name, encrypted by ManageLLMKeysUseCase
+// with the LLM key secret store. A key that can't be decrypted is logged and ignored,
+// so the user's calls fall back to the platform key.
+type UserLLMKeyResolver struct {
+	userRepo  interfaces.UserRepository
+	decrypter SecretDecrypter
+	logger    *zap.Logger
+}
+
+// NewUserLLMKeyResolver creates a new user LLM key resolver
+func NewUserLLMKeyResolver(userRepo interfaces.UserRepository, decrypter SecretDecrypter, logger *zap.Logger) *UserLLMKeyResolver {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	return &UserLLMKeyResolver{
+		userRepo:  userRepo,
+		decrypter: decrypter,
+		logger:    logger,
+	}
+}
+
+// ResolveLLMKey returns the user's decrypted key for provider, or an empty string when they
+// have none or it can't be decrypted
+func (r *UserLLMKeyResolver) ResolveLLMKey(ctx context.Context, userID, provider string) (string, error) {
+	user, err := r.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return "", nil
+	}
+
+	encrypted := strings.TrimSpace(user.APIKeys[strings.ToLower(provider)])
+	if encrypted == "" {
+		return "", nil
+	}
+
+	key, err := r.decrypter.DecryptSecret(encrypted)
+	if err != nil {
+		// Stored before encryption, or with another key; the user has to set it again
+		r.logger.Warn("ignoring unreadable LLM API key, using the platform key",
+			zap.String("user_id", userID),
+			zap.String("provider", provider),
+			zap.Error(err),
+		)
+		return "", nil
+	}
+
+	return key, nil
+}
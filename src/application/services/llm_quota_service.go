@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+)
+
+// Quota windows and budgets reported in ErrQuotaExceeded
+const (
+	QuotaPeriodDaily      = "daily"
+	QuotaPeriodMonthly    = "monthly"
+	QuotaResourceTokens   = "tokens"
+	QuotaResourceRequests = "requests"
+)
+
+// LLMQuotaService checks the LLM usage recorded for a user against their quota.
+// Users without a quota of their own get the default one.
+type LLMQuotaService struct {
+	usageRepo interfaces.LLMUsageRepository
+	userRepo  interfaces.UserRepository
+	defaults  entities.LLMQuota
+	now       func() time.Time
+}
+
+// NewLLMQuotaService creates a new LLM quota service
+func NewLLMQuotaService(
+	usageRepo interfaces.LLMUsageRepository,
+	userRepo interfaces.UserRepository,
+	defaults entities.LLMQuota,
+) *LLMQuotaService {
+	return &LLMQuotaService{
+		usageRepo: usageRepo,
+		userRepo:  userRepo,
+		defaults:  defaults,
+		now:       time.Now,
+	}
+}
+
+// quotaWindow is the usage of one quota period
+type quotaWindow struct {
+	period        string
+	tokenLimit    int64
+	requestLimit  int64
+	tokens        int64
+	requests      int64
+	resetAt       time.Time
+	tokensLeft    int64
+	requestsLeft  int64
+	exceeded      string
+	exceededLimit int64
+	exceededUsed  int64
+}
+
+// CheckLLMQuota returns *errors.ErrQuotaExceeded when the user has used up a daily or monthly quota
+func (s *LLMQuotaService) CheckLLMQuota(ctx context.Context, userID string) error {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to find user: %w", err)
+	}
+
+	quota := s.defaults
+	if user != nil && user.LLMQuota != nil {
+		quota = *user.LLMQuota
+	}
+	if quota.IsUnlimited() {
+		return nil
+	}
+
+	now := s.now().UTC()
+	dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	windows := []*quotaWindow{
+		{period: QuotaPeriodDaily, tokenLimit: quota.DailyTokens, requestLimit: quota.DailyRequests, resetAt: dayStart.AddDate(0, 0, 1)},
+		{period: QuotaPeriodMonthly, tokenLimit: quota.MonthlyTokens, requestLimit: quota.MonthlyRequests, resetAt: monthStart.AddDate(0, 1, 0)},
+	}
+	starts := []time.Time{dayStart, monthStart}
+
+	remainingTokens, remainingRequests := int64(-1), int64(-1)
+	var exhausted *quotaWindow
+	for i, window := range windows {
+		if window.tokenLimit <= 0 && window.requestLimit <= 0 {
+			continue
+		}
+
+		if err := s.measure(ctx, userID, starts[i], now, window); err != nil {
+			return err
+		}
+
+		remainingTokens = minRemaining(remainingTokens, window.tokensLeft)
+		remainingRequests = minRemaining(remainingRequests, window.requestsLeft)
+
+		// The window that resets last is the one the user has to wait for
+		if window.exceeded != "" && (exhausted == nil || window.resetAt.After(exhausted.resetAt)) {
+			exhausted = window
+		}
+	}
+
+	if exhausted == nil {
+		return nil
+	}
+
+	quotaErr := domainErrors.NewQuotaExceeded(userID, exhausted.period, exhausted.exceeded, exhausted.exceededLimit, exhausted.exceededUsed, exhausted.resetAt)
+	quotaErr.RemainingTokens = remainingTokens
+	quotaErr.RemainingRequests = remainingRequests
+	return quotaErr
+}
+
+// measure sums the usage of the window and compares it with its limits
+func (s *LLMQuotaService) measure(ctx context.Context, userID string, from, to time.Time, window *quotaWindow) error {
+	totals, err := s.usageRepo.Aggregate(ctx, entities.LLMUsageFilter{
+		UserID: userID,
+		From:   from,
+		// Include calls recorded in the current instant
+		To: to.Add(time.Second),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to measure %s LLM usage: %w", window.period, err)
+	}
+
+	for _, t := range totals {
+		window.tokens += int64(t.PromptTokens + t.CompletionTokens)
+		window.requests += int64(t.Calls)
+	}
+
+	window.tokensLeft, window.requestsLeft = -1, -1
+	if window.tokenLimit > 0 {
+		window.tokensLeft = max(window.tokenLimit-window.tokens, 0)
+		if window.tokensLeft == 0 {
+			window.exceeded, window.exceededLimit, window.exceededUsed = QuotaResourceTokens, window.tokenLimit, window.tokens
+		}
+	}
+	if window.requestLimit > 0 {
+		window.requestsLeft = max(window.requestLimit-window.requests, 0)
+		if window.requestsLeft == 0 && window.exceeded == "" {
+			window.exceeded, window.exceededLimit, window.exceededUsed = QuotaResourceRequests, window.requestLimit, window.requests
+		}
+	}
+
+	return nil
+}
+
+// minRemaining returns the tighter of two remaining budgets, where -1 means unlimited
+func minRemaining(a, b int64) int64 {
+	if a < 0 {
+		return b
+	}
+	if b < 0 {
+		return a
+	}
+	return min(a, b)
+}
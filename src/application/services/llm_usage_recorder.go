@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"go.uber.org/zap"
+)
+
+// llmUsageStoreTimeout bounds the write of a usage record once the call itself has finished
+const llmUsageStoreTimeout = 5 * time.Second
+
+// LLMUsageRecorder stores the token usage of every LLM call made on behalf of a user.
+// Calls without a user in their LLMCallInfo (startup checks, seeding) are not stored.
+type LLMUsageRecorder struct {
+	repo   interfaces.LLMUsageRepository
+	logger *zap.Logger
+}
+
+// NewLLMUsageRecorder creates a new LLM usage recorder
+func NewLLMUsageRecorder(repo interfaces.LLMUsageRepository, logger *zap.Logger) *LLMUsageRecorder {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	return &LLMUsageRecorder{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// RecordLLMUsage stores a usage record; failures are logged so accounting never fails a generation
+func (r *LLMUsageRecorder) RecordLLMUsage(ctx context.Context, usage *entities.LLMUsage) {
+	if usage == nil || usage.UserID == "" {
+		return
+	}
+
+	// The caller may cancel its context as soon as the response arrives
+	storeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), llmUsageStoreTimeout)
+	defer cancel()
+
+	if _, err := r.repo.Create(storeCtx, usage); err != nil {
+		r.logger.Warn("Failed to record LLM usage",
+			zap.String("user_id", usage.UserID),
+			zap.String("job_id", usage.JobID),
+			zap.String("model", usage.Model),
+			zap.Error(err),
+		)
+	}
+}
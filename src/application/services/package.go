@@ -4,6 +4,10 @@
 //
 // Core Services:
 // - SchedulerService: Manages periodic idea generation scheduling
+// - LLMUsageRecorder: Stores the token usage of LLM calls per user and job
+// - LLMQuotaService: Checks LLM usage against daily and monthly per-user quotas
+// - UserLLMKeyResolver: Decrypts the LLM API keys users bring for a provider
+// - PromptEvaluator: Scores prompts against a fixed corpus with deterministic checks
 // - RetryService: Handles retry logic with exponential backoff
 // - ValidationService: Application-level validation coordination
 package services
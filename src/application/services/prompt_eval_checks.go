@@ -0,0 +1,253 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/structured"
+)
+
+// Names of the deterministic checks
+const (
+	EvalCheckJSON      = "json"
+	EvalCheckPostCount = "post_count"
+	EvalCheckIdeaCount = "idea_count"
+	EvalCheckWordCount = "word_count"
+	EvalCheckCTA       = "cta"
+	EvalCheckLanguage  = "language"
+)
+
+const (
+	evalNoJSONDetail = "no valid JSON response"
+	// evalCTATailLines is how many of the last lines of a post may hold its call to action
+	evalCTATailLines = 2
+	// evalMinLanguageHits is how many stopwords a text needs for its language to be detected
+	evalMinLanguageHits = 3
+)
+
+// checkNames returns the checks run for a prompt type, in report order
+func checkNames(promptType entities.PromptType) []string {
+	if promptType == entities.PromptTypeIdeas {
+		return []string{EvalCheckJSON, EvalCheckIdeaCount, EvalCheckLanguage}
+	}
+	return []string{EvalCheckJSON, EvalCheckPostCount, EvalCheckWordCount, EvalCheckCTA, EvalCheckLanguage}
+}
+
+// checkIdeas scores an ideas response
+func (e *PromptEvaluator) checkIdeas(response string, wantIdeas int, language string) []PromptEvalCheck {
+	var result struct {
+		Ideas []string `json:"ideas"`
+	}
+	if err := structured.Decode(response, structured.IdeasSchema, &result); err != nil {
+		return failedChecks(checkNames(entities.PromptTypeIdeas), err.Error())
+	}
+
+	return []PromptEvalCheck{
+		passCheck(EvalCheckJSON),
+		boolCheck(EvalCheckIdeaCount, len(result.Ideas) == wantIdeas,
+			fmt.Sprintf("got %d ideas, want %d", len(result.Ideas), wantIdeas)),
+		languageCheck([]string{strings.Join(result.Ideas, "\n")}, language),
+	}
+}
+
+// checkDrafts scores a drafts response
+func (e *PromptEvaluator) checkDrafts(response string, language string) []PromptEvalCheck {
+	var result struct {
+		Posts    []string `json:"posts"`
+		Articles []string `json:"articles"`
+	}
+	if err := structured.Decode(response, structured.DraftsSchema, &result); err != nil {
+		return failedChecks(checkNames(entities.PromptTypeDrafts), err.Error())
+	}
+
+	postCount := boolCheck(EvalCheckPostCount,
+		len(result.Posts) == e.config.Posts && len(result.Articles) == e.config.Articles,
+		fmt.Sprintf("got %d posts and %d articles, want %d and %d", len(result.Posts), len(result.Articles), e.config.Posts, e.config.Articles))
+
+	var outOfBand []string
+	for i, post := range result.Posts {
+		if words := countWords(post); words < e.config.MinPostWords || words > e.config.MaxPostWords {
+			outOfBand = append(outOfBand, fmt.Sprintf("post %d has %d words", i+1, words))
+		}
+	}
+	wordCount := fractionCheck(EvalCheckWordCount, len(result.Posts), len(outOfBand),
+		fmt.Sprintf("%s (want %d-%d)", strings.Join(outOfBand, ", "), e.config.MinPostWords, e.config.MaxPostWords))
+
+	var withoutCTA []string
+	for i, post := range result.Posts {
+		if !hasCallToAction(post) {
+			withoutCTA = append(withoutCTA, fmt.Sprintf("post %d", i+1))
+		}
+	}
+	cta := fractionCheck(EvalCheckCTA, len(result.Posts), len(withoutCTA),
+		"no call to action in "+strings.Join(withoutCTA, ", "))
+
+	return []PromptEvalCheck{
+		passCheck(EvalCheckJSON),
+		postCount,
+		wordCount,
+		cta,
+		languageCheck(append(result.Posts, result.Articles...), language),
+	}
+}
+
+// languageCheck scores the share of texts written in language
+func languageCheck(texts []string, language string) PromptEvalCheck {
+	var wrong []string
+	for i, text := range texts {
+		if detected := detectLanguage(text); detected != language {
+			if detected == "" {
+				detected = "unknown"
+			}
+			wrong = append(wrong, fmt.Sprintf("text %d is %s", i+1, detected))
+		}
+	}
+	return fractionCheck(EvalCheckLanguage, len(texts), len(wrong),
+		fmt.Sprintf("%s (want %s)", strings.Join(wrong, ", "), language))
+}
+
+// passCheck returns a passed check
+func passCheck(name string) PromptEvalCheck {
+	return PromptEvalCheck{Name: name, Score: 1, Passed: true}
+}
+
+// boolCheck returns a check that passed or failed with detail
+func boolCheck(name string, passed bool, detail string) PromptEvalCheck {
+	if passed {
+		return passCheck(name)
+	}
+	return PromptEvalCheck{Name: name, Detail: detail}
+}
+
+// fractionCheck scores the share of total items that didn't fail
+func fractionCheck(name string, total, failed int, detail string) PromptEvalCheck {
+	if total == 0 {
+		return PromptEvalCheck{Name: name, Detail: "nothing to check"}
+	}
+	if failed == 0 {
+		return passCheck(name)
+	}
+	return PromptEvalCheck{
+		Name:   name,
+		Score:  float64(total-failed) / float64(total),
+		Detail: detail,
+	}
+}
+
+// failedChecks fails every check with the same detail
+func failedChecks(names []string, detail string) []PromptEvalCheck {
+	checks := make([]PromptEvalCheck, len(names))
+	for i, name := range names {
+		checks[i] = PromptEvalCheck{Name: name, Detail: detail}
+		if name != EvalCheckJSON {
+			checks[i].Detail = evalNoJSONDetail
+		}
+	}
+	return checks
+}
+
+// countWords counts the words of a text; hashtags, emoji and punctuation don't count
+func countWords(text string) int {
+	count := 0
+	for _, field := range strings.Fields(text) {
+		if strings.HasPrefix(field, "#") {
+			continue
+		}
+		if strings.IndexFunc(field, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }) >= 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// ctaPhrases are the calls to action recognised at the end of a post, besides a question
+var ctaPhrases = []string{
+	"comenta", "coméntame", "cuéntame", "cuéntanos", "comparte", "déjame", "déjanos", "escríbeme",
+	"sígueme", "síguenos", "te leo", "os leo", "qué opinas", "únete", "suscríbete", "descubre",
+	"comment", "share", "let me know", "follow", "what do you think", "join", "subscribe", "reach out",
+}
+
+// hasCallToAction reports whether the last lines of a post, hashtags aside, ask the reader
+// something or invite them to act
+func hasCallToAction(post string) bool {
+	var lines []string
+	for _, line := range strings.Split(post, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || isHashtagLine(line) {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) > evalCTATailLines {
+		lines = lines[len(lines)-evalCTATailLines:]
+	}
+
+	tail := strings.ToLower(strings.Join(lines, " "))
+	// A single-paragraph post only counts its last sentences
+	if len(tail) > 300 {
+		tail = tail[len(tail)-300:]
+	}
+	if strings.Contains(tail, "?") {
+		return true
+	}
+	for _, phrase := range ctaPhrases {
+		if strings.Contains(tail, phrase) {
+			return true
+		}
+	}
+	return false
+}
+
+// isHashtagLine reports whether a line only holds hashtags
+func isHashtagLine(line string) bool {
+	for _, field := range strings.Fields(line) {
+		if !strings.HasPrefix(field, "#") {
+			return false
+		}
+	}
+	return true
+}
+
+// languageStopwords are frequent words that identify the languages the checks recognise
+var languageStopwords = map[string]map[string]bool{
+	"es": wordSet("el", "la", "los", "las", "de", "del", "que", "y", "en", "un", "una", "es", "por", "para",
+		"con", "se", "lo", "como", "más", "pero", "al", "su", "sus", "tu", "tus", "este", "esta", "cuando"),
+	"en": wordSet("the", "and", "of", "to", "in", "is", "that", "for", "it", "with", "as", "on", "are",
+		"this", "be", "you", "not", "but", "your", "by", "from", "when", "what", "how"),
+}
+
+// wordSet builds a set of words
+func wordSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[word] = true
+	}
+	return set
+}
+
+// detectLanguage returns the language whose stopwords are most frequent in text, or ""
+// when the text is too short to tell
+func detectLanguage(text string) string {
+	hits := make(map[string]int, len(languageStopwords))
+	for _, field := range strings.Fields(strings.ToLower(text)) {
+		word := strings.TrimFunc(field, func(r rune) bool { return !unicode.IsLetter(r) })
+		for language, stopwords := range languageStopwords {
+			if stopwords[word] {
+				hits[language]++
+			}
+		}
+	}
+
+	best, bestHits := "", 0
+	for language, n := range hits {
+		if n > bestHits || (n == bestHits && language < best) {
+			best, bestHits = language, n
+		}
+	}
+	if bestHits < evalMinLanguageHits {
+		return ""
+	}
+	return best
+}
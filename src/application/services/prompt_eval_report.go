@@ -0,0 +1,185 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+)
+
+// PromptEvalCheck is the score of one deterministic check, from 0 to 1
+type PromptEvalCheck struct {
+	Name   string  `json:"name"`
+	Score  float64 `json:"score"`
+	Passed bool    `json:"passed"`
+	Detail string  `json:"detail,omitempty"`
+}
+
+// PromptEvalCaseResult is the output of a prompt for one case and its checks
+type PromptEvalCaseResult struct {
+	CaseID    string            `json:"case_id"`
+	Score     float64           `json:"score"`
+	Checks    []PromptEvalCheck `json:"checks"`
+	Response  string            `json:"response,omitempty"`
+	Error     string            `json:"error,omitempty"`
+	LatencyMs int64             `json:"latency_ms"`
+}
+
+// fail records an error that left the case without a response to check
+func (r *PromptEvalCaseResult) fail(err error, checks []string) {
+	r.Error = err.Error()
+	r.Checks = failedChecks(checks, r.Error)
+	r.score()
+}
+
+// score averages the checks of the case
+func (r *PromptEvalCaseResult) score() {
+	r.Score = 0
+	for _, check := range r.Checks {
+		r.Score += check.Score
+	}
+	if len(r.Checks) > 0 {
+		r.Score /= float64(len(r.Checks))
+	}
+}
+
+// PromptEvalPromptResult is how a prompt did across the corpus
+type PromptEvalPromptResult struct {
+	Prompt string              `json:"prompt"`
+	Type   entities.PromptType `json:"type"`
+	// Score is the average case score
+	Score float64 `json:"score"`
+	// Baseline is the prompt this one is compared with, and Delta its score difference
+	Baseline string  `json:"baseline,omitempty"`
+	Delta    float64 `json:"delta,omitempty"`
+	// Checks is the average score of each check across cases
+	Checks map[string]float64     `json:"checks"`
+	Passed int                    `json:"passed_cases"`
+	Cases  []PromptEvalCaseResult `json:"cases"`
+}
+
+// summarize averages the case and check scores of the prompt
+func (r *PromptEvalPromptResult) summarize() {
+	r.Score, r.Passed = 0, 0
+	r.Checks = make(map[string]float64)
+	for _, result := range r.Cases {
+		r.Score += result.Score
+		if result.Score == 1 {
+			r.Passed++
+		}
+		for _, check := range result.Checks {
+			r.Checks[check.Name] += check.Score / float64(len(r.Cases))
+		}
+	}
+	r.Score /= float64(len(r.Cases))
+}
+
+// PromptEvalReport compares prompts evaluated against the same corpus
+type PromptEvalReport struct {
+	GeneratedAt time.Time                `json:"generated_at"`
+	Prompts     []PromptEvalPromptResult `json:"prompts"`
+}
+
+// compareWithBaselines compares every prompt with the first prompt of its type
+func (r *PromptEvalReport) compareWithBaselines() {
+	baselines := make(map[entities.PromptType]*PromptEvalPromptResult)
+	for i := range r.Prompts {
+		result := &r.Prompts[i]
+		baseline, ok := baselines[result.Type]
+		if !ok {
+			baselines[result.Type] = result
+			continue
+		}
+		result.Baseline = baseline.Prompt
+		result.Delta = result.Score - baseline.Score
+	}
+}
+
+// JSON returns the report as indented JSON
+func (r *PromptEvalReport) JSON() ([]byte, error) {
+	return json.MarshalIndent(r, "", "  ")
+}
+
+// Markdown returns the report as Markdown: a summary per prompt type, the score of every
+// case for each prompt and the checks that failed
+func (r *PromptEvalReport) Markdown() string {
+	var sb strings.Builder
+	sb.WriteString("# Prompt evaluation\n\n")
+	fmt.Fprintf(&sb, "Generated at %s\n", r.GeneratedAt.Format(time.RFC3339))
+
+	for _, promptType := range []entities.PromptType{entities.PromptTypeIdeas, entities.PromptTypeDrafts} {
+		var results []PromptEvalPromptResult
+		for _, result := range r.Prompts {
+			if result.Type == promptType {
+				results = append(results, result)
+			}
+		}
+		if len(results) == 0 {
+			continue
+		}
+
+		checks := checkNames(promptType)
+		fmt.Fprintf(&sb, "\n## %s\n\n", promptType)
+
+		// Summary
+		sb.WriteString("| Prompt | Score | Δ | Passed |")
+		for _, check := range checks {
+			fmt.Fprintf(&sb, " %s |", check)
+		}
+		sb.WriteString("\n|---|---|---|---|")
+		sb.WriteString(strings.Repeat("---|", len(checks)))
+		sb.WriteString("\n")
+		for _, result := range results {
+			delta := "baseline"
+			if result.Baseline != "" {
+				delta = fmt.Sprintf("%+.2f", result.Delta)
+			}
+			fmt.Fprintf(&sb, "| %s | %.2f | %s | %d/%d |", result.Prompt, result.Score, delta, result.Passed, len(result.Cases))
+			for _, check := range checks {
+				fmt.Fprintf(&sb, " %.2f |", result.Checks[check])
+			}
+			sb.WriteString("\n")
+		}
+
+		// Cases, in corpus order, with the score of each prompt
+		sb.WriteString("\n| Case |")
+		for _, result := range results {
+			fmt.Fprintf(&sb, " %s |", result.Prompt)
+		}
+		sb.WriteString("\n|---|")
+		sb.WriteString(strings.Repeat("---|", len(results)))
+		sb.WriteString("\n")
+		for i, caseResult := range results[0].Cases {
+			fmt.Fprintf(&sb, "| %s |", caseResult.CaseID)
+			for _, result := range results {
+				fmt.Fprintf(&sb, " %.2f |", result.Cases[i].Score)
+			}
+			sb.WriteString("\n")
+		}
+
+		// Failures
+		for _, result := range results {
+			var failures []string
+			for _, caseResult := range result.Cases {
+				for _, check := range caseResult.Checks {
+					if !check.Passed {
+						failures = append(failures, fmt.Sprintf("- `%s` %s: %s", caseResult.CaseID, check.Name, markdownLine(check.Detail)))
+					}
+				}
+			}
+			if len(failures) == 0 {
+				continue
+			}
+			fmt.Fprintf(&sb, "\n### Failed checks of %s\n\n%s\n", result.Prompt, strings.Join(failures, "\n"))
+		}
+	}
+
+	return sb.String()
+}
+
+// markdownLine keeps a detail on one line of a Markdown list
+func markdownLine(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,270 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/structured"
+	infraServices "github.com/linkgen-ai/backend/src/infrastructure/services"
+)
+
+const (
+	defaultEvalLanguage     = "es"
+	defaultEvalMinPostWords = 120
+	defaultEvalMaxPostWords = 260
+	defaultEvalPosts        = 5
+	defaultEvalArticles     = 1
+)
+
+var (
+	// ErrNilPromptEngine indicates nil prompt engine
+	ErrNilPromptEngine = errors.New("prompt engine cannot be nil")
+	// ErrNilLLMService indicates nil LLM service
+	ErrNilLLMService = errors.New("LLM service cannot be nil")
+)
+
+// PromptEvalTopic is the topic of an ideas case
+type PromptEvalTopic struct {
+	Name          string   `json:"name"`
+	Ideas         int      `json:"ideas"`
+	Description   string   `json:"description,omitempty"`
+	Category      string   `json:"category,omitempty"`
+	Priority      int      `json:"priority,omitempty"`
+	RelatedTopics []string `json:"related_topics,omitempty"`
+}
+
+// PromptEvalCase is one input of the evaluation corpus: a topic for ideas prompts or an
+// idea for drafts prompts
+type PromptEvalCase struct {
+	ID          string              `json:"id"`
+	Type        entities.PromptType `json:"type"`
+	Topic       *PromptEvalTopic    `json:"topic,omitempty"`
+	Idea        string              `json:"idea,omitempty"`
+	UserContext string              `json:"user_context,omitempty"`
+	// Language overrides the corpus language for this case
+	Language string `json:"language,omitempty"`
+}
+
+// PromptEvalCorpus is the fixed set of cases every prompt edit is evaluated against
+type PromptEvalCorpus struct {
+	// Language is the language outputs are expected in, "es" by default
+	Language string           `json:"language,omitempty"`
+	Cases    []PromptEvalCase `json:"cases"`
+}
+
+// LoadPromptEvalCorpus reads and validates a JSON corpus file
+func LoadPromptEvalCorpus(path string) (*PromptEvalCorpus, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read corpus %s: %w", path, err)
+	}
+
+	var corpus PromptEvalCorpus
+	if err := json.Unmarshal(data, &corpus); err != nil {
+		return nil, fmt.Errorf("invalid corpus %s: %w", path, err)
+	}
+	if err := corpus.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid corpus %s: %w", path, err)
+	}
+
+	return &corpus, nil
+}
+
+// Validate checks that every case has a unique ID and the input its type needs
+func (c *PromptEvalCorpus) Validate() error {
+	if len(c.Cases) == 0 {
+		return fmt.Errorf("corpus has no cases")
+	}
+
+	seen := make(map[string]bool, len(c.Cases))
+	for _, evalCase := range c.Cases {
+		if strings.TrimSpace(evalCase.ID) == "" {
+			return fmt.Errorf("case ID cannot be empty")
+		}
+		if seen[evalCase.ID] {
+			return fmt.Errorf("duplicate case ID: %s", evalCase.ID)
+		}
+		seen[evalCase.ID] = true
+
+		switch evalCase.Type {
+		case entities.PromptTypeIdeas:
+			if evalCase.Topic == nil || strings.TrimSpace(evalCase.Topic.Name) == "" || evalCase.Topic.Ideas <= 0 {
+				return fmt.Errorf("case %s needs a topic with a name and a number of ideas", evalCase.ID)
+			}
+		case entities.PromptTypeDrafts:
+			if strings.TrimSpace(evalCase.Idea) == "" {
+				return fmt.Errorf("case %s needs an idea", evalCase.ID)
+			}
+		default:
+			return fmt.Errorf("case %s has invalid type %q", evalCase.ID, evalCase.Type)
+		}
+	}
+
+	return nil
+}
+
+// language returns the language expected for a case
+func (c *PromptEvalCorpus) language(evalCase PromptEvalCase) string {
+	switch {
+	case evalCase.Language != "":
+		return evalCase.Language
+	case c.Language != "":
+		return c.Language
+	default:
+		return defaultEvalLanguage
+	}
+}
+
+// PromptEvalCandidate is a prompt under evaluation
+type PromptEvalCandidate struct {
+	Name          string
+	Type          entities.PromptType
+	Template      string
+	SystemMessage string
+	Parameters    entities.PromptParameters
+}
+
+// PromptEvaluatorConfig holds the thresholds of the deterministic checks
+type PromptEvaluatorConfig struct {
+	// MinPostWords and MaxPostWords bound the length of every post (120-260 by default)
+	MinPostWords int
+	MaxPostWords int
+	// Posts and Articles are the number of each a drafts response must have (5 and 1 by default)
+	Posts    int
+	Articles int
+}
+
+// PromptEvaluator runs prompts against a corpus through an LLMService and scores the outputs
+// with deterministic checks, so that prompt edits can be compared before they reach users
+type PromptEvaluator struct {
+	engine     *infraServices.PromptEngine
+	llmService interfaces.LLMService
+	config     PromptEvaluatorConfig
+}
+
+// NewPromptEvaluator creates a new PromptEvaluator
+func NewPromptEvaluator(engine *infraServices.PromptEngine, llmService interfaces.LLMService, config PromptEvaluatorConfig) (*PromptEvaluator, error) {
+	if engine == nil {
+		return nil, ErrNilPromptEngine
+	}
+	if llmService == nil {
+		return nil, ErrNilLLMService
+	}
+
+	if config.MinPostWords <= 0 {
+		config.MinPostWords = defaultEvalMinPostWords
+	}
+	if config.MaxPostWords <= 0 {
+		config.MaxPostWords = defaultEvalMaxPostWords
+	}
+	if config.MaxPostWords < config.MinPostWords {
+		return nil, fmt.Errorf("max post words (%d) cannot be below min post words (%d)", config.MaxPostWords, config.MinPostWords)
+	}
+	if config.Posts <= 0 {
+		config.Posts = defaultEvalPosts
+	}
+	if config.Articles <= 0 {
+		config.Articles = defaultEvalArticles
+	}
+
+	return &PromptEvaluator{
+		engine:     engine,
+		llmService: llmService,
+		config:     config,
+	}, nil
+}
+
+// Evaluate runs every candidate against the corpus cases of its type. The first candidate of
+// each type is the baseline the others are compared with.
+func (e *PromptEvaluator) Evaluate(ctx context.Context, corpus *PromptEvalCorpus, candidates []PromptEvalCandidate) (*PromptEvalReport, error) {
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no prompts to evaluate")
+	}
+
+	report := &PromptEvalReport{GeneratedAt: time.Now().UTC()}
+	for _, candidate := range candidates {
+		result := PromptEvalPromptResult{Prompt: candidate.Name, Type: candidate.Type}
+		for _, evalCase := range corpus.Cases {
+			if evalCase.Type != candidate.Type {
+				continue
+			}
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			result.Cases = append(result.Cases, e.evaluateCase(ctx, candidate, evalCase, corpus.language(evalCase)))
+		}
+		if len(result.Cases) == 0 {
+			return nil, fmt.Errorf("corpus has no %s cases for prompt %s", candidate.Type, candidate.Name)
+		}
+
+		result.summarize()
+		report.Prompts = append(report.Prompts, result)
+	}
+
+	report.compareWithBaselines()
+	return report, nil
+}
+
+// evaluateCase renders the candidate for a case, sends it and scores the response
+func (e *PromptEvaluator) evaluateCase(ctx context.Context, candidate PromptEvalCandidate, evalCase PromptEvalCase, language string) PromptEvalCaseResult {
+	result := PromptEvalCaseResult{CaseID: evalCase.ID}
+
+	var topic *entities.Topic
+	if evalCase.Topic != nil {
+		topic = &entities.Topic{
+			Name:          evalCase.Topic.Name,
+			Ideas:         evalCase.Topic.Ideas,
+			Description:   evalCase.Topic.Description,
+			Category:      evalCase.Topic.Category,
+			Priority:      evalCase.Topic.Priority,
+			RelatedTopics: evalCase.Topic.RelatedTopics,
+		}
+	}
+
+	prompt, err := e.engine.ProcessTemplate(candidate.Template, topic, map[string]interface{}{
+		"content":      evalCase.Idea,
+		"user_context": evalCase.UserContext,
+	})
+	if err != nil {
+		result.fail(fmt.Errorf("failed to render prompt: %w", err), checkNames(candidate.Type))
+		return result
+	}
+
+	schema := structured.DraftsSchema
+	if candidate.Type == entities.PromptTypeIdeas {
+		schema = structured.IdeasSchema
+	}
+
+	// Send the prompt like generation does, but without the repair round-trip: the
+	// evaluation is of what the prompt gets on the first try
+	ctx = interfaces.WithLLMCallInfo(ctx, interfaces.LLMCallInfo{PromptName: candidate.Name})
+
+	start := time.Now()
+	response, err := e.llmService.SendPromptRequest(ctx, interfaces.PromptRequest{
+		Prompt:     prompt,
+		System:     candidate.SystemMessage,
+		Parameters: candidate.Parameters,
+		Schema:     schema.Response(),
+	})
+	result.LatencyMs = time.Since(start).Milliseconds()
+	if err != nil {
+		result.fail(fmt.Errorf("LLM request failed: %w", err), checkNames(candidate.Type))
+		return result
+	}
+	result.Response = response
+
+	if candidate.Type == entities.PromptTypeIdeas {
+		result.Checks = e.checkIdeas(response, evalCase.Topic.Ideas, language)
+	} else {
+		result.Checks = e.checkDrafts(response, language)
+	}
+	result.score()
+	return result
+}
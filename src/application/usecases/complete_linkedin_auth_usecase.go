@@ -0,0 +1,97 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/database"
+)
+
+// CompleteLinkedInAuthUseCase finishes the LinkedIn OAuth2 flow and stores the user's tokens
+type CompleteLinkedInAuthUseCase struct {
+	userRepo    interfaces.UserRepository
+	stateRepo   interfaces.OAuthStateRepository
+	authService interfaces.LinkedInAuthService
+}
+
+// NewCompleteLinkedInAuthUseCase creates a new instance of CompleteLinkedInAuthUseCase
+func NewCompleteLinkedInAuthUseCase(
+	userRepo interfaces.UserRepository,
+	stateRepo interfaces.OAuthStateRepository,
+	authService interfaces.LinkedInAuthService,
+) *CompleteLinkedInAuthUseCase {
+	return &CompleteLinkedInAuthUseCase{
+		userRepo:    userRepo,
+		stateRepo:   stateRepo,
+		authService: authService,
+	}
+}
+
+// CompleteLinkedInAuthInput represents the parameters LinkedIn sends to the callback
+type CompleteLinkedInAuthInput struct {
+	Code  string
+	State string
+}
+
+// Execute redeems the state, exchanges the authorization code and persists the tokens
+func (uc *CompleteLinkedInAuthUseCase) Execute(ctx context.Context, input CompleteLinkedInAuthInput) (*entities.User, error) {
+	code := strings.TrimSpace(input.Code)
+	stateValue := strings.TrimSpace(input.State)
+
+	if code == "" {
+		return nil, domainErrors.NewValidationError("code", "authorization code cannot be empty")
+	}
+	if stateValue == "" {
+		return nil, domainErrors.NewValidationError("state", "state cannot be empty")
+	}
+
+	// The state is deleted as it is read, so a callback can never be replayed
+	state, err := uc.stateRepo.Consume(ctx, stateValue)
+	if err != nil {
+		if errors.Is(err, database.ErrEntityNotFound) {
+			return nil, domainErrors.NewValidationError("state", "invalid or expired authorization state")
+		}
+		return nil, fmt.Errorf("failed to retrieve oauth state: %w", err)
+	}
+
+	now := time.Now()
+	if state.IsExpired(now) {
+		return nil, domainErrors.NewValidationError("state", "invalid or expired authorization state")
+	}
+
+	user, err := findUser(ctx, uc.userRepo, state.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := uc.authService.ExchangeAuthorizationCode(ctx, code, state.CodeVerifier)
+	if err != nil {
+		var apiErr *domainErrors.LinkedInAPIError
+		if errors.As(err, &apiErr) &&
+			(apiErr.Reason == domainErrors.LinkedInReasonBadRequest || apiErr.Reason == domainErrors.LinkedInReasonTokenInvalid) {
+			return nil, domainErrors.NewValidationError("code", "authorization code is invalid or expired")
+		}
+		return nil, err
+	}
+
+	var expiresAt time.Time
+	if tokens.ExpiresIn > 0 {
+		expiresAt = now.Add(time.Duration(tokens.ExpiresIn) * time.Second)
+	}
+
+	if err := user.SetLinkedInCredentials(tokens.AccessToken, tokens.RefreshToken, expiresAt); err != nil {
+		return nil, fmt.Errorf("invalid LinkedIn credentials: %w", err)
+	}
+
+	if err := uc.userRepo.UpdateLinkedInCredentials(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to save LinkedIn credentials: %w", err)
+	}
+
+	return user, nil
+}
@@ -2,7 +2,7 @@ package usecases
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -10,6 +10,7 @@ import (
 	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
 	"github.com/linkgen-ai/backend/src/domain/factories"
 	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/structured"
 	"github.com/linkgen-ai/backend/src/infrastructure/services"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -22,6 +23,8 @@ type GenerateDraftsUseCase struct {
 	promptsRepo  interfaces.PromptsRepository
 	promptEngine *services.PromptEngine
 	llmService   interfaces.LLMService
+	quotaChecker interfaces.LLMQuotaChecker
+	experiments  interfaces.ExperimentRepository
 }
 
 // NewGenerateDraftsUseCase creates a new instance of GenerateDraftsUseCase
@@ -43,6 +46,16 @@ func NewGenerateDraftsUseCase(
 	}
 }
 
+// SetQuotaChecker enables LLM quota enforcement before generation
+func (uc *GenerateDraftsUseCase) SetQuotaChecker(checker interfaces.LLMQuotaChecker) {
+	uc.quotaChecker = checker
+}
+
+// SetExperiments splits draft generation across the prompts of the user's active experiment
+func (uc *GenerateDraftsUseCase) SetExperiments(repo interfaces.ExperimentRepository) {
+	uc.experiments = repo
+}
+
 // GenerateDraftsInput represents input for draft generation
 type GenerateDraftsInput struct {
 	UserID string
@@ -70,12 +83,20 @@ func (uc *GenerateDraftsUseCase) Execute(ctx context.Context, input GenerateDraf
 		return nil, fmt.Errorf("user not found: %s", input.UserID)
 	}
 
+	// Attribute the LLM usage of this generation to the user
+	ctx = interfaces.WithLLMCallInfo(ctx, interfaces.LLMCallInfo{UserID: user.ID})
+
 	// Get idea from repository
 	idea, err := uc.getAndValidateIdea(ctx, input.UserID, input.IdeaID)
 	if err != nil {
 		return nil, err
 	}
 
+	// Stop before calling the LLM when the user is out of budget
+	if err := checkLLMQuota(ctx, uc.quotaChecker, user.ID); err != nil {
+		return nil, err
+	}
+
 	// Use PromptEngine if available, otherwise fall back to legacy method
 	if uc.promptEngine != nil {
 		return uc.generateDraftsWithPromptEngine(ctx, idea, user)
@@ -374,16 +395,22 @@ func (uc *GenerateDraftsUseCase) generateDraftsWithPromptEngine(ctx context.Cont
 	promptName := "profesional"
 
 	// Try to find a custom drafts prompt for the user
+	var experiment *entities.Experiment
+	var arm entities.ExperimentArm
 	if uc.promptsRepo != nil {
 		activePrompts, err := uc.promptsRepo.FindActiveByUserIDAndType(ctx, user.ID, entities.PromptTypeDrafts)
 		if err == nil && len(activePrompts) > 0 {
-			// Use the first active prompt found
+			// Use the first active prompt found, unless an experiment picks one of its arms
 			promptName = activePrompts[0].Name
+			experiment, arm = uc.pickExperimentArm(ctx, user.ID, idea.ID, activePrompts)
+			if experiment != nil {
+				promptName = arm.PromptName
+			}
 		}
 	}
 
 	// Process the prompt using PromptEngine
-	finalPrompt, err := uc.promptEngine.ProcessPrompt(
+	request, err := uc.promptEngine.ProcessPrompt(
 		ctx,
 		user.ID,
 		promptName,
@@ -395,21 +422,27 @@ func (uc *GenerateDraftsUseCase) generateDraftsWithPromptEngine(ctx context.Cont
 	if err != nil {
 		return nil, fmt.Errorf("failed to process prompt with PromptEngine: %w", err)
 	}
+	finalPrompt := request.Prompt
+	notifyPromptVersion(ctx, request.PromptID, request.PromptVersion)
 
-	// Create a simplified draft set by calling LLM service with the processed prompt
-	// Note: We need to use a different approach here since LLMService.GenerateDrafts expects content and context
-	// We'll need to extend LLMService or create a custom method
-
-	// For now, let's simulate by using the existing LLM service but with our processed prompt
-	response, err := uc.llmService.SendRequest(ctx, finalPrompt)
+	// Send the processed prompt, with its system message and model parameters, and decode
+	// the drafts, repairing an invalid response once
+	ctx = interfaces.WithLLMCallInfo(ctx, interfaces.LLMCallInfo{PromptName: promptName})
+	var result draftsResponse
+	response, err := structured.Complete(ctx, uc.llmService, request, structured.DraftsSchema, &result)
 	if err != nil {
+		var llmRespErr *domainErrors.LLMResponseError
+		if errors.As(err, &llmRespErr) {
+			return nil, fmt.Errorf("failed to parse LLM response: %w", err)
+		}
 		return nil, fmt.Errorf("LLM service error: %w", err)
 	}
 
-	// Parse the response to extract drafts
-	draftSet, err := uc.parseDraftsResponse(response)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse LLM response: %w", err)
+	draftSet := interfaces.DraftSet{
+		Posts:       result.Posts,
+		Articles:    result.Articles,
+		Prompt:      finalPrompt,
+		RawResponse: response,
 	}
 
 	// Validate the parsed response
@@ -434,6 +467,10 @@ func (uc *GenerateDraftsUseCase) generateDraftsWithPromptEngine(ctx context.Cont
 			err,
 		)
 	}
+	stampDraftsPromptVersion(drafts, request.PromptID, request.PromptVersion)
+	if experiment != nil {
+		stampDraftsExperimentArm(drafts, experiment.ID, arm)
+	}
 
 	// Save drafts to repository
 	if err := uc.saveDrafts(ctx, drafts); err != nil {
@@ -450,55 +487,36 @@ func (uc *GenerateDraftsUseCase) generateDraftsWithPromptEngine(ctx context.Cont
 	return drafts, nil
 }
 
-// parseDraftsResponse parses the JSON response from LLM for drafts
-func (uc *GenerateDraftsUseCase) parseDraftsResponse(response string) (interfaces.DraftSet, error) {
-	// Clean the response from markdown code blocks if present
-	cleanedResponse := cleanDraftJSONResponse(response)
-
-	var result struct {
-		Posts    []string `json:"posts"`
-		Articles []string `json:"articles"`
+// pickExperimentArm picks the arm of the user's active drafts experiment for this generation.
+// Without an experiment, or when none of its arms' prompts is active, it returns nil and the
+// generation uses the default prompt. The pick is keyed by the job, or the idea outside a job,
+// so a retried generation uses the same arm.
+func (uc *GenerateDraftsUseCase) pickExperimentArm(ctx context.Context, userID, ideaID string, activePrompts []*entities.Prompt) (*entities.Experiment, entities.ExperimentArm) {
+	if uc.experiments == nil {
+		return nil, entities.ExperimentArm{}
 	}
 
-	if err := json.Unmarshal([]byte(cleanedResponse), &result); err != nil {
-		return interfaces.DraftSet{}, fmt.Errorf("failed to unmarshal JSON response: %w", err)
+	experiment, err := uc.experiments.FindActiveByUserIDAndType(ctx, userID, entities.PromptTypeDrafts)
+	if err != nil || experiment == nil {
+		return nil, entities.ExperimentArm{}
 	}
 
-	return interfaces.DraftSet{
-		Posts:       result.Posts,
-		Articles:    result.Articles,
-		Prompt:      "", // Not tracked in this simplified version
-		RawResponse: response,
-	}, nil
-}
-
-// cleanDraftJSONResponse removes markdown code blocks and extracts JSON
-func cleanDraftJSONResponse(response string) string {
-	// Remove markdown code blocks (```json ... ``` or ``` ... ```)
-	response = strings.TrimSpace(response)
-
-	// Check if response starts with ``` and ends with ```
-	if strings.HasPrefix(response, "```") {
-		// Find the first newline after ```
-		start := strings.Index(response, "\n")
-		if start == -1 {
-			start = 3 // just skip ```
-		} else {
-			start++ // skip the newline
-		}
-
-		// Find the last ```
-		end := strings.LastIndex(response, "```")
-		if end > start {
-			response = response[start:end]
-		}
+	key := interfaces.LLMCallInfoFromContext(ctx).JobID
+	if key == "" {
+		key = ideaID
 	}
 
-	// Remove backticks at the beginning and end
-	response = strings.Trim(response, "`")
-	response = strings.TrimSpace(response)
+	arm, ok := pickExperimentArm(experiment, activePrompts, hashIntn(key))
+	if !ok {
+		return nil, entities.ExperimentArm{}
+	}
+	return experiment, arm
+}
 
-	return response
+// draftsResponse is the JSON shape of structured.DraftsSchema
+type draftsResponse struct {
+	Posts    []string `json:"posts"`
+	Articles []string `json:"articles"`
 }
 
 // markIdeaAsUsed marks the idea as used in the repository
@@ -2,13 +2,15 @@ package usecases
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
 	"github.com/linkgen-ai/backend/src/domain/factories"
 	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/structured"
 	"github.com/linkgen-ai/backend/src/infrastructure/services"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -21,6 +23,7 @@ type GenerateIdeasUseCase struct {
 	promptsRepo  interfaces.PromptsRepository
 	promptEngine *services.PromptEngine
 	llmService   interfaces.LLMService
+	quotaChecker interfaces.LLMQuotaChecker
 }
 
 // NewGenerateIdeasUseCase creates a new instance of GenerateIdeasUseCase
@@ -42,6 +45,11 @@ func NewGenerateIdeasUseCase(
 	}
 }
 
+// SetQuotaChecker enables LLM quota enforcement before generation
+func (uc *GenerateIdeasUseCase) SetQuotaChecker(checker interfaces.LLMQuotaChecker) {
+	uc.quotaChecker = checker
+}
+
 // GenerateIdeasInput represents input for idea generation
 type GenerateIdeasInput struct {
 	UserID string
@@ -128,6 +136,14 @@ func (uc *GenerateIdeasUseCase) generateIdeasForTopicContext(ctx context.Context
 		return nil, fmt.Errorf("user cannot be nil")
 	}
 
+	// Attribute the LLM usage of this generation to the user
+	ctx = interfaces.WithLLMCallInfo(ctx, interfaces.LLMCallInfo{UserID: user.ID})
+
+	// Stop before calling the LLM when the user is out of budget
+	if err := checkLLMQuota(ctx, uc.quotaChecker, user.ID); err != nil {
+		return nil, err
+	}
+
 	// Use PromptEngine if available, otherwise fall back to legacy method
 	if uc.promptEngine != nil {
 		return uc.generateIdeasWithPromptEngine(ctx, topic, user)
@@ -137,11 +153,17 @@ func (uc *GenerateIdeasUseCase) generateIdeasForTopicContext(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
+	ctx = interfaces.WithLLMCallInfo(ctx, interfaces.LLMCallInfo{PromptName: prompt.Name})
+	notifyPromptVersion(ctx, prompt.ID, prompt.Version)
 
 	ideaCount := uc.determineIdeaCount(topic.Ideas)
 	finalPrompt := uc.buildPromptWithVariablesFromTopic(prompt.PromptTemplate, topic, user, ideaCount)
 
-	ideaContents, err := uc.requestIdeasFromLLM(ctx, finalPrompt)
+	ideaContents, err := uc.requestIdeasFromLLM(ctx, interfaces.PromptRequest{
+		Prompt:     finalPrompt,
+		System:     prompt.SystemMessage,
+		Parameters: prompt.Parameters,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -177,6 +199,8 @@ func (uc *GenerateIdeasUseCase) generateIdeasForTopicContext(ctx context.Context
 		return nil, fmt.Errorf("no valid ideas could be created from LLM response")
 	}
 
+	stampIdeasPromptVersion(ideas, prompt.ID, prompt.Version)
+
 	if err := uc.ideasRepo.CreateBatch(ctx, ideas); err != nil {
 		return nil, fmt.Errorf("failed to save ideas: %w", err)
 	}
@@ -220,22 +244,24 @@ func (uc *GenerateIdeasUseCase) resolvePrompt(ctx context.Context, topic *entiti
 	return prompt, nil
 }
 
-func (uc *GenerateIdeasUseCase) requestIdeasFromLLM(ctx context.Context, prompt string) ([]string, error) {
-	response, err := uc.llmService.SendRequest(ctx, prompt)
-	if err != nil {
-		return nil, fmt.Errorf("LLM service error: %w", err)
+func (uc *GenerateIdeasUseCase) requestIdeasFromLLM(ctx context.Context, request interfaces.PromptRequest) ([]string, error) {
+	// Decode the ideas, repairing an invalid response once
+	var result struct {
+		Ideas []string `json:"ideas"`
 	}
-
-	ideaContents, err := uc.parseIdeasResponse(response)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse LLM response: %w", err)
+	if _, err := structured.Complete(ctx, uc.llmService, request, structured.IdeasSchema, &result); err != nil {
+		var llmRespErr *domainErrors.LLMResponseError
+		if errors.As(err, &llmRespErr) {
+			return nil, fmt.Errorf("failed to parse LLM response: %w", err)
+		}
+		return nil, fmt.Errorf("LLM service error: %w", err)
 	}
 
-	if len(ideaContents) == 0 {
-		return nil, fmt.Errorf("LLM generated no ideas")
+	if len(result.Ideas) == 0 {
+		return nil, fmt.Errorf("failed to parse LLM response: LLM returned empty ideas list")
 	}
 
-	return ideaContents, nil
+	return result.Ideas, nil
 }
 
 // validateInput validates the input parameters
@@ -332,7 +358,7 @@ func (uc *GenerateIdeasUseCase) generateIdeasWithPromptEngine(ctx context.Contex
 	}
 
 	// Process the prompt using PromptEngine
-	finalPrompt, err := uc.promptEngine.ProcessPrompt(
+	request, err := uc.promptEngine.ProcessPrompt(
 		ctx,
 		user.ID,
 		promptName,
@@ -344,9 +370,11 @@ func (uc *GenerateIdeasUseCase) generateIdeasWithPromptEngine(ctx context.Contex
 	if err != nil {
 		return nil, fmt.Errorf("failed to process prompt with PromptEngine: %w", err)
 	}
+	notifyPromptVersion(ctx, request.PromptID, request.PromptVersion)
 
-	// Request ideas from LLM
-	ideaContents, err := uc.requestIdeasFromLLM(ctx, finalPrompt)
+	// Request ideas from LLM with the prompt's system message and model parameters
+	ctx = interfaces.WithLLMCallInfo(ctx, interfaces.LLMCallInfo{PromptName: promptName})
+	ideaContents, err := uc.requestIdeasFromLLM(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -383,58 +411,11 @@ func (uc *GenerateIdeasUseCase) generateIdeasWithPromptEngine(ctx context.Contex
 		return nil, fmt.Errorf("no valid ideas could be created from LLM response")
 	}
 
+	stampIdeasPromptVersion(ideas, request.PromptID, request.PromptVersion)
+
 	if err := uc.ideasRepo.CreateBatch(ctx, ideas); err != nil {
 		return nil, fmt.Errorf("failed to save ideas: %w", err)
 	}
 
 	return ideas, nil
 }
-
-// parseIdeasResponse parses the JSON response from LLM
-func (uc *GenerateIdeasUseCase) parseIdeasResponse(response string) ([]string, error) {
-	// Clean the response from markdown code blocks if present
-	cleanedResponse := cleanJSONResponse(response)
-
-	var result struct {
-		Ideas []string `json:"ideas"`
-	}
-
-	if err := json.Unmarshal([]byte(cleanedResponse), &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON response: %w", err)
-	}
-
-	if len(result.Ideas) == 0 {
-		return nil, fmt.Errorf("LLM returned empty ideas list")
-	}
-
-	return result.Ideas, nil
-}
-
-// cleanJSONResponse removes markdown code blocks and extracts JSON
-func cleanJSONResponse(response string) string {
-	// Remove markdown code blocks (```json ... ``` or ``` ... ```)
-	response = strings.TrimSpace(response)
-
-	// Check if response starts with ``` and ends with ```
-	if strings.HasPrefix(response, "```") {
-		// Find the first newline after ```
-		start := strings.Index(response, "\n")
-		if start == -1 {
-			start = 3 // just skip ```
-		} else {
-			start++ // skip the newline
-		}
-
-		// Find the last ```
-		end := strings.LastIndex(response, "```")
-		if end > start {
-			response = response[start:end]
-		}
-	}
-
-	// Remove backticks at the beginning and end
-	response = strings.Trim(response, "`")
-	response = strings.TrimSpace(response)
-
-	return response
-}
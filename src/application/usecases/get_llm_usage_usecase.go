@@ -0,0 +1,217 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+)
+
+// DefaultLLMUsageWindow is the reporting window used when the input sets no start
+const DefaultLLMUsageWindow = 30 * 24 * time.Hour
+
+// ModelPrice is the price of a model in USD per million tokens
+type ModelPrice struct {
+	PromptPerMillion     float64
+	CompletionPerMillion float64
+}
+
+// GetLLMUsageUseCase aggregates the LLM token usage of a user and estimates its cost
+type GetLLMUsageUseCase struct {
+	usageRepo interfaces.LLMUsageRepository
+	userRepo  interfaces.UserRepository
+	prices    map[string]ModelPrice
+}
+
+// NewGetLLMUsageUseCase creates a new instance of GetLLMUsageUseCase.
+// Prices are keyed by model; a key also prices the dated versions it prefixes, e.g. gpt-4o prices gpt-4o-2024-08-06.
+func NewGetLLMUsageUseCase(
+	usageRepo interfaces.LLMUsageRepository,
+	userRepo interfaces.UserRepository,
+	prices map[string]ModelPrice,
+) *GetLLMUsageUseCase {
+	return &GetLLMUsageUseCase{
+		usageRepo: usageRepo,
+		userRepo:  userRepo,
+		prices:    prices,
+	}
+}
+
+// GetLLMUsageInput selects the calls to report; zero times default to the last 30 days
+type GetLLMUsageInput struct {
+	UserID string
+	JobID  string
+	From   time.Time
+	To     time.Time
+}
+
+// LLMUsageLine aggregates the calls of a model, a prompt or the whole report
+type LLMUsageLine struct {
+	Model            string
+	PromptName       string
+	Calls            int
+	PromptTokens     int
+	CompletionTokens int
+	AverageLatency   time.Duration
+	EstimatedCost    float64
+	// Priced is false when a model in the line has no price, making EstimatedCost a lower bound
+	Priced bool
+
+	totalLatency time.Duration
+}
+
+// TotalTokens returns prompt plus completion tokens
+func (l LLMUsageLine) TotalTokens() int {
+	return l.PromptTokens + l.CompletionTokens
+}
+
+// LLMUsageReport is the LLM usage of a user over a time range
+type LLMUsageReport struct {
+	UserID   string
+	JobID    string
+	From     time.Time
+	To       time.Time
+	Total    LLMUsageLine
+	ByModel  []LLMUsageLine
+	ByPrompt []LLMUsageLine
+}
+
+// Execute aggregates the usage of the user within [From, To)
+func (uc *GetLLMUsageUseCase) Execute(ctx context.Context, input GetLLMUsageInput) (*LLMUsageReport, error) {
+	input.UserID = strings.TrimSpace(input.UserID)
+	if _, err := findUser(ctx, uc.userRepo, input.UserID); err != nil {
+		return nil, err
+	}
+
+	if input.To.IsZero() {
+		input.To = time.Now().UTC()
+	}
+	if input.From.IsZero() {
+		input.From = input.To.Add(-DefaultLLMUsageWindow)
+	}
+	if !input.From.Before(input.To) {
+		return nil, domainErrors.NewValidationError("from", "from must be before to")
+	}
+
+	totals, err := uc.usageRepo.Aggregate(ctx, entities.LLMUsageFilter{
+		UserID: input.UserID,
+		JobID:  strings.TrimSpace(input.JobID),
+		From:   input.From,
+		To:     input.To,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate LLM usage: %w", err)
+	}
+
+	report := &LLMUsageReport{
+		UserID: input.UserID,
+		JobID:  input.JobID,
+		From:   input.From,
+		To:     input.To,
+		Total:  LLMUsageLine{Priced: true},
+	}
+
+	byModel := make(map[string]*LLMUsageLine)
+	byPrompt := make(map[string]*LLMUsageLine)
+	for _, t := range totals {
+		line := uc.price(t)
+
+		report.Total.add(line)
+		if _, ok := byModel[t.Model]; !ok {
+			byModel[t.Model] = &LLMUsageLine{Model: t.Model, Priced: true}
+		}
+		byModel[t.Model].add(line)
+		if _, ok := byPrompt[t.PromptName]; !ok {
+			byPrompt[t.PromptName] = &LLMUsageLine{PromptName: t.PromptName, Priced: true}
+		}
+		byPrompt[t.PromptName].add(line)
+	}
+
+	report.ByModel = sortedUsageLines(byModel)
+	report.ByPrompt = sortedUsageLines(byPrompt)
+	report.Total.finish()
+
+	return report, nil
+}
+
+// price turns aggregated totals into a line with its estimated cost
+func (uc *GetLLMUsageUseCase) price(t *entities.LLMUsageTotals) LLMUsageLine {
+	line := LLMUsageLine{
+		Model:            t.Model,
+		PromptName:       t.PromptName,
+		Calls:            t.Calls,
+		PromptTokens:     t.PromptTokens,
+		CompletionTokens: t.CompletionTokens,
+		totalLatency:     t.TotalLatency,
+	}
+
+	price, ok := uc.priceOf(t.Model)
+	if ok {
+		line.Priced = true
+		line.EstimatedCost = (float64(t.PromptTokens)*price.PromptPerMillion +
+			float64(t.CompletionTokens)*price.CompletionPerMillion) / 1_000_000
+	}
+
+	return line
+}
+
+// priceOf looks up the price of a model, falling back to the longest key it starts with
+func (uc *GetLLMUsageUseCase) priceOf(model string) (ModelPrice, bool) {
+	if price, ok := uc.prices[model]; ok {
+		return price, true
+	}
+
+	var best string
+	for key := range uc.prices {
+		if strings.HasPrefix(model, key) && len(key) > len(best) {
+			best = key
+		}
+	}
+	if best == "" {
+		return ModelPrice{}, false
+	}
+	return uc.prices[best], true
+}
+
+// add accumulates another line into l
+func (l *LLMUsageLine) add(other LLMUsageLine) {
+	l.Calls += other.Calls
+	l.PromptTokens += other.PromptTokens
+	l.CompletionTokens += other.CompletionTokens
+	l.totalLatency += other.totalLatency
+	l.EstimatedCost += other.EstimatedCost
+	l.Priced = l.Priced && other.Priced
+}
+
+// finish computes the derived fields of an accumulated line
+func (l *LLMUsageLine) finish() {
+	if l.Calls > 0 {
+		l.AverageLatency = l.totalLatency / time.Duration(l.Calls)
+	}
+}
+
+// sortedUsageLines returns the lines by descending cost, then descending tokens
+func sortedUsageLines(lines map[string]*LLMUsageLine) []LLMUsageLine {
+	sorted := make([]LLMUsageLine, 0, len(lines))
+	for _, line := range lines {
+		line.finish()
+		sorted = append(sorted, *line)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].EstimatedCost != sorted[j].EstimatedCost {
+			return sorted[i].EstimatedCost > sorted[j].EstimatedCost
+		}
+		if sorted[i].TotalTokens() != sorted[j].TotalTokens() {
+			return sorted[i].TotalTokens() > sorted[j].TotalTokens()
+		}
+		return sorted[i].Model+sorted[i].PromptName < sorted[j].Model+sorted[j].PromptName
+	})
+
+	return sorted
+}
@@ -0,0 +1,16 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+)
+
+// checkLLMQuota checks the user's LLM budget before a call.
+// Quota errors are returned unwrapped so handlers can map them to 429.
+func checkLLMQuota(ctx context.Context, checker interfaces.LLMQuotaChecker, userID string) error {
+	if checker == nil {
+		return nil
+	}
+	return checker.CheckLLMQuota(ctx, userID)
+}
@@ -0,0 +1,318 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Draft metadata keys recording the experiment arm that generated a draft
+const (
+	DraftMetadataExperimentID  = "experiment_id"
+	DraftMetadataExperimentArm = "experiment_arm"
+)
+
+// ManageExperimentsUseCase creates, lists and updates prompt experiments and compares their arms
+type ManageExperimentsUseCase struct {
+	experimentRepo interfaces.ExperimentRepository
+	promptsRepo    interfaces.PromptsRepository
+	draftRepo      interfaces.DraftRepository
+	userRepo       interfaces.UserRepository
+}
+
+// NewManageExperimentsUseCase creates a new instance of ManageExperimentsUseCase
+func NewManageExperimentsUseCase(
+	experimentRepo interfaces.ExperimentRepository,
+	promptsRepo interfaces.PromptsRepository,
+	draftRepo interfaces.DraftRepository,
+	userRepo interfaces.UserRepository,
+) *ManageExperimentsUseCase {
+	return &ManageExperimentsUseCase{
+		experimentRepo: experimentRepo,
+		promptsRepo:    promptsRepo,
+		draftRepo:      draftRepo,
+		userRepo:       userRepo,
+	}
+}
+
+// ExperimentInput represents the editable fields of an experiment
+type ExperimentInput struct {
+	UserID string
+	Name   string
+	Arms   []entities.ExperimentArm
+	// Active defaults to true on create and keeps the current value on update
+	Active *bool
+}
+
+// ExperimentArmResult compares the drafts generated by one arm of an experiment
+type ExperimentArmResult struct {
+	PromptName string
+	Weight     int
+	Drafts     int
+	// Refinements is the total number of refinements of the arm's drafts
+	Refinements    int
+	AvgRefinements float64
+	Published      int
+	PublishRate    float64
+	// Engagement is nil until LinkedIn engagement of published drafts is tracked
+	Engagement *float64
+}
+
+// ExperimentResults compares the arms of an experiment
+type ExperimentResults struct {
+	Experiment *entities.Experiment
+	Arms       []ExperimentArmResult
+}
+
+// List returns all experiments of a user
+func (uc *ManageExperimentsUseCase) List(ctx context.Context, userID string) ([]*entities.Experiment, error) {
+	userID = strings.TrimSpace(userID)
+	if _, err := findUser(ctx, uc.userRepo, userID); err != nil {
+		return nil, err
+	}
+
+	experiments, err := uc.experimentRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list experiments: %w", err)
+	}
+
+	return experiments, nil
+}
+
+// Get returns a single experiment owned by the user
+func (uc *ManageExperimentsUseCase) Get(ctx context.Context, userID, experimentID string) (*entities.Experiment, error) {
+	return uc.findOwnedExperiment(ctx, strings.TrimSpace(userID), strings.TrimSpace(experimentID))
+}
+
+// Create creates a draft generation experiment between the user's prompts
+func (uc *ManageExperimentsUseCase) Create(ctx context.Context, input ExperimentInput) (*entities.Experiment, error) {
+	input.UserID = strings.TrimSpace(input.UserID)
+	if _, err := findUser(ctx, uc.userRepo, input.UserID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	experiment := &entities.Experiment{
+		ID:        primitive.NewObjectID().Hex(),
+		UserID:    input.UserID,
+		Type:      entities.PromptTypeDrafts,
+		Active:    true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := uc.applyExperimentInput(ctx, experiment, input, now); err != nil {
+		return nil, err
+	}
+
+	id, err := uc.experimentRepo.Create(ctx, experiment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create experiment: %w", err)
+	}
+	experiment.ID = id
+
+	return experiment, nil
+}
+
+// Update replaces the name, arms and state of an experiment owned by the user
+func (uc *ManageExperimentsUseCase) Update(ctx context.Context, experimentID string, input ExperimentInput) (*entities.Experiment, error) {
+	input.UserID = strings.TrimSpace(input.UserID)
+	experiment, err := uc.findOwnedExperiment(ctx, input.UserID, strings.TrimSpace(experimentID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.applyExperimentInput(ctx, experiment, input, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.experimentRepo.Update(ctx, experiment); err != nil {
+		if errors.Is(err, database.ErrEntityNotFound) {
+			return nil, domainErrors.NewExperimentNotFound(experiment.ID)
+		}
+		return nil, fmt.Errorf("failed to update experiment: %w", err)
+	}
+
+	return experiment, nil
+}
+
+// Results compares the arms of an experiment owned by the user on the drafts they generated
+func (uc *ManageExperimentsUseCase) Results(ctx context.Context, userID, experimentID string) (*ExperimentResults, error) {
+	experiment, err := uc.findOwnedExperiment(ctx, strings.TrimSpace(userID), strings.TrimSpace(experimentID))
+	if err != nil {
+		return nil, err
+	}
+
+	drafts, err := uc.draftRepo.ListByUserID(ctx, experiment.UserID, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list drafts: %w", err)
+	}
+
+	return &ExperimentResults{
+		Experiment: experiment,
+		Arms:       compareExperimentArms(experiment, drafts),
+	}, nil
+}
+
+// findOwnedExperiment loads an experiment and verifies it belongs to the user
+func (uc *ManageExperimentsUseCase) findOwnedExperiment(ctx context.Context, userID, experimentID string) (*entities.Experiment, error) {
+	if userID == "" {
+		return nil, domainErrors.NewValidationError("user_id", "user ID cannot be empty")
+	}
+	if experimentID == "" {
+		return nil, domainErrors.NewValidationError("experiment_id", "experiment ID cannot be empty")
+	}
+
+	experiment, err := uc.experimentRepo.FindByID(ctx, experimentID)
+	if err != nil {
+		if errors.Is(err, database.ErrEntityNotFound) {
+			return nil, domainErrors.NewExperimentNotFound(experimentID)
+		}
+		if errors.Is(err, database.ErrInvalidID) {
+			return nil, domainErrors.NewValidationError("experiment_id", "invalid experiment ID")
+		}
+		return nil, fmt.Errorf("failed to retrieve experiment: %w", err)
+	}
+	if experiment == nil {
+		return nil, domainErrors.NewExperimentNotFound(experimentID)
+	}
+
+	if experiment.UserID != userID {
+		return nil, domainErrors.NewUnauthorizedAccess(userID, "experiment", experiment.ID)
+	}
+
+	return experiment, nil
+}
+
+// applyExperimentInput copies the input onto the experiment and validates it, its prompts
+// and that the user has no other active experiment of the same type
+func (uc *ManageExperimentsUseCase) applyExperimentInput(ctx context.Context, experiment *entities.Experiment, input ExperimentInput, now time.Time) error {
+	experiment.Name = strings.TrimSpace(input.Name)
+	experiment.Arms = make([]entities.ExperimentArm, len(input.Arms))
+	for i, arm := range input.Arms {
+		experiment.Arms[i] = entities.ExperimentArm{PromptName: strings.TrimSpace(arm.PromptName), Weight: arm.Weight}
+	}
+	if input.Active != nil {
+		experiment.Active = *input.Active
+	}
+	experiment.UpdatedAt = now
+
+	if err := experiment.Validate(); err != nil {
+		return domainErrors.NewValidationError("experiment", err.Error())
+	}
+
+	for _, arm := range experiment.Arms {
+		prompt, err := uc.promptsRepo.FindByName(ctx, experiment.UserID, arm.PromptName)
+		if err != nil {
+			return fmt.Errorf("failed to find prompt %s: %w", arm.PromptName, err)
+		}
+		if prompt == nil || prompt.Type != experiment.Type {
+			return domainErrors.NewValidationError("arms", fmt.Sprintf("no %s prompt named %s", experiment.Type, arm.PromptName))
+		}
+	}
+
+	if !experiment.Active {
+		return nil
+	}
+
+	running, err := uc.experimentRepo.FindActiveByUserIDAndType(ctx, experiment.UserID, experiment.Type)
+	if err != nil {
+		return fmt.Errorf("failed to find active experiment: %w", err)
+	}
+	if running != nil && running.ID != experiment.ID {
+		return domainErrors.NewValidationError("active", fmt.Sprintf("experiment %s is already active", running.ID))
+	}
+
+	return nil
+}
+
+// compareExperimentArms aggregates the drafts of each arm, including arms since removed
+func compareExperimentArms(experiment *entities.Experiment, drafts []*entities.Draft) []ExperimentArmResult {
+	results := make([]ExperimentArmResult, 0, len(experiment.Arms))
+	index := make(map[string]int, len(experiment.Arms))
+	for _, arm := range experiment.Arms {
+		index[arm.PromptName] = len(results)
+		results = append(results, ExperimentArmResult{PromptName: arm.PromptName, Weight: arm.Weight})
+	}
+
+	for _, draft := range drafts {
+		if id, _ := draft.Metadata[DraftMetadataExperimentID].(string); id != experiment.ID {
+			continue
+		}
+		name, _ := draft.Metadata[DraftMetadataExperimentArm].(string)
+
+		i, ok := index[name]
+		if !ok {
+			i = len(results)
+			index[name] = i
+			results = append(results, ExperimentArmResult{PromptName: name})
+		}
+
+		result := &results[i]
+		result.Drafts++
+		result.Refinements += len(draft.RefinementHistory)
+		if draft.Status == entities.DraftStatusPublished {
+			result.Published++
+		}
+	}
+
+	for i := range results {
+		if results[i].Drafts > 0 {
+			results[i].AvgRefinements = float64(results[i].Refinements) / float64(results[i].Drafts)
+			results[i].PublishRate = float64(results[i].Published) / float64(results[i].Drafts)
+		}
+	}
+
+	return results
+}
+
+// pickExperimentArm picks the arm of experiment for the next generation by weight, among the
+// arms whose prompt is active. It returns false when none of them can be picked.
+func pickExperimentArm(experiment *entities.Experiment, activePrompts []*entities.Prompt, intn func(int) int) (entities.ExperimentArm, bool) {
+	active := make(map[string]bool, len(activePrompts))
+	for _, prompt := range activePrompts {
+		active[prompt.Name] = true
+	}
+
+	candidates := entities.Experiment{}
+	for _, arm := range experiment.Arms {
+		if arm.Weight > 0 && active[arm.PromptName] {
+			candidates.Arms = append(candidates.Arms, arm)
+		}
+	}
+
+	total := candidates.TotalWeight()
+	if total == 0 {
+		return entities.ExperimentArm{}, false
+	}
+	return candidates.PickArm(intn(total)), true
+}
+
+// hashIntn returns an intn that always maps key to the same value in [0, n)
+func hashIntn(key string) func(int) int {
+	return func(n int) int {
+		h := fnv.New32a()
+		h.Write([]byte(key))
+		return int(h.Sum32() % uint32(n))
+	}
+}
+
+// stampDraftsExperimentArm records the experiment arm that generated drafts in their metadata
+func stampDraftsExperimentArm(drafts []*entities.Draft, experimentID string, arm entities.ExperimentArm) {
+	for _, draft := range drafts {
+		if draft.Metadata == nil {
+			draft.Metadata = make(map[string]interface{})
+		}
+		draft.Metadata[DraftMetadataExperimentID] = experimentID
+		draft.Metadata[DraftMetadataExperimentArm] = arm.PromptName
+	}
+}
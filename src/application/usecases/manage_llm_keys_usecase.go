@@ -0,0 +1,138 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+)
+
+// SecretEncrypter encrypts secrets before they are stored
+type SecretEncrypter interface {
+	EncryptSecret(plaintext string) (string, error)
+}
+
+// ManageLLMKeysUseCase stores the API keys users bring for LLM providers.
+// Keys are encrypted before they reach the repository and are never returned.
+type ManageLLMKeysUseCase struct {
+	userRepo  interfaces.UserRepository
+	encrypter SecretEncrypter
+	providers map[string]bool
+}
+
+// NewManageLLMKeysUseCase creates a new instance of ManageLLMKeysUseCase.
+// providers lists the provider names a key can be stored for.
+func NewManageLLMKeysUseCase(userRepo interfaces.UserRepository, encrypter SecretEncrypter, providers []string) *ManageLLMKeysUseCase {
+	supported := make(map[string]bool, len(providers))
+	for _, provider := range providers {
+		supported[strings.ToLower(provider)] = true
+	}
+
+	return &ManageLLMKeysUseCase{
+		userRepo:  userRepo,
+		encrypter: encrypter,
+		providers: supported,
+	}
+}
+
+// SetLLMKeyInput represents the key a user brings for a provider
+type SetLLMKeyInput struct {
+	UserID   string
+	Provider string
+	APIKey   string
+}
+
+// List returns the providers the user has stored a key for
+func (uc *ManageLLMKeysUseCase) List(ctx context.Context, userID string) ([]string, error) {
+	user, err := findUser(ctx, uc.userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := make([]string, 0, len(user.APIKeys))
+	for provider, key := range user.APIKeys {
+		if uc.providers[provider] && strings.TrimSpace(key) != "" {
+			providers = append(providers, provider)
+		}
+	}
+	sort.Strings(providers)
+
+	return providers, nil
+}
+
+// Set encrypts and stores the user's key for a provider, replacing any previous one
+func (uc *ManageLLMKeysUseCase) Set(ctx context.Context, input SetLLMKeyInput) error {
+	provider, err := uc.validateProvider(input.Provider)
+	if err != nil {
+		return err
+	}
+
+	apiKey := strings.TrimSpace(input.APIKey)
+	if apiKey == "" {
+		return domainErrors.NewValidationError("api_key", "API key cannot be empty")
+	}
+
+	user, err := findUser(ctx, uc.userRepo, input.UserID)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := uc.encrypter.EncryptSecret(apiKey)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt %s API key: %w", provider, err)
+	}
+
+	updates := map[string]interface{}{
+		"api_keys." + provider: encrypted,
+	}
+	// A user created without keys has a null api_keys field, which can't be set into
+	if user.APIKeys == nil {
+		updates = map[string]interface{}{
+			"api_keys": map[string]string{provider: encrypted},
+		}
+	}
+	if err := uc.userRepo.Update(ctx, input.UserID, updates); err != nil {
+		return fmt.Errorf("failed to store %s API key: %w", provider, err)
+	}
+
+	return nil
+}
+
+// Delete removes the user's key for a provider, so their calls use the platform key again
+func (uc *ManageLLMKeysUseCase) Delete(ctx context.Context, userID, provider string) error {
+	provider, err := uc.validateProvider(provider)
+	if err != nil {
+		return err
+	}
+
+	user, err := findUser(ctx, uc.userRepo, userID)
+	if err != nil {
+		return err
+	}
+	if user.APIKeys[provider] == "" {
+		return nil
+	}
+
+	// An empty key reads as no key
+	updates := map[string]interface{}{
+		"api_keys." + provider: "",
+	}
+	if err := uc.userRepo.Update(ctx, userID, updates); err != nil {
+		return fmt.Errorf("failed to delete %s API key: %w", provider, err)
+	}
+
+	return nil
+}
+
+// validateProvider normalizes the provider name; only known names reach the document path
+func (uc *ManageLLMKeysUseCase) validateProvider(provider string) (string, error) {
+	provider = strings.ToLower(strings.TrimSpace(provider))
+	if !uc.providers[provider] {
+		return "", domainErrors.NewValidationError("provider", fmt.Sprintf("unsupported LLM provider %q", provider))
+	}
+
+	return provider, nil
+}
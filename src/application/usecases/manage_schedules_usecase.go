@@ -0,0 +1,183 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ManageSchedulesUseCase creates, lists, updates and deletes per-user cron schedules
+type ManageSchedulesUseCase struct {
+	scheduleRepo interfaces.ScheduleRepository
+	userRepo     interfaces.UserRepository
+}
+
+// NewManageSchedulesUseCase creates a new instance of ManageSchedulesUseCase
+func NewManageSchedulesUseCase(scheduleRepo interfaces.ScheduleRepository, userRepo interfaces.UserRepository) *ManageSchedulesUseCase {
+	return &ManageSchedulesUseCase{
+		scheduleRepo: scheduleRepo,
+		userRepo:     userRepo,
+	}
+}
+
+// ScheduleInput represents the editable fields of a schedule
+type ScheduleInput struct {
+	UserID         string
+	Name           string
+	CronExpression string
+	TimeZone       string
+	Actions        []entities.ScheduleAction
+	// Active defaults to true on create and keeps the current value on update
+	Active *bool
+}
+
+// List returns all schedules of a user
+func (uc *ManageSchedulesUseCase) List(ctx context.Context, userID string) ([]*entities.Schedule, error) {
+	userID = strings.TrimSpace(userID)
+	if _, err := findUser(ctx, uc.userRepo, userID); err != nil {
+		return nil, err
+	}
+
+	schedules, err := uc.scheduleRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedules: %w", err)
+	}
+
+	return schedules, nil
+}
+
+// Get returns a single schedule owned by the user
+func (uc *ManageSchedulesUseCase) Get(ctx context.Context, userID, scheduleID string) (*entities.Schedule, error) {
+	return uc.findOwnedSchedule(ctx, strings.TrimSpace(userID), strings.TrimSpace(scheduleID))
+}
+
+// Create creates a schedule and computes its first run
+func (uc *ManageSchedulesUseCase) Create(ctx context.Context, input ScheduleInput) (*entities.Schedule, error) {
+	input.UserID = strings.TrimSpace(input.UserID)
+	if _, err := findUser(ctx, uc.userRepo, input.UserID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	schedule := &entities.Schedule{
+		ID:        primitive.NewObjectID().Hex(),
+		UserID:    input.UserID,
+		Active:    true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := applyScheduleInput(schedule, input, now); err != nil {
+		return nil, err
+	}
+
+	id, err := uc.scheduleRepo.Create(ctx, schedule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create schedule: %w", err)
+	}
+	schedule.ID = id
+
+	return schedule, nil
+}
+
+// Update replaces the editable fields of a schedule owned by the user and
+// recomputes its next run
+func (uc *ManageSchedulesUseCase) Update(ctx context.Context, scheduleID string, input ScheduleInput) (*entities.Schedule, error) {
+	input.UserID = strings.TrimSpace(input.UserID)
+	schedule, err := uc.findOwnedSchedule(ctx, input.UserID, strings.TrimSpace(scheduleID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyScheduleInput(schedule, input, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.scheduleRepo.Update(ctx, schedule); err != nil {
+		if errors.Is(err, database.ErrEntityNotFound) {
+			return nil, domainErrors.NewScheduleNotFound(schedule.ID)
+		}
+		return nil, fmt.Errorf("failed to update schedule: %w", err)
+	}
+
+	return schedule, nil
+}
+
+// Delete removes a schedule owned by the user
+func (uc *ManageSchedulesUseCase) Delete(ctx context.Context, userID, scheduleID string) error {
+	schedule, err := uc.findOwnedSchedule(ctx, strings.TrimSpace(userID), strings.TrimSpace(scheduleID))
+	if err != nil {
+		return err
+	}
+
+	if err := uc.scheduleRepo.Delete(ctx, schedule.ID); err != nil {
+		if errors.Is(err, database.ErrEntityNotFound) {
+			return domainErrors.NewScheduleNotFound(schedule.ID)
+		}
+		return fmt.Errorf("failed to delete schedule: %w", err)
+	}
+
+	return nil
+}
+
+// findOwnedSchedule loads a schedule and verifies it belongs to the user
+func (uc *ManageSchedulesUseCase) findOwnedSchedule(ctx context.Context, userID, scheduleID string) (*entities.Schedule, error) {
+	if userID == "" {
+		return nil, domainErrors.NewValidationError("user_id", "user ID cannot be empty")
+	}
+	if scheduleID == "" {
+		return nil, domainErrors.NewValidationError("schedule_id", "schedule ID cannot be empty")
+	}
+
+	schedule, err := uc.scheduleRepo.FindByID(ctx, scheduleID)
+	if err != nil {
+		if errors.Is(err, database.ErrEntityNotFound) {
+			return nil, domainErrors.NewScheduleNotFound(scheduleID)
+		}
+		if errors.Is(err, database.ErrInvalidID) {
+			return nil, domainErrors.NewValidationError("schedule_id", "invalid schedule ID")
+		}
+		return nil, fmt.Errorf("failed to retrieve schedule: %w", err)
+	}
+	if schedule == nil {
+		return nil, domainErrors.NewScheduleNotFound(scheduleID)
+	}
+
+	if schedule.UserID != userID {
+		return nil, domainErrors.NewUnauthorizedAccess(userID, "schedule", schedule.ID)
+	}
+
+	return schedule, nil
+}
+
+// applyScheduleInput copies the input onto the schedule, validates it and
+// computes the next run after now
+func applyScheduleInput(schedule *entities.Schedule, input ScheduleInput, now time.Time) error {
+	schedule.Name = strings.TrimSpace(input.Name)
+	schedule.CronExpression = input.CronExpression
+	schedule.TimeZone = input.TimeZone
+	schedule.Actions = input.Actions
+	if input.Active != nil {
+		schedule.Active = *input.Active
+	}
+	schedule.UpdatedAt = now
+	schedule.SetDefaults()
+
+	if err := schedule.Validate(); err != nil {
+		return domainErrors.NewValidationError("schedule", err.Error())
+	}
+
+	if err := schedule.ScheduleNext(now); err != nil {
+		return domainErrors.NewValidationError("cron_expression", err.Error())
+	}
+
+	return nil
+}
@@ -6,8 +6,16 @@
 // - GenerateIdeasUseCase: Automated periodic idea generation from topics
 // - GenerateDraftsUseCase: Create drafts from ideas (5 posts + 1 article)
 // - RefineDraftUseCase: Refine existing drafts with user feedback
+// - PublishDraftUseCase: Publish drafts to LinkedIn and record failures
+// - ScheduleDraftUseCase: Schedule drafts for automatic publishing
+// - StartLinkedInAuthUseCase / CompleteLinkedInAuthUseCase: Connect a LinkedIn account via OAuth2
+// - RefreshLinkedInTokenUseCase: Renew LinkedIn access tokens before they expire
+// - ManageSchedulesUseCase: Create, list, update and delete per-user cron schedules
+// - ManageExperimentsUseCase: Create, list and update prompt A/B experiments and compare their arms
+// - RunScheduledActionUseCase: Execute the actions fired by a schedule
 // - ListIdeasUseCase: List accumulated ideas with optional filters
 // - ClearIdeasUseCase: Clear accumulated ideas for a user
+// - GetLLMUsageUseCase: Aggregate LLM token usage, latency and estimated cost per user
 //
 // Each use case follows Clean Architecture principles:
 // - Depends only on domain interfaces and entities
@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+)
+
+// Draft metadata keys of the prompt version that generated a draft
+const (
+	DraftMetadataPromptID      = "prompt_id"
+	DraftMetadataPromptVersion = "prompt_version"
+)
+
+// notifyPromptVersion reports the stored prompt version a generation uses; built-in default
+// prompts have no version and aren't reported
+func notifyPromptVersion(ctx context.Context, promptID string, version int) {
+	if promptID == "" {
+		return
+	}
+	interfaces.NotifyPromptVersion(ctx, promptID, version)
+}
+
+// stampIdeasPromptVersion records the prompt version that generated ideas
+func stampIdeasPromptVersion(ideas []*entities.Idea, promptID string, version int) {
+	for _, idea := range ideas {
+		idea.PromptID = promptID
+		idea.PromptVersion = version
+	}
+}
+
+// stampDraftsPromptVersion records the prompt version that generated drafts in their metadata
+func stampDraftsPromptVersion(drafts []*entities.Draft, promptID string, version int) {
+	if promptID == "" {
+		return
+	}
+	for _, draft := range drafts {
+		if draft.Metadata == nil {
+			draft.Metadata = make(map[string]interface{})
+		}
+		draft.Metadata[DraftMetadataPromptID] = promptID
+		draft.Metadata[DraftMetadataPromptVersion] = version
+	}
+}
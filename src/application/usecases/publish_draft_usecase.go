@@ -0,0 +1,389 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/database"
+)
+
+const (
+	// failureRecordTimeout bounds how long recording a publish failure may take
+	failureRecordTimeout = 5 * time.Second
+
+	// publishedSaveAttempts is how many times the result of a publish accepted by LinkedIn
+	// is saved before giving up, and publishedSaveRetryDelay the wait before the first retry
+	publishedSaveAttempts   = 3
+	publishedSaveRetryDelay = 100 * time.Millisecond
+	publishedSaveTimeout    = 10 * time.Second
+
+	// publishClaimLease is how long a PUBLISHING claim holds the draft. Once it expires
+	// the scheduled publisher takes the draft back, e.g. after a crash mid-publish.
+	publishClaimLease = 5 * time.Minute
+	publishLeaseField = "publish_lease_until"
+)
+
+// PublishDraftUseCase orchestrates publishing a draft to LinkedIn
+type PublishDraftUseCase struct {
+	draftRepo       interfaces.DraftRepository
+	userRepo        interfaces.UserRepository
+	ideasRepo       interfaces.IdeasRepository
+	linkedInService interfaces.LinkedInService
+	authService     interfaces.LinkedInAuthService
+}
+
+// NewPublishDraftUseCase creates a new instance of PublishDraftUseCase.
+// authService is optional; without it expired tokens are not refreshed.
+func NewPublishDraftUseCase(
+	draftRepo interfaces.DraftRepository,
+	userRepo interfaces.UserRepository,
+	ideasRepo interfaces.IdeasRepository,
+	linkedInService interfaces.LinkedInService,
+	authService interfaces.LinkedInAuthService,
+) *PublishDraftUseCase {
+	return &PublishDraftUseCase{
+		draftRepo:       draftRepo,
+		userRepo:        userRepo,
+		ideasRepo:       ideasRepo,
+		linkedInService: linkedInService,
+		authService:     authService,
+	}
+}
+
+// PublishDraftInput represents input for draft publishing
+type PublishDraftInput struct {
+	DraftID string
+	UserID  string
+}
+
+// Execute publishes a draft to LinkedIn on behalf of its owner
+func (uc *PublishDraftUseCase) Execute(ctx context.Context, input PublishDraftInput) (*entities.Draft, error) {
+	input = PublishDraftInput{
+		DraftID: strings.TrimSpace(input.DraftID),
+		UserID:  strings.TrimSpace(input.UserID),
+	}
+
+	// Validate input
+	if err := uc.validateInput(input); err != nil {
+		return nil, err
+	}
+
+	// Get draft from repository
+	draft, err := uc.draftRepo.FindByID(ctx, input.DraftID)
+	if err != nil {
+		if errors.Is(err, database.ErrEntityNotFound) {
+			return nil, domainErrors.NewDraftNotFound(input.DraftID)
+		}
+		if errors.Is(err, database.ErrInvalidID) {
+			return nil, domainErrors.NewValidationError("draft_id", "invalid draft ID")
+		}
+		return nil, fmt.Errorf("failed to retrieve draft: %w", err)
+	}
+	if draft == nil {
+		return nil, domainErrors.NewDraftNotFound(input.DraftID)
+	}
+
+	// Verify ownership
+	if draft.UserID != input.UserID {
+		return nil, domainErrors.NewUnauthorizedAccess(input.UserID, "draft", draft.ID)
+	}
+
+	// Verify draft is publishable
+	if draft.Status == entities.DraftStatusPublished {
+		return nil, domainErrors.NewDraftAlreadyPublished(draft.ID, draft.LinkedInPostID)
+	}
+	if draft.Status == entities.DraftStatusPublishing {
+		return nil, domainErrors.NewDraftPublishInProgress(draft.ID)
+	}
+	if err := draft.CanBePublished(); err != nil {
+		return nil, domainErrors.NewValidationError("draft", err.Error())
+	}
+
+	// Get user and verify publishing credentials
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("user not found: %s", input.UserID)
+	}
+
+	// An expired token is refreshed up front rather than waiting for LinkedIn to reject it
+	if user.IsLinkedInTokenExpired(time.Now()) && uc.canRefresh(user) {
+		if err := refreshLinkedInCredentials(ctx, uc.userRepo, uc.authService, user); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.validatePublisher(user); err != nil {
+		return nil, err
+	}
+
+	// Take the draft before calling LinkedIn, so a manual publish and the scheduled
+	// publisher can never both post it
+	claimedFrom := draft.Status
+	if err := uc.claimDraft(ctx, draft); err != nil {
+		return nil, err
+	}
+
+	// Publish according to draft type
+	response, err := uc.publishToLinkedIn(ctx, draft, user.LinkedInToken)
+	if isLinkedInTokenRejected(err) && uc.canRefresh(user) {
+		// The token may have been revoked or expired early; retry once with a fresh one
+		if refreshErr := refreshLinkedInCredentials(ctx, uc.userRepo, uc.authService, user); refreshErr == nil {
+			response, err = uc.publishToLinkedIn(ctx, draft, user.LinkedInToken)
+		}
+	}
+	if err != nil {
+		return nil, uc.recordFailure(ctx, draft, claimedFrom, err)
+	}
+
+	if err := draft.MarkAsPublished(response.ID); err != nil {
+		uc.releaseClaim(ctx, draft, claimedFrom)
+		return nil, domainErrors.NewValidationError("linkedin_post_id", err.Error())
+	}
+
+	if err := uc.savePublishedDraft(ctx, draft); err != nil {
+		return nil, err
+	}
+
+	// Mark the source idea; the post is already live so this is best effort
+	if err := uc.markIdeaAsPublished(ctx, draft); err != nil {
+		return draft, fmt.Errorf("draft published but failed to mark idea as published: %w", err)
+	}
+
+	return draft, nil
+}
+
+// validateInput validates publish input
+func (uc *PublishDraftUseCase) validateInput(input PublishDraftInput) error {
+	if input.DraftID == "" {
+		return domainErrors.NewValidationError("draft_id", "draft ID cannot be empty")
+	}
+
+	if input.UserID == "" {
+		return domainErrors.NewValidationError("user_id", "user ID cannot be empty")
+	}
+
+	return nil
+}
+
+// validatePublisher verifies the user is allowed to publish to LinkedIn
+func (uc *PublishDraftUseCase) validatePublisher(user *entities.User) error {
+	if reason := user.PublishBlockedReason(); reason != "" {
+		return domainErrors.NewInvalidUserCredentials(user.ID, reason)
+	}
+
+	return nil
+}
+
+// canRefresh checks if the user's LinkedIn token can be refreshed
+func (uc *PublishDraftUseCase) canRefresh(user *entities.User) bool {
+	return uc.authService != nil &&
+		user.IsActive() &&
+		user.LinkedInRefreshToken != "" &&
+		user.LinkedInTokenInvalidReason == ""
+}
+
+// isLinkedInTokenRejected checks if LinkedIn refused the access token
+func isLinkedInTokenRejected(err error) bool {
+	var apiErr *domainErrors.LinkedInAPIError
+	return errors.As(err, &apiErr) && apiErr.Reason == domainErrors.LinkedInReasonTokenInvalid
+}
+
+// claimDraft moves the draft to PUBLISHING if it is still in the status it was read with.
+// The claim is leased until the publish can no longer be running.
+func (uc *PublishDraftUseCase) claimDraft(ctx context.Context, draft *entities.Draft) error {
+	updates := map[string]interface{}{
+		"status":          entities.DraftStatusPublishing,
+		publishLeaseField: claimLeaseUntil(ctx, time.Now()),
+	}
+
+	claimed, err := uc.draftRepo.UpdateIfStatus(ctx, draft.ID, draft.Status, updates)
+	if err != nil {
+		return fmt.Errorf("failed to claim draft for publishing: %w", err)
+	}
+	if !claimed {
+		// Another publisher took the draft, or it was edited, since it was read
+		return domainErrors.NewDraftPublishInProgress(draft.ID)
+	}
+
+	draft.Status = entities.DraftStatusPublishing
+	return nil
+}
+
+// claimLeaseUntil returns when a claim taken at now expires. A caller deadline beyond
+// publishClaimLease extends it, as saving the outcome may outlive the deadline.
+func claimLeaseUntil(ctx context.Context, now time.Time) time.Time {
+	leaseUntil := now.Add(publishClaimLease)
+	if deadline, ok := ctx.Deadline(); ok {
+		if end := deadline.Add(publishedSaveTimeout); end.After(leaseUntil) {
+			leaseUntil = end
+		}
+	}
+	return leaseUntil
+}
+
+// releaseClaim hands a draft nothing was posted for back in the status it was claimed from.
+// If that fails too, the scheduled publisher takes the draft back once the lease expires.
+func (uc *PublishDraftUseCase) releaseClaim(ctx context.Context, draft *entities.Draft, status entities.DraftStatus) {
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), failureRecordTimeout)
+	defer cancel()
+
+	updates := map[string]interface{}{
+		"status":          status,
+		publishLeaseField: nil,
+	}
+	if released, err := uc.draftRepo.UpdateIfStatus(saveCtx, draft.ID, entities.DraftStatusPublishing, updates); err == nil && released {
+		draft.Status = status
+	}
+}
+
+// publishToLinkedIn calls the LinkedIn API matching the draft type
+func (uc *PublishDraftUseCase) publishToLinkedIn(ctx context.Context, draft *entities.Draft, accessToken string) (*interfaces.LinkedInPostResponse, error) {
+	var (
+		response *interfaces.LinkedInPostResponse
+		err      error
+	)
+
+	switch draft.Type {
+	case entities.DraftTypePost:
+		response, err = uc.linkedInService.PublishPost(ctx, draft.Content, accessToken)
+	case entities.DraftTypeArticle:
+		response, err = uc.linkedInService.PublishArticle(ctx, draft.Title, draft.Content, accessToken)
+	default:
+		return nil, domainErrors.NewInvalidDraftType(string(draft.Type))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response == nil || strings.TrimSpace(response.ID) == "" {
+		return nil, domainErrors.NewLinkedInAPIError("publish", domainErrors.LinkedInReasonInvalidResponse, 0, "LinkedIn returned no post ID", nil)
+	}
+
+	return response, nil
+}
+
+// recordFailure stores the failure reason on the draft and returns the error to surface.
+// claimedFrom is the status the draft is released to when LinkedIn was never called.
+func (uc *PublishDraftUseCase) recordFailure(ctx context.Context, draft *entities.Draft, claimedFrom entities.DraftStatus, publishErr error) error {
+	var invalidType *domainErrors.ErrInvalidDraftType
+	if errors.As(publishErr, &invalidType) {
+		uc.releaseClaim(ctx, draft, claimedFrom)
+		return publishErr
+	}
+
+	apiErr := classifyPublishError(publishErr)
+	draft.MarkAsPublishFailed(string(apiErr.Reason), publishErr.Error())
+
+	// The failure must be recorded even if the caller's context was cancelled
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), failureRecordTimeout)
+	defer cancel()
+
+	updates := map[string]interface{}{
+		"status":          draft.Status,
+		"publish_error":   draft.PublishError,
+		publishLeaseField: nil,
+	}
+	recorded, err := uc.draftRepo.UpdateIfStatus(saveCtx, draft.ID, entities.DraftStatusPublishing, updates)
+	if err != nil {
+		return fmt.Errorf("failed to record publish failure (%s): %w", apiErr.Reason, err)
+	}
+	if !recorded {
+		return fmt.Errorf("failed to record publish failure (%s): draft %s is no longer being published", apiErr.Reason, draft.ID)
+	}
+
+	return apiErr
+}
+
+// classifyPublishError converts any LinkedIn call failure into a LinkedInAPIError
+func classifyPublishError(err error) *domainErrors.LinkedInAPIError {
+	var apiErr *domainErrors.LinkedInAPIError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return domainErrors.NewLinkedInAPIError("publish", domainErrors.LinkedInReasonTimeout, 0, "LinkedIn request timeout", err)
+	}
+
+	return domainErrors.NewLinkedInAPIError("publish", domainErrors.LinkedInReasonUnavailable, 0, "LinkedIn service unavailable", err)
+}
+
+// savePublishedDraft persists the publication result. The post is already live, so the
+// save is retried and outlives the caller's context. If it still fails the error names
+// the LinkedIn post: the draft stays PUBLISHING until its lease expires, after which the
+// scheduled publisher takes it back and would post it again.
+func (uc *PublishDraftUseCase) savePublishedDraft(ctx context.Context, draft *entities.Draft) error {
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), publishedSaveTimeout)
+	defer cancel()
+
+	var err error
+	delay := publishedSaveRetryDelay
+	for attempt := 1; attempt <= publishedSaveAttempts; attempt++ {
+		if attempt > 1 {
+			select {
+			case <-saveCtx.Done():
+				return fmt.Errorf("draft %s published as LinkedIn post %s but saving it failed: %w", draft.ID, draft.LinkedInPostID, err)
+			case <-time.After(delay):
+			}
+			delay *= 2
+		}
+
+		updates := map[string]interface{}{
+			"status":           draft.Status,
+			"linkedin_post_id": draft.LinkedInPostID,
+			"published_at":     *draft.PublishedAt,
+			"publish_error":    draft.PublishError,
+			publishLeaseField:  nil,
+		}
+
+		var saved bool
+		saved, err = uc.draftRepo.UpdateIfStatus(saveCtx, draft.ID, entities.DraftStatusPublishing, updates)
+		if err == nil && saved {
+			return nil
+		}
+		if err == nil {
+			// The lease expired and the scheduled publisher took the draft back, so retrying can't help
+			return fmt.Errorf("draft %s published as LinkedIn post %s but is no longer being published", draft.ID, draft.LinkedInPostID)
+		}
+	}
+
+	return fmt.Errorf("draft %s published as LinkedIn post %s but saving it failed: %w", draft.ID, draft.LinkedInPostID, err)
+}
+
+// markIdeaAsPublished flags the idea the draft was generated from
+func (uc *PublishDraftUseCase) markIdeaAsPublished(ctx context.Context, draft *entities.Draft) error {
+	if uc.ideasRepo == nil || draft.IdeaID == nil || *draft.IdeaID == "" {
+		return nil
+	}
+
+	idea, err := uc.ideasRepo.FindByID(ctx, *draft.IdeaID)
+	if err != nil {
+		if errors.Is(err, database.ErrEntityNotFound) {
+			// The idea may have been cleared since the draft was generated
+			return nil
+		}
+		return err
+	}
+
+	if err := idea.MarkAsPublished(draft.ID); err != nil {
+		return err
+	}
+
+	updates := map[string]interface{}{
+		"used":               idea.Used,
+		"published_at":       *idea.PublishedAt,
+		"published_draft_id": idea.PublishedDraftID,
+	}
+
+	return uc.ideasRepo.Update(ctx, idea.ID, updates)
+}
@@ -14,8 +14,9 @@ import (
 
 // RefineDraftUseCase orchestrates draft refinement with user feedback
 type RefineDraftUseCase struct {
-	draftRepo  interfaces.DraftRepository
-	llmService interfaces.LLMService
+	draftRepo    interfaces.DraftRepository
+	llmService   interfaces.LLMService
+	quotaChecker interfaces.LLMQuotaChecker
 }
 
 // NewRefineDraftUseCase creates a new instance of RefineDraftUseCase
@@ -29,6 +30,11 @@ func NewRefineDraftUseCase(
 	}
 }
 
+// SetQuotaChecker enables LLM quota enforcement before refinement
+func (uc *RefineDraftUseCase) SetQuotaChecker(checker interfaces.LLMQuotaChecker) {
+	uc.quotaChecker = checker
+}
+
 // RefineDraftInput represents input for draft refinement
 type RefineDraftInput struct {
 	DraftID    string
@@ -37,6 +43,26 @@ type RefineDraftInput struct {
 
 // Execute refines a draft based on user feedback
 func (uc *RefineDraftUseCase) Execute(ctx context.Context, input RefineDraftInput) (*entities.Draft, error) {
+	return uc.refine(ctx, input, func(ctx context.Context, draft *entities.Draft, userPrompt string, history []string) (string, error) {
+		return uc.llmService.RefineDraft(ctx, draft.Content, userPrompt, history)
+	})
+}
+
+// ExecuteStream refines a draft like Execute, passing the refined content to onChunk as
+// the LLM generates it. The refinement is only saved once the stream completes; nothing
+// is passed to onChunk when the draft can't be refined.
+func (uc *RefineDraftUseCase) ExecuteStream(ctx context.Context, input RefineDraftInput, onChunk interfaces.LLMStreamHandler) (*entities.Draft, error) {
+	streaming := interfaces.Streaming(uc.llmService)
+	return uc.refine(ctx, input, func(ctx context.Context, draft *entities.Draft, userPrompt string, history []string) (string, error) {
+		return streaming.RefineDraftStream(ctx, draft.Content, userPrompt, history, onChunk)
+	})
+}
+
+// refineFunc asks the LLM for the refined content of a draft
+type refineFunc func(ctx context.Context, draft *entities.Draft, userPrompt string, history []string) (string, error)
+
+// refine validates the request, calls generate and saves the refinement it returns
+func (uc *RefineDraftUseCase) refine(ctx context.Context, input RefineDraftInput, generate refineFunc) (*entities.Draft, error) {
 	normalized := RefineDraftInput{
 		DraftID:    strings.TrimSpace(input.DraftID),
 		UserPrompt: strings.TrimSpace(input.UserPrompt),
@@ -77,11 +103,17 @@ func (uc *RefineDraftUseCase) Execute(ctx context.Context, input RefineDraftInpu
 		)
 	}
 
+	// Stop before calling the LLM when the user is out of budget
+	if err := checkLLMQuota(ctx, uc.quotaChecker, draft.UserID); err != nil {
+		return nil, err
+	}
+
 	// Build conversation history from refinement history
 	history := uc.buildConversationHistory(draft)
 
 	// Call LLM to refine content
-	refinedContent, err := uc.llmService.RefineDraft(ctx, draft.Content, input.UserPrompt, history)
+	ctx = interfaces.WithLLMCallInfo(ctx, interfaces.LLMCallInfo{UserID: draft.UserID, PromptName: "refine"})
+	refinedContent, err := generate(ctx, draft, input.UserPrompt, history)
 	if err != nil {
 		return nil, fmt.Errorf("LLM service error: %w", err)
 	}
@@ -0,0 +1,113 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+)
+
+// RefreshLinkedInTokenUseCase renews a user's LinkedIn access token before it expires
+type RefreshLinkedInTokenUseCase struct {
+	userRepo    interfaces.UserRepository
+	authService interfaces.LinkedInAuthService
+}
+
+// NewRefreshLinkedInTokenUseCase creates a new instance of RefreshLinkedInTokenUseCase
+func NewRefreshLinkedInTokenUseCase(
+	userRepo interfaces.UserRepository,
+	authService interfaces.LinkedInAuthService,
+) *RefreshLinkedInTokenUseCase {
+	return &RefreshLinkedInTokenUseCase{
+		userRepo:    userRepo,
+		authService: authService,
+	}
+}
+
+// RefreshLinkedInTokenInput represents input for refreshing a LinkedIn token
+type RefreshLinkedInTokenInput struct {
+	UserID string
+}
+
+// Execute refreshes the user's LinkedIn token. When LinkedIn rejects the refresh token
+// the user is marked as needing to reconnect and ErrInvalidUserCredentials is returned.
+func (uc *RefreshLinkedInTokenUseCase) Execute(ctx context.Context, input RefreshLinkedInTokenInput) (*entities.User, error) {
+	userID := strings.TrimSpace(input.UserID)
+	if userID == "" {
+		return nil, domainErrors.NewValidationError("user_id", "user ID cannot be empty")
+	}
+
+	user, err := findUser(ctx, uc.userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.LinkedInTokenInvalidReason != "" {
+		return nil, domainErrors.NewInvalidUserCredentials(user.ID, user.PublishBlockedReason())
+	}
+
+	if err := refreshLinkedInCredentials(ctx, uc.userRepo, uc.authService, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// refreshLinkedInCredentials exchanges the user's refresh token for a new access token and
+// persists it. Tokens LinkedIn will never accept again are recorded on the user.
+func refreshLinkedInCredentials(
+	ctx context.Context,
+	userRepo interfaces.UserRepository,
+	authService interfaces.LinkedInAuthService,
+	user *entities.User,
+) error {
+	if user.LinkedInRefreshToken == "" {
+		return markLinkedInTokenUnrecoverable(ctx, userRepo, user, "no refresh token available")
+	}
+
+	tokens, err := authService.RefreshTokenPair(ctx, user.LinkedInRefreshToken)
+	if err != nil {
+		var apiErr *domainErrors.LinkedInAPIError
+		if errors.As(err, &apiErr) &&
+			(apiErr.Reason == domainErrors.LinkedInReasonTokenInvalid || apiErr.Reason == domainErrors.LinkedInReasonBadRequest) {
+			return markLinkedInTokenUnrecoverable(ctx, userRepo, user, "refresh token was rejected by LinkedIn")
+		}
+		return err
+	}
+
+	var expiresAt time.Time
+	if tokens.ExpiresIn > 0 {
+		expiresAt = time.Now().Add(time.Duration(tokens.ExpiresIn) * time.Second)
+	}
+
+	if err := user.SetLinkedInCredentials(tokens.AccessToken, tokens.RefreshToken, expiresAt); err != nil {
+		return fmt.Errorf("invalid LinkedIn credentials: %w", err)
+	}
+
+	if err := userRepo.UpdateLinkedInCredentials(ctx, user); err != nil {
+		return fmt.Errorf("failed to save refreshed LinkedIn credentials: %w", err)
+	}
+
+	return nil
+}
+
+// markLinkedInTokenUnrecoverable records why the user must reconnect LinkedIn and
+// returns the credentials error to surface
+func markLinkedInTokenUnrecoverable(ctx context.Context, userRepo interfaces.UserRepository, user *entities.User, reason string) error {
+	user.MarkLinkedInTokenUnrecoverable(reason)
+
+	updates := map[string]interface{}{
+		"linkedin_token_invalid_reason": user.LinkedInTokenInvalidReason,
+		"linkedin_token_invalid_at":     *user.LinkedInTokenInvalidAt,
+	}
+	if err := userRepo.Update(ctx, user.ID, updates); err != nil {
+		return fmt.Errorf("failed to mark LinkedIn token as unrecoverable: %w", err)
+	}
+
+	return domainErrors.NewInvalidUserCredentials(user.ID, user.PublishBlockedReason())
+}
@@ -0,0 +1,163 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+)
+
+// ErrNothingScheduled indicates a scheduled action found no work to do,
+// such as no unused ideas or no refined drafts
+var ErrNothingScheduled = errors.New("nothing to do for scheduled action")
+
+// UserIdeasGenerator generates ideas from a random topic of a user.
+// GenerateIdeasUseCase satisfies this interface.
+type UserIdeasGenerator interface {
+	GenerateIdeasForUser(ctx context.Context, userID string, count int) ([]*entities.Idea, error)
+}
+
+// DraftsGenerator generates drafts from an idea.
+// GenerateDraftsUseCase satisfies this interface.
+type DraftsGenerator interface {
+	Execute(ctx context.Context, input GenerateDraftsInput) ([]*entities.Draft, error)
+}
+
+// DraftPublisher publishes a draft to LinkedIn.
+// PublishDraftUseCase satisfies this interface.
+type DraftPublisher interface {
+	Execute(ctx context.Context, input PublishDraftInput) (*entities.Draft, error)
+}
+
+// RunScheduledActionUseCase executes the actions fired by per-user schedules
+type RunScheduledActionUseCase struct {
+	ideasRepo       interfaces.IdeasRepository
+	draftRepo       interfaces.DraftRepository
+	ideasGenerator  UserIdeasGenerator
+	draftsGenerator DraftsGenerator
+	publisher       DraftPublisher
+}
+
+// NewRunScheduledActionUseCase creates a new instance of RunScheduledActionUseCase
+func NewRunScheduledActionUseCase(
+	ideasRepo interfaces.IdeasRepository,
+	draftRepo interfaces.DraftRepository,
+	ideasGenerator UserIdeasGenerator,
+	draftsGenerator DraftsGenerator,
+	publisher DraftPublisher,
+) *RunScheduledActionUseCase {
+	return &RunScheduledActionUseCase{
+		ideasRepo:       ideasRepo,
+		draftRepo:       draftRepo,
+		ideasGenerator:  ideasGenerator,
+		draftsGenerator: draftsGenerator,
+		publisher:       publisher,
+	}
+}
+
+// RunScheduledActionInput represents a single action fired by a schedule
+type RunScheduledActionInput struct {
+	ScheduleID string
+	UserID     string
+	Action     entities.ScheduleAction
+}
+
+// Execute runs the scheduled action for the user.
+// It returns ErrNothingScheduled when the action had nothing to work on.
+func (uc *RunScheduledActionUseCase) Execute(ctx context.Context, input RunScheduledActionInput) error {
+	input.UserID = strings.TrimSpace(input.UserID)
+	if input.UserID == "" {
+		return domainErrors.NewValidationError("user_id", "user ID cannot be empty")
+	}
+
+	switch input.Action {
+	case entities.ScheduleActionGenerateIdeas:
+		return uc.generateIdeas(ctx, input.UserID)
+	case entities.ScheduleActionGenerateDrafts:
+		return uc.generateDraftsForBestIdea(ctx, input.UserID)
+	case entities.ScheduleActionPublishNext:
+		return uc.publishNextApproved(ctx, input.UserID)
+	default:
+		return domainErrors.NewValidationError("action", fmt.Sprintf("unsupported schedule action: %s", input.Action))
+	}
+}
+
+// generateIdeas generates ideas from a random topic of the user
+func (uc *RunScheduledActionUseCase) generateIdeas(ctx context.Context, userID string) error {
+	if _, err := uc.ideasGenerator.GenerateIdeasForUser(ctx, userID, 0); err != nil {
+		return fmt.Errorf("failed to generate ideas: %w", err)
+	}
+	return nil
+}
+
+// generateDraftsForBestIdea generates drafts from the unused idea with the highest quality score
+func (uc *RunScheduledActionUseCase) generateDraftsForBestIdea(ctx context.Context, userID string) error {
+	ideas, err := uc.ideasRepo.ListByUserID(ctx, userID, "", 0)
+	if err != nil {
+		return fmt.Errorf("failed to list ideas: %w", err)
+	}
+
+	best := selectBestIdea(ideas)
+	if best == nil {
+		return ErrNothingScheduled
+	}
+
+	input := GenerateDraftsInput{UserID: userID, IdeaID: best.ID}
+	if _, err := uc.draftsGenerator.Execute(ctx, input); err != nil {
+		return fmt.Errorf("failed to generate drafts: %w", err)
+	}
+
+	return nil
+}
+
+// publishNextApproved publishes the oldest refined draft of the user
+func (uc *RunScheduledActionUseCase) publishNextApproved(ctx context.Context, userID string) error {
+	drafts, err := uc.draftRepo.FindReadyForPublishing(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to find drafts ready for publishing: %w", err)
+	}
+
+	// Drafts come oldest first; only refined drafts count as approved by the user
+	for _, draft := range drafts {
+		if draft.Status != entities.DraftStatusRefined {
+			continue
+		}
+
+		input := PublishDraftInput{DraftID: draft.ID, UserID: userID}
+		if _, err := uc.publisher.Execute(ctx, input); err != nil {
+			return fmt.Errorf("failed to publish draft %s: %w", draft.ID, err)
+		}
+		return nil
+	}
+
+	return ErrNothingScheduled
+}
+
+// selectBestIdea returns the usable idea with the highest quality score,
+// preferring the newest idea on ties and scored ideas over unscored ones
+func selectBestIdea(ideas []*entities.Idea) *entities.Idea {
+	var best *entities.Idea
+	for _, idea := range ideas {
+		if idea == nil || !idea.CanBeUsed() {
+			continue
+		}
+
+		if best == nil || ideaScore(idea) > ideaScore(best) ||
+			(ideaScore(idea) == ideaScore(best) && idea.CreatedAt.After(best.CreatedAt)) {
+			best = idea
+		}
+	}
+	return best
+}
+
+// ideaScore returns the quality score of an idea, or -1 when it has none
+func ideaScore(idea *entities.Idea) float64 {
+	if idea.QualityScore == nil {
+		return -1
+	}
+	return *idea.QualityScore
+}
@@ -0,0 +1,91 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/database"
+)
+
+// ScheduleDraftUseCase schedules drafts to be published automatically
+type ScheduleDraftUseCase struct {
+	draftRepo interfaces.DraftRepository
+}
+
+// NewScheduleDraftUseCase creates a new instance of ScheduleDraftUseCase
+func NewScheduleDraftUseCase(draftRepo interfaces.DraftRepository) *ScheduleDraftUseCase {
+	return &ScheduleDraftUseCase{
+		draftRepo: draftRepo,
+	}
+}
+
+// ScheduleDraftInput represents input for draft scheduling
+type ScheduleDraftInput struct {
+	DraftID   string
+	UserID    string
+	PublishAt time.Time
+}
+
+// Execute schedules a draft owned by the user for publishing at PublishAt.
+// Scheduling an already scheduled draft moves its publish time.
+func (uc *ScheduleDraftUseCase) Execute(ctx context.Context, input ScheduleDraftInput) (*entities.Draft, error) {
+	input.DraftID = strings.TrimSpace(input.DraftID)
+	input.UserID = strings.TrimSpace(input.UserID)
+
+	// Validate input
+	if input.DraftID == "" {
+		return nil, domainErrors.NewValidationError("draft_id", "draft ID cannot be empty")
+	}
+	if input.UserID == "" {
+		return nil, domainErrors.NewValidationError("user_id", "user ID cannot be empty")
+	}
+
+	// Get draft from repository
+	draft, err := uc.draftRepo.FindByID(ctx, input.DraftID)
+	if err != nil {
+		if errors.Is(err, database.ErrEntityNotFound) {
+			return nil, domainErrors.NewDraftNotFound(input.DraftID)
+		}
+		if errors.Is(err, database.ErrInvalidID) {
+			return nil, domainErrors.NewValidationError("draft_id", "invalid draft ID")
+		}
+		return nil, fmt.Errorf("failed to retrieve draft: %w", err)
+	}
+	if draft == nil {
+		return nil, domainErrors.NewDraftNotFound(input.DraftID)
+	}
+
+	// Verify ownership
+	if draft.UserID != input.UserID {
+		return nil, domainErrors.NewUnauthorizedAccess(input.UserID, "draft", draft.ID)
+	}
+
+	if draft.Status == entities.DraftStatusPublished {
+		return nil, domainErrors.NewDraftAlreadyPublished(draft.ID, draft.LinkedInPostID)
+	}
+
+	if err := draft.Schedule(input.PublishAt); err != nil {
+		return nil, domainErrors.NewValidationError("publish_at", err.Error())
+	}
+
+	updates := map[string]interface{}{
+		"status":        draft.Status,
+		"scheduled_at":  *draft.ScheduledAt,
+		"publish_error": draft.PublishError,
+	}
+
+	if err := uc.draftRepo.Update(ctx, draft.ID, updates); err != nil {
+		if errors.Is(err, database.ErrEntityNotFound) {
+			return nil, domainErrors.NewDraftNotFound(draft.ID)
+		}
+		return nil, fmt.Errorf("failed to save scheduled draft: %w", err)
+	}
+
+	return draft, nil
+}
@@ -0,0 +1,139 @@
+package usecases
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/database"
+)
+
+const (
+	// oauthStateTTL bounds how long a user has to complete the LinkedIn consent screen
+	oauthStateTTL = 10 * time.Minute
+	// oauthRandomBytes is the entropy used for state values and PKCE verifiers
+	oauthRandomBytes = 32
+)
+
+// StartLinkedInAuthUseCase begins the LinkedIn OAuth2 authorization code flow for a user
+type StartLinkedInAuthUseCase struct {
+	userRepo    interfaces.UserRepository
+	stateRepo   interfaces.OAuthStateRepository
+	authService interfaces.LinkedInAuthService
+}
+
+// NewStartLinkedInAuthUseCase creates a new instance of StartLinkedInAuthUseCase
+func NewStartLinkedInAuthUseCase(
+	userRepo interfaces.UserRepository,
+	stateRepo interfaces.OAuthStateRepository,
+	authService interfaces.LinkedInAuthService,
+) *StartLinkedInAuthUseCase {
+	return &StartLinkedInAuthUseCase{
+		userRepo:    userRepo,
+		stateRepo:   stateRepo,
+		authService: authService,
+	}
+}
+
+// StartLinkedInAuthInput represents input for starting the LinkedIn authorization
+type StartLinkedInAuthInput struct {
+	UserID string
+}
+
+// StartLinkedInAuthOutput contains the consent page the user must be sent to
+type StartLinkedInAuthOutput struct {
+	AuthorizationURL string
+	ExpiresAt        time.Time
+}
+
+// Execute stores a single-use state with a PKCE verifier and returns the LinkedIn consent URL
+func (uc *StartLinkedInAuthUseCase) Execute(ctx context.Context, input StartLinkedInAuthInput) (*StartLinkedInAuthOutput, error) {
+	userID := strings.TrimSpace(input.UserID)
+	if userID == "" {
+		return nil, domainErrors.NewValidationError("user_id", "user ID cannot be empty")
+	}
+
+	user, err := findUser(ctx, uc.userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsActive() {
+		return nil, domainErrors.NewInvalidUserCredentials(user.ID, "user is not active")
+	}
+
+	state, err := generateOAuthToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate oauth state: %w", err)
+	}
+
+	codeVerifier, err := generateOAuthToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate PKCE verifier: %w", err)
+	}
+
+	now := time.Now()
+	oauthState := &entities.OAuthState{
+		State:        state,
+		UserID:       user.ID,
+		CodeVerifier: codeVerifier,
+		CreatedAt:    now,
+		ExpiresAt:    now.Add(oauthStateTTL),
+	}
+
+	authorizationURL, err := uc.authService.AuthorizationURL(state, pkceChallenge(codeVerifier))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.stateRepo.Create(ctx, oauthState); err != nil {
+		return nil, fmt.Errorf("failed to save oauth state: %w", err)
+	}
+
+	return &StartLinkedInAuthOutput{
+		AuthorizationURL: authorizationURL,
+		ExpiresAt:        oauthState.ExpiresAt,
+	}, nil
+}
+
+// findUser loads a user, mapping repository errors to domain errors
+func findUser(ctx context.Context, userRepo interfaces.UserRepository, userID string) (*entities.User, error) {
+	user, err := userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, database.ErrEntityNotFound) {
+			return nil, domainErrors.NewUserNotFound(userID)
+		}
+		if errors.Is(err, database.ErrInvalidID) {
+			return nil, domainErrors.NewValidationError("user_id", "invalid user ID")
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return nil, domainErrors.NewUserNotFound(userID)
+	}
+
+	return user, nil
+}
+
+// generateOAuthToken returns a URL-safe random value suitable for states and PKCE verifiers
+func generateOAuthToken() (string, error) {
+	buf := make([]byte, oauthRandomBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// pkceChallenge derives the S256 code challenge from a PKCE verifier
+func pkceChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
 	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
 	"github.com/linkgen-ai/backend/src/infrastructure/messaging/nats"
 	"go.uber.org/zap"
 )
@@ -69,6 +71,7 @@ type Job struct {
 	IdeaID      *string
 	DraftIDs    []string
 	Error       string
+	Metadata    map[string]string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	StartedAt   *time.Time
@@ -220,6 +223,14 @@ func (w *DraftGenerationWorker) processMessage(ctx context.Context, msgData []by
 
 	w.markJobProcessing(ctx, msg.JobID)
 
+	// Remember whose API key the LLM calls of this job used
+	keyUse := &llmKeyUse{}
+	ctx = interfaces.WithLLMKeyObserver(ctx, keyUse.observe)
+
+	// And which prompt version generated its drafts
+	promptUse := &promptVersionUse{}
+	ctx = interfaces.WithPromptVersionObserver(ctx, promptUse.observe)
+
 	drafts, err := w.generateWithRetries(ctx, msg)
 	if err != nil {
 		w.logger.Error("draft generation failed after retries",
@@ -228,11 +239,11 @@ func (w *DraftGenerationWorker) processMessage(ctx context.Context, msgData []by
 			zap.String("job_id", msg.JobID),
 			zap.Error(err),
 		)
-		w.markJobFailed(ctx, msg.JobID, err)
+		w.markJobFailed(ctx, msg.JobID, err, keyUse.metadata(), promptUse.metadata())
 		return nil
 	}
 
-	w.markJobCompleted(ctx, msg.JobID, drafts)
+	w.markJobCompleted(ctx, msg.JobID, drafts, keyUse.metadata(), promptUse.metadata())
 
 	w.logger.Info("draft generation completed successfully",
 		zap.String("user_id", msg.UserID),
@@ -263,6 +274,9 @@ func (w *DraftGenerationWorker) validateMessage(msg *DraftGenerationMessage) err
 
 // generateWithRetries executes the draft generation use case with in-process retries
 func (w *DraftGenerationWorker) generateWithRetries(ctx context.Context, msg DraftGenerationMessage) ([]*Draft, error) {
+	// Attribute the LLM usage of every attempt to the job
+	ctx = interfaces.WithLLMCallInfo(ctx, interfaces.LLMCallInfo{JobID: msg.JobID})
+
 	totalAttempts := w.maxRetries + 1
 	var lastErr error
 
@@ -283,6 +297,13 @@ func (w *DraftGenerationWorker) generateWithRetries(ctx context.Context, msg Dra
 		lastErr = err
 		w.incrementProcessingErrors()
 
+		// Retrying can't help until the quota resets
+		var quotaErr *domainErrors.ErrQuotaExceeded
+		if errors.As(err, &quotaErr) {
+			w.incrementGenerationFailures()
+			return nil, fmt.Errorf("draft generation stopped: %w", err)
+		}
+
 		if attempt < w.maxRetries {
 			w.incrementRetries()
 			w.logger.Warn("draft generation attempt failed, retrying",
@@ -352,7 +373,7 @@ func (w *DraftGenerationWorker) markJobProcessing(ctx context.Context, jobID str
 }
 
 // markJobCompleted updates the job with completion metadata and generated draft IDs
-func (w *DraftGenerationWorker) markJobCompleted(ctx context.Context, jobID string, drafts []*Draft) {
+func (w *DraftGenerationWorker) markJobCompleted(ctx context.Context, jobID string, drafts []*Draft, metadata ...map[string]string) {
 	if len(drafts) == 0 {
 		w.logger.Warn("no drafts generated but job marked as completed",
 			zap.String("job_id", jobID),
@@ -371,12 +392,13 @@ func (w *DraftGenerationWorker) markJobCompleted(ctx context.Context, jobID stri
 		job.UpdatedAt = now
 		job.DraftIDs = draftIDs
 		job.Error = ""
+		mergeJobMetadata(job, metadata...)
 		return true
 	})
 }
 
 // markJobFailed records the failure details for the job
-func (w *DraftGenerationWorker) markJobFailed(ctx context.Context, jobID string, failure error) {
+func (w *DraftGenerationWorker) markJobFailed(ctx context.Context, jobID string, failure error, metadata ...map[string]string) {
 	if failure == nil {
 		return
 	}
@@ -392,10 +414,81 @@ func (w *DraftGenerationWorker) markJobFailed(ctx context.Context, jobID string,
 		job.Error = message
 		job.CompletedAt = &now
 		job.UpdatedAt = now
+		mergeJobMetadata(job, metadata...)
 		return true
 	})
 }
 
+// mergeJobMetadata adds metadata to the job, replacing the values of keys it already has
+func mergeJobMetadata(job *Job, metadata ...map[string]string) {
+	for _, values := range metadata {
+		if len(values) == 0 {
+			continue
+		}
+		if job.Metadata == nil {
+			job.Metadata = make(map[string]string, len(values))
+		}
+		for key, value := range values {
+			job.Metadata[key] = value
+		}
+	}
+}
+
+// llmKeyUse remembers the provider and key source of the last LLM call of a job,
+// so the job records whether it ran on the user's own key or the platform's
+type llmKeyUse struct {
+	mu       sync.Mutex
+	provider string
+	source   string
+}
+
+func (u *llmKeyUse) observe(provider, source string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.provider = provider
+	u.source = source
+}
+
+// metadata returns the job metadata for the recorded key choice, nil when no LLM call was made
+func (u *llmKeyUse) metadata() map[string]string {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.source == "" {
+		return nil
+	}
+	return map[string]string{
+		"llm_provider":   u.provider,
+		"llm_key_source": u.source,
+	}
+}
+
+// promptVersionUse remembers the prompt version of the last generation of a job
+type promptVersionUse struct {
+	mu       sync.Mutex
+	promptID string
+	version  int
+}
+
+func (u *promptVersionUse) observe(promptID string, version int) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.promptID = promptID
+	u.version = version
+}
+
+// metadata returns the job metadata for the recorded prompt version, nil when no stored prompt was used
+func (u *promptVersionUse) metadata() map[string]string {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.promptID == "" {
+		return nil
+	}
+	return map[string]string{
+		"prompt_id":      u.promptID,
+		"prompt_version": strconv.Itoa(u.version),
+	}
+}
+
 // updateJob loads a job, applies the provided mutation, and persists the change when needed
 func (w *DraftGenerationWorker) updateJob(ctx context.Context, jobID string, mutate func(job *Job) bool) {
 	if w.jobRepo == nil {
@@ -3,6 +3,10 @@
 //
 // Components:
 // - DraftGenerationWorker: Processes draft generation requests from queue
+// - ScheduledPublisherWorker: Publishes scheduled drafts once they are due
+// - TokenRefreshWorker: Refreshes LinkedIn tokens before they expire
+// - ScheduleDispatcherWorker: Fires due per-user cron schedules onto the queue
+// - ScheduledActionWorker: Executes scheduled actions consumed from the queue
 //
 // Workers are designed to run as goroutines within the monolith application,
 // providing async processing without requiring separate service deployments.
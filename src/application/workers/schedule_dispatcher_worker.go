@@ -0,0 +1,383 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrNilScheduleRepository indicates nil schedule repository
+	ErrNilScheduleRepository = errors.New("schedule repository cannot be nil")
+	// ErrNilLeaseRepository indicates nil lease repository
+	ErrNilLeaseRepository = errors.New("lease repository cannot be nil")
+	// ErrNilPublisher indicates nil message publisher
+	ErrNilPublisher = errors.New("publisher cannot be nil")
+)
+
+const (
+	// ScheduleDispatcherLease is the name of the lease shared by all dispatcher replicas
+	ScheduleDispatcherLease = "schedule-dispatcher"
+
+	defaultDispatchInterval  = time.Minute
+	defaultDispatchBatchSize = 100
+)
+
+// MessagePublisher publishes messages to a queue.
+// nats.Publisher satisfies this interface.
+type MessagePublisher interface {
+	Publish(ctx context.Context, data interface{}) error
+}
+
+// ScheduleDispatcherWorker fires due per-user schedules by enqueueing one
+// ScheduledActionMessage per action. Only the replica holding the dispatcher
+// lease fires on a tick. A schedule is moved to its next run with a
+// compare-and-set only after all its actions were enqueued, so an activation
+// that can't be enqueued (e.g. while NATS is unavailable) stays due and is
+// retried on the next tick instead of being lost; the actions enqueued before
+// a failure are enqueued again then. Activations missed while no replica was
+// running are collapsed into a single run.
+type ScheduleDispatcherWorker struct {
+	scheduleRepo interfaces.ScheduleRepository
+	leaseRepo    interfaces.LeaseRepository
+	publisher    MessagePublisher
+	logger       *zap.Logger
+	interval     time.Duration
+	batchSize    int
+	leaseTTL     time.Duration
+	holder       string
+	now          func() time.Time
+
+	mu      sync.RWMutex
+	running bool
+	cancel  context.CancelFunc
+	done    chan struct{}
+	// Metrics
+	schedulesFiredTotal int64
+	actionsQueuedTotal  int64
+	publishErrorsTotal  int64
+	conflictsTotal      int64
+	leaseMissesTotal    int64
+	pollErrorsTotal     int64
+}
+
+// ScheduleDispatcherConfig holds schedule dispatcher configuration
+type ScheduleDispatcherConfig struct {
+	ScheduleRepo interfaces.ScheduleRepository
+	LeaseRepo    interfaces.LeaseRepository
+	Publisher    MessagePublisher
+	Interval     time.Duration
+	BatchSize    int
+	// LeaseTTL defaults to twice the interval, so a crashed holder is replaced within two ticks
+	LeaseTTL time.Duration
+	// Holder identifies this replica in the lease
+	Holder string
+	Logger *zap.Logger
+	// Now overrides the clock, mainly for tests
+	Now func() time.Time
+}
+
+// NewScheduleDispatcherWorker creates a new schedule dispatcher worker
+func NewScheduleDispatcherWorker(config ScheduleDispatcherConfig) (*ScheduleDispatcherWorker, error) {
+	if config.ScheduleRepo == nil {
+		return nil, ErrNilScheduleRepository
+	}
+
+	if config.LeaseRepo == nil {
+		return nil, ErrNilLeaseRepository
+	}
+
+	if config.Publisher == nil {
+		return nil, ErrNilPublisher
+	}
+
+	if config.Holder == "" {
+		return nil, errors.New("lease holder cannot be empty")
+	}
+
+	logger := config.Logger
+	if logger == nil {
+		logger, _ = zap.NewProduction()
+	}
+
+	interval := config.Interval
+	if interval <= 0 {
+		interval = defaultDispatchInterval
+	}
+
+	batchSize := config.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultDispatchBatchSize
+	}
+
+	leaseTTL := config.LeaseTTL
+	if leaseTTL <= 0 {
+		leaseTTL = 2 * interval
+	}
+
+	now := config.Now
+	if now == nil {
+		now = time.Now
+	}
+
+	return &ScheduleDispatcherWorker{
+		scheduleRepo: config.ScheduleRepo,
+		leaseRepo:    config.LeaseRepo,
+		publisher:    config.Publisher,
+		logger:       logger,
+		interval:     interval,
+		batchSize:    batchSize,
+		leaseTTL:     leaseTTL,
+		holder:       config.Holder,
+		now:          now,
+	}, nil
+}
+
+// Start starts dispatching due schedules in the background
+func (w *ScheduleDispatcherWorker) Start(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.running {
+		return ErrAlreadyRunning
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	w.cancel = cancel
+	w.done = make(chan struct{})
+	w.running = true
+
+	go w.run(runCtx, w.done)
+
+	w.logger.Info("schedule dispatcher worker started",
+		zap.Duration("interval", w.interval),
+		zap.String("holder", w.holder),
+	)
+
+	return nil
+}
+
+// Stop stops the worker, waiting for the in-flight tick up to shutdownTimeout,
+// and hands the lease over to other replicas
+func (w *ScheduleDispatcherWorker) Stop(shutdownTimeout time.Duration) error {
+	w.mu.Lock()
+	if !w.running {
+		w.mu.Unlock()
+		return ErrNotRunning
+	}
+	cancel, done := w.cancel, w.done
+	w.running = false
+	w.mu.Unlock()
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(shutdownTimeout):
+		return errors.New("schedule dispatcher worker shutdown timeout exceeded")
+	}
+
+	releaseCtx, releaseCancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer releaseCancel()
+	if err := w.leaseRepo.Release(releaseCtx, ScheduleDispatcherLease, w.holder); err != nil {
+		w.logger.Warn("failed to release schedule dispatcher lease", zap.Error(err))
+	}
+
+	w.logger.Info("schedule dispatcher worker stopped")
+	return nil
+}
+
+// run dispatches immediately and then on every interval until ctx is cancelled
+func (w *ScheduleDispatcherWorker) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.DispatchDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchDue fires one batch of due schedules and returns how many fired.
+// It does nothing when another replica holds the dispatcher lease.
+func (w *ScheduleDispatcherWorker) DispatchDue(ctx context.Context) int {
+	acquired, err := w.leaseRepo.Acquire(ctx, ScheduleDispatcherLease, w.holder, w.leaseTTL)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.incrementPollErrors()
+			w.logger.Error("failed to acquire schedule dispatcher lease", zap.Error(err))
+		}
+		return 0
+	}
+
+	if !acquired {
+		w.incrementLeaseMisses()
+		return 0
+	}
+
+	now := w.now()
+	schedules, err := w.scheduleRepo.FindDue(ctx, now, w.batchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.incrementPollErrors()
+			w.logger.Error("failed to find due schedules", zap.Error(err))
+		}
+		return 0
+	}
+
+	fired := 0
+	for _, schedule := range schedules {
+		if ctx.Err() != nil {
+			break
+		}
+
+		if w.fire(ctx, schedule, now) {
+			fired++
+		}
+	}
+
+	return fired
+}
+
+// fire enqueues the actions of a due schedule and then advances it to its next run
+func (w *ScheduleDispatcherWorker) fire(ctx context.Context, schedule *entities.Schedule, now time.Time) bool {
+	if schedule.NextRunAt == nil {
+		return false
+	}
+
+	nextRun, err := schedule.ComputeNextRun(now)
+	if err != nil {
+		w.incrementPollErrors()
+		w.logger.Warn("failed to compute next schedule run",
+			zap.String("schedule_id", schedule.ID),
+			zap.Error(err),
+		)
+		return false
+	}
+
+	for _, action := range schedule.Actions {
+		msg := ScheduledActionMessage{
+			ScheduleID: schedule.ID,
+			UserID:     schedule.UserID,
+			Action:     string(action),
+			FiredAt:    now,
+		}
+
+		if err := w.publisher.Publish(ctx, msg); err != nil {
+			// Leave the schedule due so the whole activation is retried on the next tick
+			w.incrementPublishErrors()
+			w.logger.Error("failed to enqueue scheduled action, schedule will be retried",
+				zap.String("schedule_id", schedule.ID),
+				zap.String("user_id", schedule.UserID),
+				zap.String("action", string(action)),
+				zap.Error(err),
+			)
+			return false
+		}
+
+		w.incrementQueued()
+	}
+
+	won, err := w.scheduleRepo.MarkFired(ctx, schedule.ID, *schedule.NextRunAt, now, nextRun)
+	if err != nil {
+		w.incrementPollErrors()
+		w.logger.Warn("failed to mark schedule as fired",
+			zap.String("schedule_id", schedule.ID),
+			zap.Error(err),
+		)
+		return false
+	}
+
+	if !won {
+		// The schedule was edited, deleted or fired elsewhere meanwhile
+		w.incrementConflicts()
+		return false
+	}
+
+	w.incrementFired()
+
+	w.logger.Info("schedule fired",
+		zap.String("schedule_id", schedule.ID),
+		zap.String("user_id", schedule.UserID),
+		zap.Int("actions", len(schedule.Actions)),
+		zap.Time("next_run_at", nextRun),
+	)
+
+	return true
+}
+
+// IsRunning returns worker running status
+func (w *ScheduleDispatcherWorker) IsRunning() bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.running
+}
+
+// GetMetrics returns worker metrics
+func (w *ScheduleDispatcherWorker) GetMetrics() map[string]int64 {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	return map[string]int64{
+		"schedules_fired_total": w.schedulesFiredTotal,
+		"actions_queued_total":  w.actionsQueuedTotal,
+		"publish_errors_total":  w.publishErrorsTotal,
+		"conflicts_total":       w.conflictsTotal,
+		"lease_misses_total":    w.leaseMissesTotal,
+		"poll_errors_total":     w.pollErrorsTotal,
+	}
+}
+
+// incrementFired increments fired schedules counter
+func (w *ScheduleDispatcherWorker) incrementFired() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.schedulesFiredTotal++
+}
+
+// incrementQueued increments queued actions counter
+func (w *ScheduleDispatcherWorker) incrementQueued() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.actionsQueuedTotal++
+}
+
+// incrementPublishErrors increments enqueue failures counter
+func (w *ScheduleDispatcherWorker) incrementPublishErrors() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.publishErrorsTotal++
+}
+
+// incrementConflicts increments lost compare-and-set counter
+func (w *ScheduleDispatcherWorker) incrementConflicts() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.conflictsTotal++
+}
+
+// incrementLeaseMisses increments ticks skipped because another replica holds the lease
+func (w *ScheduleDispatcherWorker) incrementLeaseMisses() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.leaseMissesTotal++
+}
+
+// incrementPollErrors increments repository errors counter
+func (w *ScheduleDispatcherWorker) incrementPollErrors() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.pollErrorsTotal++
+}
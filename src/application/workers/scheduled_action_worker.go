@@ -0,0 +1,222 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/infrastructure/messaging/nats"
+	"go.uber.org/zap"
+)
+
+// ErrNoScheduledWork indicates a scheduled action had nothing to work on
+var ErrNoScheduledWork = errors.New("no work for scheduled action")
+
+// ScheduledActionMessage represents a single action fired by a per-user schedule
+type ScheduledActionMessage struct {
+	ScheduleID string    `json:"schedule_id"`
+	UserID     string    `json:"user_id"`
+	Action     string    `json:"action"`
+	FiredAt    time.Time `json:"fired_at"`
+	RetryCount int       `json:"retry_count"`
+}
+
+// RunScheduledActionUseCase is the interface for the scheduled action use case.
+// Implementations return ErrNoScheduledWork when there is nothing to do.
+type RunScheduledActionUseCase interface {
+	Execute(ctx context.Context, input RunScheduledActionInput) error
+}
+
+// RunScheduledActionInput represents input for a scheduled action
+type RunScheduledActionInput struct {
+	ScheduleID string
+	UserID     string
+	Action     string
+}
+
+// ScheduledActionWorker executes scheduled actions consumed from the NATS queue
+type ScheduledActionWorker struct {
+	consumer *nats.Consumer
+	useCase  RunScheduledActionUseCase
+	logger   *zap.Logger
+	mu       sync.RWMutex
+	running  bool
+	// Metrics
+	actionsCompletedTotal int64
+	actionsSkippedTotal   int64
+	actionsFailedTotal    int64
+	processingErrorsTotal int64
+}
+
+// ScheduledActionConfig holds scheduled action worker configuration
+type ScheduledActionConfig struct {
+	Consumer *nats.Consumer
+	UseCase  RunScheduledActionUseCase
+	Logger   *zap.Logger
+}
+
+// NewScheduledActionWorker creates a new scheduled action worker
+func NewScheduledActionWorker(config ScheduledActionConfig) (*ScheduledActionWorker, error) {
+	if config.UseCase == nil {
+		return nil, ErrNilUseCase
+	}
+
+	if config.Consumer == nil {
+		return nil, ErrNilConsumer
+	}
+
+	logger := config.Logger
+	if logger == nil {
+		logger, _ = zap.NewProduction()
+	}
+
+	return &ScheduledActionWorker{
+		consumer: config.Consumer,
+		useCase:  config.UseCase,
+		logger:   logger,
+	}, nil
+}
+
+// Start subscribes the worker to the scheduled actions queue
+func (w *ScheduledActionWorker) Start(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.running {
+		return ErrAlreadyRunning
+	}
+
+	if err := w.consumer.Subscribe(ctx, w.processMessage); err != nil {
+		return fmt.Errorf("failed to start worker: %w", err)
+	}
+
+	w.running = true
+	w.logger.Info("scheduled action worker started")
+
+	return nil
+}
+
+// Stop stops the worker gracefully
+func (w *ScheduledActionWorker) Stop(shutdownTimeout time.Duration) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.running {
+		return ErrNotRunning
+	}
+
+	if err := w.consumer.Unsubscribe(shutdownTimeout); err != nil {
+		w.logger.Warn("failed to unsubscribe consumer", zap.Error(err))
+		return err
+	}
+
+	w.running = false
+	w.logger.Info("scheduled action worker stopped")
+
+	return nil
+}
+
+// processMessage runs a single scheduled action.
+// Failures are logged and acknowledged: the schedule fires again on its next run,
+// and redelivering a publish could post the same draft twice.
+func (w *ScheduledActionWorker) processMessage(ctx context.Context, msgData []byte) error {
+	var msg ScheduledActionMessage
+	if err := json.Unmarshal(msgData, &msg); err != nil {
+		w.incrementProcessingErrors()
+		w.logger.Error("failed to parse scheduled action message", zap.Error(err))
+		return nil
+	}
+
+	if msg.UserID == "" || msg.Action == "" {
+		w.incrementProcessingErrors()
+		w.logger.Error("invalid scheduled action message",
+			zap.String("schedule_id", msg.ScheduleID),
+			zap.String("action", msg.Action),
+		)
+		return nil
+	}
+
+	err := w.useCase.Execute(ctx, RunScheduledActionInput{
+		ScheduleID: msg.ScheduleID,
+		UserID:     msg.UserID,
+		Action:     msg.Action,
+	})
+
+	switch {
+	case err == nil:
+		w.incrementCompleted()
+		w.logger.Info("scheduled action completed",
+			zap.String("schedule_id", msg.ScheduleID),
+			zap.String("user_id", msg.UserID),
+			zap.String("action", msg.Action),
+		)
+	case errors.Is(err, ErrNoScheduledWork):
+		w.incrementSkipped()
+		w.logger.Info("scheduled action had nothing to do",
+			zap.String("schedule_id", msg.ScheduleID),
+			zap.String("user_id", msg.UserID),
+			zap.String("action", msg.Action),
+		)
+	default:
+		w.incrementFailed()
+		w.logger.Error("scheduled action failed",
+			zap.String("schedule_id", msg.ScheduleID),
+			zap.String("user_id", msg.UserID),
+			zap.String("action", msg.Action),
+			zap.Error(err),
+		)
+	}
+
+	return nil
+}
+
+// IsRunning returns worker running status
+func (w *ScheduledActionWorker) IsRunning() bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.running
+}
+
+// GetMetrics returns worker metrics
+func (w *ScheduledActionWorker) GetMetrics() map[string]int64 {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	return map[string]int64{
+		"actions_completed_total": w.actionsCompletedTotal,
+		"actions_skipped_total":   w.actionsSkippedTotal,
+		"actions_failed_total":    w.actionsFailedTotal,
+		"processing_errors_total": w.processingErrorsTotal,
+	}
+}
+
+// incrementCompleted increments completed actions counter
+func (w *ScheduledActionWorker) incrementCompleted() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.actionsCompletedTotal++
+}
+
+// incrementSkipped increments actions with nothing to do counter
+func (w *ScheduledActionWorker) incrementSkipped() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.actionsSkippedTotal++
+}
+
+// incrementFailed increments failed actions counter
+func (w *ScheduledActionWorker) incrementFailed() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.actionsFailedTotal++
+}
+
+// incrementProcessingErrors increments malformed messages counter
+func (w *ScheduledActionWorker) incrementProcessingErrors() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.processingErrorsTotal++
+}
@@ -0,0 +1,359 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrNilDraftRepository indicates nil draft repository
+	ErrNilDraftRepository = errors.New("draft repository cannot be nil")
+)
+
+const (
+	defaultPublishInterval  = time.Minute
+	defaultPublishBatchSize = 100
+	// defaultPublishLease bounds a single publish. The use case leases the draft again when
+	// it moves it to PUBLISHING, so the draft is only taken back once that lease expires too.
+	defaultPublishLease = 5 * time.Minute
+
+	// Failure reasons recorded for scheduled drafts rejected before reaching LinkedIn
+	publishFailureCredentials = "credentials_invalid"
+	publishFailureRejected    = "rejected"
+)
+
+// PublishDraftUseCase is the interface for the draft publishing use case
+type PublishDraftUseCase interface {
+	Execute(ctx context.Context, input PublishDraftInput) error
+}
+
+// PublishDraftInput represents input for draft publishing
+type PublishDraftInput struct {
+	DraftID string
+	UserID  string
+}
+
+// ScheduledPublisherWorker publishes scheduled drafts once their publish time is due.
+// State lives in the drafts collection, so drafts scheduled before a restart are
+// picked up on the next tick, and so are drafts left PUBLISHING by a publisher that stopped.
+// Each draft is claimed atomically before publishing, so several instances can run side
+// by side without double-publishing.
+type ScheduledPublisherWorker struct {
+	draftRepo interfaces.DraftRepository
+	useCase   PublishDraftUseCase
+	logger    *zap.Logger
+	interval  time.Duration
+	batchSize int
+	lease     time.Duration
+	now       func() time.Time
+
+	mu      sync.RWMutex
+	running bool
+	cancel  context.CancelFunc
+	done    chan struct{}
+	// Metrics
+	publishedTotal     int64
+	failuresTotal      int64
+	claimConflictTotal int64
+	pollErrorsTotal    int64
+}
+
+// ScheduledPublisherConfig holds scheduled publisher configuration
+type ScheduledPublisherConfig struct {
+	DraftRepo interfaces.DraftRepository
+	UseCase   PublishDraftUseCase
+	Interval  time.Duration
+	BatchSize int
+	Lease     time.Duration
+	Logger    *zap.Logger
+	// Now overrides the clock, mainly for tests
+	Now func() time.Time
+}
+
+// NewScheduledPublisherWorker creates a new scheduled publisher worker
+func NewScheduledPublisherWorker(config ScheduledPublisherConfig) (*ScheduledPublisherWorker, error) {
+	if config.UseCase == nil {
+		return nil, ErrNilUseCase
+	}
+
+	if config.DraftRepo == nil {
+		return nil, ErrNilDraftRepository
+	}
+
+	logger := config.Logger
+	if logger == nil {
+		logger, _ = zap.NewProduction()
+	}
+
+	interval := config.Interval
+	if interval <= 0 {
+		interval = defaultPublishInterval
+	}
+
+	batchSize := config.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultPublishBatchSize
+	}
+
+	lease := config.Lease
+	if lease <= 0 {
+		lease = defaultPublishLease
+	}
+
+	now := config.Now
+	if now == nil {
+		now = time.Now
+	}
+
+	return &ScheduledPublisherWorker{
+		draftRepo: config.DraftRepo,
+		useCase:   config.UseCase,
+		logger:    logger,
+		interval:  interval,
+		batchSize: batchSize,
+		lease:     lease,
+		now:       now,
+	}, nil
+}
+
+// Start starts polling for due drafts in the background
+func (w *ScheduledPublisherWorker) Start(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.running {
+		return ErrAlreadyRunning
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	w.cancel = cancel
+	w.done = make(chan struct{})
+	w.running = true
+
+	go w.run(runCtx, w.done)
+
+	w.logger.Info("scheduled publisher worker started",
+		zap.Duration("interval", w.interval),
+		zap.Int("batch_size", w.batchSize),
+	)
+
+	return nil
+}
+
+// Stop stops the worker, waiting for the in-flight batch up to shutdownTimeout
+func (w *ScheduledPublisherWorker) Stop(shutdownTimeout time.Duration) error {
+	w.mu.Lock()
+	if !w.running {
+		w.mu.Unlock()
+		return ErrNotRunning
+	}
+	cancel, done := w.cancel, w.done
+	w.running = false
+	w.mu.Unlock()
+
+	cancel()
+
+	select {
+	case <-done:
+		w.logger.Info("scheduled publisher worker stopped")
+		return nil
+	case <-time.After(shutdownTimeout):
+		return errors.New("scheduled publisher worker shutdown timeout exceeded")
+	}
+}
+
+// run polls immediately and then on every interval until ctx is cancelled
+func (w *ScheduledPublisherWorker) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.PublishDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PublishDue publishes one batch of due drafts and returns how many were published
+func (w *ScheduledPublisherWorker) PublishDue(ctx context.Context) int {
+	drafts, err := w.draftRepo.FindDueForPublishing(ctx, w.now(), w.batchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.incrementPollErrors()
+			w.logger.Error("failed to find drafts due for publishing", zap.Error(err))
+		}
+		return 0
+	}
+
+	published := 0
+	for _, draft := range drafts {
+		if ctx.Err() != nil {
+			break
+		}
+
+		if w.publishDraft(ctx, draft) {
+			published++
+		}
+	}
+
+	return published
+}
+
+// publishDraft claims and publishes a single draft
+func (w *ScheduledPublisherWorker) publishDraft(ctx context.Context, draft *entities.Draft) bool {
+	now := w.now()
+
+	claimed, err := w.draftRepo.ClaimForPublishing(ctx, draft.ID, now, now.Add(w.lease))
+	if err != nil {
+		w.incrementPollErrors()
+		w.logger.Warn("failed to claim scheduled draft",
+			zap.String("draft_id", draft.ID),
+			zap.Error(err),
+		)
+		return false
+	}
+
+	if !claimed {
+		// Another instance took it or the draft was rescheduled meanwhile
+		w.incrementClaimConflicts()
+		return false
+	}
+
+	// Never let the publish outlive the claim, otherwise another instance could take over
+	publishCtx, cancel := context.WithTimeout(ctx, w.lease)
+	defer cancel()
+
+	err = w.useCase.Execute(publishCtx, PublishDraftInput{
+		DraftID: draft.ID,
+		UserID:  draft.UserID,
+	})
+	if isTakenByAnotherPublisher(err) {
+		// A manual publish got there first
+		w.incrementClaimConflicts()
+		return false
+	}
+	if err != nil {
+		w.incrementFailures()
+		w.logger.Warn("scheduled draft publishing failed",
+			zap.String("draft_id", draft.ID),
+			zap.String("user_id", draft.UserID),
+			zap.Error(err),
+		)
+		w.recordRejection(ctx, draft, err)
+		return false
+	}
+
+	w.incrementPublished()
+	w.logger.Info("scheduled draft published",
+		zap.String("draft_id", draft.ID),
+		zap.String("user_id", draft.UserID),
+	)
+
+	return true
+}
+
+// isTakenByAnotherPublisher checks if the draft was published or taken by someone else
+func isTakenByAnotherPublisher(err error) bool {
+	var (
+		inProgressErr *domainErrors.ErrDraftPublishInProgress
+		publishedErr  *domainErrors.ErrDraftAlreadyPublished
+	)
+	return errors.As(err, &inProgressErr) || errors.As(err, &publishedErr)
+}
+
+// recordRejection marks drafts that can never be published as failed.
+// Every LinkedIn failure, including rate limits, timeouts and 5xx errors, is already
+// recorded as FAILED by the use case and isn't retried. Errors before LinkedIn is called,
+// such as a failed user lookup, leave the draft scheduled, so it is retried once the claim expires.
+func (w *ScheduledPublisherWorker) recordRejection(ctx context.Context, draft *entities.Draft, err error) {
+	var (
+		credentialsErr *domainErrors.ErrInvalidUserCredentials
+		validationErr  *domainErrors.ErrValidation
+		accessErr      *domainErrors.ErrUnauthorizedAccess
+	)
+
+	reason := ""
+	switch {
+	case errors.As(err, &credentialsErr):
+		reason = publishFailureCredentials
+	case errors.As(err, &validationErr), errors.As(err, &accessErr):
+		reason = publishFailureRejected
+	default:
+		return
+	}
+
+	draft.MarkAsPublishFailed(reason, err.Error())
+
+	updates := map[string]interface{}{
+		"status":        draft.Status,
+		"publish_error": draft.PublishError,
+	}
+	// Only a draft that is still scheduled is marked, never one a manual publish has taken
+	if _, updateErr := w.draftRepo.UpdateIfStatus(ctx, draft.ID, entities.DraftStatusScheduled, updates); updateErr != nil {
+		w.logger.Warn("failed to record scheduled publish rejection",
+			zap.String("draft_id", draft.ID),
+			zap.Error(updateErr),
+		)
+	}
+}
+
+// IsRunning returns worker running status
+func (w *ScheduledPublisherWorker) IsRunning() bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.running
+}
+
+// GetMetrics returns worker metrics
+func (w *ScheduledPublisherWorker) GetMetrics() map[string]int64 {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	return map[string]int64{
+		"published_total":      w.publishedTotal,
+		"failures_total":       w.failuresTotal,
+		"claim_conflict_total": w.claimConflictTotal,
+		"poll_errors_total":    w.pollErrorsTotal,
+	}
+}
+
+// incrementPublished increments published drafts counter
+func (w *ScheduledPublisherWorker) incrementPublished() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.publishedTotal++
+}
+
+// incrementFailures increments publishing failures counter
+func (w *ScheduledPublisherWorker) incrementFailures() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.failuresTotal++
+}
+
+// incrementClaimConflicts increments lost claims counter
+func (w *ScheduledPublisherWorker) incrementClaimConflicts() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.claimConflictTotal++
+}
+
+// incrementPollErrors increments repository errors counter
+func (w *ScheduledPublisherWorker) incrementPollErrors() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.pollErrorsTotal++
+}
@@ -0,0 +1,281 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrNilUserRepository indicates nil user repository
+	ErrNilUserRepository = errors.New("user repository cannot be nil")
+)
+
+const (
+	defaultTokenRefreshInterval  = time.Hour
+	defaultTokenRefreshWindow    = 7 * 24 * time.Hour
+	defaultTokenRefreshBatchSize = 100
+)
+
+// RefreshLinkedInTokenUseCase is the interface for the LinkedIn token refresh use case
+type RefreshLinkedInTokenUseCase interface {
+	Execute(ctx context.Context, input RefreshLinkedInTokenInput) error
+}
+
+// RefreshLinkedInTokenInput represents input for LinkedIn token refresh
+type RefreshLinkedInTokenInput struct {
+	UserID string
+}
+
+// TokenRefreshWorker refreshes LinkedIn access tokens before they expire.
+// Users whose refresh token is rejected are marked by the use case and are
+// skipped on later polls until they reconnect their LinkedIn account.
+type TokenRefreshWorker struct {
+	userRepo  interfaces.UserRepository
+	useCase   RefreshLinkedInTokenUseCase
+	logger    *zap.Logger
+	interval  time.Duration
+	window    time.Duration
+	batchSize int
+	now       func() time.Time
+
+	mu      sync.RWMutex
+	running bool
+	cancel  context.CancelFunc
+	done    chan struct{}
+	// Metrics
+	refreshedTotal     int64
+	unrecoverableTotal int64
+	failuresTotal      int64
+	pollErrorsTotal    int64
+}
+
+// TokenRefreshConfig holds token refresh worker configuration
+type TokenRefreshConfig struct {
+	UserRepo interfaces.UserRepository
+	UseCase  RefreshLinkedInTokenUseCase
+	Interval time.Duration
+	// Window is how long before expiry a token is refreshed
+	Window    time.Duration
+	BatchSize int
+	Logger    *zap.Logger
+	// Now overrides the clock, mainly for tests
+	Now func() time.Time
+}
+
+// NewTokenRefreshWorker creates a new token refresh worker
+func NewTokenRefreshWorker(config TokenRefreshConfig) (*TokenRefreshWorker, error) {
+	if config.UseCase == nil {
+		return nil, ErrNilUseCase
+	}
+
+	if config.UserRepo == nil {
+		return nil, ErrNilUserRepository
+	}
+
+	logger := config.Logger
+	if logger == nil {
+		logger, _ = zap.NewProduction()
+	}
+
+	interval := config.Interval
+	if interval <= 0 {
+		interval = defaultTokenRefreshInterval
+	}
+
+	window := config.Window
+	if window <= 0 {
+		window = defaultTokenRefreshWindow
+	}
+
+	batchSize := config.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultTokenRefreshBatchSize
+	}
+
+	now := config.Now
+	if now == nil {
+		now = time.Now
+	}
+
+	return &TokenRefreshWorker{
+		userRepo:  config.UserRepo,
+		useCase:   config.UseCase,
+		logger:    logger,
+		interval:  interval,
+		window:    window,
+		batchSize: batchSize,
+		now:       now,
+	}, nil
+}
+
+// Start starts polling for expiring tokens in the background
+func (w *TokenRefreshWorker) Start(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.running {
+		return ErrAlreadyRunning
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	w.cancel = cancel
+	w.done = make(chan struct{})
+	w.running = true
+
+	go w.run(runCtx, w.done)
+
+	w.logger.Info("token refresh worker started",
+		zap.Duration("interval", w.interval),
+		zap.Duration("window", w.window),
+	)
+
+	return nil
+}
+
+// Stop stops the worker, waiting for the in-flight batch up to shutdownTimeout
+func (w *TokenRefreshWorker) Stop(shutdownTimeout time.Duration) error {
+	w.mu.Lock()
+	if !w.running {
+		w.mu.Unlock()
+		return ErrNotRunning
+	}
+	cancel, done := w.cancel, w.done
+	w.running = false
+	w.mu.Unlock()
+
+	cancel()
+
+	select {
+	case <-done:
+		w.logger.Info("token refresh worker stopped")
+		return nil
+	case <-time.After(shutdownTimeout):
+		return errors.New("token refresh worker shutdown timeout exceeded")
+	}
+}
+
+// run polls immediately and then on every interval until ctx is cancelled
+func (w *TokenRefreshWorker) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.RefreshExpiring(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RefreshExpiring refreshes one batch of tokens nearing expiry and returns how many were refreshed
+func (w *TokenRefreshWorker) RefreshExpiring(ctx context.Context) int {
+	users, err := w.userRepo.FindWithExpiringLinkedInToken(ctx, w.now().Add(w.window), w.batchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.incrementPollErrors()
+			w.logger.Error("failed to find users with expiring LinkedIn tokens", zap.Error(err))
+		}
+		return 0
+	}
+
+	refreshed := 0
+	for _, user := range users {
+		if ctx.Err() != nil {
+			break
+		}
+
+		if w.refreshUser(ctx, user) {
+			refreshed++
+		}
+	}
+
+	return refreshed
+}
+
+// refreshUser refreshes a single user's token
+func (w *TokenRefreshWorker) refreshUser(ctx context.Context, user *entities.User) bool {
+	err := w.useCase.Execute(ctx, RefreshLinkedInTokenInput{UserID: user.ID})
+	if err == nil {
+		w.incrementRefreshed()
+		w.logger.Info("LinkedIn token refreshed", zap.String("user_id", user.ID))
+		return true
+	}
+
+	var credentialsErr *domainErrors.ErrInvalidUserCredentials
+	if errors.As(err, &credentialsErr) {
+		w.incrementUnrecoverable()
+		w.logger.Warn("LinkedIn token cannot be refreshed, user must reconnect",
+			zap.String("user_id", user.ID),
+			zap.String("reason", credentialsErr.Reason),
+		)
+		return false
+	}
+
+	// Transient failures are retried on the next poll while the token is still in the window
+	w.incrementFailures()
+	w.logger.Warn("LinkedIn token refresh failed",
+		zap.String("user_id", user.ID),
+		zap.Error(err),
+	)
+	return false
+}
+
+// IsRunning returns worker running status
+func (w *TokenRefreshWorker) IsRunning() bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.running
+}
+
+// GetMetrics returns worker metrics
+func (w *TokenRefreshWorker) GetMetrics() map[string]int64 {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	return map[string]int64{
+		"refreshed_total":     w.refreshedTotal,
+		"unrecoverable_total": w.unrecoverableTotal,
+		"failures_total":      w.failuresTotal,
+		"poll_errors_total":   w.pollErrorsTotal,
+	}
+}
+
+// incrementRefreshed increments refreshed tokens counter
+func (w *TokenRefreshWorker) incrementRefreshed() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.refreshedTotal++
+}
+
+// incrementUnrecoverable increments unrecoverable tokens counter
+func (w *TokenRefreshWorker) incrementUnrecoverable() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.unrecoverableTotal++
+}
+
+// incrementFailures increments refresh failures counter
+func (w *TokenRefreshWorker) incrementFailures() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.failuresTotal++
+}
+
+// incrementPollErrors increments repository errors counter
+func (w *TokenRefreshWorker) incrementPollErrors() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.pollErrorsTotal++
+}
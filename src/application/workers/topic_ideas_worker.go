@@ -0,0 +1,251 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrNilTopicIdeasGenerator indicates nil topic ideas generator
+	ErrNilTopicIdeasGenerator = errors.New("topic ideas generator cannot be nil")
+	// ErrQueueFull indicates the worker queue has no room for more work
+	ErrQueueFull = errors.New("worker queue is full")
+)
+
+const (
+	defaultTopicIdeasWorkers   = 2
+	defaultTopicIdeasQueueSize = 100
+	defaultTopicIdeasTimeout   = 5 * time.Minute
+)
+
+// TopicIdeasGenerator generates ideas for a single topic
+type TopicIdeasGenerator interface {
+	GenerateIdeasForTopic(ctx context.Context, topicID string) ([]*entities.Idea, error)
+}
+
+// TopicIdeasWorker generates ideas for topics created or updated through the API.
+// Topics are queued in a bounded buffer and handled by a fixed number of goroutines,
+// so a burst of requests never starts more LLM calls than the worker allows.
+// Topics that don't fit in the queue are rejected; the idea scheduler fills them later.
+type TopicIdeasWorker struct {
+	generator TopicIdeasGenerator
+	logger    *zap.Logger
+	workers   int
+	timeout   time.Duration
+	queue     chan string
+
+	mu      sync.RWMutex
+	running bool
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	// Metrics
+	enqueuedTotal  int64
+	rejectedTotal  int64
+	generatedTotal int64
+	failuresTotal  int64
+}
+
+// TopicIdeasConfig holds topic ideas worker configuration
+type TopicIdeasConfig struct {
+	Generator TopicIdeasGenerator
+	// Workers is the number of topics handled at the same time
+	Workers int
+	// QueueSize is the number of topics that can wait for a worker
+	QueueSize int
+	// Timeout bounds the generation for a single topic
+	Timeout time.Duration
+	Logger  *zap.Logger
+}
+
+// NewTopicIdeasWorker creates a new topic ideas worker
+func NewTopicIdeasWorker(config TopicIdeasConfig) (*TopicIdeasWorker, error) {
+	if config.Generator == nil {
+		return nil, ErrNilTopicIdeasGenerator
+	}
+
+	logger := config.Logger
+	if logger == nil {
+		logger, _ = zap.NewProduction()
+	}
+
+	workers := config.Workers
+	if workers <= 0 {
+		workers = defaultTopicIdeasWorkers
+	}
+
+	queueSize := config.QueueSize
+	if queueSize <= 0 {
+		queueSize = defaultTopicIdeasQueueSize
+	}
+
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = defaultTopicIdeasTimeout
+	}
+
+	return &TopicIdeasWorker{
+		generator: config.Generator,
+		logger:    logger,
+		workers:   workers,
+		timeout:   timeout,
+		queue:     make(chan string, queueSize),
+	}, nil
+}
+
+// Enqueue queues idea generation for a topic without blocking.
+// It returns ErrQueueFull when the queue has no room left.
+func (w *TopicIdeasWorker) Enqueue(topicID string) error {
+	select {
+	case w.queue <- topicID:
+		w.incrementEnqueued()
+		return nil
+	default:
+		w.incrementRejected()
+		return ErrQueueFull
+	}
+}
+
+// Start starts the worker goroutines
+func (w *TopicIdeasWorker) Start(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.running {
+		return ErrAlreadyRunning
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	w.cancel = cancel
+	w.running = true
+
+	for i := 0; i < w.workers; i++ {
+		w.wg.Add(1)
+		go w.run(runCtx)
+	}
+
+	w.logger.Info("topic ideas worker started",
+		zap.Int("workers", w.workers),
+		zap.Int("queue_size", cap(w.queue)),
+	)
+
+	return nil
+}
+
+// Stop stops the worker, waiting for in-flight topics up to shutdownTimeout.
+// Topics still queued are left to the idea scheduler.
+func (w *TopicIdeasWorker) Stop(shutdownTimeout time.Duration) error {
+	w.mu.Lock()
+	if !w.running {
+		w.mu.Unlock()
+		return ErrNotRunning
+	}
+	cancel := w.cancel
+	w.running = false
+	w.mu.Unlock()
+
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		w.logger.Info("topic ideas worker stopped")
+		return nil
+	case <-time.After(shutdownTimeout):
+		return errors.New("topic ideas worker shutdown timeout exceeded")
+	}
+}
+
+// run generates ideas for queued topics until ctx is cancelled
+func (w *TopicIdeasWorker) run(ctx context.Context) {
+	defer w.wg.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case topicID := <-w.queue:
+			w.generate(ctx, topicID)
+		}
+	}
+}
+
+// generate generates ideas for a single topic
+func (w *TopicIdeasWorker) generate(ctx context.Context, topicID string) {
+	generateCtx, cancel := context.WithTimeout(ctx, w.timeout)
+	defer cancel()
+
+	ideas, err := w.generator.GenerateIdeasForTopic(generateCtx, topicID)
+	if err != nil {
+		w.incrementFailures()
+		w.logger.Warn("failed to generate ideas for topic",
+			zap.String("topic_id", topicID),
+			zap.Error(err),
+		)
+		return
+	}
+
+	w.incrementGenerated()
+	w.logger.Info("generated ideas for topic",
+		zap.String("topic_id", topicID),
+		zap.Int("ideas", len(ideas)),
+	)
+}
+
+// IsRunning returns worker running status
+func (w *TopicIdeasWorker) IsRunning() bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.running
+}
+
+// GetMetrics returns worker metrics
+func (w *TopicIdeasWorker) GetMetrics() map[string]int64 {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	return map[string]int64{
+		"enqueued_total":  w.enqueuedTotal,
+		"rejected_total":  w.rejectedTotal,
+		"generated_total": w.generatedTotal,
+		"failures_total":  w.failuresTotal,
+		"queue_depth":     int64(len(w.queue)),
+	}
+}
+
+// incrementEnqueued increments queued topics counter
+func (w *TopicIdeasWorker) incrementEnqueued() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.enqueuedTotal++
+}
+
+// incrementRejected increments topics rejected by a full queue counter
+func (w *TopicIdeasWorker) incrementRejected() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.rejectedTotal++
+}
+
+// incrementGenerated increments topics with generated ideas counter
+func (w *TopicIdeasWorker) incrementGenerated() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.generatedTotal++
+}
+
+// incrementFailures increments generation failures counter
+func (w *TopicIdeasWorker) incrementFailures() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.failuresTotal++
+}
@@ -0,0 +1,101 @@
+// Command fake-linkedin serves an in-memory stand-in for the LinkedIn API.
+//
+// Point the application at it with:
+//
+//	LINKGEN_LINKEDIN_API_URL=http://localhost:8090/v2
+//	LINKGEN_LINKEDIN_TOKEN_URL=http://localhost:8090/oauth/v2/accessToken
+//	LINKGEN_LINKEDIN_AUTH_URL=http://localhost:8090/oauth/v2/authorization
+//
+// Faults are injected at runtime through the admin endpoints, e.g.
+//
+//	curl -X POST localhost:8090/_fake/faults -d '{"path":"/v2/ugcPosts","status":429,"retry_after_seconds":30,"times":1}'
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/infrastructure/http/linkedin/fakelinkedin"
+	"go.uber.org/zap"
+)
+
+func main() {
+	logger, err := zap.NewProduction()
+	if err != nil {
+		panic(err)
+	}
+	defer logger.Sync()
+
+	addr := flag.String("addr", envOrDefault("FAKE_LINKEDIN_ADDR", ":8090"), "listen address")
+	clientID := flag.String("client-id", os.Getenv("FAKE_LINKEDIN_CLIENT_ID"), "OAuth client ID required by the token endpoint (optional)")
+	clientSecret := flag.String("client-secret", os.Getenv("FAKE_LINKEDIN_CLIENT_SECRET"), "OAuth client secret required by the token endpoint (optional)")
+	memberID := flag.String("member-id", envOrDefault("FAKE_LINKEDIN_MEMBER_ID", fakelinkedin.DefaultMemberID), "member behind seeded and authorized tokens")
+	accessToken := flag.String("token", os.Getenv("FAKE_LINKEDIN_TOKEN"), "static access token to accept (optional)")
+	refreshToken := flag.String("refresh-token", os.Getenv("FAKE_LINKEDIN_REFRESH_TOKEN"), "static refresh token paired with -token (optional)")
+	latency := flag.Duration("latency", durationOrDefault("FAKE_LINKEDIN_LATENCY", 0), "latency added to every API request")
+	flag.Parse()
+
+	server := fakelinkedin.New(fakelinkedin.Options{
+		ClientID:     *clientID,
+		ClientSecret: *clientSecret,
+		MemberID:     *memberID,
+		Latency:      *latency,
+	})
+
+	if *accessToken != "" {
+		server.AddToken(fakelinkedin.Token{
+			AccessToken:  *accessToken,
+			RefreshToken: *refreshToken,
+		})
+	}
+
+	httpServer := &http.Server{
+		Addr:              *addr,
+		Handler:           server,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		logger.Info("fake LinkedIn API listening",
+			zap.String("addr", *addr),
+			zap.String("member_id", *memberID),
+			zap.Duration("latency", *latency),
+		)
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Fatal("fake LinkedIn API failed", zap.Error(err))
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := httpServer.Shutdown(ctx); err != nil {
+		logger.Error("failed to shut down fake LinkedIn API", zap.Error(err))
+	}
+}
+
+// envOrDefault returns the environment variable or a default when unset
+func envOrDefault(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// durationOrDefault parses a duration environment variable, falling back when unset or invalid
+func durationOrDefault(key string, fallback time.Duration) time.Duration {
+	if d, err := time.ParseDuration(os.Getenv(key)); err == nil {
+		return d
+	}
+	return fallback
+}
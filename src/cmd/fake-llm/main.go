@@ -0,0 +1,102 @@
+// Command fake-llm serves a scripted OpenAI-compatible chat completions API.
+//
+// Point the application at it with:
+//
+//	LINKGEN_LLM_ENDPOINT=http://localhost:8081
+//
+// Rules are loaded from a JSON script (-script) and matched in order before the
+// built-in defaults, which answer the ideas, drafts and refinement prompts with valid
+// content. More rules can be added at runtime, e.g.
+//
+//	curl -X POST localhost:8081/_fake/rules -d '{"match":"\"ideas\"","status":429,"retry_after_seconds":2,"times":1}'
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/fakellm"
+	"go.uber.org/zap"
+)
+
+func main() {
+	logger, err := zap.NewProduction()
+	if err != nil {
+		panic(err)
+	}
+	defer logger.Sync()
+
+	addr := flag.String("addr", envOrDefault("FAKE_LLM_ADDR", ":8081"), "listen address")
+	scriptPath := flag.String("script", os.Getenv("FAKE_LLM_SCRIPT"), "JSON script with rules matched before the defaults (optional)")
+	latency := flag.Duration("latency", durationOrDefault("FAKE_LLM_LATENCY", 0), "latency added to every completion")
+	noDefaults := flag.Bool("no-defaults", os.Getenv("FAKE_LLM_NO_DEFAULTS") == "true", "answer 404 instead of default content when no rule matches")
+	flag.Parse()
+
+	var rules []fakellm.Rule
+	if *scriptPath != "" {
+		scripted, err := fakellm.LoadScript(*scriptPath)
+		if err != nil {
+			logger.Fatal("failed to load script", zap.Error(err))
+		}
+		rules = append(rules, scripted...)
+	}
+	if !*noDefaults {
+		rules = append(rules, fakellm.DefaultRules()...)
+	}
+
+	server := fakellm.New(fakellm.Options{
+		Rules:   rules,
+		Latency: *latency,
+	})
+
+	httpServer := &http.Server{
+		Addr:              *addr,
+		Handler:           server,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		logger.Info("fake LLM API listening",
+			zap.String("addr", *addr),
+			zap.String("script", *scriptPath),
+			zap.Int("rules", len(rules)),
+			zap.Duration("latency", *latency),
+		)
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Fatal("fake LLM API failed", zap.Error(err))
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := httpServer.Shutdown(ctx); err != nil {
+		logger.Error("failed to shut down fake LLM API", zap.Error(err))
+	}
+}
+
+// envOrDefault returns the environment variable or a default when unset
+func envOrDefault(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// durationOrDefault parses a duration environment variable, falling back when unset or invalid
+func durationOrDefault(key string, fallback time.Duration) time.Duration {
+	if d, err := time.ParseDuration(os.Getenv(key)); err == nil {
+		return d
+	}
+	return fallback
+}
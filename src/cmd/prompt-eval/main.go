@@ -0,0 +1,231 @@
+// Command prompt-eval runs prompts against a fixed corpus of topics and ideas before an edit
+// is rolled out, and writes a comparison report in JSON and Markdown.
+//
+// Each prompt file is rendered for every corpus case of its type and sent through the
+// LLM configured for the application (LINKGEN_*, including LINKGEN_LLM_REPLAY_MODE), or through
+// an in-process fake (-fake), optionally behind recorded fixtures (-replay-mode replay
+// -replay-dir testdata/eval). The first prompt of each type is the baseline:
+//
+//	go run ./cmd/prompt-eval -corpus ../seed/eval/corpus.json \
+//		-prompt ../seed/prompt/pro.draft.md -prompt candidate.draft.md -out eval-report
+//
+// The outputs are scored with deterministic checks: valid JSON, number of posts, 120-260
+// words per post, a call to action and the expected language.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	appServices "github.com/linkgen-ai/backend/src/application/services"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/config"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/fakellm"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/replay"
+	infraServices "github.com/linkgen-ai/backend/src/infrastructure/services"
+	"go.uber.org/zap"
+)
+
+// promptFlags collects the repeated -prompt flag
+type promptFlags []string
+
+func (p *promptFlags) String() string {
+	return strings.Join(*p, ",")
+}
+
+func (p *promptFlags) Set(value string) error {
+	*p = append(*p, value)
+	return nil
+}
+
+func main() {
+	logger, err := zap.NewProduction()
+	if err != nil {
+		panic(err)
+	}
+	defer logger.Sync()
+
+	var prompts promptFlags
+	flag.Var(&prompts, "prompt", "prompt file to evaluate (repeatable; the first of each type is the baseline)")
+	corpusPath := flag.String("corpus", "../seed/eval/corpus.json", "JSON corpus of topics and ideas")
+	outDir := flag.String("out", "eval-report", "directory the report.json and report.md are written to")
+	fake := flag.Bool("fake", false, "answer with the built-in fake LLM instead of the configured one")
+	replayMode := flag.String("replay-mode", "", "record or replay LLM responses (overrides LINKGEN_LLM_REPLAY_MODE)")
+	replayDir := flag.String("replay-dir", "", "fixture directory for -replay-mode (overrides LINKGEN_LLM_REPLAY_DIR)")
+	minWords := flag.Int("min-words", 0, "minimum words per post (default 120)")
+	maxWords := flag.Int("max-words", 0, "maximum words per post (default 260)")
+	flag.Parse()
+
+	if len(prompts) == 0 {
+		fmt.Fprintln(os.Stderr, "at least one -prompt is required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, logger, runOptions{
+		prompts:    prompts,
+		corpusPath: *corpusPath,
+		outDir:     *outDir,
+		fake:       *fake,
+		replayMode: *replayMode,
+		replayDir:  *replayDir,
+		config:     appServices.PromptEvaluatorConfig{MinPostWords: *minWords, MaxPostWords: *maxWords},
+	}); err != nil {
+		logger.Fatal("prompt evaluation failed", zap.Error(err))
+	}
+}
+
+// runOptions are the parsed command line options
+type runOptions struct {
+	prompts    []string
+	corpusPath string
+	outDir     string
+	fake       bool
+	replayMode string
+	replayDir  string
+	config     appServices.PromptEvaluatorConfig
+}
+
+// run evaluates the prompts and writes the report
+func run(ctx context.Context, logger *zap.Logger, opts runOptions) error {
+	corpus, err := appServices.LoadPromptEvalCorpus(opts.corpusPath)
+	if err != nil {
+		return err
+	}
+
+	loader := infraServices.NewPromptLoader(nil)
+	candidates := make([]appServices.PromptEvalCandidate, 0, len(opts.prompts))
+	for _, path := range opts.prompts {
+		file, err := loader.LoadPromptFile(path)
+		if err != nil {
+			return err
+		}
+		candidates = append(candidates, appServices.PromptEvalCandidate{
+			Name:          file.Name,
+			Type:          entities.PromptType(file.Type),
+			Template:      file.PromptTemplate,
+			SystemMessage: file.SystemMessage,
+			Parameters:    file.Parameters,
+		})
+	}
+
+	llmService, closeLLM, err := newLLMService(opts)
+	if err != nil {
+		return err
+	}
+	defer closeLLM()
+
+	evaluator, err := appServices.NewPromptEvaluator(infraServices.NewPromptEngine(nil, nil), llmService, opts.config)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("evaluating prompts",
+		zap.Int("prompts", len(candidates)),
+		zap.Int("cases", len(corpus.Cases)),
+		zap.String("corpus", opts.corpusPath),
+	)
+
+	report, err := evaluator.Evaluate(ctx, corpus, candidates)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(opts.outDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create report directory: %w", err)
+	}
+	reportJSON, err := report.JSON()
+	if err != nil {
+		return fmt.Errorf("failed to encode report: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(opts.outDir, "report.json"), reportJSON, 0o644); err != nil {
+		return fmt.Errorf("failed to write JSON report: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(opts.outDir, "report.md"), []byte(report.Markdown()), 0o644); err != nil {
+		return fmt.Errorf("failed to write Markdown report: %w", err)
+	}
+
+	for _, result := range report.Prompts {
+		logger.Info("prompt evaluated",
+			zap.String("prompt", result.Prompt),
+			zap.String("type", string(result.Type)),
+			zap.Float64("score", result.Score),
+			zap.Int("passed_cases", result.Passed),
+			zap.Int("cases", len(result.Cases)),
+		)
+	}
+	logger.Info("report written", zap.String("dir", opts.outDir))
+	return nil
+}
+
+// newLLMService builds the LLM service the prompts are sent through and a function that
+// releases it. The application configuration is only loaded when the fake isn't used, so
+// replaying fixtures needs no database or NATS settings.
+func newLLMService(opts runOptions) (interfaces.LLMService, func(), error) {
+	closeLLM := func() {}
+	var llmConfig llm.Config
+	var mode, dir string
+
+	if opts.fake {
+		server := fakellm.NewTestServer(fakellm.Options{Rules: fakellm.DefaultRules()})
+		closeLLM = server.Close
+		llmConfig = server.ClientConfig()
+	} else {
+		cfg, err := config.LoadFromEnvironment()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load configuration: %w", err)
+		}
+		llmConfig = llm.Config{
+			BaseURL:    cfg.LLM.Endpoint,
+			Timeout:    cfg.LLM.Timeout,
+			MaxRetries: 3,
+			Model:      cfg.LLM.Model,
+			Provider:   cfg.LLM.Provider,
+			APIKey:     cfg.LLM.APIKey,
+
+			Temperature:             cfg.LLM.Temperature,
+			MaxTokens:               cfg.LLM.MaxTokens,
+			DisableStructuredOutput: cfg.LLM.DisableStructuredOutput,
+		}
+		mode, dir = cfg.LLM.ReplayMode, cfg.LLM.ReplayDir
+	}
+
+	client, err := llm.NewLLMHTTPClient(llmConfig)
+	if err != nil {
+		closeLLM()
+		return nil, nil, fmt.Errorf("failed to create LLM client: %w", err)
+	}
+
+	if opts.replayMode != "" {
+		mode = opts.replayMode
+	}
+	if opts.replayDir != "" {
+		dir = opts.replayDir
+	}
+	if mode == "" {
+		return client, closeLLM, nil
+	}
+
+	parsed, err := replay.ParseMode(mode)
+	if err != nil {
+		closeLLM()
+		return nil, nil, err
+	}
+	replayClient, err := replay.NewClient(client, replay.Config{Mode: parsed, Dir: dir, Model: llmConfig.Model})
+	if err != nil {
+		closeLLM()
+		return nil, nil, fmt.Errorf("failed to create LLM replay client: %w", err)
+	}
+	return replayClient, closeLLM, nil
+}
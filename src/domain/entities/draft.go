@@ -20,8 +20,11 @@ type DraftStatus string
 const (
 	DraftStatusDraft     DraftStatus = "DRAFT"
 	DraftStatusRefined   DraftStatus = "REFINED"
-	DraftStatusPublished DraftStatus = "PUBLISHED"
-	DraftStatusFailed    DraftStatus = "FAILED"
+	DraftStatusScheduled DraftStatus = "SCHEDULED"
+	// DraftStatusPublishing marks a draft taken by a publisher while LinkedIn is being called
+	DraftStatusPublishing DraftStatus = "PUBLISHING"
+	DraftStatusPublished  DraftStatus = "PUBLISHED"
+	DraftStatusFailed     DraftStatus = "FAILED"
 )
 
 // RefinementEntry represents a single refinement in the history
@@ -32,6 +35,13 @@ type RefinementEntry struct {
 	Version   int
 }
 
+// PublishError records why the last publishing attempt of a draft failed
+type PublishError struct {
+	Reason     string
+	Message    string
+	OccurredAt time.Time
+}
+
 // Draft represents a content draft ready for publication
 type Draft struct {
 	ID                string
@@ -42,8 +52,10 @@ type Draft struct {
 	Content           string
 	Status            DraftStatus
 	RefinementHistory []RefinementEntry
+	ScheduledAt       *time.Time
 	PublishedAt       *time.Time
 	LinkedInPostID    string
+	PublishError      *PublishError
 	Metadata          map[string]interface{}
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
@@ -93,6 +105,8 @@ func (d *Draft) isValidType() bool {
 func (d *Draft) isValidStatus() bool {
 	return d.Status == DraftStatusDraft ||
 		d.Status == DraftStatusRefined ||
+		d.Status == DraftStatusScheduled ||
+		d.Status == DraftStatusPublishing ||
 		d.Status == DraftStatusPublished ||
 		d.Status == DraftStatusFailed
 }
@@ -166,6 +180,10 @@ func (d *Draft) CanBePublished() error {
 		return fmt.Errorf("draft is already published")
 	}
 
+	if d.Status == DraftStatusPublishing {
+		return fmt.Errorf("draft is already being published")
+	}
+
 	if d.Status == DraftStatusFailed {
 		return fmt.Errorf("cannot publish failed draft")
 	}
@@ -177,6 +195,43 @@ func (d *Draft) CanBePublished() error {
 	return nil
 }
 
+// Schedule schedules the draft to be published automatically at publishAt
+func (d *Draft) Schedule(publishAt time.Time) error {
+	if d.Status != DraftStatusScheduled {
+		if err := d.CanTransitionTo(DraftStatusScheduled); err != nil {
+			return err
+		}
+	}
+
+	if publishAt.IsZero() {
+		return fmt.Errorf("publish time cannot be empty")
+	}
+
+	now := time.Now()
+	if !publishAt.After(now) {
+		return fmt.Errorf("publish time must be in the future")
+	}
+
+	if err := d.ValidateForType(); err != nil {
+		return fmt.Errorf("draft validation failed: %w", err)
+	}
+
+	scheduledAt := publishAt.UTC()
+	d.Status = DraftStatusScheduled
+	d.ScheduledAt = &scheduledAt
+	d.PublishError = nil
+	d.UpdatedAt = now
+
+	return nil
+}
+
+// IsDueForPublishing checks if a scheduled draft should be published at the given time
+func (d *Draft) IsDueForPublishing(now time.Time) bool {
+	return d.Status == DraftStatusScheduled &&
+		d.ScheduledAt != nil &&
+		!d.ScheduledAt.After(now)
+}
+
 // AddRefinement adds a refinement to the history
 func (d *Draft) AddRefinement(content, prompt string) error {
 	if !d.CanBeRefined() {
@@ -227,6 +282,7 @@ func (d *Draft) MarkAsPublished(linkedInID string) error {
 	d.Status = DraftStatusPublished
 	d.LinkedInPostID = trimmedID
 	d.PublishedAt = &now
+	d.PublishError = nil
 	d.UpdatedAt = now
 
 	return nil
@@ -238,6 +294,18 @@ func (d *Draft) MarkAsFailed() {
 	d.UpdatedAt = time.Now()
 }
 
+// MarkAsPublishFailed marks draft as failed and records the publishing error reason
+func (d *Draft) MarkAsPublishFailed(reason, message string) {
+	now := time.Now()
+	d.Status = DraftStatusFailed
+	d.PublishError = &PublishError{
+		Reason:     strings.TrimSpace(reason),
+		Message:    strings.TrimSpace(message),
+		OccurredAt: now,
+	}
+	d.UpdatedAt = now
+}
+
 // GetLatestVersion returns the latest content version
 func (d *Draft) GetLatestVersion() string {
 	if len(d.RefinementHistory) == 0 {
@@ -253,19 +321,38 @@ func (d *Draft) CanTransitionTo(newStatus DraftStatus) error {
 	allowedTransitions := map[DraftStatus][]DraftStatus{
 		DraftStatusDraft: {
 			DraftStatusRefined,
+			DraftStatusScheduled,
+			DraftStatusPublishing,
 			DraftStatusPublished,
 			DraftStatusFailed,
 		},
 		DraftStatusRefined: {
 			DraftStatusDraft,
+			DraftStatusScheduled,
+			DraftStatusPublishing,
 			DraftStatusPublished,
 			DraftStatusFailed,
 		},
+		DraftStatusScheduled: {
+			DraftStatusDraft, // Unschedule
+			DraftStatusPublishing,
+			DraftStatusPublished,
+			DraftStatusFailed,
+		},
+		DraftStatusPublishing: {
+			DraftStatusPublished,
+			DraftStatusFailed,
+			// Released when nothing was posted, or taken back once the claim expired
+			DraftStatusDraft,
+			DraftStatusRefined,
+			DraftStatusScheduled,
+		},
 		DraftStatusPublished: {
 			// No transitions allowed from published
 		},
 		DraftStatusFailed: {
-			DraftStatusDraft, // Allow retry
+			DraftStatusDraft,     // Allow retry
+			DraftStatusScheduled, // Allow scheduled retry
 		},
 	}
 
@@ -0,0 +1,101 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	MaxExperimentNameLength = 100
+	MinExperimentArms       = 2
+	MaxExperimentArms       = 10
+)
+
+// ExperimentArm is one of the prompts an experiment compares and its share of the traffic
+type ExperimentArm struct {
+	PromptName string
+	Weight     int
+}
+
+// Experiment splits the generations of a prompt type across several of the user's prompts,
+// each picked with probability proportional to its weight
+type Experiment struct {
+	ID        string
+	UserID    string
+	Name      string
+	Type      PromptType
+	Arms      []ExperimentArm
+	Active    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Validate validates the experiment entity
+func (e *Experiment) Validate() error {
+	if e == nil {
+		return fmt.Errorf("experiment cannot be nil")
+	}
+
+	if strings.TrimSpace(e.ID) == "" {
+		return fmt.Errorf("experiment ID cannot be empty")
+	}
+
+	if strings.TrimSpace(e.UserID) == "" {
+		return fmt.Errorf("user ID cannot be empty")
+	}
+
+	if len(e.Name) > MaxExperimentNameLength {
+		return fmt.Errorf("experiment name too long (maximum %d characters)", MaxExperimentNameLength)
+	}
+
+	// Only draft generation picks between prompts
+	if e.Type != PromptTypeDrafts {
+		return fmt.Errorf("unsupported experiment type: %s", e.Type)
+	}
+
+	if len(e.Arms) < MinExperimentArms || len(e.Arms) > MaxExperimentArms {
+		return fmt.Errorf("experiment must have between %d and %d arms", MinExperimentArms, MaxExperimentArms)
+	}
+
+	seen := make(map[string]bool, len(e.Arms))
+	for _, arm := range e.Arms {
+		if strings.TrimSpace(arm.PromptName) == "" {
+			return fmt.Errorf("arm prompt name cannot be empty")
+		}
+		if seen[arm.PromptName] {
+			return fmt.Errorf("duplicate arm prompt: %s", arm.PromptName)
+		}
+		seen[arm.PromptName] = true
+
+		if arm.Weight < 0 {
+			return fmt.Errorf("arm weight cannot be negative: %s", arm.PromptName)
+		}
+	}
+
+	if e.TotalWeight() == 0 {
+		return fmt.Errorf("at least one arm must have a positive weight")
+	}
+
+	return nil
+}
+
+// TotalWeight returns the sum of the weights of the arms
+func (e *Experiment) TotalWeight() int {
+	total := 0
+	for _, arm := range e.Arms {
+		total += arm.Weight
+	}
+	return total
+}
+
+// PickArm returns the arm that owns point n of the cumulative weights, for n in [0, TotalWeight())
+func (e *Experiment) PickArm(n int) ExperimentArm {
+	for _, arm := range e.Arms {
+		if n < arm.Weight {
+			return arm
+		}
+		n -= arm.Weight
+	}
+	return e.Arms[len(e.Arms)-1]
+}
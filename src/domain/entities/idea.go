@@ -8,16 +8,21 @@ import (
 
 // Idea represents a generated content idea
 type Idea struct {
-	ID           string
-	UserID       string
-	TopicID      string
-	TopicName    string // Name of the related topic
-	Content      string
-	QualityScore *float64
-	Used         bool
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	ExpiresAt    *time.Time
+	ID               string
+	UserID           string
+	TopicID          string
+	TopicName        string // Name of the related topic
+	Content          string
+	QualityScore     *float64
+	Used             bool
+	PublishedAt      *time.Time
+	PublishedDraftID string
+	// PromptID and PromptVersion identify the prompt version that generated the idea
+	PromptID      string
+	PromptVersion int
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	ExpiresAt     *time.Time
 }
 
 const (
@@ -123,6 +128,26 @@ func (i *Idea) MarkAsUsed() error {
 	return nil
 }
 
+// MarkAsPublished records that a draft generated from the idea was published
+func (i *Idea) MarkAsPublished(draftID string) error {
+	trimmedID := strings.TrimSpace(draftID)
+	if trimmedID == "" {
+		return fmt.Errorf("draft ID cannot be empty")
+	}
+
+	now := time.Now()
+	i.Used = true
+	i.PublishedDraftID = trimmedID
+	i.PublishedAt = &now
+	i.UpdatedAt = now
+	return nil
+}
+
+// IsPublished checks if a draft generated from the idea was published
+func (i *Idea) IsPublished() bool {
+	return i.PublishedAt != nil
+}
+
 // IsExpired checks if idea has expired
 func (i *Idea) IsExpired() bool {
 	if i.ExpiresAt == nil {
@@ -31,6 +31,7 @@ type Job struct {
 	IdeaID      *string
 	DraftIDs    []string
 	Error       string
+	Metadata    map[string]string // Execution details, such as the LLM key source
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	StartedAt   *time.Time
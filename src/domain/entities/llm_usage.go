@@ -0,0 +1,87 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// LLMUsage records the token usage and latency of a single LLM call
+type LLMUsage struct {
+	ID               string
+	UserID           string
+	JobID            string
+	PromptName       string
+	Provider         string
+	Model            string
+	PromptTokens     int
+	CompletionTokens int
+	Latency          time.Duration
+	CreatedAt        time.Time
+}
+
+// Validate validates the LLMUsage entity
+func (u *LLMUsage) Validate() error {
+	if u == nil {
+		return fmt.Errorf("llm usage cannot be nil")
+	}
+
+	if strings.TrimSpace(u.UserID) == "" {
+		return fmt.Errorf("llm usage user_id cannot be empty")
+	}
+
+	if strings.TrimSpace(u.Model) == "" {
+		return fmt.Errorf("llm usage model cannot be empty")
+	}
+
+	if u.PromptTokens < 0 || u.CompletionTokens < 0 {
+		return fmt.Errorf("llm usage token counts must be >= 0")
+	}
+
+	if u.Latency < 0 {
+		return fmt.Errorf("llm usage latency must be >= 0")
+	}
+
+	if u.CreatedAt.IsZero() {
+		u.CreatedAt = time.Now()
+	}
+
+	return nil
+}
+
+// TotalTokens returns prompt plus completion tokens
+func (u *LLMUsage) TotalTokens() int {
+	return u.PromptTokens + u.CompletionTokens
+}
+
+// LLMUsageTotals aggregates the calls of one model and prompt
+type LLMUsageTotals struct {
+	Model            string
+	PromptName       string
+	Calls            int
+	PromptTokens     int
+	CompletionTokens int
+	TotalLatency     time.Duration
+}
+
+// LLMUsageFilter selects the calls of a user within [From, To)
+type LLMUsageFilter struct {
+	UserID string
+	// JobID restricts the calls to a single job when set
+	JobID string
+	From  time.Time
+	To    time.Time
+}
+
+// LLMQuota limits the LLM usage of a user per UTC day and calendar month; zero means unlimited
+type LLMQuota struct {
+	DailyTokens     int64
+	DailyRequests   int64
+	MonthlyTokens   int64
+	MonthlyRequests int64
+}
+
+// IsUnlimited reports whether the quota sets no limit at all
+func (q LLMQuota) IsUnlimited() bool {
+	return q.DailyTokens <= 0 && q.DailyRequests <= 0 && q.MonthlyTokens <= 0 && q.MonthlyRequests <= 0
+}
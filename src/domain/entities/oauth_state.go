@@ -0,0 +1,50 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// OAuthState tracks a pending OAuth authorization request until its callback arrives
+type OAuthState struct {
+	State        string
+	UserID       string
+	CodeVerifier string
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+}
+
+// Validate validates the OAuthState entity
+func (s *OAuthState) Validate() error {
+	if s == nil {
+		return fmt.Errorf("oauth state cannot be nil")
+	}
+
+	if strings.TrimSpace(s.State) == "" {
+		return fmt.Errorf("oauth state value cannot be empty")
+	}
+
+	if strings.TrimSpace(s.UserID) == "" {
+		return fmt.Errorf("oauth state user_id cannot be empty")
+	}
+
+	if strings.TrimSpace(s.CodeVerifier) == "" {
+		return fmt.Errorf("oauth state code verifier cannot be empty")
+	}
+
+	if s.ExpiresAt.IsZero() {
+		return fmt.Errorf("oauth state expiry cannot be zero")
+	}
+
+	if s.CreatedAt.IsZero() {
+		s.CreatedAt = time.Now()
+	}
+
+	return nil
+}
+
+// IsExpired checks if the authorization request is no longer valid at now
+func (s *OAuthState) IsExpired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
@@ -7,4 +7,7 @@
 // - Topic: Represents a content topic for idea generation
 // - Idea: Represents a generated content idea
 // - Draft: Represents a draft post or article ready for publication
+// - PromptVersion: Represents an immutable snapshot of a prompt
+// - Experiment: Represents an A/B split of draft generation across prompts
+// - LLMUsage: Represents the token usage and latency of one LLM call
 package entities
@@ -2,6 +2,7 @@ package entities
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 )
@@ -22,9 +23,24 @@ type Prompt struct {
 	Name           string // Unique identifier for the prompt
 	StyleName      string // For backward compatibility
 	PromptTemplate string
-	Active         bool
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	// SystemMessage is sent as a system message ahead of the processed template
+	SystemMessage string
+	// Parameters override the configured LLM settings for this prompt
+	Parameters PromptParameters
+	// Version is the number of the prompt's current PromptVersion, 0 for prompts saved before versioning
+	Version   int
+	Active    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// PromptParameters are the LLM settings of a prompt; zero values keep the configured defaults
+type PromptParameters struct {
+	// Temperature is nil when unset, since 0 is a valid temperature
+	Temperature   *float64
+	MaxTokens     int
+	Model         string
+	StopSequences []string
 }
 
 const (
@@ -32,6 +48,10 @@ const (
 	MaxPromptTemplateLength = 5000
 	MaxStyleNameLength      = 50
 	MaxNameLength           = 50
+	MaxPromptMaxTokens      = 32000
+	MaxPromptModelLength    = 100
+	// MaxStopSequences is the most stop sequences OpenAI accepts
+	MaxStopSequences = 4
 )
 
 // Validate validates the prompt entity
@@ -60,6 +80,14 @@ func (p *Prompt) Validate() error {
 		return err
 	}
 
+	if err := p.ValidateSystemMessage(); err != nil {
+		return err
+	}
+
+	if err := p.Parameters.Validate(); err != nil {
+		return err
+	}
+
 	if p.CreatedAt.IsZero() {
 		return fmt.Errorf("created timestamp cannot be zero")
 	}
@@ -144,6 +172,53 @@ func (p *Prompt) ValidateTemplate() error {
 	return nil
 }
 
+// ValidateSystemMessage validates the optional system message
+func (p *Prompt) ValidateSystemMessage() error {
+	if len(p.SystemMessage) > MaxPromptTemplateLength {
+		return fmt.Errorf("system message too long (maximum %d characters)", MaxPromptTemplateLength)
+	}
+	return nil
+}
+
+// Validate validates the prompt parameters
+func (pp PromptParameters) Validate() error {
+	if pp.Temperature != nil && (*pp.Temperature < 0 || *pp.Temperature > 2) {
+		return fmt.Errorf("temperature must be between 0.0 and 2.0")
+	}
+
+	if pp.MaxTokens < 0 || pp.MaxTokens > MaxPromptMaxTokens {
+		return fmt.Errorf("max_tokens must be between 0 and %d", MaxPromptMaxTokens)
+	}
+
+	if len(pp.Model) > MaxPromptModelLength {
+		return fmt.Errorf("model too long (maximum %d characters)", MaxPromptModelLength)
+	}
+
+	if len(pp.StopSequences) > MaxStopSequences {
+		return fmt.Errorf("too many stop sequences (maximum %d)", MaxStopSequences)
+	}
+	for _, stop := range pp.StopSequences {
+		if stop == "" {
+			return fmt.Errorf("stop sequences cannot be empty")
+		}
+	}
+
+	return nil
+}
+
+// Equal reports whether two sets of parameters set the same values
+func (pp PromptParameters) Equal(other PromptParameters) bool {
+	if (pp.Temperature == nil) != (other.Temperature == nil) {
+		return false
+	}
+	if pp.Temperature != nil && *pp.Temperature != *other.Temperature {
+		return false
+	}
+	return pp.MaxTokens == other.MaxTokens &&
+		pp.Model == other.Model &&
+		slices.Equal(pp.StopSequences, other.StopSequences)
+}
+
 // IsOwnedBy checks if prompt belongs to specified user
 func (p *Prompt) IsOwnedBy(userID string) bool {
 	return p.UserID != "" && p.UserID == userID
@@ -0,0 +1,75 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+// PromptVersion is an immutable snapshot of what a prompt sends to the LLM. Every change to a
+// prompt's template, system message or parameters records a new version.
+type PromptVersion struct {
+	ID             string
+	PromptID       string
+	UserID         string
+	Version        int
+	Type           PromptType
+	Name           string
+	PromptTemplate string
+	SystemMessage  string
+	Parameters     PromptParameters
+	CreatedAt      time.Time
+}
+
+// NewPromptVersion snapshots prompt as its current version
+func NewPromptVersion(prompt *Prompt) *PromptVersion {
+	return &PromptVersion{
+		PromptID:       prompt.ID,
+		UserID:         prompt.UserID,
+		Version:        prompt.Version,
+		Type:           prompt.Type,
+		Name:           prompt.Name,
+		PromptTemplate: prompt.PromptTemplate,
+		SystemMessage:  prompt.SystemMessage,
+		Parameters:     prompt.Parameters,
+		CreatedAt:      time.Now(),
+	}
+}
+
+// Validate validates the PromptVersion entity
+func (v *PromptVersion) Validate() error {
+	if v == nil {
+		return fmt.Errorf("prompt version cannot be nil")
+	}
+
+	if v.PromptID == "" {
+		return fmt.Errorf("prompt ID cannot be empty")
+	}
+
+	if v.Version < 1 {
+		return fmt.Errorf("version must be positive")
+	}
+
+	if v.CreatedAt.IsZero() {
+		return fmt.Errorf("created timestamp cannot be zero")
+	}
+
+	return nil
+}
+
+// ChangesContent reports whether saving prompt would change what its current version sends
+// to the LLM, and so needs a new version
+func (p *Prompt) ChangesContent(current *Prompt) bool {
+	return current == nil ||
+		p.Type != current.Type ||
+		p.PromptTemplate != current.PromptTemplate ||
+		p.SystemMessage != current.SystemMessage ||
+		!p.Parameters.Equal(current.Parameters)
+}
+
+// Restore sets the prompt's content back to that of version. Saving it records a new version.
+func (p *Prompt) Restore(version *PromptVersion) {
+	p.PromptTemplate = version.PromptTemplate
+	p.SystemMessage = version.SystemMessage
+	p.Parameters = version.Parameters
+	p.UpdatedAt = time.Now()
+}
@@ -0,0 +1,152 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/valueobjects"
+)
+
+// ScheduleAction represents the work a schedule triggers when it fires
+type ScheduleAction string
+
+const (
+	// ScheduleActionGenerateIdeas generates new ideas from a random topic of the user
+	ScheduleActionGenerateIdeas ScheduleAction = "generate-ideas"
+	// ScheduleActionGenerateDrafts generates drafts from the user's best unused idea
+	ScheduleActionGenerateDrafts ScheduleAction = "generate-drafts-for-best-idea"
+	// ScheduleActionPublishNext publishes the user's oldest refined draft
+	ScheduleActionPublishNext ScheduleAction = "publish-next-approved"
+)
+
+const (
+	MaxScheduleNameLength = 100
+	DefaultScheduleZone   = "UTC"
+)
+
+// IsValid checks if the schedule action is supported
+func (a ScheduleAction) IsValid() bool {
+	return a == ScheduleActionGenerateIdeas ||
+		a == ScheduleActionGenerateDrafts ||
+		a == ScheduleActionPublishNext
+}
+
+// Schedule fires a set of actions for a user following a cron expression
+// evaluated in the user's time zone
+type Schedule struct {
+	ID             string
+	UserID         string
+	Name           string
+	CronExpression string
+	TimeZone       string
+	Actions        []ScheduleAction
+	Active         bool
+	NextRunAt      *time.Time
+	LastRunAt      *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Validate validates the schedule entity
+func (s *Schedule) Validate() error {
+	if s == nil {
+		return fmt.Errorf("schedule cannot be nil")
+	}
+
+	if strings.TrimSpace(s.ID) == "" {
+		return fmt.Errorf("schedule ID cannot be empty")
+	}
+
+	if strings.TrimSpace(s.UserID) == "" {
+		return fmt.Errorf("user ID cannot be empty")
+	}
+
+	if len(s.Name) > MaxScheduleNameLength {
+		return fmt.Errorf("schedule name too long (maximum %d characters)", MaxScheduleNameLength)
+	}
+
+	if _, err := valueobjects.ParseCronExpression(s.CronExpression); err != nil {
+		return fmt.Errorf("invalid cron expression: %w", err)
+	}
+
+	if _, err := s.Location(); err != nil {
+		return err
+	}
+
+	if len(s.Actions) == 0 {
+		return fmt.Errorf("schedule must have at least one action")
+	}
+
+	seen := make(map[ScheduleAction]bool, len(s.Actions))
+	for _, action := range s.Actions {
+		if !action.IsValid() {
+			return fmt.Errorf("invalid schedule action: %s", action)
+		}
+		if seen[action] {
+			return fmt.Errorf("duplicate schedule action: %s", action)
+		}
+		seen[action] = true
+	}
+
+	return nil
+}
+
+// SetDefaults sets default values for optional fields
+func (s *Schedule) SetDefaults() {
+	s.CronExpression = strings.TrimSpace(s.CronExpression)
+	s.TimeZone = strings.TrimSpace(s.TimeZone)
+	if s.TimeZone == "" {
+		s.TimeZone = DefaultScheduleZone
+	}
+}
+
+// Location returns the IANA time zone the schedule is evaluated in
+func (s *Schedule) Location() (*time.Location, error) {
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil || s.TimeZone == "" || strings.EqualFold(s.TimeZone, "local") {
+		return nil, fmt.Errorf("invalid time zone: %q", s.TimeZone)
+	}
+	return loc, nil
+}
+
+// ComputeNextRun returns the first activation after the given time, in UTC
+func (s *Schedule) ComputeNextRun(after time.Time) (time.Time, error) {
+	cron, err := valueobjects.ParseCronExpression(s.CronExpression)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid cron expression: %w", err)
+	}
+
+	loc, err := s.Location()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	next := cron.Next(after.In(loc))
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("cron expression never fires: %s", s.CronExpression)
+	}
+
+	return next.UTC(), nil
+}
+
+// ScheduleNext recomputes NextRunAt from the given time, clearing it for inactive schedules
+func (s *Schedule) ScheduleNext(after time.Time) error {
+	if !s.Active {
+		s.NextRunAt = nil
+		return nil
+	}
+
+	next, err := s.ComputeNextRun(after)
+	if err != nil {
+		return err
+	}
+
+	s.NextRunAt = &next
+	return nil
+}
+
+// IsDue checks if the schedule should fire at now
+func (s *Schedule) IsDue(now time.Time) bool {
+	return s.Active && s.NextRunAt != nil && !now.Before(*s.NextRunAt)
+}
@@ -9,15 +9,20 @@ import (
 
 // User represents a LinkedIn user in the system
 type User struct {
-	ID            string
-	Email         string
-	Language      string // Default: "es" (Spanish)
-	LinkedInToken string
-	APIKeys       map[string]string
-	Configuration map[string]interface{}
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
-	Active        bool
+	ID                         string
+	Email                      string
+	Language                   string // Default: "es" (Spanish)
+	LinkedInToken              string
+	LinkedInRefreshToken       string
+	LinkedInTokenExpiresAt     *time.Time
+	LinkedInTokenInvalidReason string
+	LinkedInTokenInvalidAt     *time.Time
+	APIKeys                    map[string]string
+	Configuration              map[string]interface{}
+	LLMQuota                   *LLMQuota // Overrides the default LLM budget when set
+	CreatedAt                  time.Time
+	UpdatedAt                  time.Time
+	Active                     bool
 }
 
 const (
@@ -41,7 +46,79 @@ func (u *User) ValidateCredentials() error {
 
 // CanPublish checks if user can publish to LinkedIn
 func (u *User) CanPublish() bool {
-	return u.Active && u.LinkedInToken != ""
+	return u.PublishBlockedReason() == ""
+}
+
+// PublishBlockedReason explains why the user cannot publish to LinkedIn,
+// or returns an empty string when publishing is allowed
+func (u *User) PublishBlockedReason() string {
+	switch {
+	case !u.Active:
+		return "user is not active"
+	case u.LinkedInToken == "":
+		return "LinkedIn access token not configured"
+	case u.LinkedInTokenInvalidReason != "":
+		return "LinkedIn account must be reconnected: " + u.LinkedInTokenInvalidReason
+	case u.IsLinkedInTokenExpired(time.Now()) && u.LinkedInRefreshToken == "":
+		return "LinkedIn access token expired and cannot be refreshed"
+	default:
+		return ""
+	}
+}
+
+// IsLinkedInTokenExpired checks if the LinkedIn access token has expired at now.
+// Tokens without a known expiry are assumed to be valid.
+func (u *User) IsLinkedInTokenExpired(now time.Time) bool {
+	return u.LinkedInTokenExpiresAt != nil && !now.Before(*u.LinkedInTokenExpiresAt)
+}
+
+// NeedsLinkedInTokenRefresh checks if the access token expires within window of now
+// and can still be refreshed
+func (u *User) NeedsLinkedInTokenRefresh(now time.Time, window time.Duration) bool {
+	if u.LinkedInTokenInvalidReason != "" || u.LinkedInTokenExpiresAt == nil {
+		return false
+	}
+	return !now.Add(window).Before(*u.LinkedInTokenExpiresAt)
+}
+
+// MarkLinkedInTokenUnrecoverable records that the LinkedIn token can no longer be refreshed,
+// so the user has to reconnect their LinkedIn account before publishing again
+func (u *User) MarkLinkedInTokenUnrecoverable(reason string) {
+	if strings.TrimSpace(reason) == "" {
+		reason = "LinkedIn token could not be refreshed"
+	}
+
+	now := time.Now()
+	u.LinkedInTokenInvalidReason = reason
+	u.LinkedInTokenInvalidAt = &now
+	u.UpdatedAt = now
+}
+
+// SetLinkedInCredentials stores the tokens obtained from the LinkedIn OAuth flow.
+// An empty refresh token keeps the previous one, since LinkedIn does not always issue one.
+func (u *User) SetLinkedInCredentials(accessToken, refreshToken string, expiresAt time.Time) error {
+	if strings.TrimSpace(accessToken) == "" {
+		return fmt.Errorf("LinkedIn access token cannot be empty")
+	}
+
+	u.LinkedInToken = accessToken
+	if refreshToken != "" {
+		u.LinkedInRefreshToken = refreshToken
+	}
+
+	// Fresh credentials make the account usable again
+	u.LinkedInTokenInvalidReason = ""
+	u.LinkedInTokenInvalidAt = nil
+
+	if expiresAt.IsZero() {
+		u.LinkedInTokenExpiresAt = nil
+	} else {
+		expiry := expiresAt.UTC()
+		u.LinkedInTokenExpiresAt = &expiry
+	}
+
+	u.UpdatedAt = time.Now()
+	return nil
 }
 
 // IsActive checks if user is active
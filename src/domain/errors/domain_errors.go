@@ -1,6 +1,9 @@
 package errors
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // ErrInvalidUserCredentials represents invalid user credentials error
 type ErrInvalidUserCredentials struct {
@@ -20,6 +23,20 @@ func NewInvalidUserCredentials(userID, reason string) *ErrInvalidUserCredentials
 	}
 }
 
+// ErrUserNotFound represents user not found error
+type ErrUserNotFound struct {
+	UserID string
+}
+
+func (e *ErrUserNotFound) Error() string {
+	return fmt.Sprintf("user not found: %s", e.UserID)
+}
+
+// NewUserNotFound creates a new user not found error
+func NewUserNotFound(userID string) *ErrUserNotFound {
+	return &ErrUserNotFound{UserID: userID}
+}
+
 // ErrTopicNotFound represents topic not found error
 type ErrTopicNotFound struct {
 	TopicID string
@@ -34,6 +51,34 @@ func NewTopicNotFound(topicID string) *ErrTopicNotFound {
 	return &ErrTopicNotFound{TopicID: topicID}
 }
 
+// ErrScheduleNotFound represents schedule not found error
+type ErrScheduleNotFound struct {
+	ScheduleID string
+}
+
+func (e *ErrScheduleNotFound) Error() string {
+	return fmt.Sprintf("schedule not found: %s", e.ScheduleID)
+}
+
+// NewScheduleNotFound creates a new schedule not found error
+func NewScheduleNotFound(scheduleID string) *ErrScheduleNotFound {
+	return &ErrScheduleNotFound{ScheduleID: scheduleID}
+}
+
+// ErrExperimentNotFound represents experiment not found error
+type ErrExperimentNotFound struct {
+	ExperimentID string
+}
+
+func (e *ErrExperimentNotFound) Error() string {
+	return fmt.Sprintf("experiment not found: %s", e.ExperimentID)
+}
+
+// NewExperimentNotFound creates a new experiment not found error
+func NewExperimentNotFound(experimentID string) *ErrExperimentNotFound {
+	return &ErrExperimentNotFound{ExperimentID: experimentID}
+}
+
 // ErrIdeaExpired represents expired idea error
 type ErrIdeaExpired struct {
 	IdeaID string
@@ -80,6 +125,20 @@ func NewDraftAlreadyPublished(draftID, linkedInPostID string) *ErrDraftAlreadyPu
 	}
 }
 
+// ErrDraftPublishInProgress represents a draft taken by another publisher
+type ErrDraftPublishInProgress struct {
+	DraftID string
+}
+
+func (e *ErrDraftPublishInProgress) Error() string {
+	return fmt.Sprintf("draft %s is already being published", e.DraftID)
+}
+
+// NewDraftPublishInProgress creates a new draft publish in progress error
+func NewDraftPublishInProgress(draftID string) *ErrDraftPublishInProgress {
+	return &ErrDraftPublishInProgress{DraftID: draftID}
+}
+
 // ErrDraftNotFound represents draft not found error
 type ErrDraftNotFound struct {
 	DraftID string
@@ -214,6 +273,39 @@ func NewValidationError(field, message string) *ErrValidation {
 	}
 }
 
+// ErrQuotaExceeded represents a user that has used up an LLM quota
+type ErrQuotaExceeded struct {
+	UserID string
+	// Period is the exhausted quota window: daily or monthly
+	Period string
+	// Resource is the exhausted budget: tokens or requests
+	Resource string
+	Limit    int64
+	Used     int64
+	// RemainingTokens and RemainingRequests are the budgets left across all windows, -1 when unlimited
+	RemainingTokens   int64
+	RemainingRequests int64
+	ResetAt           time.Time
+}
+
+func (e *ErrQuotaExceeded) Error() string {
+	return fmt.Sprintf("%s LLM %s quota exceeded for user %s: used %d of %d", e.Period, e.Resource, e.UserID, e.Used, e.Limit)
+}
+
+// NewQuotaExceeded creates a new quota exceeded error
+func NewQuotaExceeded(userID, period, resource string, limit, used int64, resetAt time.Time) *ErrQuotaExceeded {
+	return &ErrQuotaExceeded{
+		UserID:            userID,
+		Period:            period,
+		Resource:          resource,
+		Limit:             limit,
+		Used:              used,
+		RemainingTokens:   -1,
+		RemainingRequests: -1,
+		ResetAt:           resetAt,
+	}
+}
+
 // LLMResponseError represents an invalid or unparsable response from the LLM provider
 type LLMResponseError struct {
 	Operation   string
@@ -258,3 +350,101 @@ func NewLLMResponseError(operation, reason, prompt, raw string, err error) *LLMR
 		Err:         err,
 	}
 }
+
+// LinkedInErrorReason classifies a failed LinkedIn API call
+type LinkedInErrorReason string
+
+const (
+	// LinkedInReasonTokenInvalid indicates the access token was rejected (401/403)
+	LinkedInReasonTokenInvalid LinkedInErrorReason = "token_invalid"
+	// LinkedInReasonRateLimit indicates LinkedIn throttled the request (429)
+	LinkedInReasonRateLimit LinkedInErrorReason = "rate_limit"
+	// LinkedInReasonServerError indicates LinkedIn failed to process the request (5xx)
+	LinkedInReasonServerError LinkedInErrorReason = "server_error"
+	// LinkedInReasonBadRequest indicates LinkedIn rejected the request payload
+	LinkedInReasonBadRequest LinkedInErrorReason = "bad_request"
+	// LinkedInReasonInvalidResponse indicates LinkedIn returned an unparsable response
+	LinkedInReasonInvalidResponse LinkedInErrorReason = "invalid_response"
+	// LinkedInReasonTimeout indicates LinkedIn did not answer in time
+	LinkedInReasonTimeout LinkedInErrorReason = "timeout"
+	// LinkedInReasonUnavailable indicates LinkedIn could not be reached
+	LinkedInReasonUnavailable LinkedInErrorReason = "service_unavailable"
+)
+
+// LinkedInAPIError represents a failed call to the LinkedIn API
+type LinkedInAPIError struct {
+	Operation  string
+	Reason     LinkedInErrorReason
+	StatusCode int
+	Message    string
+	RetryAfter time.Duration
+	Err        error
+}
+
+// Error implements the error interface
+func (e *LinkedInAPIError) Error() string {
+	if e == nil {
+		return "linkedin api error"
+	}
+
+	base := "linkedin api error"
+	if e.Operation != "" {
+		base = fmt.Sprintf("%s (%s)", base, e.Operation)
+	}
+
+	switch {
+	case e.Reason == LinkedInReasonRateLimit:
+		base = fmt.Sprintf("%s: rate limit exceeded", base)
+	case e.StatusCode >= 500:
+		base = fmt.Sprintf("%s: server error %d", base, e.StatusCode)
+	case e.StatusCode > 0:
+		base = fmt.Sprintf("%s: %s %d", base, linkedInStatusLabel(e.StatusCode), e.StatusCode)
+	case e.Reason != "":
+		base = fmt.Sprintf("%s: %s", base, e.Reason)
+	}
+
+	if e.Message != "" {
+		base = fmt.Sprintf("%s: %s", base, e.Message)
+	}
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %v", base, e.Err)
+	}
+	return base
+}
+
+// linkedInStatusLabel returns a short description for LinkedIn client error statuses
+func linkedInStatusLabel(statusCode int) string {
+	switch statusCode {
+	case 400:
+		return "bad request"
+	case 401:
+		return "unauthorized"
+	case 403:
+		return "forbidden"
+	case 404:
+		return "not found"
+	case 422:
+		return "unprocessable entity"
+	default:
+		return "client error"
+	}
+}
+
+// Unwrap returns the underlying error
+func (e *LinkedInAPIError) Unwrap() error {
+	if e == nil {
+		return nil
+	}
+	return e.Err
+}
+
+// NewLinkedInAPIError creates a new LinkedIn API error
+func NewLinkedInAPIError(operation string, reason LinkedInErrorReason, statusCode int, message string, err error) *LinkedInAPIError {
+	return &LinkedInAPIError{
+		Operation:  operation,
+		Reason:     reason,
+		StatusCode: statusCode,
+		Message:    message,
+		Err:        err,
+	}
+}
@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/linkgen-ai/backend/src/domain/entities"
 )
@@ -25,6 +26,10 @@ type DraftRepository interface {
 	// draftType: filter by type POST/ARTICLE (empty string for all types)
 	ListByUserID(ctx context.Context, userID string, status entities.DraftStatus, draftType entities.DraftType) ([]*entities.Draft, error)
 
+	// UpdateIfStatus applies updates only while the draft is still in the expected status.
+	// It returns false if the draft has moved to another status in the meantime.
+	UpdateIfStatus(ctx context.Context, draftID string, expected entities.DraftStatus, updates map[string]interface{}) (bool, error)
+
 	// UpdateStatus updates the status of a draft
 	UpdateStatus(ctx context.Context, draftID string, status entities.DraftStatus) error
 
@@ -33,4 +38,14 @@ type DraftRepository interface {
 
 	// FindReadyForPublishing retrieves drafts ready to be published
 	FindReadyForPublishing(ctx context.Context, userID string) ([]*entities.Draft, error)
+
+	// FindDueForPublishing retrieves scheduled drafts whose publish time has passed and
+	// PUBLISHING drafts whose claim expired, none of them held by a publisher, oldest first
+	// (limit <= 0 means no limit)
+	FindDueForPublishing(ctx context.Context, now time.Time, limit int) ([]*entities.Draft, error)
+
+	// ClaimForPublishing atomically reserves a due draft until leaseUntil. A PUBLISHING draft
+	// is moved back to SCHEDULED so it can be published again.
+	// It returns false if the draft is no longer due or another publisher holds the claim.
+	ClaimForPublishing(ctx context.Context, draftID string, now time.Time, leaseUntil time.Time) (bool, error)
 }
@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+)
+
+// ExperimentRepository defines persistence operations for prompt experiments
+type ExperimentRepository interface {
+	// Create creates a new experiment
+	Create(ctx context.Context, experiment *entities.Experiment) (string, error)
+
+	// FindByID retrieves an experiment by its unique ID
+	FindByID(ctx context.Context, experimentID string) (*entities.Experiment, error)
+
+	// ListByUserID retrieves all experiments belonging to a user
+	ListByUserID(ctx context.Context, userID string) ([]*entities.Experiment, error)
+
+	// FindActiveByUserIDAndType retrieves the user's active experiment for a prompt type,
+	// or nil when there is none
+	FindActiveByUserIDAndType(ctx context.Context, userID string, promptType entities.PromptType) (*entities.Experiment, error)
+
+	// Update replaces the editable fields of an experiment
+	Update(ctx context.Context, experiment *entities.Experiment) error
+}
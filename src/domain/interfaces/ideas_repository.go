@@ -12,6 +12,12 @@ type IdeasRepository interface {
 	// Used by the scheduler when generating periodic ideas
 	CreateBatch(ctx context.Context, ideas []*entities.Idea) error
 
+	// FindByID retrieves an idea by its unique ID
+	FindByID(ctx context.Context, ideaID string) (*entities.Idea, error)
+
+	// Update updates idea information
+	Update(ctx context.Context, ideaID string, updates map[string]interface{}) error
+
 	// ListByUserID retrieves ideas for a user with optional filtering
 	// topicID: filter by specific topic (empty string for all topics)
 	// limit: maximum number of ideas to return (0 for no limit)
@@ -20,6 +26,10 @@ type IdeasRepository interface {
 	// CountByUserID returns the total number of ideas for a user
 	CountByUserID(ctx context.Context, userID string) (int64, error)
 
+	// CountUnusedByTopicID returns the number of ideas of a topic not yet used for drafts
+	// Used by the scheduler to skip topics with a sufficient idea backlog
+	CountUnusedByTopicID(ctx context.Context, topicID string) (int64, error)
+
 	// ClearByUserID removes all ideas for a specific user
 	// Used when user wants to clear their idea backlog
 	ClearByUserID(ctx context.Context, userID string) error
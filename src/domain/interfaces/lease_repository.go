@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+// LeaseRepository manages named distributed leases shared by all replicas
+type LeaseRepository interface {
+	// Acquire takes or renews the named lease for holder until ttl elapses.
+	// Returns false when another holder owns an unexpired lease.
+	Acquire(ctx context.Context, name, holder string, ttl time.Duration) (bool, error)
+
+	// Release gives up the named lease if holder still owns it
+	Release(ctx context.Context, name, holder string) error
+}
@@ -11,6 +11,15 @@ type LinkedInPostResponse struct {
 	Success bool   `json:"success"`
 }
 
+// LinkedInTokenResponse represents the tokens issued by the LinkedIn OAuth2 token endpoint
+type LinkedInTokenResponse struct {
+	AccessToken           string `json:"access_token"`
+	ExpiresIn             int64  `json:"expires_in"`
+	RefreshToken          string `json:"refresh_token,omitempty"`
+	RefreshTokenExpiresIn int64  `json:"refresh_token_expires_in,omitempty"`
+	Scope                 string `json:"scope,omitempty"`
+}
+
 // LinkedInService defines the contract for LinkedIn API integration
 type LinkedInService interface {
 	// PublishPost publishes a post to LinkedIn UGC Posts API
@@ -27,3 +36,15 @@ type LinkedInService interface {
 	// RefreshToken refreshes an expired LinkedIn access token (if supported)
 	RefreshToken(ctx context.Context, refreshToken string) (string, error)
 }
+
+// LinkedInAuthService defines the contract for the LinkedIn OAuth2 authorization code flow
+type LinkedInAuthService interface {
+	// AuthorizationURL builds the consent page URL for a state and PKCE code challenge
+	AuthorizationURL(state string, codeChallenge string) (string, error)
+
+	// ExchangeAuthorizationCode trades an authorization code and its PKCE verifier for tokens
+	ExchangeAuthorizationCode(ctx context.Context, code string, codeVerifier string) (*LinkedInTokenResponse, error)
+
+	// RefreshTokenPair trades a refresh token for a new access token and its expiry
+	RefreshTokenPair(ctx context.Context, refreshToken string) (*LinkedInTokenResponse, error)
+}
@@ -0,0 +1,37 @@
+package interfaces
+
+import (
+	"context"
+)
+
+// Key sources reported for each LLM call
+const (
+	// LLMKeySourcePlatform is the API key configured for the whole platform
+	LLMKeySourcePlatform = "platform"
+	// LLMKeySourceUser is the user's own API key for the provider
+	LLMKeySourceUser = "user"
+)
+
+// LLMKeyResolver finds the API key a user brought for an LLM provider
+type LLMKeyResolver interface {
+	// ResolveLLMKey returns the user's decrypted key, or an empty string when they have none
+	ResolveLLMKey(ctx context.Context, userID, provider string) (string, error)
+}
+
+// LLMKeyObserver is told which provider and key source an LLM call used
+type LLMKeyObserver func(provider, source string)
+
+// llmKeyObserverKey is the context key of LLMKeyObserver
+type llmKeyObserverKey struct{}
+
+// WithLLMKeyObserver returns a context whose LLM calls report their key source to observer
+func WithLLMKeyObserver(ctx context.Context, observer LLMKeyObserver) context.Context {
+	return context.WithValue(ctx, llmKeyObserverKey{}, observer)
+}
+
+// NotifyLLMKeySource reports the key source of an LLM call to the observer in ctx, if any
+func NotifyLLMKeySource(ctx context.Context, provider, source string) {
+	if observer, ok := ctx.Value(llmKeyObserverKey{}).(LLMKeyObserver); ok && observer != nil {
+		observer(provider, source)
+	}
+}
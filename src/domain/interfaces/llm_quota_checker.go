@@ -0,0 +1,11 @@
+package interfaces
+
+import (
+	"context"
+)
+
+// LLMQuotaChecker enforces per-user LLM budgets before a generation calls the LLM
+type LLMQuotaChecker interface {
+	// CheckLLMQuota returns *errors.ErrQuotaExceeded when the user has used up a quota
+	CheckLLMQuota(ctx context.Context, userID string) error
+}
@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+)
+
+// LLMResponseCache defines storage for LLM responses that can be reused instead of calling the model again
+type LLMResponseCache interface {
+	// Get returns the response cached under key, and false when there is none or it expired
+	Get(ctx context.Context, key string) (string, bool, error)
+
+	// Set caches response under key, replacing any previous response
+	Set(ctx context.Context, key string, response string) error
+}
@@ -2,6 +2,9 @@ package interfaces
 
 import (
 	"context"
+	"encoding/json"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
 )
 
 // DraftSet represents a set of drafts generated by the LLM
@@ -14,9 +17,14 @@ type DraftSet struct {
 
 // LLMService defines the contract for LLM-based content generation
 type LLMService interface {
-	// SendRequest sends a raw prompt to the LLM and returns the response
+	// SendRequest sends a raw prompt to the LLM with the client's own settings
+	// and returns the response
 	SendRequest(ctx context.Context, prompt string) (string, error)
 
+	// SendPromptRequest sends a processed prompt with its system message, model parameters
+	// and response schema, and returns the response
+	SendPromptRequest(ctx context.Context, req PromptRequest) (string, error)
+
 	// GenerateIdeas generates content ideas based on a topic
 	// Returns a list of ideas or an error
 	GenerateIdeas(ctx context.Context, topic string, count int) ([]string, error)
@@ -29,3 +37,132 @@ type LLMService interface {
 	// with optional conversation history for context
 	RefineDraft(ctx context.Context, draft string, userPrompt string, history []string) (string, error)
 }
+
+// LLMStreamHandler receives the text of a streamed completion as it is generated;
+// returning an error aborts the stream
+type LLMStreamHandler func(chunk string) error
+
+// StreamingLLMService is an LLMService that can also stream completions.
+// Callers type-assert for it and fall back to the blocking methods otherwise.
+type StreamingLLMService interface {
+	LLMService
+
+	// StreamRequest sends a raw prompt like SendRequest, passing the response to onChunk
+	// as it is generated, and returns the complete response
+	StreamRequest(ctx context.Context, prompt string, onChunk LLMStreamHandler) (string, error)
+
+	// RefineDraftStream refines a draft like RefineDraft, passing the refined text to onChunk
+	// as it is generated, and returns the complete refined text
+	RefineDraftStream(ctx context.Context, draft string, userPrompt string, history []string, onChunk LLMStreamHandler) (string, error)
+}
+
+// Streaming returns service itself when it can stream, or an adapter that passes each
+// blocking response to onChunk in one piece
+func Streaming(service LLMService) StreamingLLMService {
+	if streaming, ok := service.(StreamingLLMService); ok {
+		return streaming
+	}
+	return unstreamedLLMService{LLMService: service}
+}
+
+// unstreamedLLMService adapts an LLMService that can't stream to StreamingLLMService
+type unstreamedLLMService struct {
+	LLMService
+}
+
+func (s unstreamedLLMService) StreamRequest(ctx context.Context, prompt string, onChunk LLMStreamHandler) (string, error) {
+	response, err := s.SendRequest(ctx, prompt)
+	if err != nil {
+		return "", err
+	}
+	if err := onChunk(response); err != nil {
+		return "", err
+	}
+	return response, nil
+}
+
+func (s unstreamedLLMService) RefineDraftStream(ctx context.Context, draft string, userPrompt string, history []string, onChunk LLMStreamHandler) (string, error) {
+	refined, err := s.RefineDraft(ctx, draft, userPrompt, history)
+	if err != nil {
+		return "", err
+	}
+	if err := onChunk(refined); err != nil {
+		return "", err
+	}
+	return refined, nil
+}
+
+// PromptRequest is a processed prompt ready to send to the LLM
+type PromptRequest struct {
+	// Prompt is the user message
+	Prompt string
+	// System is the optional system message
+	System string
+	// Parameters override the LLM client's settings; zero values keep them
+	Parameters entities.PromptParameters
+	// Schema asks providers that support it for output matching a JSON schema
+	Schema *ResponseSchema
+	// PromptID and PromptVersion identify the stored prompt version it was rendered from,
+	// empty for built-in default prompts
+	PromptID      string
+	PromptVersion int
+}
+
+// ResponseSchema is a JSON schema an LLM response is asked to match
+type ResponseSchema struct {
+	// Name identifies the schema in provider requests and cache keys
+	Name string
+	// Definition is the JSON schema document
+	Definition json.RawMessage
+}
+
+// LLMCallInfo attributes an LLM call to the user, job and prompt it was made for
+type LLMCallInfo struct {
+	UserID     string
+	JobID      string
+	PromptName string
+}
+
+// llmCallInfoKey is the context key of LLMCallInfo
+type llmCallInfoKey struct{}
+
+// WithLLMCallInfo returns a context carrying info; empty fields keep the values already in ctx,
+// so a worker can set the job and the use case it runs can add the user and prompt
+func WithLLMCallInfo(ctx context.Context, info LLMCallInfo) context.Context {
+	current := LLMCallInfoFromContext(ctx)
+	if info.UserID != "" {
+		current.UserID = info.UserID
+	}
+	if info.JobID != "" {
+		current.JobID = info.JobID
+	}
+	if info.PromptName != "" {
+		current.PromptName = info.PromptName
+	}
+	return context.WithValue(ctx, llmCallInfoKey{}, current)
+}
+
+// LLMCallInfoFromContext returns the LLMCallInfo carried by ctx, if any
+func LLMCallInfoFromContext(ctx context.Context) LLMCallInfo {
+	info, _ := ctx.Value(llmCallInfoKey{}).(LLMCallInfo)
+	return info
+}
+
+// LLMModelObserver is told which provider and model answered an LLM call
+type LLMModelObserver func(provider, model string)
+
+// llmModelObserverKey is the context key of LLMModelObserver
+type llmModelObserverKey struct{}
+
+// WithLLMModelObserver returns a context whose LLM calls report the model that answered them
+// to observer; behind a fallback chain, the last report is the backend whose answer was used
+func WithLLMModelObserver(ctx context.Context, observer LLMModelObserver) context.Context {
+	return context.WithValue(ctx, llmModelObserverKey{}, observer)
+}
+
+// NotifyLLMModel reports the model that answered an LLM call to the observer in ctx, if any
+func NotifyLLMModel(ctx context.Context, provider, model string) {
+	if observer, ok := ctx.Value(llmModelObserverKey{}).(LLMModelObserver); ok && observer != nil {
+		observer(provider, model)
+	}
+}
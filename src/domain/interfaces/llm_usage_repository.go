@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+)
+
+// LLMUsageRepository defines persistence operations for LLM usage records
+type LLMUsageRepository interface {
+	// Create stores the usage of one LLM call
+	Create(ctx context.Context, usage *entities.LLMUsage) (string, error)
+
+	// Aggregate sums the calls matching the filter, grouped by model and prompt name
+	Aggregate(ctx context.Context, filter entities.LLMUsageFilter) ([]*entities.LLMUsageTotals, error)
+}
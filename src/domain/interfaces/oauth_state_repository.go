@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+)
+
+// OAuthStateRepository defines persistence operations for pending OAuth authorizations
+type OAuthStateRepository interface {
+	// Create stores a pending authorization request
+	Create(ctx context.Context, state *entities.OAuthState) error
+
+	// Consume atomically retrieves and deletes the authorization request for a state value,
+	// so that each state can only be redeemed once
+	Consume(ctx context.Context, state string) (*entities.OAuthState, error)
+}
@@ -3,7 +3,13 @@
 // the domain layer has no dependencies on external concerns.
 //
 // Core Interfaces:
-// - LLMService: Interface for LLM interactions
+// - LLMService: Interface for LLM interactions, and StreamingLLMService for streamed completions
+// - LLMUsageRepository: Interface for LLM usage persistence
+// - LLMResponseCache: Interface for reusable LLM responses
+// - LLMQuotaChecker: Interface for per-user LLM budget enforcement
+// - LLMKeyResolver: Interface for users' own LLM API keys
+// - PromptVersionsRepository: Interface for prompt version history
+// - ExperimentRepository: Interface for prompt experiments persistence
 // - DraftRepository: Interface for draft persistence
 // - IdeasRepository: Interface for ideas persistence
 // - TopicsRepository: Interface for topics persistence
@@ -0,0 +1,37 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+)
+
+// PromptVersionsRepository defines persistence for the immutable version history of prompts
+type PromptVersionsRepository interface {
+	// Create records a version; it fails if the prompt already has a version with that number
+	Create(ctx context.Context, version *entities.PromptVersion) (string, error)
+
+	// FindByPromptIDAndVersion retrieves one version of a prompt, nil when it doesn't exist
+	FindByPromptIDAndVersion(ctx context.Context, promptID string, version int) (*entities.PromptVersion, error)
+
+	// ListByPromptID retrieves the versions of a prompt, newest first
+	ListByPromptID(ctx context.Context, promptID string) ([]*entities.PromptVersion, error)
+}
+
+// PromptVersionObserver is told which prompt version a generation rendered
+type PromptVersionObserver func(promptID string, version int)
+
+// promptVersionObserverKey is the context key of PromptVersionObserver
+type promptVersionObserverKey struct{}
+
+// WithPromptVersionObserver returns a context whose generations report their prompt version to observer
+func WithPromptVersionObserver(ctx context.Context, observer PromptVersionObserver) context.Context {
+	return context.WithValue(ctx, promptVersionObserverKey{}, observer)
+}
+
+// NotifyPromptVersion reports the prompt version of a generation to the observer in ctx, if any
+func NotifyPromptVersion(ctx context.Context, promptID string, version int) {
+	if observer, ok := ctx.Value(promptVersionObserverKey{}).(PromptVersionObserver); ok && observer != nil {
+		observer(promptID, version)
+	}
+}
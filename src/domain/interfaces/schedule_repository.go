@@ -0,0 +1,35 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+)
+
+// ScheduleRepository defines persistence operations for per-user schedules
+type ScheduleRepository interface {
+	// Create creates a new schedule
+	Create(ctx context.Context, schedule *entities.Schedule) (string, error)
+
+	// FindByID retrieves a schedule by its unique ID
+	FindByID(ctx context.Context, scheduleID string) (*entities.Schedule, error)
+
+	// ListByUserID retrieves all schedules belonging to a user
+	ListByUserID(ctx context.Context, userID string) ([]*entities.Schedule, error)
+
+	// Update replaces the editable fields of a schedule, including its next run
+	Update(ctx context.Context, schedule *entities.Schedule) error
+
+	// Delete removes a schedule
+	Delete(ctx context.Context, scheduleID string) error
+
+	// FindDue retrieves active schedules whose next run is at or before now,
+	// oldest first (limit <= 0 means no limit)
+	FindDue(ctx context.Context, now time.Time, limit int) ([]*entities.Schedule, error)
+
+	// MarkFired records a run and moves the schedule to its next run, but only if the
+	// schedule is still due at expectedNextRun. Returns false when another process
+	// already fired it or the schedule was edited meanwhile.
+	MarkFired(ctx context.Context, scheduleID string, expectedNextRun, firedAt, nextRun time.Time) (bool, error)
+}
@@ -30,6 +30,11 @@ type TopicRepository interface {
 	// FindByPrompt retrieves topics that reference a specific prompt
 	FindByPrompt(ctx context.Context, userID string, promptName string) ([]*entities.Topic, error)
 
+	// ListActive retrieves active topics of all users, highest priority first
+	// Used by the scheduler for periodic idea generation
+	// limit: maximum number of topics to return (0 for no limit)
+	ListActive(ctx context.Context, limit int) ([]*entities.Topic, error)
+
 	// FindByIdeasRange retrieves topics with ideas count in the specified range
 	FindByIdeasRange(ctx context.Context, userID string, minIdeas, maxIdeas int) ([]*entities.Topic, error)
 }
@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/linkgen-ai/backend/src/domain/entities"
 )
@@ -23,6 +24,13 @@ type UserRepository interface {
 	// UpdateLinkedInToken updates the LinkedIn access token for a user
 	UpdateLinkedInToken(ctx context.Context, userID string, token string) error
 
+	// UpdateLinkedInCredentials persists the user's LinkedIn access token, refresh token and expiry
+	UpdateLinkedInCredentials(ctx context.Context, user *entities.User) error
+
+	// FindWithExpiringLinkedInToken retrieves active users whose LinkedIn token expires before
+	// the given time and has not been marked unrecoverable, soonest first
+	FindWithExpiringLinkedInToken(ctx context.Context, before time.Time, limit int) ([]*entities.User, error)
+
 	// Delete removes a user from the system
 	Delete(ctx context.Context, userID string) error
 }
@@ -0,0 +1,255 @@
+package valueobjects
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSearchLimit bounds the search for the next activation so impossible
+// expressions such as "0 0 30 2 *" terminate
+const cronSearchLimit = 5 * 366 * 24 * time.Hour
+
+// cronField describes the bounds and aliases of a single cron field
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	cronMinute = cronField{name: "minute", min: 0, max: 59}
+	cronHour   = cronField{name: "hour", min: 0, max: 23}
+	cronDom    = cronField{name: "day of month", min: 1, max: 31}
+	cronMonth  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	cronDow = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+
+	cronDescriptors = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+)
+
+// CronExpression is a parsed standard five-field cron expression
+// (minute hour day-of-month month day-of-week)
+type CronExpression struct {
+	raw     string
+	minutes uint64
+	hours   uint64
+	doms    uint64
+	months  uint64
+	dows    uint64
+	// When both day fields are restricted a day matches if either matches
+	domAny bool
+	dowAny bool
+}
+
+// ParseCronExpression parses a five-field cron expression.
+// Fields accept *, lists, ranges and steps, months and weekdays accept
+// three-letter names, and the @hourly/@daily/@weekly/@monthly/@yearly
+// descriptors are supported.
+func ParseCronExpression(expr string) (CronExpression, error) {
+	raw := strings.TrimSpace(expr)
+	if raw == "" {
+		return CronExpression{}, fmt.Errorf("cron expression cannot be empty")
+	}
+
+	spec := raw
+	if strings.HasPrefix(spec, "@") {
+		expanded, ok := cronDescriptors[strings.ToLower(spec)]
+		if !ok {
+			return CronExpression{}, fmt.Errorf("unknown cron descriptor: %s", spec)
+		}
+		spec = expanded
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return CronExpression{}, fmt.Errorf("cron expression must have 5 fields, got %d", len(fields))
+	}
+
+	cron := CronExpression{raw: raw}
+	var err error
+
+	if cron.minutes, err = parseCronField(fields[0], cronMinute); err != nil {
+		return CronExpression{}, err
+	}
+	if cron.hours, err = parseCronField(fields[1], cronHour); err != nil {
+		return CronExpression{}, err
+	}
+	if cron.doms, err = parseCronField(fields[2], cronDom); err != nil {
+		return CronExpression{}, err
+	}
+	if cron.months, err = parseCronField(fields[3], cronMonth); err != nil {
+		return CronExpression{}, err
+	}
+	if cron.dows, err = parseCronField(fields[4], cronDow); err != nil {
+		return CronExpression{}, err
+	}
+
+	// 7 is an alias for Sunday
+	if cron.dows&(1<<7) != 0 {
+		cron.dows = (cron.dows &^ (1 << 7)) | 1
+	}
+
+	cron.domAny = fields[2] == "*" || fields[2] == "?"
+	cron.dowAny = fields[4] == "*" || fields[4] == "?"
+
+	return cron, nil
+}
+
+// String returns the expression as written
+func (c CronExpression) String() string {
+	return c.raw
+}
+
+// IsZero reports whether the expression was never parsed
+func (c CronExpression) IsZero() bool {
+	return c.minutes == 0
+}
+
+// Next returns the first activation strictly after t, evaluated in t's location.
+// Wall-clock times skipped by a DST change are not activated; repeated ones
+// activate once. It returns the zero time when nothing matches within five years.
+func (c CronExpression) Next(t time.Time) time.Time {
+	if c.IsZero() {
+		return time.Time{}
+	}
+
+	loc := t.Location()
+	next := t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(cronSearchLimit)
+
+	for next.Before(limit) {
+		if c.months&(1<<uint(next.Month())) == 0 {
+			next = time.Date(next.Year(), next.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if !c.matchesDay(next) {
+			next = time.Date(next.Year(), next.Month(), next.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if c.hours&(1<<uint(next.Hour())) == 0 {
+			next = time.Date(next.Year(), next.Month(), next.Day(), next.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+
+		if c.minutes&(1<<uint(next.Minute())) == 0 {
+			next = next.Truncate(time.Minute).Add(time.Minute)
+			continue
+		}
+
+		// Skip the second pass through a wall-clock hour repeated by a DST change
+		if !wallClock(next).After(wallClock(t)) {
+			next = next.Add(time.Minute)
+			continue
+		}
+
+		return next
+	}
+
+	return time.Time{}
+}
+
+// wallClock returns the local date and time of t as if it were UTC
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, time.UTC)
+}
+
+// matchesDay applies the cron rule for day-of-month and day-of-week
+func (c CronExpression) matchesDay(t time.Time) bool {
+	domMatch := c.doms&(1<<uint(t.Day())) != 0
+	dowMatch := c.dows&(1<<uint(t.Weekday())) != 0
+
+	if c.domAny || c.dowAny {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// parseCronField parses a comma-separated list of values, ranges and steps into a bitset
+func parseCronField(field string, spec cronField) (uint64, error) {
+	var bits uint64
+
+	for _, part := range strings.Split(field, ",") {
+		if part == "" {
+			return 0, fmt.Errorf("invalid %s field: %q", spec.name, field)
+		}
+
+		rangePart, step := part, 1
+		if idx := strings.Index(part, "/"); idx >= 0 {
+			rangePart = part[:idx]
+			parsed, err := strconv.Atoi(part[idx+1:])
+			if err != nil || parsed <= 0 {
+				return 0, fmt.Errorf("invalid step in %s field: %q", spec.name, part)
+			}
+			step = parsed
+		}
+
+		var start, end int
+		switch {
+		case rangePart == "*" || rangePart == "?":
+			start, end = spec.min, spec.max
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if start, err = parseCronValue(bounds[0], spec); err != nil {
+				return 0, err
+			}
+			if end, err = parseCronValue(bounds[1], spec); err != nil {
+				return 0, err
+			}
+			if start > end {
+				return 0, fmt.Errorf("invalid range in %s field: %q", spec.name, part)
+			}
+		default:
+			value, err := parseCronValue(rangePart, spec)
+			if err != nil {
+				return 0, err
+			}
+			start, end = value, value
+			// "5/15" means starting at 5 every 15
+			if step > 1 {
+				end = spec.max
+			}
+		}
+
+		for value := start; value <= end; value += step {
+			bits |= 1 << uint(value)
+		}
+	}
+
+	return bits, nil
+}
+
+// parseCronValue parses a single number or name within the field bounds
+func parseCronValue(value string, spec cronField) (int, error) {
+	if named, ok := spec.names[strings.ToLower(value)]; ok {
+		return named, nil
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s value: %q", spec.name, value)
+	}
+
+	if parsed < spec.min || parsed > spec.max {
+		return 0, fmt.Errorf("%s value %d out of range %d-%d", spec.name, parsed, spec.min, spec.max)
+	}
+
+	return parsed, nil
+}
@@ -12,6 +12,12 @@ const (
 	// DraftStatusRefined represents a draft that has been refined
 	DraftStatusRefined DraftStatus = "REFINED"
 
+	// DraftStatusScheduled represents a draft waiting for its publish time
+	DraftStatusScheduled DraftStatus = "SCHEDULED"
+
+	// DraftStatusPublishing represents a draft taken by a publisher while LinkedIn is being called
+	DraftStatusPublishing DraftStatus = "PUBLISHING"
+
 	// DraftStatusPublished represents a successfully published draft
 	DraftStatusPublished DraftStatus = "PUBLISHED"
 
@@ -28,6 +34,8 @@ func (ds DraftStatus) String() string {
 func (ds DraftStatus) IsValid() bool {
 	return ds == DraftStatusDraft ||
 		ds == DraftStatusRefined ||
+		ds == DraftStatusScheduled ||
+		ds == DraftStatusPublishing ||
 		ds == DraftStatusPublished ||
 		ds == DraftStatusFailed
 }
@@ -47,7 +55,7 @@ func (ds DraftStatus) CanRefine() bool {
 
 // CanPublish checks if drafts in this status can be published
 func (ds DraftStatus) CanPublish() bool {
-	return ds == DraftStatusDraft || ds == DraftStatusRefined
+	return ds == DraftStatusDraft || ds == DraftStatusRefined || ds == DraftStatusScheduled
 }
 
 // CanTransitionTo checks if transition to new status is allowed
@@ -55,17 +63,35 @@ func (ds DraftStatus) CanTransitionTo(newStatus DraftStatus) bool {
 	allowedTransitions := map[DraftStatus][]DraftStatus{
 		DraftStatusDraft: {
 			DraftStatusRefined,
+			DraftStatusScheduled,
+			DraftStatusPublishing,
 			DraftStatusPublished,
 			DraftStatusFailed,
 		},
 		DraftStatusRefined: {
+			DraftStatusScheduled,
+			DraftStatusPublishing,
+			DraftStatusPublished,
+			DraftStatusFailed,
+		},
+		DraftStatusScheduled: {
 			DraftStatusDraft,
+			DraftStatusPublishing,
 			DraftStatusPublished,
 			DraftStatusFailed,
 		},
+		DraftStatusPublishing: {
+			DraftStatusPublished,
+			DraftStatusFailed,
+			// Released when nothing was posted, or taken back once the claim expired
+			DraftStatusDraft,
+			DraftStatusRefined,
+			DraftStatusScheduled,
+		},
 		DraftStatusPublished: {},
 		DraftStatusFailed: {
 			DraftStatusDraft,
+			DraftStatusScheduled,
 		},
 	}
 
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -46,32 +47,98 @@ type NATSConfig struct {
 
 // LLMConfig contains LLM service configuration
 type LLMConfig struct {
+	// Provider selects the API format: openai, anthropic or ollama
+	Provider    string
 	Endpoint    string
 	Model       string
 	APIKey      string
 	Timeout     time.Duration
 	MaxTokens   int
 	Temperature float64
+	// KeyEncryptionKey encrypts the API keys users bring for LLM providers;
+	// without it users can't store keys and every call uses APIKey
+	KeyEncryptionKey string
+	// ReplayMode records ("record") or replays ("replay") LLM responses; empty talks to the LLM directly
+	ReplayMode string
+	// ReplayDir is where recorded LLM fixtures are stored
+	ReplayDir string
+	// Fallbacks are tried in order when the primary backend fails or its circuit breaker is open
+	Fallbacks []LLMBackendConfig
+	// BreakerThreshold is the number of consecutive failures that opens a backend's circuit breaker
+	BreakerThreshold int
+	// BreakerCooldown is how long an open circuit breaker waits before a trial call
+	BreakerCooldown time.Duration
+	// Prices estimates the cost of LLM usage, keyed by model
+	Prices map[string]LLMPrice
+	// Quota is the default LLM budget of users without a quota of their own
+	Quota LLMQuotaConfig
+	// DisableStructuredOutput stops sending JSON schemas as response_format, for servers that reject it
+	DisableStructuredOutput bool
+	// Cache reuses a job's LLM responses when the job is retried
+	Cache LLMCacheConfig
+	// MaxInFlight bounds the LLM requests sent at once; zero is unlimited
+	MaxInFlight int
+	// TokensPerMinute bounds the estimated tokens sent to the LLM per minute; zero is unlimited
+	TokensPerMinute int
+}
+
+// LLMCacheConfig configures the LLM response cache
+type LLMCacheConfig struct {
+	Enabled bool
+	// Size is the number of responses kept in memory; MongoDB keeps every response until TTL
+	Size int
+	TTL  time.Duration
+}
+
+// LLMQuotaConfig limits LLM usage per user and UTC day or calendar month. Zero means unlimited.
+type LLMQuotaConfig struct {
+	DailyTokens     int64
+	DailyRequests   int64
+	MonthlyTokens   int64
+	MonthlyRequests int64
+}
+
+// LLMPrice is the price of a model in USD per million tokens
+type LLMPrice struct {
+	Prompt     float64
+	Completion float64
+}
+
+// LLMBackendConfig describes a fallback LLM backend.
+// Empty Endpoint and APIKey are inherited from the primary backend.
+type LLMBackendConfig struct {
+	Provider string
+	Model    string
+	Endpoint string
+	APIKey   string
 }
 
 // LinkedInAPIConfig contains LinkedIn API configuration
 type LinkedInAPIConfig struct {
 	APIURL          string
+	TokenURL        string
+	AuthURL         string
 	ClientID        string
 	ClientSecret    string
 	RedirectURI     string
 	Timeout         time.Duration
 	RateLimit       int
 	RateLimitWindow time.Duration
+	// TokenEncryptionKey encrypts the OAuth tokens stored for each user
+	TokenEncryptionKey string
 }
 
 // SchedulerConfig contains scheduler configuration
 type SchedulerConfig struct {
-	Interval   time.Duration
-	BatchSize  int
-	MaxRetries int
-	RetryDelay time.Duration
-	Enabled    bool
+	Interval             time.Duration
+	PublishInterval      time.Duration
+	TokenRefreshInterval time.Duration
+	TokenRefreshWindow   time.Duration
+	ScheduleInterval     time.Duration
+	BatchSize            int
+	MaxRetries           int
+	RetryDelay           time.Duration
+	Enabled              bool
 }
 
 // LoggingConfig contains logging configuration
@@ -103,22 +170,42 @@ func (c *Config) String() string {
     MaxReconnects: %d
     ReconnectWait: %s
   LLM:
+    Provider: %s
     Endpoint: %s
     Model: %s
     APIKey: %s
     Timeout: %s
     MaxTokens: %d
     Temperature: %.2f
+    KeyEncryptionKey: %s
+    ReplayMode: %s
+    ReplayDir: %s
+    Fallbacks: %s
+    BreakerThreshold: %d
+    BreakerCooldown: %s
+    Prices: %d models
+    Quota: daily %d tokens/%d requests, monthly %d tokens/%d requests
+    DisableStructuredOutput: %t
+    Cache: enabled %t, %d in memory, TTL %s
+    MaxInFlight: %d
+    TokensPerMinute: %d
   LinkedIn:
     APIURL: %s
+    TokenURL: %s
+    AuthURL: %s
     ClientID: %s
     ClientSecret: %s
     RedirectURI: %s
     Timeout: %s
     RateLimit: %d
     RateLimitWindow: %s
+    TokenEncryptionKey: %s
   Scheduler:
     Interval: %s
+    PublishInterval: %s
+    TokenRefreshInterval: %s
+    TokenRefreshWindow: %s
+    ScheduleInterval: %s
     BatchSize: %d
     MaxRetries: %d
     RetryDelay: %s
@@ -143,20 +230,45 @@ func (c *Config) String() string {
 		c.NATS.Queue,
 		c.NATS.MaxReconnects,
 		c.NATS.ReconnectWait,
+		c.LLM.Provider,
 		c.LLM.Endpoint,
 		c.LLM.Model,
 		maskSecret(c.LLM.APIKey),
 		c.LLM.Timeout,
 		c.LLM.MaxTokens,
 		c.LLM.Temperature,
+		maskSecret(c.LLM.KeyEncryptionKey),
+		c.LLM.ReplayMode,
+		c.LLM.ReplayDir,
+		formatLLMBackends(c.LLM.Fallbacks),
+		c.LLM.BreakerThreshold,
+		c.LLM.BreakerCooldown,
+		len(c.LLM.Prices),
+		c.LLM.Quota.DailyTokens,
+		c.LLM.Quota.DailyRequests,
+		c.LLM.Quota.MonthlyTokens,
+		c.LLM.Quota.MonthlyRequests,
+		c.LLM.DisableStructuredOutput,
+		c.LLM.Cache.Enabled,
+		c.LLM.Cache.Size,
+		c.LLM.Cache.TTL,
+		c.LLM.MaxInFlight,
+		c.LLM.TokensPerMinute,
 		c.LinkedIn.APIURL,
+		c.LinkedIn.TokenURL,
+		c.LinkedIn.AuthURL,
 		c.LinkedIn.ClientID,
 		maskSecret(c.LinkedIn.ClientSecret),
 		c.LinkedIn.RedirectURI,
 		c.LinkedIn.Timeout,
 		c.LinkedIn.RateLimit,
 		c.LinkedIn.RateLimitWindow,
+		maskSecret(c.LinkedIn.TokenEncryptionKey),
 		c.Scheduler.Interval,
+		c.Scheduler.PublishInterval,
+		c.Scheduler.TokenRefreshInterval,
+		c.Scheduler.TokenRefreshWindow,
+		c.Scheduler.ScheduleInterval,
 		c.Scheduler.BatchSize,
 		c.Scheduler.MaxRetries,
 		c.Scheduler.RetryDelay,
@@ -169,6 +281,81 @@ func (c *Config) String() string {
 	)
 }
 
+// formatLLMBackends renders fallback backends as provider:model@endpoint
+func formatLLMBackends(backends []LLMBackendConfig) string {
+	parts := make([]string, 0, len(backends))
+	for _, backend := range backends {
+		part := backend.Model
+		if backend.Provider != "" {
+			part = backend.Provider + ":" + part
+		}
+		if backend.Endpoint != "" {
+			part += "@" + backend.Endpoint
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ParseLLMFallbacks parses a comma-separated list of [provider:]model[@endpoint] entries
+func ParseLLMFallbacks(value string) []LLMBackendConfig {
+	var backends []LLMBackendConfig
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		var backend LLMBackendConfig
+		if at := strings.LastIndex(entry, "@"); at >= 0 {
+			backend.Endpoint = strings.TrimSpace(entry[at+1:])
+			entry = entry[:at]
+		}
+
+		// Model names may contain colons (llama3:8b), so only known providers are split off
+		if provider, model, found := strings.Cut(entry, ":"); found {
+			switch strings.ToLower(provider) {
+			case "openai", "anthropic", "ollama":
+				backend.Provider = strings.ToLower(provider)
+				entry = model
+			}
+		}
+		backend.Model = strings.TrimSpace(entry)
+
+		backends = append(backends, backend)
+	}
+	return backends
+}
+
+// ParseLLMPrices parses a comma-separated list of model=prompt/completion entries,
+// with prices in USD per million tokens, e.g. "gpt-4o=2.5/10,gpt-4o-mini=0.15/0.6"
+func ParseLLMPrices(value string) (map[string]LLMPrice, error) {
+	prices := make(map[string]LLMPrice)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		model, rates, found := strings.Cut(entry, "=")
+		prompt, completion, hasCompletion := strings.Cut(rates, "/")
+		if !found || !hasCompletion || strings.TrimSpace(model) == "" {
+			return nil, fmt.Errorf("invalid LLM price %q: expected model=prompt/completion", entry)
+		}
+
+		var price LLMPrice
+		var err error
+		if price.Prompt, err = strconv.ParseFloat(strings.TrimSpace(prompt), 64); err != nil {
+			return nil, fmt.Errorf("invalid LLM price %q: %w", entry, err)
+		}
+		if price.Completion, err = strconv.ParseFloat(strings.TrimSpace(completion), 64); err != nil {
+			return nil, fmt.Errorf("invalid LLM price %q: %w", entry, err)
+		}
+		prices[strings.TrimSpace(model)] = price
+	}
+	return prices, nil
+}
+
 // maskSecret masks sensitive information for display
 func maskSecret(secret string) string {
 	if secret == "" {
@@ -89,6 +89,10 @@ func LoadFromEnvironment() (*Config, error) {
 	}
 
 	// LLM configuration
+	if provider := os.Getenv("LINKGEN_LLM_PROVIDER"); provider != "" {
+		cfg.LLM.Provider = provider
+	}
+
 	if endpoint := os.Getenv("LINKGEN_LLM_ENDPOINT"); endpoint != "" {
 		cfg.LLM.Endpoint = endpoint
 	}
@@ -101,6 +105,10 @@ func LoadFromEnvironment() (*Config, error) {
 		cfg.LLM.APIKey = apiKey
 	}
 
+	if encryptionKey := os.Getenv("LINKGEN_LLM_KEY_ENCRYPTION_KEY"); encryptionKey != "" {
+		cfg.LLM.KeyEncryptionKey = encryptionKey
+	}
+
 	if timeout := os.Getenv("LINKGEN_LLM_TIMEOUT"); timeout != "" {
 		t, err := strconv.Atoi(timeout)
 		if err == nil {
@@ -122,11 +130,109 @@ func LoadFromEnvironment() (*Config, error) {
 		}
 	}
 
+	if replayMode := os.Getenv("LINKGEN_LLM_REPLAY_MODE"); replayMode != "" {
+		cfg.LLM.ReplayMode = replayMode
+	}
+
+	if replayDir := os.Getenv("LINKGEN_LLM_REPLAY_DIR"); replayDir != "" {
+		cfg.LLM.ReplayDir = replayDir
+	}
+
+	if fallbacks := os.Getenv("LINKGEN_LLM_FALLBACKS"); fallbacks != "" {
+		cfg.LLM.Fallbacks = ParseLLMFallbacks(fallbacks)
+	}
+
+	if threshold := os.Getenv("LINKGEN_LLM_BREAKER_THRESHOLD"); threshold != "" {
+		n, err := strconv.Atoi(threshold)
+		if err == nil {
+			cfg.LLM.BreakerThreshold = n
+		}
+	}
+
+	if cooldown := os.Getenv("LINKGEN_LLM_BREAKER_COOLDOWN"); cooldown != "" {
+		d, err := time.ParseDuration(cooldown)
+		if err == nil {
+			cfg.LLM.BreakerCooldown = d
+		}
+	}
+
+	if prices := os.Getenv("LINKGEN_LLM_PRICES"); prices != "" {
+		p, err := ParseLLMPrices(prices)
+		if err == nil {
+			cfg.LLM.Prices = p
+		}
+	}
+
+	quotas := map[string]*int64{
+		"LINKGEN_LLM_QUOTA_DAILY_TOKENS":     &cfg.LLM.Quota.DailyTokens,
+		"LINKGEN_LLM_QUOTA_DAILY_REQUESTS":   &cfg.LLM.Quota.DailyRequests,
+		"LINKGEN_LLM_QUOTA_MONTHLY_TOKENS":   &cfg.LLM.Quota.MonthlyTokens,
+		"LINKGEN_LLM_QUOTA_MONTHLY_REQUESTS": &cfg.LLM.Quota.MonthlyRequests,
+	}
+	for key, field := range quotas {
+		if value := os.Getenv(key); value != "" {
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err == nil {
+				*field = n
+			}
+		}
+	}
+
+	if disable := os.Getenv("LINKGEN_LLM_DISABLE_STRUCTURED_OUTPUT"); disable != "" {
+		b, err := strconv.ParseBool(disable)
+		if err == nil {
+			cfg.LLM.DisableStructuredOutput = b
+		}
+	}
+
+	if enabled := os.Getenv("LINKGEN_LLM_CACHE_ENABLED"); enabled != "" {
+		b, err := strconv.ParseBool(enabled)
+		if err == nil {
+			cfg.LLM.Cache.Enabled = b
+		}
+	}
+
+	if size := os.Getenv("LINKGEN_LLM_CACHE_SIZE"); size != "" {
+		n, err := strconv.Atoi(size)
+		if err == nil {
+			cfg.LLM.Cache.Size = n
+		}
+	}
+
+	if ttl := os.Getenv("LINKGEN_LLM_CACHE_TTL"); ttl != "" {
+		d, err := time.ParseDuration(ttl)
+		if err == nil {
+			cfg.LLM.Cache.TTL = d
+		}
+	}
+
+	if maxInFlight := os.Getenv("LINKGEN_LLM_MAX_IN_FLIGHT"); maxInFlight != "" {
+		n, err := strconv.Atoi(maxInFlight)
+		if err == nil {
+			cfg.LLM.MaxInFlight = n
+		}
+	}
+
+	if tpm := os.Getenv("LINKGEN_LLM_TOKENS_PER_MINUTE"); tpm != "" {
+		n, err := strconv.Atoi(tpm)
+		if err == nil {
+			cfg.LLM.TokensPerMinute = n
+		}
+	}
+
 	// LinkedIn configuration
 	if apiURL := os.Getenv("LINKGEN_LINKEDIN_API_URL"); apiURL != "" {
 		cfg.LinkedIn.APIURL = apiURL
 	}
 
+	if tokenURL := os.Getenv("LINKGEN_LINKEDIN_TOKEN_URL"); tokenURL != "" {
+		cfg.LinkedIn.TokenURL = tokenURL
+	}
+
+	if authURL := os.Getenv("LINKGEN_LINKEDIN_AUTH_URL"); authURL != "" {
+		cfg.LinkedIn.AuthURL = authURL
+	}
+
 	if clientID := os.Getenv("LINKGEN_LINKEDIN_CLIENT_ID"); clientID != "" {
 		cfg.LinkedIn.ClientID = clientID
 	}
@@ -135,6 +241,14 @@ func LoadFromEnvironment() (*Config, error) {
 		cfg.LinkedIn.ClientSecret = clientSecret
 	}
 
+	if redirectURI := os.Getenv("LINKGEN_LINKEDIN_REDIRECT_URI"); redirectURI != "" {
+		cfg.LinkedIn.RedirectURI = redirectURI
+	}
+
+	if encryptionKey := os.Getenv("LINKGEN_LINKEDIN_TOKEN_ENCRYPTION_KEY"); encryptionKey != "" {
+		cfg.LinkedIn.TokenEncryptionKey = encryptionKey
+	}
+
 	// Scheduler configuration
 	if interval := os.Getenv("LINKGEN_SCHEDULER_INTERVAL"); interval != "" {
 		d, err := time.ParseDuration(interval)
@@ -143,6 +257,34 @@ func LoadFromEnvironment() (*Config, error) {
 		}
 	}
 
+	if publishInterval := os.Getenv("LINKGEN_SCHEDULER_PUBLISH_INTERVAL"); publishInterval != "" {
+		d, err := time.ParseDuration(publishInterval)
+		if err == nil {
+			cfg.Scheduler.PublishInterval = d
+		}
+	}
+
+	if refreshInterval := os.Getenv("LINKGEN_SCHEDULER_TOKEN_REFRESH_INTERVAL"); refreshInterval != "" {
+		d, err := time.ParseDuration(refreshInterval)
+		if err == nil {
+			cfg.Scheduler.TokenRefreshInterval = d
+		}
+	}
+
+	if refreshWindow := os.Getenv("LINKGEN_SCHEDULER_TOKEN_REFRESH_WINDOW"); refreshWindow != "" {
+		d, err := time.ParseDuration(refreshWindow)
+		if err == nil {
+			cfg.Scheduler.TokenRefreshWindow = d
+		}
+	}
+
+	if scheduleInterval := os.Getenv("LINKGEN_SCHEDULER_SCHEDULE_INTERVAL"); scheduleInterval != "" {
+		d, err := time.ParseDuration(scheduleInterval)
+		if err == nil {
+			cfg.Scheduler.ScheduleInterval = d
+		}
+	}
+
 	if batchSize := os.Getenv("LINKGEN_SCHEDULER_BATCH_SIZE"); batchSize != "" {
 		size, err := strconv.Atoi(batchSize)
 		if err == nil {
@@ -228,6 +370,9 @@ func LoadFromFile(filePath string) (*Config, error) {
 
 	// Parse LLM configuration
 	if llm, ok := rawConfig["llm"].(map[string]interface{}); ok {
+		if provider, ok := llm["provider"].(string); ok {
+			cfg.LLM.Provider = provider
+		}
 		if endpoint, ok := llm["endpoint"].(string); ok {
 			cfg.LLM.Endpoint = endpoint
 		}
@@ -237,6 +382,9 @@ func LoadFromFile(filePath string) (*Config, error) {
 		if apiKey, ok := llm["api_key"].(string); ok {
 			cfg.LLM.APIKey = apiKey
 		}
+		if encryptionKey, ok := llm["key_encryption_key"].(string); ok {
+			cfg.LLM.KeyEncryptionKey = encryptionKey
+		}
 		if timeout, ok := llm["timeout"].(int); ok {
 			cfg.LLM.Timeout = time.Duration(timeout) * time.Second
 		}
@@ -246,6 +394,86 @@ func LoadFromFile(filePath string) (*Config, error) {
 		if temperature, ok := llm["temperature"].(float64); ok {
 			cfg.LLM.Temperature = temperature
 		}
+		if replayMode, ok := llm["replay_mode"].(string); ok {
+			cfg.LLM.ReplayMode = replayMode
+		}
+		if replayDir, ok := llm["replay_dir"].(string); ok {
+			cfg.LLM.ReplayDir = replayDir
+		}
+		if fallbacks, ok := llm["fallbacks"].([]interface{}); ok {
+			cfg.LLM.Fallbacks = nil
+			for _, item := range fallbacks {
+				fallback, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				backend := LLMBackendConfig{}
+				backend.Provider, _ = fallback["provider"].(string)
+				backend.Model, _ = fallback["model"].(string)
+				backend.Endpoint, _ = fallback["endpoint"].(string)
+				backend.APIKey, _ = fallback["api_key"].(string)
+				cfg.LLM.Fallbacks = append(cfg.LLM.Fallbacks, backend)
+			}
+		}
+		if threshold, ok := llm["breaker_threshold"].(int); ok {
+			cfg.LLM.BreakerThreshold = threshold
+		}
+		if cooldown, ok := llm["breaker_cooldown"].(string); ok {
+			d, err := time.ParseDuration(cooldown)
+			if err == nil {
+				cfg.LLM.BreakerCooldown = d
+			}
+		}
+		if prices, ok := llm["prices"].(map[string]interface{}); ok {
+			cfg.LLM.Prices = make(map[string]LLMPrice, len(prices))
+			for model, item := range prices {
+				rates, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				cfg.LLM.Prices[model] = LLMPrice{
+					Prompt:     yamlFloat(rates["prompt"]),
+					Completion: yamlFloat(rates["completion"]),
+				}
+			}
+		}
+		if quota, ok := llm["quota"].(map[string]interface{}); ok {
+			if n, ok := quota["daily_tokens"].(int); ok {
+				cfg.LLM.Quota.DailyTokens = int64(n)
+			}
+			if n, ok := quota["daily_requests"].(int); ok {
+				cfg.LLM.Quota.DailyRequests = int64(n)
+			}
+			if n, ok := quota["monthly_tokens"].(int); ok {
+				cfg.LLM.Quota.MonthlyTokens = int64(n)
+			}
+			if n, ok := quota["monthly_requests"].(int); ok {
+				cfg.LLM.Quota.MonthlyRequests = int64(n)
+			}
+		}
+		if disable, ok := llm["disable_structured_output"].(bool); ok {
+			cfg.LLM.DisableStructuredOutput = disable
+		}
+		if maxInFlight, ok := llm["max_in_flight"].(int); ok {
+			cfg.LLM.MaxInFlight = maxInFlight
+		}
+		if tpm, ok := llm["tokens_per_minute"].(int); ok {
+			cfg.LLM.TokensPerMinute = tpm
+		}
+		if cache, ok := llm["cache"].(map[string]interface{}); ok {
+			if enabled, ok := cache["enabled"].(bool); ok {
+				cfg.LLM.Cache.Enabled = enabled
+			}
+			if size, ok := cache["size"].(int); ok {
+				cfg.LLM.Cache.Size = size
+			}
+			if ttl, ok := cache["ttl"].(string); ok {
+				d, err := time.ParseDuration(ttl)
+				if err == nil {
+					cfg.LLM.Cache.TTL = d
+				}
+			}
+		}
 	}
 
 	// Parse LinkedIn configuration
@@ -253,12 +481,24 @@ func LoadFromFile(filePath string) (*Config, error) {
 		if apiURL, ok := linkedin["api_url"].(string); ok {
 			cfg.LinkedIn.APIURL = apiURL
 		}
+		if tokenURL, ok := linkedin["token_url"].(string); ok {
+			cfg.LinkedIn.TokenURL = tokenURL
+		}
+		if authURL, ok := linkedin["auth_url"].(string); ok {
+			cfg.LinkedIn.AuthURL = authURL
+		}
 		if clientID, ok := linkedin["client_id"].(string); ok {
 			cfg.LinkedIn.ClientID = clientID
 		}
 		if clientSecret, ok := linkedin["client_secret"].(string); ok {
 			cfg.LinkedIn.ClientSecret = clientSecret
 		}
+		if redirectURI, ok := linkedin["redirect_uri"].(string); ok {
+			cfg.LinkedIn.RedirectURI = redirectURI
+		}
+		if encryptionKey, ok := linkedin["token_encryption_key"].(string); ok {
+			cfg.LinkedIn.TokenEncryptionKey = encryptionKey
+		}
 	}
 
 	// Parse scheduler configuration
@@ -269,6 +509,30 @@ func LoadFromFile(filePath string) (*Config, error) {
 				cfg.Scheduler.Interval = d
 			}
 		}
+		if publishInterval, ok := scheduler["publish_interval"].(string); ok {
+			d, err := time.ParseDuration(publishInterval)
+			if err == nil {
+				cfg.Scheduler.PublishInterval = d
+			}
+		}
+		if refreshInterval, ok := scheduler["token_refresh_interval"].(string); ok {
+			d, err := time.ParseDuration(refreshInterval)
+			if err == nil {
+				cfg.Scheduler.TokenRefreshInterval = d
+			}
+		}
+		if refreshWindow, ok := scheduler["token_refresh_window"].(string); ok {
+			d, err := time.ParseDuration(refreshWindow)
+			if err == nil {
+				cfg.Scheduler.TokenRefreshWindow = d
+			}
+		}
+		if scheduleInterval, ok := scheduler["schedule_interval"].(string); ok {
+			d, err := time.ParseDuration(scheduleInterval)
+			if err == nil {
+				cfg.Scheduler.ScheduleInterval = d
+			}
+		}
 		if batchSize, ok := scheduler["batch_size"].(int); ok {
 			cfg.Scheduler.BatchSize = batchSize
 		}
@@ -414,22 +678,37 @@ func NewDefaultConfig() *Config {
 			ReconnectWait: 2 * time.Second,
 		},
 		LLM: LLMConfig{
+			Provider:    "openai",
 			Timeout:     45 * time.Second,
 			MaxTokens:   2000,
 			Temperature: 0.7,
+			ReplayDir:   "testdata/llm",
+			// Fail over after a few consecutive failures, then probe again after the cooldown
+			BreakerThreshold: 5,
+			BreakerCooldown:  30 * time.Second,
+			// Long enough to outlive a job's retries, including redeliveries after a restart
+			Cache: LLMCacheConfig{Size: 256, TTL: 24 * time.Hour},
+			// Enough for a few users refining while workers generate in the background
+			MaxInFlight: 4,
 		},
 		LinkedIn: LinkedInAPIConfig{
 			APIURL:          "https://api.linkedin.com/v2",
+			TokenURL:        "https://www.linkedin.com/oauth/v2/accessToken",
+			AuthURL:         "https://www.linkedin.com/oauth/v2/authorization",
 			Timeout:         30 * time.Second,
 			RateLimit:       100,
 			RateLimitWindow: 1 * time.Minute,
 		},
 		Scheduler: SchedulerConfig{
-			Interval:   6 * time.Hour,
-			BatchSize:  100,
-			MaxRetries: 3,
-			RetryDelay: 5 * time.Minute,
-			Enabled:    true,
+			Interval:             6 * time.Hour,
+			PublishInterval:      1 * time.Minute,
+			TokenRefreshInterval: 1 * time.Hour,
+			TokenRefreshWindow:   7 * 24 * time.Hour,
+			ScheduleInterval:     1 * time.Minute,
+			BatchSize:            100,
+			MaxRetries:           3,
+			RetryDelay:           5 * time.Minute,
+			Enabled:              true,
 		},
 		Logging: LoggingConfig{
 			Level:  "info",
@@ -502,6 +781,9 @@ func mergeConfigs(dst, src *Config) {
 	}
 
 	// LLM
+	if src.LLM.Provider != "" {
+		dst.LLM.Provider = src.LLM.Provider
+	}
 	if src.LLM.Endpoint != "" {
 		dst.LLM.Endpoint = src.LLM.Endpoint
 	}
@@ -511,11 +793,88 @@ func mergeConfigs(dst, src *Config) {
 	if src.LLM.APIKey != "" {
 		dst.LLM.APIKey = src.LLM.APIKey
 	}
+	if src.LLM.KeyEncryptionKey != "" {
+		dst.LLM.KeyEncryptionKey = src.LLM.KeyEncryptionKey
+	}
+	if src.LLM.ReplayMode != "" {
+		dst.LLM.ReplayMode = src.LLM.ReplayMode
+	}
+	if src.LLM.ReplayDir != "" {
+		dst.LLM.ReplayDir = src.LLM.ReplayDir
+	}
+	if len(src.LLM.Fallbacks) > 0 {
+		dst.LLM.Fallbacks = src.LLM.Fallbacks
+	}
+	if src.LLM.BreakerThreshold != 5 && src.LLM.BreakerThreshold != 0 {
+		dst.LLM.BreakerThreshold = src.LLM.BreakerThreshold
+	}
+	if src.LLM.BreakerCooldown != 30*time.Second && src.LLM.BreakerCooldown != 0 {
+		dst.LLM.BreakerCooldown = src.LLM.BreakerCooldown
+	}
+	if len(src.LLM.Prices) > 0 {
+		dst.LLM.Prices = src.LLM.Prices
+	}
+	if src.LLM.Quota.DailyTokens != 0 {
+		dst.LLM.Quota.DailyTokens = src.LLM.Quota.DailyTokens
+	}
+	if src.LLM.Quota.DailyRequests != 0 {
+		dst.LLM.Quota.DailyRequests = src.LLM.Quota.DailyRequests
+	}
+	if src.LLM.Quota.MonthlyTokens != 0 {
+		dst.LLM.Quota.MonthlyTokens = src.LLM.Quota.MonthlyTokens
+	}
+	if src.LLM.Quota.MonthlyRequests != 0 {
+		dst.LLM.Quota.MonthlyRequests = src.LLM.Quota.MonthlyRequests
+	}
+	if src.LLM.DisableStructuredOutput {
+		dst.LLM.DisableStructuredOutput = true
+	}
+	if src.LLM.MaxInFlight != 4 && src.LLM.MaxInFlight != 0 {
+		dst.LLM.MaxInFlight = src.LLM.MaxInFlight
+	}
+	if src.LLM.TokensPerMinute != 0 {
+		dst.LLM.TokensPerMinute = src.LLM.TokensPerMinute
+	}
+	if src.LLM.Cache.Enabled {
+		dst.LLM.Cache.Enabled = true
+	}
+	if src.LLM.Cache.Size != 256 && src.LLM.Cache.Size != 0 {
+		dst.LLM.Cache.Size = src.LLM.Cache.Size
+	}
+	if src.LLM.Cache.TTL != 24*time.Hour && src.LLM.Cache.TTL != 0 {
+		dst.LLM.Cache.TTL = src.LLM.Cache.TTL
+	}
+
+	// LinkedIn
+	if src.LinkedIn.ClientID != "" {
+		dst.LinkedIn.ClientID = src.LinkedIn.ClientID
+	}
+	if src.LinkedIn.ClientSecret != "" {
+		dst.LinkedIn.ClientSecret = src.LinkedIn.ClientSecret
+	}
+	if src.LinkedIn.RedirectURI != "" {
+		dst.LinkedIn.RedirectURI = src.LinkedIn.RedirectURI
+	}
+	if src.LinkedIn.TokenEncryptionKey != "" {
+		dst.LinkedIn.TokenEncryptionKey = src.LinkedIn.TokenEncryptionKey
+	}
 
 	// Scheduler
 	if src.Scheduler.Interval != 6*time.Hour && src.Scheduler.Interval != 0 {
 		dst.Scheduler.Interval = src.Scheduler.Interval
 	}
+	if src.Scheduler.PublishInterval != time.Minute && src.Scheduler.PublishInterval != 0 {
+		dst.Scheduler.PublishInterval = src.Scheduler.PublishInterval
+	}
+	if src.Scheduler.TokenRefreshInterval != time.Hour && src.Scheduler.TokenRefreshInterval != 0 {
+		dst.Scheduler.TokenRefreshInterval = src.Scheduler.TokenRefreshInterval
+	}
+	if src.Scheduler.TokenRefreshWindow != 7*24*time.Hour && src.Scheduler.TokenRefreshWindow != 0 {
+		dst.Scheduler.TokenRefreshWindow = src.Scheduler.TokenRefreshWindow
+	}
+	if src.Scheduler.ScheduleInterval != time.Minute && src.Scheduler.ScheduleInterval != 0 {
+		dst.Scheduler.ScheduleInterval = src.Scheduler.ScheduleInterval
+	}
 	if src.Scheduler.BatchSize != 100 && src.Scheduler.BatchSize != 0 {
 		dst.Scheduler.BatchSize = src.Scheduler.BatchSize
 	}
@@ -528,3 +887,15 @@ func mergeConfigs(dst, src *Config) {
 		dst.Logging.Format = src.Logging.Format
 	}
 }
+
+// yamlFloat converts a YAML number, which decodes as int for whole values, to float64
+func yamlFloat(value interface{}) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case int:
+		return float64(v)
+	default:
+		return 0
+	}
+}
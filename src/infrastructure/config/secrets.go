@@ -4,6 +4,7 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -37,6 +38,9 @@ type SecretStore struct {
 	key     []byte
 }
 
+// MinEncryptionKeyLength is the minimum length of a passphrase used to derive an encryption key
+const MinEncryptionKeyLength = 32
+
 var globalSecretStore *SecretStore
 var secretStoreMutex sync.Mutex
 
@@ -67,6 +71,21 @@ func NewSecretStore() *SecretStore {
 	}
 }
 
+// NewSecretStoreWithKey creates a secret store whose encryption key is derived from
+// the given passphrase, so values encrypted by one process can be decrypted by another
+func NewSecretStoreWithKey(passphrase string) (*SecretStore, error) {
+	if err := ValidateSecretStrength(passphrase, MinEncryptionKeyLength); err != nil {
+		return nil, err
+	}
+
+	key := sha256.Sum256([]byte(passphrase))
+
+	return &SecretStore{
+		secrets: make(map[string]string),
+		key:     key[:],
+	}, nil
+}
+
 // LoadSecrets loads secrets from environment variables
 func LoadSecrets() error {
 	store := GetSecretStore()
@@ -29,6 +29,18 @@ var (
 	ErrInvalidBatchSize = errors.New("invalid batch size")
 	// ErrInvalidTemperature indicates invalid LLM temperature
 	ErrInvalidTemperature = errors.New("invalid LLM temperature")
+	// ErrInvalidLLMProvider indicates an unsupported LLM provider
+	ErrInvalidLLMProvider = errors.New("invalid LLM provider")
+	// ErrInvalidReplayMode indicates an invalid LLM record/replay mode
+	ErrInvalidReplayMode = errors.New("invalid LLM replay mode")
+	// ErrInvalidLLMPrice indicates a negative LLM price
+	ErrInvalidLLMPrice = errors.New("invalid LLM price")
+	// ErrInvalidLLMQuota indicates a negative LLM quota
+	ErrInvalidLLMQuota = errors.New("invalid LLM quota")
+	// ErrInvalidLLMLimit indicates a negative LLM concurrency or rate limit
+	ErrInvalidLLMLimit = errors.New("invalid LLM limit")
+	// ErrInvalidLLMCache indicates an invalid LLM cache size or TTL
+	ErrInvalidLLMCache = errors.New("invalid LLM cache")
 	// ErrMissingRequiredField indicates a required field is missing
 	ErrMissingRequiredField = errors.New("missing required field")
 )
@@ -212,6 +224,26 @@ func ValidateLLMTemperature(temperature float64) error {
 	return nil
 }
 
+// ValidateLLMProvider validates the LLM provider name
+func ValidateLLMProvider(provider string) error {
+	switch strings.ToLower(strings.TrimSpace(provider)) {
+	case "", "openai", "anthropic", "ollama":
+		return nil
+	default:
+		return fmt.Errorf("%w: must be openai, anthropic or ollama, got %q", ErrInvalidLLMProvider, provider)
+	}
+}
+
+// ValidateLLMReplayMode validates the LLM record/replay mode
+func ValidateLLMReplayMode(mode string) error {
+	switch strings.ToLower(strings.TrimSpace(mode)) {
+	case "", "record", "replay":
+		return nil
+	default:
+		return fmt.Errorf("%w: must be empty, record or replay, got %q", ErrInvalidReplayMode, mode)
+	}
+}
+
 // ValidateCompleteConfig validates entire configuration object
 func ValidateCompleteConfig(cfg *Config) error {
 	// Required fields
@@ -249,6 +281,49 @@ func ValidateCompleteConfig(cfg *Config) error {
 		return err
 	}
 
+	// LLM provider validation
+	if err := ValidateLLMProvider(cfg.LLM.Provider); err != nil {
+		return err
+	}
+
+	// LLM fallback validation
+	for i, fallback := range cfg.LLM.Fallbacks {
+		if fallback.Model == "" {
+			return fmt.Errorf("%w: llm_fallbacks[%d].model", ErrMissingRequiredField, i)
+		}
+		if err := ValidateLLMProvider(fallback.Provider); err != nil {
+			return err
+		}
+	}
+
+	// LLM price validation
+	for model, price := range cfg.LLM.Prices {
+		if price.Prompt < 0 || price.Completion < 0 {
+			return fmt.Errorf("%w: %s", ErrInvalidLLMPrice, model)
+		}
+	}
+
+	// LLM quota validation
+	quota := cfg.LLM.Quota
+	if quota.DailyTokens < 0 || quota.DailyRequests < 0 || quota.MonthlyTokens < 0 || quota.MonthlyRequests < 0 {
+		return fmt.Errorf("%w: quotas cannot be negative", ErrInvalidLLMQuota)
+	}
+
+	// LLM dispatcher validation
+	if cfg.LLM.MaxInFlight < 0 || cfg.LLM.TokensPerMinute < 0 {
+		return fmt.Errorf("%w: max in-flight requests and tokens per minute cannot be negative", ErrInvalidLLMLimit)
+	}
+
+	// LLM cache validation
+	if cfg.LLM.Cache.Enabled && (cfg.LLM.Cache.Size <= 0 || cfg.LLM.Cache.TTL <= 0) {
+		return fmt.Errorf("%w: size and TTL must be greater than 0", ErrInvalidLLMCache)
+	}
+
+	// LLM replay mode validation
+	if err := ValidateLLMReplayMode(cfg.LLM.ReplayMode); err != nil {
+		return err
+	}
+
 	// Log level validation
 	if err := ValidateLogLevel(cfg.Logging.Level); err != nil {
 		return err
@@ -12,14 +12,21 @@ import (
 
 // Collection name constants
 const (
-	CollectionUsers      = "users"
-	CollectionTopics     = "topics"
-	CollectionIdeas      = "ideas"
-	CollectionDrafts     = "drafts"
-	CollectionUserTopics = "userTopics"
-	CollectionPrompts    = "prompts"
-	CollectionJobs       = "jobs"
-	CollectionJobErrors  = "jobErrors"
+	CollectionUsers          = "users"
+	CollectionTopics         = "topics"
+	CollectionIdeas          = "ideas"
+	CollectionDrafts         = "drafts"
+	CollectionUserTopics     = "userTopics"
+	CollectionPrompts        = "prompts"
+	CollectionJobs           = "jobs"
+	CollectionJobErrors      = "jobErrors"
+	CollectionOAuthStates    = "oauthStates"
+	CollectionSchedules      = "schedules"
+	CollectionLeases         = "leases"
+	CollectionLLMUsage       = "llmUsage"
+	CollectionLLMCache       = "llmCache"
+	CollectionPromptVersions = "promptVersions"
+	CollectionExperiments    = "experiments"
 )
 
 // IndexDefinition represents a MongoDB index
@@ -43,6 +50,11 @@ func GetAllIndexDefinitions() []IndexDefinition {
 			Keys:       bson.D{{Key: "linkedin_id", Value: 1}},
 			Options:    options.Index().SetUnique(true).SetName("linkedin_id_unique"),
 		},
+		IndexDefinition{
+			Collection: CollectionUsers,
+			Keys:       bson.D{{Key: "linkedin_token_expires_at", Value: 1}},
+			Options:    options.Index().SetSparse(true).SetName("linkedin_token_expires_at_idx"),
+		},
 		// Ideas collection indexes
 		IndexDefinition{
 			Collection: CollectionIdeas,
@@ -59,6 +71,11 @@ func GetAllIndexDefinitions() []IndexDefinition {
 			Keys:       bson.D{{Key: "user_id", Value: 1}, {Key: "topic", Value: 1}},
 			Options:    options.Index().SetName("user_topic_compound_idx"),
 		},
+		IndexDefinition{
+			Collection: CollectionIdeas,
+			Keys:       bson.D{{Key: "topic_id", Value: 1}, {Key: "used", Value: 1}},
+			Options:    options.Index().SetName("topic_used_compound_idx"),
+		},
 		// Drafts collection indexes
 		IndexDefinition{
 			Collection: CollectionDrafts,
@@ -70,12 +87,22 @@ func GetAllIndexDefinitions() []IndexDefinition {
 			Keys:       bson.D{{Key: "status", Value: 1}},
 			Options:    options.Index().SetName("status_idx"),
 		},
+		IndexDefinition{
+			Collection: CollectionDrafts,
+			Keys:       bson.D{{Key: "status", Value: 1}, {Key: "scheduled_at", Value: 1}},
+			Options:    options.Index().SetName("status_scheduled_at_idx"),
+		},
 		// Topics collection indexes
 		IndexDefinition{
 			Collection: CollectionTopics,
 			Keys:       bson.D{{Key: "user_id", Value: 1}},
 			Options:    options.Index().SetName("user_id_idx"),
 		},
+		IndexDefinition{
+			Collection: CollectionTopics,
+			Keys:       bson.D{{Key: "active", Value: 1}, {Key: "priority", Value: -1}},
+			Options:    options.Index().SetName("active_priority_compound_idx"),
+		},
 		// Prompts collection indexes
 		{
 			Collection: CollectionPrompts,
@@ -103,6 +130,57 @@ func GetAllIndexDefinitions() []IndexDefinition {
 			Keys:       bson.D{{Key: "created_at", Value: 1}},
 			Options:    options.Index().SetName("created_at_ttl_idx").SetExpireAfterSeconds(60 * 60 * 24 * 30),
 		},
+		// OAuth states collection indexes
+		{
+			Collection: CollectionOAuthStates,
+			Keys:       bson.D{{Key: "state", Value: 1}},
+			Options:    options.Index().SetUnique(true).SetName("state_unique"),
+		},
+		{
+			Collection: CollectionOAuthStates,
+			Keys:       bson.D{{Key: "expires_at", Value: 1}},
+			Options:    options.Index().SetName("expires_at_ttl_idx").SetExpireAfterSeconds(0),
+		},
+		// Schedules collection indexes
+		{
+			Collection: CollectionSchedules,
+			Keys:       bson.D{{Key: "user_id", Value: 1}},
+			Options:    options.Index().SetName("user_id_idx"),
+		},
+		{
+			Collection: CollectionSchedules,
+			Keys:       bson.D{{Key: "active", Value: 1}, {Key: "next_run_at", Value: 1}},
+			Options:    options.Index().SetName("active_next_run_compound_idx"),
+		},
+		// LLM usage collection indexes
+		{
+			Collection: CollectionLLMUsage,
+			Keys:       bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options:    options.Index().SetName("user_created_at_compound_idx"),
+		},
+		{
+			Collection: CollectionLLMUsage,
+			Keys:       bson.D{{Key: "job_id", Value: 1}},
+			Options:    options.Index().SetSparse(true).SetName("job_id_idx"),
+		},
+		// LLM cache collection indexes
+		{
+			Collection: CollectionLLMCache,
+			Keys:       bson.D{{Key: "expires_at", Value: 1}},
+			Options:    options.Index().SetName("expires_at_ttl_idx").SetExpireAfterSeconds(0),
+		},
+		// Prompt versions collection indexes
+		{
+			Collection: CollectionPromptVersions,
+			Keys:       bson.D{{Key: "prompt_id", Value: 1}, {Key: "version", Value: -1}},
+			Options:    options.Index().SetUnique(true).SetName("prompt_version_unique"),
+		},
+		// Experiments collection indexes
+		{
+			Collection: CollectionExperiments,
+			Keys:       bson.D{{Key: "user_id", Value: 1}, {Key: "type", Value: 1}, {Key: "active", Value: 1}},
+			Options:    options.Index().SetName("user_type_active_compound_idx"),
+		},
 	}
 }
 
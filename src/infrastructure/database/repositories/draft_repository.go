@@ -14,6 +14,9 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// publishLeaseField stores until when a publisher holds a scheduled or PUBLISHING draft
+const publishLeaseField = "publish_lease_until"
+
 // draftRepository implements the DraftRepository interface for MongoDB
 type draftRepository struct {
 	*database.BaseRepository
@@ -36,6 +39,13 @@ type refinementEntryDocument struct {
 	Version   int                `bson:"version"`
 }
 
+// publishErrorDocument represents the MongoDB document structure for PublishError
+type publishErrorDocument struct {
+	Reason     string             `bson:"reason"`
+	Message    string             `bson:"message,omitempty"`
+	OccurredAt primitive.DateTime `bson:"occurred_at"`
+}
+
 // draftDocument represents the MongoDB document structure for Draft
 type draftDocument struct {
 	ID                primitive.ObjectID        `bson:"_id,omitempty"`
@@ -46,8 +56,10 @@ type draftDocument struct {
 	Content           string                    `bson:"content"`
 	Status            string                    `bson:"status"`
 	RefinementHistory []refinementEntryDocument `bson:"refinement_history"`
+	ScheduledAt       *primitive.DateTime       `bson:"scheduled_at,omitempty"`
 	PublishedAt       *primitive.DateTime       `bson:"published_at,omitempty"`
 	LinkedInPostID    string                    `bson:"linkedin_post_id"`
+	PublishError      *publishErrorDocument     `bson:"publish_error,omitempty"`
 	Metadata          map[string]interface{}    `bson:"metadata"`
 	CreatedAt         primitive.DateTime        `bson:"created_at"`
 	UpdatedAt         primitive.DateTime        `bson:"updated_at"`
@@ -107,12 +119,20 @@ func (r *draftRepository) toDocument(draft *entities.Draft) (*draftDocument, err
 		}
 	}
 
+	// Set scheduled timestamp if present
+	if draft.ScheduledAt != nil {
+		scheduledAt := primitive.NewDateTimeFromTime(*draft.ScheduledAt)
+		doc.ScheduledAt = &scheduledAt
+	}
+
 	// Set published timestamp if present
 	if draft.PublishedAt != nil {
 		publishedAt := primitive.NewDateTimeFromTime(*draft.PublishedAt)
 		doc.PublishedAt = &publishedAt
 	}
 
+	doc.PublishError = toPublishErrorDocument(draft.PublishError)
+
 	return doc, nil
 }
 
@@ -154,15 +174,42 @@ func (r *draftRepository) toEntity(doc *draftDocument) *entities.Draft {
 		}
 	}
 
+	// Set scheduled timestamp if present
+	if doc.ScheduledAt != nil {
+		scheduledAt := doc.ScheduledAt.Time()
+		draft.ScheduledAt = &scheduledAt
+	}
+
 	// Set published timestamp if present
 	if doc.PublishedAt != nil {
 		publishedAt := doc.PublishedAt.Time()
 		draft.PublishedAt = &publishedAt
 	}
 
+	if doc.PublishError != nil {
+		draft.PublishError = &entities.PublishError{
+			Reason:     doc.PublishError.Reason,
+			Message:    doc.PublishError.Message,
+			OccurredAt: doc.PublishError.OccurredAt.Time(),
+		}
+	}
+
 	return draft
 }
 
+// toPublishErrorDocument converts a PublishError to its MongoDB representation
+func toPublishErrorDocument(publishError *entities.PublishError) *publishErrorDocument {
+	if publishError == nil {
+		return nil
+	}
+
+	return &publishErrorDocument{
+		Reason:     publishError.Reason,
+		Message:    publishError.Message,
+		OccurredAt: primitive.NewDateTimeFromTime(publishError.OccurredAt),
+	}
+}
+
 // Create creates a new draft in the database
 func (r *draftRepository) Create(ctx context.Context, draft *entities.Draft) (string, error) {
 	if draft == nil {
@@ -232,6 +279,11 @@ func (r *draftRepository) Update(ctx context.Context, draftID string, updates ma
 		return database.ErrInvalidID
 	}
 
+	// Convert entity values that need a custom document layout
+	if publishError, ok := updates["publish_error"].(*entities.PublishError); ok {
+		updates["publish_error"] = toPublishErrorDocument(publishError)
+	}
+
 	// Add updated timestamp
 	updates["updated_at"] = primitive.NewDateTimeFromTime(time.Now())
 
@@ -250,6 +302,39 @@ func (r *draftRepository) Update(ctx context.Context, draftID string, updates ma
 	return nil
 }
 
+// UpdateIfStatus updates draft information only while the draft is in the expected status
+func (r *draftRepository) UpdateIfStatus(ctx context.Context, draftID string, expected entities.DraftStatus, updates map[string]interface{}) (bool, error) {
+	if draftID == "" {
+		return false, database.ErrInvalidID
+	}
+
+	if len(updates) == 0 {
+		return false, database.ErrEmptyUpdate
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(draftID)
+	if err != nil {
+		return false, database.ErrInvalidID
+	}
+
+	if publishError, ok := updates["publish_error"].(*entities.PublishError); ok {
+		updates["publish_error"] = toPublishErrorDocument(publishError)
+	}
+	updates["updated_at"] = primitive.NewDateTimeFromTime(time.Now())
+
+	// The status check and the update run as a single document operation, so only
+	// one caller can move the draft out of the expected status
+	filter := bson.M{"_id": objectID, "status": string(expected)}
+	update := bson.M{"$set": updates}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, fmt.Errorf("failed to update draft: %w", err)
+	}
+
+	return result.MatchedCount == 1, nil
+}
+
 // Delete removes a draft from the database
 func (r *draftRepository) Delete(ctx context.Context, draftID string) error {
 	if draftID == "" {
@@ -428,3 +513,92 @@ func (r *draftRepository) FindReadyForPublishing(ctx context.Context, userID str
 
 	return drafts, nil
 }
+
+// dueForPublishingFilter matches scheduled drafts that are due and PUBLISHING drafts
+// whose publisher stopped, neither of them claimed at now
+func dueForPublishingFilter(now time.Time) bson.M {
+	nowDateTime := primitive.NewDateTimeFromTime(now)
+
+	return bson.M{
+		"$or": bson.A{
+			bson.M{
+				"status":       string(entities.DraftStatusScheduled),
+				"scheduled_at": bson.M{"$lte": nowDateTime},
+			},
+			bson.M{"status": string(entities.DraftStatusPublishing)},
+		},
+		// Matches missing, null and expired leases
+		publishLeaseField: bson.M{"$not": bson.M{"$gt": nowDateTime}},
+	}
+}
+
+// FindDueForPublishing retrieves scheduled drafts whose publish time has passed
+func (r *draftRepository) FindDueForPublishing(ctx context.Context, now time.Time, limit int) ([]*entities.Draft, error) {
+	filter := dueForPublishingFilter(now)
+
+	// Sort by schedule (oldest first, FIFO)
+	opts := options.Find().SetSort(bson.D{{Key: "scheduled_at", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find drafts due for publishing: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var drafts []*entities.Draft
+	for cursor.Next(ctx) {
+		var doc draftDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("failed to decode draft document: %w", err)
+		}
+		drafts = append(drafts, r.toEntity(&doc))
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return drafts, nil
+}
+
+// ClaimForPublishing atomically reserves a due draft for one publisher. A PUBLISHING
+// draft whose lease expired is handed back as SCHEDULED, due now unless it was
+// scheduled, so the publish use case can claim it again.
+func (r *draftRepository) ClaimForPublishing(ctx context.Context, draftID string, now time.Time, leaseUntil time.Time) (bool, error) {
+	if draftID == "" {
+		return false, database.ErrInvalidID
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(draftID)
+	if err != nil {
+		return false, database.ErrInvalidID
+	}
+
+	if !leaseUntil.After(now) {
+		return false, fmt.Errorf("lease must end after now")
+	}
+
+	// The filter and the update run as a single document operation, so only
+	// one publisher can move the lease forward for a given due draft
+	filter := dueForPublishingFilter(now)
+	filter["_id"] = objectID
+
+	nowDateTime := primitive.NewDateTimeFromTime(now)
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"status":          string(entities.DraftStatusScheduled),
+			"scheduled_at":    bson.M{"$ifNull": bson.A{"$scheduled_at", nowDateTime}},
+			publishLeaseField: primitive.NewDateTimeFromTime(leaseUntil),
+		}}},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim draft for publishing: %w", err)
+	}
+
+	return result.ModifiedCount == 1, nil
+}
@@ -0,0 +1,256 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// experimentRepository implements ExperimentRepository for MongoDB
+type experimentRepository struct {
+	*database.BaseRepository
+	collection *mongo.Collection
+}
+
+// NewExperimentRepository creates a new MongoDB experiment repository
+func NewExperimentRepository(collection *mongo.Collection) interfaces.ExperimentRepository {
+	return &experimentRepository{
+		BaseRepository: database.NewBaseRepository(collection),
+		collection:     collection,
+	}
+}
+
+// experimentDocument represents the MongoDB document structure for Experiment
+type experimentDocument struct {
+	ID        primitive.ObjectID      `bson:"_id,omitempty"`
+	UserID    primitive.ObjectID      `bson:"user_id"`
+	Name      string                  `bson:"name"`
+	Type      string                  `bson:"type"`
+	Arms      []experimentArmDocument `bson:"arms"`
+	Active    bool                    `bson:"active"`
+	CreatedAt primitive.DateTime      `bson:"created_at"`
+	UpdatedAt primitive.DateTime      `bson:"updated_at"`
+}
+
+// experimentArmDocument represents an arm of an experiment in MongoDB
+type experimentArmDocument struct {
+	PromptName string `bson:"prompt_name"`
+	Weight     int    `bson:"weight"`
+}
+
+// toDocument converts an Experiment entity to a MongoDB document
+func (r *experimentRepository) toDocument(experiment *entities.Experiment) (*experimentDocument, error) {
+	var objectID primitive.ObjectID
+	if experiment.ID != "" {
+		id, err := primitive.ObjectIDFromHex(experiment.ID)
+		if err != nil {
+			return nil, database.ErrInvalidID
+		}
+		objectID = id
+	}
+
+	userObjectID, err := primitive.ObjectIDFromHex(experiment.UserID)
+	if err != nil {
+		return nil, database.ErrInvalidID
+	}
+
+	arms := make([]experimentArmDocument, len(experiment.Arms))
+	for i, arm := range experiment.Arms {
+		arms[i] = experimentArmDocument{PromptName: arm.PromptName, Weight: arm.Weight}
+	}
+
+	return &experimentDocument{
+		ID:        objectID,
+		UserID:    userObjectID,
+		Name:      experiment.Name,
+		Type:      string(experiment.Type),
+		Arms:      arms,
+		Active:    experiment.Active,
+		CreatedAt: primitive.NewDateTimeFromTime(experiment.CreatedAt),
+		UpdatedAt: primitive.NewDateTimeFromTime(experiment.UpdatedAt),
+	}, nil
+}
+
+// toEntity converts a MongoDB document to an Experiment entity
+func (r *experimentRepository) toEntity(doc *experimentDocument) *entities.Experiment {
+	arms := make([]entities.ExperimentArm, len(doc.Arms))
+	for i, arm := range doc.Arms {
+		arms[i] = entities.ExperimentArm{PromptName: arm.PromptName, Weight: arm.Weight}
+	}
+
+	return &entities.Experiment{
+		ID:        doc.ID.Hex(),
+		UserID:    doc.UserID.Hex(),
+		Name:      doc.Name,
+		Type:      entities.PromptType(doc.Type),
+		Arms:      arms,
+		Active:    doc.Active,
+		CreatedAt: doc.CreatedAt.Time(),
+		UpdatedAt: doc.UpdatedAt.Time(),
+	}
+}
+
+// Create creates a new experiment in the database
+func (r *experimentRepository) Create(ctx context.Context, experiment *entities.Experiment) (string, error) {
+	if experiment == nil {
+		return "", database.ErrInvalidEntity
+	}
+
+	if err := experiment.Validate(); err != nil {
+		return "", fmt.Errorf("experiment validation failed: %w", err)
+	}
+
+	doc, err := r.toDocument(experiment)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := r.collection.InsertOne(ctx, doc)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return "", database.ErrEntityAlreadyExists
+		}
+		return "", fmt.Errorf("failed to create experiment: %w", err)
+	}
+
+	insertedID, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return "", fmt.Errorf("failed to convert inserted ID to ObjectID")
+	}
+
+	return insertedID.Hex(), nil
+}
+
+// FindByID retrieves an experiment by its ID
+func (r *experimentRepository) FindByID(ctx context.Context, experimentID string) (*entities.Experiment, error) {
+	if experimentID == "" {
+		return nil, database.ErrInvalidID
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(experimentID)
+	if err != nil {
+		return nil, database.ErrInvalidID
+	}
+
+	var doc experimentDocument
+	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, database.ErrEntityNotFound
+		}
+		return nil, fmt.Errorf("failed to find experiment by ID: %w", err)
+	}
+
+	return r.toEntity(&doc), nil
+}
+
+// ListByUserID retrieves all experiments of a user, newest first
+func (r *experimentRepository) ListByUserID(ctx context.Context, userID string) ([]*entities.Experiment, error) {
+	userObjectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, database.ErrInvalidID
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userObjectID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find experiments: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	experiments := make([]*entities.Experiment, 0)
+	for cursor.Next(ctx) {
+		var doc experimentDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("failed to decode experiment document: %w", err)
+		}
+		experiments = append(experiments, r.toEntity(&doc))
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return experiments, nil
+}
+
+// FindActiveByUserIDAndType retrieves the newest active experiment of a user for a prompt type
+func (r *experimentRepository) FindActiveByUserIDAndType(ctx context.Context, userID string, promptType entities.PromptType) (*entities.Experiment, error) {
+	userObjectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, database.ErrInvalidID
+	}
+
+	filter := bson.M{
+		"user_id": userObjectID,
+		"type":    string(promptType),
+		"active":  true,
+	}
+	opts := options.FindOne().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	var doc experimentDocument
+	err = r.collection.FindOne(ctx, filter, opts).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find active experiment: %w", err)
+	}
+
+	return r.toEntity(&doc), nil
+}
+
+// Update persists the editable fields of an experiment
+func (r *experimentRepository) Update(ctx context.Context, experiment *entities.Experiment) error {
+	if experiment == nil {
+		return database.ErrInvalidEntity
+	}
+
+	if experiment.ID == "" {
+		return database.ErrInvalidID
+	}
+
+	if err := experiment.Validate(); err != nil {
+		return fmt.Errorf("experiment validation failed: %w", err)
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(experiment.ID)
+	if err != nil {
+		return database.ErrInvalidID
+	}
+
+	experiment.UpdatedAt = time.Now()
+
+	doc, err := r.toDocument(experiment)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"name":       doc.Name,
+			"arms":       doc.Arms,
+			"active":     doc.Active,
+			"updated_at": doc.UpdatedAt,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	if err != nil {
+		return fmt.Errorf("failed to update experiment: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return database.ErrEntityNotFound
+	}
+
+	return nil
+}
@@ -30,16 +30,20 @@ func NewIdeasRepository(collection *mongo.Collection) interfaces.IdeasRepository
 
 // ideaDocument represents the MongoDB document structure for Idea
 type ideaDocument struct {
-	ID           primitive.ObjectID  `bson:"_id,omitempty"`
-	UserID       primitive.ObjectID  `bson:"user_id"`
-	TopicID      primitive.ObjectID  `bson:"topic_id"`
-	TopicName    string              `bson:"topic_name"`
-	Content      string              `bson:"content"`
-	QualityScore *float64            `bson:"quality_score,omitempty"`
-	Used         bool                `bson:"used"`
-	CreatedAt    primitive.DateTime  `bson:"created_at"`
-	UpdatedAt    primitive.DateTime  `bson:"updated_at"`
-	ExpiresAt    *primitive.DateTime `bson:"expires_at,omitempty"`
+	ID               primitive.ObjectID  `bson:"_id,omitempty"`
+	UserID           primitive.ObjectID  `bson:"user_id"`
+	TopicID          primitive.ObjectID  `bson:"topic_id"`
+	TopicName        string              `bson:"topic_name"`
+	Content          string              `bson:"content"`
+	QualityScore     *float64            `bson:"quality_score,omitempty"`
+	Used             bool                `bson:"used"`
+	PublishedAt      *primitive.DateTime `bson:"published_at,omitempty"`
+	PublishedDraftID *primitive.ObjectID `bson:"published_draft_id,omitempty"`
+	PromptID         string              `bson:"prompt_id,omitempty"`
+	PromptVersion    int                 `bson:"prompt_version,omitempty"`
+	CreatedAt        primitive.DateTime  `bson:"created_at"`
+	UpdatedAt        primitive.DateTime  `bson:"updated_at"`
+	ExpiresAt        *primitive.DateTime `bson:"expires_at,omitempty"`
 }
 
 // toDocument converts an Idea entity to a MongoDB document
@@ -59,14 +63,16 @@ func (r *ideasRepository) toDocument(idea *entities.Idea) (*ideaDocument, error)
 	}
 
 	doc := &ideaDocument{
-		UserID:       userObjectID,
-		TopicID:      topicObjectID,
-		TopicName:    idea.TopicName,
-		Content:      idea.Content,
-		QualityScore: idea.QualityScore,
-		Used:         idea.Used,
-		CreatedAt:    primitive.NewDateTimeFromTime(idea.CreatedAt),
-		UpdatedAt:    primitive.NewDateTimeFromTime(idea.UpdatedAt),
+		UserID:        userObjectID,
+		TopicID:       topicObjectID,
+		TopicName:     idea.TopicName,
+		Content:       idea.Content,
+		QualityScore:  idea.QualityScore,
+		Used:          idea.Used,
+		PromptID:      idea.PromptID,
+		PromptVersion: idea.PromptVersion,
+		CreatedAt:     primitive.NewDateTimeFromTime(idea.CreatedAt),
+		UpdatedAt:     primitive.NewDateTimeFromTime(idea.UpdatedAt),
 	}
 
 	// Only set ID if it's valid
@@ -84,6 +90,20 @@ func (r *ideasRepository) toDocument(idea *entities.Idea) (*ideaDocument, error)
 		doc.ExpiresAt = &expiresAt
 	}
 
+	// Set publication data if present
+	if idea.PublishedAt != nil {
+		publishedAt := primitive.NewDateTimeFromTime(*idea.PublishedAt)
+		doc.PublishedAt = &publishedAt
+	}
+
+	if idea.PublishedDraftID != "" {
+		draftObjectID, err := primitive.ObjectIDFromHex(idea.PublishedDraftID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid published draft ID: %w", err)
+		}
+		doc.PublishedDraftID = &draftObjectID
+	}
+
 	return doc, nil
 }
 
@@ -94,14 +114,16 @@ func (r *ideasRepository) toEntity(doc *ideaDocument) *entities.Idea {
 	}
 
 	idea := &entities.Idea{
-		ID:           doc.ID.Hex(),
-		UserID:       doc.UserID.Hex(),
-		TopicID:      doc.TopicID.Hex(),
-		TopicName:    doc.TopicName,
-		Content:      doc.Content,
-		QualityScore: doc.QualityScore,
-		Used:         doc.Used,
-		CreatedAt:    doc.CreatedAt.Time(),
+		ID:            doc.ID.Hex(),
+		UserID:        doc.UserID.Hex(),
+		TopicID:       doc.TopicID.Hex(),
+		TopicName:     doc.TopicName,
+		Content:       doc.Content,
+		QualityScore:  doc.QualityScore,
+		Used:          doc.Used,
+		PromptID:      doc.PromptID,
+		PromptVersion: doc.PromptVersion,
+		CreatedAt:     doc.CreatedAt.Time(),
 		UpdatedAt: func() time.Time {
 			updatedAt := doc.UpdatedAt.Time()
 			if updatedAt.IsZero() {
@@ -116,6 +138,15 @@ func (r *ideasRepository) toEntity(doc *ideaDocument) *entities.Idea {
 		idea.ExpiresAt = &expiresAt
 	}
 
+	if doc.PublishedAt != nil {
+		publishedAt := doc.PublishedAt.Time()
+		idea.PublishedAt = &publishedAt
+	}
+
+	if doc.PublishedDraftID != nil {
+		idea.PublishedDraftID = doc.PublishedDraftID.Hex()
+	}
+
 	return idea
 }
 
@@ -154,6 +185,64 @@ func (r *ideasRepository) CreateBatch(ctx context.Context, ideas []*entities.Ide
 	return nil
 }
 
+// FindByID retrieves an idea by its ID
+func (r *ideasRepository) FindByID(ctx context.Context, ideaID string) (*entities.Idea, error) {
+	if ideaID == "" {
+		return nil, database.ErrInvalidID
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(ideaID)
+	if err != nil {
+		return nil, database.ErrInvalidID
+	}
+
+	var doc ideaDocument
+	filter := bson.M{"_id": objectID}
+
+	err = r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, database.ErrEntityNotFound
+		}
+		return nil, fmt.Errorf("failed to find idea by ID: %w", err)
+	}
+
+	return r.toEntity(&doc), nil
+}
+
+// Update updates idea information
+func (r *ideasRepository) Update(ctx context.Context, ideaID string, updates map[string]interface{}) error {
+	if ideaID == "" {
+		return database.ErrInvalidID
+	}
+
+	if len(updates) == 0 {
+		return database.ErrEmptyUpdate
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(ideaID)
+	if err != nil {
+		return database.ErrInvalidID
+	}
+
+	// Add updated timestamp
+	updates["updated_at"] = primitive.NewDateTimeFromTime(time.Now())
+
+	filter := bson.M{"_id": objectID}
+	update := bson.M{"$set": updates}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update idea: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return database.ErrEntityNotFound
+	}
+
+	return nil
+}
+
 // ListByUserID retrieves ideas for a user with optional filtering
 func (r *ideasRepository) ListByUserID(ctx context.Context, userID string, topicID string, limit int) ([]*entities.Idea, error) {
 	if userID == "" {
@@ -225,6 +314,26 @@ func (r *ideasRepository) CountByUserID(ctx context.Context, userID string) (int
 	return count, nil
 }
 
+// CountUnusedByTopicID returns the number of unused ideas for a topic
+func (r *ideasRepository) CountUnusedByTopicID(ctx context.Context, topicID string) (int64, error) {
+	if topicID == "" {
+		return 0, database.ErrInvalidID
+	}
+
+	topicObjectID, err := primitive.ObjectIDFromHex(topicID)
+	if err != nil {
+		return 0, database.ErrInvalidID
+	}
+
+	filter := bson.M{"topic_id": topicObjectID, "used": false}
+	count, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unused ideas: %w", err)
+	}
+
+	return count, nil
+}
+
 // ClearByUserID removes all ideas for a specific user
 func (r *ideasRepository) ClearByUserID(ctx context.Context, userID string) error {
 	if userID == "" {
@@ -38,6 +38,7 @@ type jobDocument struct {
 	IdeaID      *primitive.ObjectID  `bson:"idea_id,omitempty"`
 	DraftIDs    []primitive.ObjectID `bson:"draft_ids"`
 	Error       string               `bson:"error"`
+	Metadata    map[string]string    `bson:"metadata,omitempty"`
 	CreatedAt   primitive.DateTime   `bson:"created_at"`
 	UpdatedAt   primitive.DateTime   `bson:"updated_at"`
 	StartedAt   *primitive.DateTime  `bson:"started_at,omitempty"`
@@ -61,6 +62,7 @@ func (r *jobRepository) toDocument(job *entities.Job) (*jobDocument, error) {
 		Type:      string(job.Type),
 		Status:    string(job.Status),
 		Error:     job.Error,
+		Metadata:  job.Metadata,
 		CreatedAt: primitive.NewDateTimeFromTime(job.CreatedAt),
 		UpdatedAt: primitive.NewDateTimeFromTime(job.UpdatedAt),
 	}
@@ -113,6 +115,7 @@ func (r *jobRepository) toEntity(doc *jobDocument) *entities.Job {
 		Type:      entities.JobType(doc.Type),
 		Status:    entities.JobStatus(doc.Status),
 		Error:     doc.Error,
+		Metadata:  doc.Metadata,
 		CreatedAt: doc.CreatedAt.Time(),
 		UpdatedAt: doc.UpdatedAt.Time(),
 	}
@@ -200,6 +203,7 @@ func (r *jobRepository) Update(ctx context.Context, job *entities.Job) error {
 			"status":       doc.Status,
 			"draft_ids":    doc.DraftIDs,
 			"error":        doc.Error,
+			"metadata":     doc.Metadata,
 			"updated_at":   doc.UpdatedAt,
 			"started_at":   doc.StartedAt,
 			"completed_at": doc.CompletedAt,
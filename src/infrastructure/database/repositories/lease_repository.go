@@ -0,0 +1,78 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// leaseRepository implements LeaseRepository for MongoDB
+type leaseRepository struct {
+	*database.BaseRepository
+	collection *mongo.Collection
+}
+
+// NewLeaseRepository creates a new MongoDB lease repository
+func NewLeaseRepository(collection *mongo.Collection) interfaces.LeaseRepository {
+	return &leaseRepository{
+		BaseRepository: database.NewBaseRepository(collection),
+		collection:     collection,
+	}
+}
+
+// Acquire takes the lease when it is free or expired, or renews it for its current holder.
+// The lease document is keyed by name, so a concurrent acquirer that loses the race hits
+// a duplicate key on upsert and is reported as not acquired.
+func (r *leaseRepository) Acquire(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	if name == "" || holder == "" {
+		return false, database.ErrInvalidEntity
+	}
+
+	now := time.Now()
+	filter := bson.M{
+		"_id": name,
+		"$or": bson.A{
+			bson.M{"holder": holder},
+			bson.M{"expires_at": bson.M{"$lte": primitive.NewDateTimeFromTime(now)}},
+		},
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"holder":      holder,
+			"acquired_at": primitive.NewDateTimeFromTime(now),
+			"expires_at":  primitive.NewDateTimeFromTime(now.Add(ttl)),
+		},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to acquire lease: %w", err)
+	}
+
+	return true, nil
+}
+
+// Release deletes the lease if holder still owns it
+func (r *leaseRepository) Release(ctx context.Context, name, holder string) error {
+	if name == "" || holder == "" {
+		return database.ErrInvalidEntity
+	}
+
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": name, "holder": holder})
+	if err != nil {
+		return fmt.Errorf("failed to release lease: %w", err)
+	}
+
+	return nil
+}
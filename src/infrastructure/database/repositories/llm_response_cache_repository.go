@@ -0,0 +1,85 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// llmResponseCacheRepository implements LLMResponseCache for MongoDB
+type llmResponseCacheRepository struct {
+	*database.BaseRepository
+	collection *mongo.Collection
+	ttl        time.Duration
+}
+
+// NewLLMResponseCacheRepository creates a new MongoDB LLM response cache whose entries expire after ttl
+func NewLLMResponseCacheRepository(collection *mongo.Collection, ttl time.Duration) interfaces.LLMResponseCache {
+	return &llmResponseCacheRepository{
+		BaseRepository: database.NewBaseRepository(collection),
+		collection:     collection,
+		ttl:            ttl,
+	}
+}
+
+// llmResponseCacheDocument represents the MongoDB document structure of a cached response
+type llmResponseCacheDocument struct {
+	Key       string             `bson:"_id"`
+	Response  string             `bson:"response"`
+	CreatedAt primitive.DateTime `bson:"created_at"`
+	// ExpiresAt drives the TTL index; the monitor runs every minute, so Get filters on it too
+	ExpiresAt primitive.DateTime `bson:"expires_at"`
+}
+
+// Get returns the response cached under key while it hasn't expired
+func (r *llmResponseCacheRepository) Get(ctx context.Context, key string) (string, bool, error) {
+	if key == "" {
+		return "", false, database.ErrInvalidEntity
+	}
+
+	filter := bson.M{
+		"_id":        key,
+		"expires_at": bson.M{"$gt": primitive.NewDateTimeFromTime(time.Now())},
+	}
+
+	var doc llmResponseCacheDocument
+	if err := r.collection.FindOne(ctx, filter).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("failed to find cached llm response: %w", err)
+	}
+
+	return doc.Response, true, nil
+}
+
+// Set caches response under key for the repository's TTL
+func (r *llmResponseCacheRepository) Set(ctx context.Context, key string, response string) error {
+	if key == "" {
+		return database.ErrInvalidEntity
+	}
+
+	now := time.Now()
+	update := bson.M{
+		"$set": bson.M{
+			"response":   response,
+			"created_at": primitive.NewDateTimeFromTime(now),
+			"expires_at": primitive.NewDateTimeFromTime(now.Add(r.ttl)),
+		},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": key}, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to cache llm response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// llmUsageRepository implements LLMUsageRepository for MongoDB
+type llmUsageRepository struct {
+	*database.BaseRepository
+	collection *mongo.Collection
+}
+
+// NewLLMUsageRepository creates a new MongoDB LLM usage repository
+func NewLLMUsageRepository(collection *mongo.Collection) interfaces.LLMUsageRepository {
+	return &llmUsageRepository{
+		BaseRepository: database.NewBaseRepository(collection),
+		collection:     collection,
+	}
+}
+
+// llmUsageDocument represents the MongoDB document structure for LLMUsage
+type llmUsageDocument struct {
+	ID               primitive.ObjectID `bson:"_id,omitempty"`
+	UserID           primitive.ObjectID `bson:"user_id"`
+	JobID            string             `bson:"job_id,omitempty"`
+	PromptName       string             `bson:"prompt_name,omitempty"`
+	Provider         string             `bson:"provider,omitempty"`
+	Model            string             `bson:"model"`
+	PromptTokens     int                `bson:"prompt_tokens"`
+	CompletionTokens int                `bson:"completion_tokens"`
+	LatencyMs        int64              `bson:"latency_ms"`
+	CreatedAt        primitive.DateTime `bson:"created_at"`
+}
+
+// llmUsageTotalsDocument is the result of the usage aggregation pipeline
+type llmUsageTotalsDocument struct {
+	ID struct {
+		Model      string `bson:"model"`
+		PromptName string `bson:"prompt_name"`
+	} `bson:"_id"`
+	Calls            int   `bson:"calls"`
+	PromptTokens     int   `bson:"prompt_tokens"`
+	CompletionTokens int   `bson:"completion_tokens"`
+	LatencyMs        int64 `bson:"latency_ms"`
+}
+
+// Create persists the usage of one LLM call
+func (r *llmUsageRepository) Create(ctx context.Context, usage *entities.LLMUsage) (string, error) {
+	if usage == nil {
+		return "", database.ErrInvalidEntity
+	}
+
+	if err := usage.Validate(); err != nil {
+		return "", fmt.Errorf("llm usage validation failed: %w", err)
+	}
+
+	userObjectID, err := primitive.ObjectIDFromHex(usage.UserID)
+	if err != nil {
+		return "", database.ErrInvalidID
+	}
+
+	doc := &llmUsageDocument{
+		UserID:           userObjectID,
+		JobID:            usage.JobID,
+		PromptName:       usage.PromptName,
+		Provider:         usage.Provider,
+		Model:            usage.Model,
+		PromptTokens:     usage.PromptTokens,
+		CompletionTokens: usage.CompletionTokens,
+		LatencyMs:        usage.Latency.Milliseconds(),
+		CreatedAt:        primitive.NewDateTimeFromTime(usage.CreatedAt),
+	}
+
+	result, err := r.collection.InsertOne(ctx, doc)
+	if err != nil {
+		return "", fmt.Errorf("failed to insert llm usage: %w", err)
+	}
+
+	insertedID, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return "", fmt.Errorf("failed to convert inserted ID to ObjectID")
+	}
+
+	return insertedID.Hex(), nil
+}
+
+// Aggregate sums the calls matching the filter, grouped by model and prompt name
+func (r *llmUsageRepository) Aggregate(ctx context.Context, filter entities.LLMUsageFilter) ([]*entities.LLMUsageTotals, error) {
+	userObjectID, err := primitive.ObjectIDFromHex(filter.UserID)
+	if err != nil {
+		return nil, database.ErrInvalidID
+	}
+
+	match := bson.M{
+		"user_id": userObjectID,
+		"created_at": bson.M{
+			"$gte": primitive.NewDateTimeFromTime(filter.From),
+			"$lt":  primitive.NewDateTimeFromTime(filter.To),
+		},
+	}
+	if filter.JobID != "" {
+		match["job_id"] = filter.JobID
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{
+			"_id":               bson.M{"model": "$model", "prompt_name": "$prompt_name"},
+			"calls":             bson.M{"$sum": 1},
+			"prompt_tokens":     bson.M{"$sum": "$prompt_tokens"},
+			"completion_tokens": bson.M{"$sum": "$completion_tokens"},
+			"latency_ms":        bson.M{"$sum": "$latency_ms"},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id.model", Value: 1}, {Key: "_id.prompt_name", Value: 1}}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate llm usage: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []llmUsageTotalsDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode llm usage totals: %w", err)
+	}
+
+	totals := make([]*entities.LLMUsageTotals, 0, len(docs))
+	for _, doc := range docs {
+		totals = append(totals, &entities.LLMUsageTotals{
+			Model:            doc.ID.Model,
+			PromptName:       doc.ID.PromptName,
+			Calls:            doc.Calls,
+			PromptTokens:     doc.PromptTokens,
+			CompletionTokens: doc.CompletionTokens,
+			TotalLatency:     time.Duration(doc.LatencyMs) * time.Millisecond,
+		})
+	}
+
+	return totals, nil
+}
@@ -0,0 +1,90 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// oauthStateRepository implements OAuthStateRepository for MongoDB
+type oauthStateRepository struct {
+	*database.BaseRepository
+	collection *mongo.Collection
+}
+
+// NewOAuthStateRepository creates a new MongoDB OAuth state repository
+func NewOAuthStateRepository(collection *mongo.Collection) interfaces.OAuthStateRepository {
+	return &oauthStateRepository{
+		BaseRepository: database.NewBaseRepository(collection),
+		collection:     collection,
+	}
+}
+
+// oauthStateDocument represents the Mongo document
+type oauthStateDocument struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	State        string             `bson:"state"`
+	UserID       primitive.ObjectID `bson:"user_id"`
+	CodeVerifier string             `bson:"code_verifier"`
+	CreatedAt    primitive.DateTime `bson:"created_at"`
+	ExpiresAt    primitive.DateTime `bson:"expires_at"`
+}
+
+// Create persists a pending authorization request
+func (r *oauthStateRepository) Create(ctx context.Context, state *entities.OAuthState) error {
+	if err := state.Validate(); err != nil {
+		return err
+	}
+
+	userObjectID, err := primitive.ObjectIDFromHex(state.UserID)
+	if err != nil {
+		return database.ErrInvalidID
+	}
+
+	doc := &oauthStateDocument{
+		State:        state.State,
+		UserID:       userObjectID,
+		CodeVerifier: state.CodeVerifier,
+		CreatedAt:    primitive.NewDateTimeFromTime(state.CreatedAt),
+		ExpiresAt:    primitive.NewDateTimeFromTime(state.ExpiresAt),
+	}
+
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return database.ErrEntityAlreadyExists
+		}
+		return fmt.Errorf("failed to insert oauth state: %w", err)
+	}
+
+	return nil
+}
+
+// Consume retrieves and deletes the authorization request in a single operation
+func (r *oauthStateRepository) Consume(ctx context.Context, state string) (*entities.OAuthState, error) {
+	if state == "" {
+		return nil, database.ErrEntityNotFound
+	}
+
+	var doc oauthStateDocument
+	err := r.collection.FindOneAndDelete(ctx, bson.M{"state": state}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, database.ErrEntityNotFound
+		}
+		return nil, fmt.Errorf("failed to consume oauth state: %w", err)
+	}
+
+	return &entities.OAuthState{
+		State:        doc.State,
+		UserID:       doc.UserID.Hex(),
+		CodeVerifier: doc.CodeVerifier,
+		CreatedAt:    doc.CreatedAt.Time(),
+		ExpiresAt:    doc.ExpiresAt.Time(),
+	}, nil
+}
@@ -3,10 +3,17 @@
 // following the Clean Architecture principle of dependency inversion.
 //
 // Repository Implementations:
-// - UserRepository: Manages user persistence and authentication tokens
+// - UserRepository: Manages user persistence and encrypted authentication tokens
 // - TopicRepository: Handles user topics for content generation
 // - IdeasRepository: Manages the idea backlog with batch operations
 // - DraftRepository: Persists drafts with refinement history and status tracking
+// - OAuthStateRepository: Stores single-use OAuth states and PKCE verifiers
+// - ScheduleRepository: Persists per-user cron schedules and their next runs
+// - LeaseRepository: Named distributed leases shared by all replicas
+// - PromptVersionsRepository: Keeps the immutable version history of prompts
+// - VersionedPromptsRepository: Records a prompt version whenever a prompt's content changes
+// - ExperimentRepository: Persists prompt A/B experiments and their arm weights
+// - LLMResponseCacheRepository: LLM responses kept for retries until they expire
 //
 // All repositories implement their corresponding interfaces defined in domain/interfaces
 // and use the BaseRepository from infrastructure/database for common CRUD operations.
@@ -0,0 +1,134 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// promptVersionsRepository implements PromptVersionsRepository for MongoDB
+type promptVersionsRepository struct {
+	*database.BaseRepository
+	collection *mongo.Collection
+}
+
+// NewPromptVersionsRepository creates a new MongoDB prompt versions repository
+func NewPromptVersionsRepository(collection *mongo.Collection) interfaces.PromptVersionsRepository {
+	return &promptVersionsRepository{
+		BaseRepository: database.NewBaseRepository(collection),
+		collection:     collection,
+	}
+}
+
+// promptVersionDocument represents the MongoDB document structure for PromptVersion
+type promptVersionDocument struct {
+	ID             primitive.ObjectID  `bson:"_id,omitempty"`
+	PromptID       string              `bson:"prompt_id"`
+	UserID         string              `bson:"user_id"`
+	Version        int                 `bson:"version"`
+	Type           string              `bson:"type"`
+	Name           string              `bson:"name"`
+	PromptTemplate string              `bson:"prompt_template"`
+	SystemMessage  string              `bson:"system_message,omitempty"`
+	Parameters     promptParametersDoc `bson:"parameters,omitempty"`
+	CreatedAt      time.Time           `bson:"created_at"`
+}
+
+// toEntity converts a MongoDB document to a PromptVersion entity
+func (r *promptVersionsRepository) toEntity(doc *promptVersionDocument) *entities.PromptVersion {
+	return &entities.PromptVersion{
+		ID:             doc.ID.Hex(),
+		PromptID:       doc.PromptID,
+		UserID:         doc.UserID,
+		Version:        doc.Version,
+		Type:           entities.PromptType(doc.Type),
+		Name:           doc.Name,
+		PromptTemplate: doc.PromptTemplate,
+		SystemMessage:  doc.SystemMessage,
+		Parameters:     entities.PromptParameters(doc.Parameters),
+		CreatedAt:      doc.CreatedAt,
+	}
+}
+
+// Create implements PromptVersionsRepository.Create
+func (r *promptVersionsRepository) Create(ctx context.Context, version *entities.PromptVersion) (string, error) {
+	if err := version.Validate(); err != nil {
+		return "", fmt.Errorf("prompt version validation failed: %w", err)
+	}
+
+	doc := &promptVersionDocument{
+		PromptID:       version.PromptID,
+		UserID:         version.UserID,
+		Version:        version.Version,
+		Type:           string(version.Type),
+		Name:           version.Name,
+		PromptTemplate: version.PromptTemplate,
+		SystemMessage:  version.SystemMessage,
+		Parameters:     promptParametersDoc(version.Parameters),
+		CreatedAt:      version.CreatedAt,
+	}
+
+	result, err := r.collection.InsertOne(ctx, doc)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return "", fmt.Errorf("prompt %s already has version %d: %w", version.PromptID, version.Version, database.ErrEntityAlreadyExists)
+		}
+		return "", fmt.Errorf("failed to insert prompt version: %w", err)
+	}
+
+	oid, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return "", fmt.Errorf("failed to convert inserted ID to ObjectID")
+	}
+
+	return oid.Hex(), nil
+}
+
+// FindByPromptIDAndVersion implements PromptVersionsRepository.FindByPromptIDAndVersion
+func (r *promptVersionsRepository) FindByPromptIDAndVersion(ctx context.Context, promptID string, version int) (*entities.PromptVersion, error) {
+	var doc promptVersionDocument
+	err := r.collection.FindOne(ctx, bson.M{"prompt_id": promptID, "version": version}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find prompt version: %w", err)
+	}
+
+	return r.toEntity(&doc), nil
+}
+
+// ListByPromptID implements PromptVersionsRepository.ListByPromptID
+func (r *promptVersionsRepository) ListByPromptID(ctx context.Context, promptID string) ([]*entities.PromptVersion, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "version", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{"prompt_id": promptID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list prompt versions: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	versions := make([]*entities.PromptVersion, 0)
+	for cursor.Next(ctx) {
+		var doc promptVersionDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("failed to decode prompt version: %w", err)
+		}
+		versions = append(versions, r.toEntity(&doc))
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return versions, nil
+}
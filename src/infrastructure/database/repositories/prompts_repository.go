@@ -26,15 +26,26 @@ func NewPromptsRepository(collection *mongo.Collection) *PromptsRepository {
 
 // promptDocument represents the MongoDB document structure for Prompt
 type promptDocument struct {
-	ID             primitive.ObjectID `bson:"_id,omitempty"`
-	UserID         string             `bson:"user_id"`
-	Type           string             `bson:"type"`
-	Name           string             `bson:"name"`
-	StyleName      string             `bson:"style_name,omitempty"`
-	PromptTemplate string             `bson:"prompt_template"`
-	Active         bool               `bson:"active"`
-	CreatedAt      time.Time          `bson:"created_at"`
-	UpdatedAt      time.Time          `bson:"updated_at"`
+	ID             primitive.ObjectID  `bson:"_id,omitempty"`
+	UserID         string              `bson:"user_id"`
+	Type           string              `bson:"type"`
+	Name           string              `bson:"name"`
+	StyleName      string              `bson:"style_name,omitempty"`
+	PromptTemplate string              `bson:"prompt_template"`
+	SystemMessage  string              `bson:"system_message,omitempty"`
+	Parameters     promptParametersDoc `bson:"parameters,omitempty"`
+	Version        int                 `bson:"version,omitempty"`
+	Active         bool                `bson:"active"`
+	CreatedAt      time.Time           `bson:"created_at"`
+	UpdatedAt      time.Time           `bson:"updated_at"`
+}
+
+// promptParametersDoc represents the model parameters of a prompt document
+type promptParametersDoc struct {
+	Temperature   *float64 `bson:"temperature,omitempty"`
+	MaxTokens     int      `bson:"max_tokens,omitempty"`
+	Model         string   `bson:"model,omitempty"`
+	StopSequences []string `bson:"stop_sequences,omitempty"`
 }
 
 // toDocument converts a Prompt entity to a MongoDB document
@@ -58,6 +69,9 @@ func (r *PromptsRepository) toDocument(prompt *entities.Prompt) (*promptDocument
 		Name:           prompt.Name,
 		StyleName:      prompt.StyleName,
 		PromptTemplate: prompt.PromptTemplate,
+		SystemMessage:  prompt.SystemMessage,
+		Parameters:     promptParametersDoc(prompt.Parameters),
+		Version:        prompt.Version,
 		Active:         prompt.Active,
 		CreatedAt:      prompt.CreatedAt,
 		UpdatedAt:      prompt.UpdatedAt,
@@ -73,6 +87,9 @@ func (r *PromptsRepository) toEntity(doc *promptDocument) *entities.Prompt {
 		Name:           doc.Name,
 		StyleName:      doc.StyleName,
 		PromptTemplate: doc.PromptTemplate,
+		SystemMessage:  doc.SystemMessage,
+		Parameters:     entities.PromptParameters(doc.Parameters),
+		Version:        doc.Version,
 		Active:         doc.Active,
 		CreatedAt:      doc.CreatedAt,
 		UpdatedAt:      doc.UpdatedAt,
@@ -258,6 +275,9 @@ func (r *PromptsRepository) Update(ctx context.Context, prompt *entities.Prompt)
 			"type":            string(prompt.Type),
 			"style_name":      prompt.StyleName,
 			"prompt_template": prompt.PromptTemplate,
+			"system_message":  prompt.SystemMessage,
+			"parameters":      promptParametersDoc(prompt.Parameters),
+			"version":         prompt.Version,
 			"active":          prompt.Active,
 			"updated_at":      prompt.UpdatedAt,
 		},
@@ -464,6 +484,9 @@ func (r *PromptsRepository) Upsert(ctx context.Context, prompt *entities.Prompt)
 					"name":            prompt.Name,
 					"style_name":      prompt.StyleName,
 					"prompt_template": prompt.PromptTemplate,
+					"system_message":  prompt.SystemMessage,
+					"parameters":      promptParametersDoc(prompt.Parameters),
+					"version":         prompt.Version,
 					"active":          prompt.Active,
 					"updated_at":      prompt.UpdatedAt,
 				},
@@ -0,0 +1,340 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// scheduleRepository implements ScheduleRepository for MongoDB
+type scheduleRepository struct {
+	*database.BaseRepository
+	collection *mongo.Collection
+}
+
+// NewScheduleRepository creates a new MongoDB schedule repository
+func NewScheduleRepository(collection *mongo.Collection) interfaces.ScheduleRepository {
+	return &scheduleRepository{
+		BaseRepository: database.NewBaseRepository(collection),
+		collection:     collection,
+	}
+}
+
+// scheduleDocument represents the MongoDB document structure for Schedule
+type scheduleDocument struct {
+	ID             primitive.ObjectID  `bson:"_id,omitempty"`
+	UserID         primitive.ObjectID  `bson:"user_id"`
+	Name           string              `bson:"name"`
+	CronExpression string              `bson:"cron_expression"`
+	TimeZone       string              `bson:"time_zone"`
+	Actions        []string            `bson:"actions"`
+	Active         bool                `bson:"active"`
+	NextRunAt      *primitive.DateTime `bson:"next_run_at,omitempty"`
+	LastRunAt      *primitive.DateTime `bson:"last_run_at,omitempty"`
+	CreatedAt      primitive.DateTime  `bson:"created_at"`
+	UpdatedAt      primitive.DateTime  `bson:"updated_at"`
+}
+
+// toDocument converts a Schedule entity to a MongoDB document
+func (r *scheduleRepository) toDocument(schedule *entities.Schedule) (*scheduleDocument, error) {
+	var objectID primitive.ObjectID
+	if schedule.ID != "" {
+		id, err := primitive.ObjectIDFromHex(schedule.ID)
+		if err != nil {
+			return nil, database.ErrInvalidID
+		}
+		objectID = id
+	}
+
+	userObjectID, err := primitive.ObjectIDFromHex(schedule.UserID)
+	if err != nil {
+		return nil, database.ErrInvalidID
+	}
+
+	actions := make([]string, len(schedule.Actions))
+	for i, action := range schedule.Actions {
+		actions[i] = string(action)
+	}
+
+	doc := &scheduleDocument{
+		ID:             objectID,
+		UserID:         userObjectID,
+		Name:           schedule.Name,
+		CronExpression: schedule.CronExpression,
+		TimeZone:       schedule.TimeZone,
+		Actions:        actions,
+		Active:         schedule.Active,
+		CreatedAt:      primitive.NewDateTimeFromTime(schedule.CreatedAt),
+		UpdatedAt:      primitive.NewDateTimeFromTime(schedule.UpdatedAt),
+	}
+
+	if schedule.NextRunAt != nil {
+		nextRunAt := primitive.NewDateTimeFromTime(*schedule.NextRunAt)
+		doc.NextRunAt = &nextRunAt
+	}
+
+	if schedule.LastRunAt != nil {
+		lastRunAt := primitive.NewDateTimeFromTime(*schedule.LastRunAt)
+		doc.LastRunAt = &lastRunAt
+	}
+
+	return doc, nil
+}
+
+// toEntity converts a MongoDB document to a Schedule entity
+func (r *scheduleRepository) toEntity(doc *scheduleDocument) *entities.Schedule {
+	actions := make([]entities.ScheduleAction, len(doc.Actions))
+	for i, action := range doc.Actions {
+		actions[i] = entities.ScheduleAction(action)
+	}
+
+	schedule := &entities.Schedule{
+		ID:             doc.ID.Hex(),
+		UserID:         doc.UserID.Hex(),
+		Name:           doc.Name,
+		CronExpression: doc.CronExpression,
+		TimeZone:       doc.TimeZone,
+		Actions:        actions,
+		Active:         doc.Active,
+		CreatedAt:      doc.CreatedAt.Time(),
+		UpdatedAt:      doc.UpdatedAt.Time(),
+	}
+
+	if doc.NextRunAt != nil {
+		nextRunAt := doc.NextRunAt.Time().UTC()
+		schedule.NextRunAt = &nextRunAt
+	}
+
+	if doc.LastRunAt != nil {
+		lastRunAt := doc.LastRunAt.Time().UTC()
+		schedule.LastRunAt = &lastRunAt
+	}
+
+	return schedule
+}
+
+// Create creates a new schedule in the database
+func (r *scheduleRepository) Create(ctx context.Context, schedule *entities.Schedule) (string, error) {
+	if schedule == nil {
+		return "", database.ErrInvalidEntity
+	}
+
+	if err := schedule.Validate(); err != nil {
+		return "", fmt.Errorf("schedule validation failed: %w", err)
+	}
+
+	doc, err := r.toDocument(schedule)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := r.collection.InsertOne(ctx, doc)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return "", database.ErrEntityAlreadyExists
+		}
+		return "", fmt.Errorf("failed to create schedule: %w", err)
+	}
+
+	insertedID, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return "", fmt.Errorf("failed to convert inserted ID to ObjectID")
+	}
+
+	return insertedID.Hex(), nil
+}
+
+// FindByID retrieves a schedule by its ID
+func (r *scheduleRepository) FindByID(ctx context.Context, scheduleID string) (*entities.Schedule, error) {
+	if scheduleID == "" {
+		return nil, database.ErrInvalidID
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(scheduleID)
+	if err != nil {
+		return nil, database.ErrInvalidID
+	}
+
+	var doc scheduleDocument
+	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, database.ErrEntityNotFound
+		}
+		return nil, fmt.Errorf("failed to find schedule by ID: %w", err)
+	}
+
+	return r.toEntity(&doc), nil
+}
+
+// ListByUserID retrieves all schedules of a user, oldest first
+func (r *scheduleRepository) ListByUserID(ctx context.Context, userID string) ([]*entities.Schedule, error) {
+	if userID == "" {
+		return nil, database.ErrInvalidID
+	}
+
+	userObjectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, database.ErrInvalidID
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	return r.find(ctx, bson.M{"user_id": userObjectID}, opts)
+}
+
+// Update persists the editable fields of a schedule
+func (r *scheduleRepository) Update(ctx context.Context, schedule *entities.Schedule) error {
+	if schedule == nil {
+		return database.ErrInvalidEntity
+	}
+
+	if schedule.ID == "" {
+		return database.ErrInvalidID
+	}
+
+	if err := schedule.Validate(); err != nil {
+		return fmt.Errorf("schedule validation failed: %w", err)
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(schedule.ID)
+	if err != nil {
+		return database.ErrInvalidID
+	}
+
+	schedule.UpdatedAt = time.Now()
+
+	doc, err := r.toDocument(schedule)
+	if err != nil {
+		return err
+	}
+
+	set := bson.M{
+		"name":            doc.Name,
+		"cron_expression": doc.CronExpression,
+		"time_zone":       doc.TimeZone,
+		"actions":         doc.Actions,
+		"active":          doc.Active,
+		"updated_at":      doc.UpdatedAt,
+	}
+	update := bson.M{"$set": set}
+	if doc.NextRunAt != nil {
+		set["next_run_at"] = doc.NextRunAt
+	} else {
+		update["$unset"] = bson.M{"next_run_at": ""}
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	if err != nil {
+		return fmt.Errorf("failed to update schedule: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return database.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+// Delete removes a schedule from the database
+func (r *scheduleRepository) Delete(ctx context.Context, scheduleID string) error {
+	if scheduleID == "" {
+		return database.ErrInvalidID
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(scheduleID)
+	if err != nil {
+		return database.ErrInvalidID
+	}
+
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return fmt.Errorf("failed to delete schedule: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return database.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+// FindDue retrieves active schedules whose next run has passed, oldest first
+func (r *scheduleRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*entities.Schedule, error) {
+	filter := bson.M{
+		"active":      true,
+		"next_run_at": bson.M{"$lte": primitive.NewDateTimeFromTime(now)},
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "next_run_at", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	return r.find(ctx, filter, opts)
+}
+
+// MarkFired moves a due schedule to its next run if nobody else did first
+func (r *scheduleRepository) MarkFired(ctx context.Context, scheduleID string, expectedNextRun, firedAt, nextRun time.Time) (bool, error) {
+	if scheduleID == "" {
+		return false, database.ErrInvalidID
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(scheduleID)
+	if err != nil {
+		return false, database.ErrInvalidID
+	}
+
+	// Matching on the expected next run makes this a compare-and-set, so a
+	// schedule fires at most once per activation even across replicas
+	filter := bson.M{
+		"_id":         objectID,
+		"active":      true,
+		"next_run_at": primitive.NewDateTimeFromTime(expectedNextRun),
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"next_run_at": primitive.NewDateTimeFromTime(nextRun),
+			"last_run_at": primitive.NewDateTimeFromTime(firedAt),
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark schedule as fired: %w", err)
+	}
+
+	return result.ModifiedCount == 1, nil
+}
+
+// find runs a query and decodes all matching schedules
+func (r *scheduleRepository) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*entities.Schedule, error) {
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find schedules: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	schedules := make([]*entities.Schedule, 0)
+	for cursor.Next(ctx) {
+		var doc scheduleDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("failed to decode schedule document: %w", err)
+		}
+		schedules = append(schedules, r.toEntity(&doc))
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return schedules, nil
+}
@@ -352,6 +352,39 @@ func (r *topicRepository) FindByPrompt(ctx context.Context, userID string, promp
 	return topics, nil
 }
 
+// ListActive retrieves active topics of all users sorted by priority (descending)
+func (r *topicRepository) ListActive(ctx context.Context, limit int) ([]*entities.Topic, error) {
+	filter := bson.M{"active": true}
+	opts := options.Find().SetSort(bson.D{
+		{Key: "priority", Value: -1},
+		{Key: "created_at", Value: 1},
+	})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active topics: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var topics []*entities.Topic
+	for cursor.Next(ctx) {
+		var doc topicDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("failed to decode topic document: %w", err)
+		}
+		topics = append(topics, r.toEntity(&doc))
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return topics, nil
+}
+
 // FindByIdeasRange retrieves topics with ideas count in the specified range
 func (r *topicRepository) FindByIdeasRange(ctx context.Context, userID string, minIdeas, maxIdeas int) ([]*entities.Topic, error) {
 	userObjectID, err := primitive.ObjectIDFromHex(userID)
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -11,33 +12,61 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// TokenCipher encrypts LinkedIn tokens before they are stored
+type TokenCipher interface {
+	EncryptSecret(plaintext string) (string, error)
+	DecryptSecret(ciphertext string) (string, error)
+}
+
+// ErrTokenCipherRequired indicates a LinkedIn token was about to be stored without encryption
+var ErrTokenCipherRequired = errors.New("no LinkedIn token cipher configured, refusing to store the token unencrypted")
+
 // userRepository implements the UserRepository interface for MongoDB
 type userRepository struct {
 	*database.BaseRepository
-	collection *mongo.Collection
+	collection  *mongo.Collection
+	tokenCipher TokenCipher
 }
 
-// NewUserRepository creates a new MongoDB user repository
-func NewUserRepository(collection *mongo.Collection) interfaces.UserRepository {
+// NewUserRepository creates a new MongoDB user repository.
+// LinkedIn tokens are encrypted with tokenCipher; without one, storing a token fails with
+// ErrTokenCipherRequired and only tokens stored unencrypted before can be read.
+func NewUserRepository(collection *mongo.Collection, tokenCipher TokenCipher) interfaces.UserRepository {
 	return &userRepository{
 		BaseRepository: database.NewBaseRepository(collection),
 		collection:     collection,
+		tokenCipher:    tokenCipher,
 	}
 }
 
 // userDocument represents the MongoDB document structure for User
 type userDocument struct {
-	ID            primitive.ObjectID     `bson:"_id,omitempty"`
-	Email         string                 `bson:"email"`
-	Language      string                 `bson:"language,omitempty"`
-	LinkedInToken string                 `bson:"linkedin_token"`
-	APIKeys       map[string]string      `bson:"api_keys"`
-	Configuration map[string]interface{} `bson:"configuration"`
-	CreatedAt     primitive.DateTime     `bson:"created_at"`
-	UpdatedAt     primitive.DateTime     `bson:"updated_at"`
-	Active        bool                   `bson:"active"`
+	ID                     primitive.ObjectID     `bson:"_id,omitempty"`
+	Email                  string                 `bson:"email"`
+	Language               string                 `bson:"language,omitempty"`
+	LinkedInToken          string                 `bson:"linkedin_token"`
+	LinkedInRefreshToken   string                 `bson:"linkedin_refresh_token,omitempty"`
+	LinkedInTokenExpiresAt *primitive.DateTime    `bson:"linkedin_token_expires_at,omitempty"`
+	LinkedInTokenEncrypted bool                   `bson:"linkedin_token_encrypted,omitempty"`
+	LinkedInTokenInvalid   string                 `bson:"linkedin_token_invalid_reason,omitempty"`
+	LinkedInTokenInvalidAt *primitive.DateTime    `bson:"linkedin_token_invalid_at,omitempty"`
+	APIKeys                map[string]string      `bson:"api_keys"`
+	Configuration          map[string]interface{} `bson:"configuration"`
+	LLMQuota               *llmQuotaDocument      `bson:"llm_quota,omitempty"`
+	CreatedAt              primitive.DateTime     `bson:"created_at"`
+	UpdatedAt              primitive.DateTime     `bson:"updated_at"`
+	Active                 bool                   `bson:"active"`
+}
+
+// llmQuotaDocument represents the per-user LLM quota stored with the user
+type llmQuotaDocument struct {
+	DailyTokens     int64 `bson:"daily_tokens,omitempty"`
+	DailyRequests   int64 `bson:"daily_requests,omitempty"`
+	MonthlyTokens   int64 `bson:"monthly_tokens,omitempty"`
+	MonthlyRequests int64 `bson:"monthly_requests,omitempty"`
 }
 
 // toDocument converts a User entity to a MongoDB document
@@ -46,15 +75,48 @@ func (r *userRepository) toDocument(user *entities.User) (*userDocument, error)
 		return nil, fmt.Errorf("user cannot be nil")
 	}
 
+	accessToken, err := r.encryptToken(user.LinkedInToken)
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := r.encryptToken(user.LinkedInRefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
 	doc := &userDocument{
-		Email:         user.Email,
-		Language:      user.Language,
-		LinkedInToken: user.LinkedInToken,
-		APIKeys:       user.APIKeys,
-		Configuration: user.Configuration,
-		CreatedAt:     primitive.NewDateTimeFromTime(user.CreatedAt),
-		UpdatedAt:     primitive.NewDateTimeFromTime(user.UpdatedAt),
-		Active:        user.Active,
+		Email:                  user.Email,
+		Language:               user.Language,
+		LinkedInToken:          accessToken,
+		LinkedInRefreshToken:   refreshToken,
+		LinkedInTokenEncrypted: r.tokenCipher != nil,
+		APIKeys:                user.APIKeys,
+		Configuration:          user.Configuration,
+		CreatedAt:              primitive.NewDateTimeFromTime(user.CreatedAt),
+		UpdatedAt:              primitive.NewDateTimeFromTime(user.UpdatedAt),
+		Active:                 user.Active,
+	}
+
+	if user.LinkedInTokenExpiresAt != nil {
+		expiresAt := primitive.NewDateTimeFromTime(*user.LinkedInTokenExpiresAt)
+		doc.LinkedInTokenExpiresAt = &expiresAt
+	}
+
+	if user.LLMQuota != nil {
+		doc.LLMQuota = &llmQuotaDocument{
+			DailyTokens:     user.LLMQuota.DailyTokens,
+			DailyRequests:   user.LLMQuota.DailyRequests,
+			MonthlyTokens:   user.LLMQuota.MonthlyTokens,
+			MonthlyRequests: user.LLMQuota.MonthlyRequests,
+		}
+	}
+
+	if user.LinkedInTokenInvalidReason != "" {
+		doc.LinkedInTokenInvalid = user.LinkedInTokenInvalidReason
+		if user.LinkedInTokenInvalidAt != nil {
+			invalidAt := primitive.NewDateTimeFromTime(*user.LinkedInTokenInvalidAt)
+			doc.LinkedInTokenInvalidAt = &invalidAt
+		}
 	}
 
 	// Only set ID if it's valid
@@ -70,9 +132,9 @@ func (r *userRepository) toDocument(user *entities.User) (*userDocument, error)
 }
 
 // toEntity converts a MongoDB document to a User entity
-func (r *userRepository) toEntity(doc *userDocument) *entities.User {
+func (r *userRepository) toEntity(doc *userDocument) (*entities.User, error) {
 	if doc == nil {
-		return nil
+		return nil, nil
 	}
 
 	language := doc.Language
@@ -80,17 +142,87 @@ func (r *userRepository) toEntity(doc *userDocument) *entities.User {
 		language = entities.DefaultLanguage
 	}
 
-	return &entities.User{
-		ID:            doc.ID.Hex(),
-		Email:         doc.Email,
-		Language:      language,
-		LinkedInToken: doc.LinkedInToken,
-		APIKeys:       doc.APIKeys,
-		Configuration: doc.Configuration,
-		CreatedAt:     doc.CreatedAt.Time(),
-		UpdatedAt:     doc.UpdatedAt.Time(),
-		Active:        doc.Active,
+	user := &entities.User{
+		ID:                         doc.ID.Hex(),
+		Email:                      doc.Email,
+		Language:                   language,
+		LinkedInToken:              doc.LinkedInToken,
+		LinkedInRefreshToken:       doc.LinkedInRefreshToken,
+		LinkedInTokenInvalidReason: doc.LinkedInTokenInvalid,
+		APIKeys:                    doc.APIKeys,
+		Configuration:              doc.Configuration,
+		CreatedAt:                  doc.CreatedAt.Time(),
+		UpdatedAt:                  doc.UpdatedAt.Time(),
+		Active:                     doc.Active,
+	}
+
+	// Tokens stored before encryption was enabled are kept in plain text
+	if doc.LinkedInTokenEncrypted {
+		var err error
+		if user.LinkedInToken, err = r.decryptToken(doc.LinkedInToken); err != nil {
+			return nil, err
+		}
+		if user.LinkedInRefreshToken, err = r.decryptToken(doc.LinkedInRefreshToken); err != nil {
+			return nil, err
+		}
+	}
+
+	if doc.LinkedInTokenExpiresAt != nil {
+		expiresAt := doc.LinkedInTokenExpiresAt.Time().UTC()
+		user.LinkedInTokenExpiresAt = &expiresAt
+	}
+
+	if doc.LLMQuota != nil {
+		user.LLMQuota = &entities.LLMQuota{
+			DailyTokens:     doc.LLMQuota.DailyTokens,
+			DailyRequests:   doc.LLMQuota.DailyRequests,
+			MonthlyTokens:   doc.LLMQuota.MonthlyTokens,
+			MonthlyRequests: doc.LLMQuota.MonthlyRequests,
+		}
+	}
+
+	if doc.LinkedInTokenInvalidAt != nil {
+		invalidAt := doc.LinkedInTokenInvalidAt.Time()
+		user.LinkedInTokenInvalidAt = &invalidAt
 	}
+
+	return user, nil
+}
+
+// encryptToken encrypts a LinkedIn token; tokens are never stored in plain text
+func (r *userRepository) encryptToken(token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+
+	if r.tokenCipher == nil {
+		return "", ErrTokenCipherRequired
+	}
+
+	encrypted, err := r.tokenCipher.EncryptSecret(token)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt LinkedIn token: %w", err)
+	}
+
+	return encrypted, nil
+}
+
+// decryptToken decrypts a stored LinkedIn token
+func (r *userRepository) decryptToken(token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+
+	if r.tokenCipher == nil {
+		return "", fmt.Errorf("LinkedIn token is encrypted but no token cipher is configured")
+	}
+
+	decrypted, err := r.tokenCipher.DecryptSecret(token)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt LinkedIn token: %w", err)
+	}
+
+	return decrypted, nil
 }
 
 // Create creates a new user in the database
@@ -148,7 +280,7 @@ func (r *userRepository) FindByID(ctx context.Context, userID string) (*entities
 		return nil, fmt.Errorf("failed to find user by ID: %w", err)
 	}
 
-	return r.toEntity(&doc), nil
+	return r.toEntity(&doc)
 }
 
 // FindByEmail retrieves a user by their email address
@@ -168,7 +300,7 @@ func (r *userRepository) FindByEmail(ctx context.Context, email string) (*entiti
 		return nil, fmt.Errorf("failed to find user by email: %w", err)
 	}
 
-	return r.toEntity(&doc), nil
+	return r.toEntity(&doc)
 }
 
 // Update updates user information
@@ -214,13 +346,102 @@ func (r *userRepository) UpdateLinkedInToken(ctx context.Context, userID string,
 		return fmt.Errorf("LinkedIn token cannot be empty")
 	}
 
+	encrypted, err := r.encryptToken(token)
+	if err != nil {
+		return err
+	}
+
 	updates := map[string]interface{}{
-		"linkedin_token": token,
+		"linkedin_token":           encrypted,
+		"linkedin_token_encrypted": r.tokenCipher != nil,
 	}
 
 	return r.Update(ctx, userID, updates)
 }
 
+// UpdateLinkedInCredentials persists the user's LinkedIn tokens and expiry
+func (r *userRepository) UpdateLinkedInCredentials(ctx context.Context, user *entities.User) error {
+	if user == nil {
+		return database.ErrInvalidEntity
+	}
+
+	if user.ID == "" {
+		return database.ErrInvalidID
+	}
+
+	if user.LinkedInToken == "" {
+		return fmt.Errorf("LinkedIn token cannot be empty")
+	}
+
+	accessToken, err := r.encryptToken(user.LinkedInToken)
+	if err != nil {
+		return err
+	}
+	refreshToken, err := r.encryptToken(user.LinkedInRefreshToken)
+	if err != nil {
+		return err
+	}
+
+	updates := map[string]interface{}{
+		"linkedin_token":                accessToken,
+		"linkedin_refresh_token":        refreshToken,
+		"linkedin_token_encrypted":      r.tokenCipher != nil,
+		"linkedin_token_expires_at":     nil,
+		"linkedin_token_invalid_reason": user.LinkedInTokenInvalidReason,
+		"linkedin_token_invalid_at":     nil,
+	}
+	if user.LinkedInTokenExpiresAt != nil {
+		updates["linkedin_token_expires_at"] = primitive.NewDateTimeFromTime(*user.LinkedInTokenExpiresAt)
+	}
+	if user.LinkedInTokenInvalidAt != nil {
+		updates["linkedin_token_invalid_at"] = primitive.NewDateTimeFromTime(*user.LinkedInTokenInvalidAt)
+	}
+
+	return r.Update(ctx, user.ID, updates)
+}
+
+// FindWithExpiringLinkedInToken retrieves active users whose LinkedIn token expires before the given time
+func (r *userRepository) FindWithExpiringLinkedInToken(ctx context.Context, before time.Time, limit int) ([]*entities.User, error) {
+	if limit <= 0 {
+		return []*entities.User{}, nil
+	}
+
+	filter := bson.M{
+		"active":                        true,
+		"linkedin_token_expires_at":     bson.M{"$lte": primitive.NewDateTimeFromTime(before)},
+		"linkedin_token_invalid_reason": bson.M{"$in": bson.A{nil, ""}},
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "linkedin_token_expires_at", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find users with expiring LinkedIn tokens: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	users := make([]*entities.User, 0)
+	for cursor.Next(ctx) {
+		var doc userDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("failed to decode user: %w", err)
+		}
+
+		user, err := r.toEntity(&doc)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return users, nil
+}
+
 // Delete removes a user from the database
 func (r *userRepository) Delete(ctx context.Context, userID string) error {
 	if userID == "" {
@@ -0,0 +1,130 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+)
+
+// versionedPromptsRepository is a PromptsRepository that records a PromptVersion whenever a
+// prompt is created or its content changes, so that updates never lose a template
+type versionedPromptsRepository struct {
+	interfaces.PromptsRepository
+	versions interfaces.PromptVersionsRepository
+}
+
+// NewVersionedPromptsRepository wraps prompts so that its writes keep the version history in versions.
+//
+// Prompts saved before versioning have version 0; their content is recorded as version 1 the
+// first time it changes.
+func NewVersionedPromptsRepository(prompts interfaces.PromptsRepository, versions interfaces.PromptVersionsRepository) interfaces.PromptsRepository {
+	return &versionedPromptsRepository{
+		PromptsRepository: prompts,
+		versions:          versions,
+	}
+}
+
+// Create implements PromptsRepository.Create, recording version 1
+func (r *versionedPromptsRepository) Create(ctx context.Context, prompt *entities.Prompt) (string, error) {
+	prompt.Version = 1
+	id, err := r.PromptsRepository.Create(ctx, prompt)
+	if err != nil {
+		return "", err
+	}
+
+	prompt.ID = id
+	if err := r.record(ctx, prompt); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// CreateBatch implements PromptsRepository.CreateBatch, recording version 1 of each prompt
+func (r *versionedPromptsRepository) CreateBatch(ctx context.Context, prompts []*entities.Prompt) ([]string, error) {
+	for _, prompt := range prompts {
+		prompt.Version = 1
+	}
+
+	ids, err := r.PromptsRepository.CreateBatch(ctx, prompts)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, id := range ids {
+		prompts[i].ID = id
+		if err := r.record(ctx, prompts[i]); err != nil {
+			return ids, err
+		}
+	}
+	return ids, nil
+}
+
+// Update implements PromptsRepository.Update, recording a new version when the content changed
+func (r *versionedPromptsRepository) Update(ctx context.Context, prompt *entities.Prompt) error {
+	_, err := r.save(ctx, prompt, func() (string, error) {
+		return prompt.ID, r.PromptsRepository.Update(ctx, prompt)
+	})
+	return err
+}
+
+// Upsert implements PromptsRepository.Upsert, recording a new version when the content changed
+func (r *versionedPromptsRepository) Upsert(ctx context.Context, prompt *entities.Prompt) (string, error) {
+	if prompt == nil {
+		return "", fmt.Errorf("prompt cannot be nil")
+	}
+
+	return r.save(ctx, prompt, func() (string, error) {
+		return r.PromptsRepository.Upsert(ctx, prompt)
+	})
+}
+
+// save numbers the version of prompt, writes it with write and records the version if it's new
+func (r *versionedPromptsRepository) save(ctx context.Context, prompt *entities.Prompt, write func() (string, error)) (string, error) {
+	var current *entities.Prompt
+	if prompt.ID != "" {
+		var err error
+		if current, err = r.FindByID(ctx, prompt.ID); err != nil {
+			return "", err
+		}
+	}
+
+	changed := prompt.ChangesContent(current)
+	switch {
+	case current == nil:
+		prompt.Version = 1
+	case !changed:
+		prompt.Version = current.Version
+	case current.Version == 0:
+		// Keep the content saved before versioning as version 1
+		current.Version = 1
+		if err := r.record(ctx, current); err != nil {
+			return "", err
+		}
+		prompt.Version = 2
+	default:
+		prompt.Version = current.Version + 1
+	}
+
+	id, err := write()
+	if err != nil {
+		return "", err
+	}
+
+	if changed {
+		prompt.ID = id
+		if err := r.record(ctx, prompt); err != nil {
+			return id, err
+		}
+	}
+	return id, nil
+}
+
+// record saves the current content of prompt as its version
+func (r *versionedPromptsRepository) record(ctx context.Context, prompt *entities.Prompt) error {
+	if _, err := r.versions.Create(ctx, entities.NewPromptVersion(prompt)); err != nil {
+		return fmt.Errorf("failed to record version %d of prompt %s: %w", prompt.Version, prompt.ID, err)
+	}
+	return nil
+}
@@ -0,0 +1,606 @@
+package linkedin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm"
+)
+
+const (
+	// DefaultAPIURL is the base URL of the LinkedIn REST API
+	DefaultAPIURL = "https://api.linkedin.com/v2"
+	// DefaultTokenURL is the LinkedIn OAuth2 token endpoint
+	DefaultTokenURL = "https://www.linkedin.com/oauth/v2/accessToken"
+	// DefaultAuthURL is the LinkedIn OAuth2 authorization endpoint
+	DefaultAuthURL = "https://www.linkedin.com/oauth/v2/authorization"
+
+	restliProtocolVersion = "2.0.0"
+	maxErrorBodySize      = 64 * 1024
+)
+
+// DefaultScopes are the OAuth2 scopes needed to resolve the member and publish on their behalf
+var DefaultScopes = []string{"r_liteprofile", "w_member_social"}
+
+// LinkedInAPIClient implements the LinkedInService and LinkedInAuthService interfaces using HTTP
+type LinkedInAPIClient struct {
+	apiURL       string
+	tokenURL     string
+	authURL      string
+	clientID     string
+	clientSecret string
+	redirectURI  string
+	scopes       []string
+	httpClient   *http.Client
+	retryConfig  llm.RetryConfig
+	limiter      *rateLimiter
+}
+
+// Config holds configuration for the LinkedIn HTTP client
+type Config struct {
+	APIURL          string
+	TokenURL        string
+	AuthURL         string
+	ClientID        string
+	ClientSecret    string
+	RedirectURI     string
+	Scopes          []string
+	Timeout         time.Duration
+	MaxRetries      int
+	RateLimit       int
+	RateLimitWindow time.Duration
+}
+
+// ugcPostRequest represents the body of a UGC Posts API request
+type ugcPostRequest struct {
+	Author          string                 `json:"author"`
+	LifecycleState  string                 `json:"lifecycleState"`
+	SpecificContent ugcSpecificContent     `json:"specificContent"`
+	Visibility      map[string]interface{} `json:"visibility"`
+}
+
+// ugcSpecificContent wraps the share content of a UGC post
+type ugcSpecificContent struct {
+	ShareContent ugcShareContent `json:"com.linkedin.ugc.ShareContent"`
+}
+
+// ugcShareContent represents the commentary and media of a UGC post
+type ugcShareContent struct {
+	ShareCommentary    ugcText `json:"shareCommentary"`
+	ShareMediaCategory string  `json:"shareMediaCategory"`
+}
+
+// ugcText represents a text attribute in LinkedIn payloads
+type ugcText struct {
+	Text string `json:"text"`
+}
+
+// articleRequest represents the body of an Articles API request
+type articleRequest struct {
+	Author         string                 `json:"author"`
+	LifecycleState string                 `json:"lifecycleState"`
+	Title          string                 `json:"title"`
+	Content        ugcText                `json:"content"`
+	Visibility     map[string]interface{} `json:"visibility"`
+}
+
+// publishResponse represents the body returned after a successful publish
+type publishResponse struct {
+	ID string `json:"id"`
+}
+
+// profileResponse represents the authenticated member profile
+type profileResponse struct {
+	ID string `json:"id"`
+}
+
+// apiErrorResponse represents an error body returned by LinkedIn
+type apiErrorResponse struct {
+	Message          string `json:"message"`
+	ServiceErrorCode int    `json:"serviceErrorCode"`
+	Status           int    `json:"status"`
+}
+
+// TokenResponse represents the OAuth2 token endpoint response
+type TokenResponse = interfaces.LinkedInTokenResponse
+
+// NewLinkedInAPIClient creates a new LinkedIn API client
+func NewLinkedInAPIClient(config Config) (*LinkedInAPIClient, error) {
+	if config.APIURL == "" {
+		config.APIURL = DefaultAPIURL
+	}
+	if config.TokenURL == "" {
+		config.TokenURL = DefaultTokenURL
+	}
+	if config.AuthURL == "" {
+		config.AuthURL = DefaultAuthURL
+	}
+	if len(config.Scopes) == 0 {
+		config.Scopes = DefaultScopes
+	}
+
+	if err := validateConfig(config); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	// Only transient failures are retried; 429 is surfaced to the caller
+	// so that publishing can be rescheduled instead of hammering the API
+	retryConfig := llm.DefaultRetryConfig()
+	retryConfig.RetryableStatus = map[int]bool{
+		http.StatusRequestTimeout:      true,
+		http.StatusInternalServerError: true,
+		http.StatusBadGateway:          true,
+		http.StatusServiceUnavailable:  true,
+		http.StatusGatewayTimeout:      true,
+	}
+	if config.MaxRetries > 0 {
+		retryConfig.MaxRetries = config.MaxRetries
+	}
+
+	return &LinkedInAPIClient{
+		apiURL:       strings.TrimSuffix(config.APIURL, "/"),
+		tokenURL:     config.TokenURL,
+		authURL:      config.AuthURL,
+		clientID:     config.ClientID,
+		clientSecret: config.ClientSecret,
+		redirectURI:  config.RedirectURI,
+		scopes:       config.Scopes,
+		httpClient: &http.Client{
+			Timeout: config.Timeout,
+		},
+		retryConfig: retryConfig,
+		limiter:     newRateLimiter(config.RateLimit, config.RateLimitWindow),
+	}, nil
+}
+
+// validateConfig validates the client configuration
+func validateConfig(config Config) error {
+	for name, raw := range map[string]string{"API URL": config.APIURL, "token URL": config.TokenURL, "auth URL": config.AuthURL} {
+		parsedURL, err := url.Parse(raw)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", name, err)
+		}
+		if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+			return fmt.Errorf("%s must use http or https scheme", name)
+		}
+	}
+
+	if config.Timeout <= 0 {
+		return fmt.Errorf("timeout must be greater than 0")
+	}
+
+	if config.MaxRetries < 0 {
+		return fmt.Errorf("max retries cannot be negative")
+	}
+
+	if config.RateLimit < 0 {
+		return fmt.Errorf("rate limit cannot be negative")
+	}
+
+	if config.RateLimit > 0 && config.RateLimitWindow <= 0 {
+		return fmt.Errorf("rate limit window must be greater than 0 when rate limit is set")
+	}
+
+	return nil
+}
+
+// SetRetryConfig overrides the retry behaviour of the client
+func (c *LinkedInAPIClient) SetRetryConfig(config llm.RetryConfig) {
+	c.retryConfig = config
+}
+
+// PublishPost implements LinkedInService.PublishPost
+func (c *LinkedInAPIClient) PublishPost(ctx context.Context, content string, accessToken string) (*interfaces.LinkedInPostResponse, error) {
+	if strings.TrimSpace(content) == "" {
+		return nil, domainErrors.NewValidationError("content", "post content cannot be empty")
+	}
+	if strings.TrimSpace(accessToken) == "" {
+		return nil, domainErrors.NewValidationError("access_token", "access token cannot be empty")
+	}
+
+	author, err := c.resolveAuthor(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	body := ugcPostRequest{
+		Author:         author,
+		LifecycleState: "PUBLISHED",
+		SpecificContent: ugcSpecificContent{
+			ShareContent: ugcShareContent{
+				ShareCommentary:    ugcText{Text: strings.TrimSpace(content)},
+				ShareMediaCategory: "NONE",
+			},
+		},
+		Visibility: publicVisibility(),
+	}
+
+	return c.publish(ctx, "publish_post", c.apiURL+"/ugcPosts", body, accessToken)
+}
+
+// PublishArticle implements LinkedInService.PublishArticle
+func (c *LinkedInAPIClient) PublishArticle(ctx context.Context, title string, content string, accessToken string) (*interfaces.LinkedInPostResponse, error) {
+	if strings.TrimSpace(title) == "" {
+		return nil, domainErrors.NewValidationError("title", "article title cannot be empty")
+	}
+	if strings.TrimSpace(content) == "" {
+		return nil, domainErrors.NewValidationError("content", "article content cannot be empty")
+	}
+	if strings.TrimSpace(accessToken) == "" {
+		return nil, domainErrors.NewValidationError("access_token", "access token cannot be empty")
+	}
+
+	author, err := c.resolveAuthor(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	body := articleRequest{
+		Author:         author,
+		LifecycleState: "PUBLISHED",
+		Title:          strings.TrimSpace(title),
+		Content:        ugcText{Text: strings.TrimSpace(content)},
+		Visibility:     publicVisibility(),
+	}
+
+	return c.publish(ctx, "publish_article", c.apiURL+"/articles", body, accessToken)
+}
+
+// ValidateToken implements LinkedInService.ValidateToken
+// A rejected token is reported as (false, nil); transport and server failures return an error
+func (c *LinkedInAPIClient) ValidateToken(ctx context.Context, accessToken string) (bool, error) {
+	if strings.TrimSpace(accessToken) == "" {
+		return false, nil
+	}
+
+	_, err := c.resolveAuthor(ctx, accessToken)
+	if err == nil {
+		return true, nil
+	}
+
+	var apiErr *domainErrors.LinkedInAPIError
+	if errors.As(err, &apiErr) && apiErr.Reason == domainErrors.LinkedInReasonTokenInvalid {
+		return false, nil
+	}
+
+	return false, err
+}
+
+// RefreshToken implements LinkedInService.RefreshToken
+func (c *LinkedInAPIClient) RefreshToken(ctx context.Context, refreshToken string) (string, error) {
+	tokens, err := c.RefreshTokenPair(ctx, refreshToken)
+	if err != nil {
+		return "", err
+	}
+	return tokens.AccessToken, nil
+}
+
+// RefreshTokenPair exchanges a refresh token for a new token pair, including expiry information
+func (c *LinkedInAPIClient) RefreshTokenPair(ctx context.Context, refreshToken string) (*TokenResponse, error) {
+	if strings.TrimSpace(refreshToken) == "" {
+		return nil, domainErrors.NewValidationError("refresh_token", "refresh token cannot be empty")
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("refresh_token", refreshToken)
+	form.Set("client_id", c.clientID)
+	form.Set("client_secret", c.clientSecret)
+
+	return c.requestToken(ctx, "refresh_token", form)
+}
+
+// AuthorizationURL implements LinkedInAuthService.AuthorizationURL using the PKCE S256 method
+func (c *LinkedInAPIClient) AuthorizationURL(state, codeChallenge string) (string, error) {
+	if c.clientID == "" || c.redirectURI == "" {
+		return "", domainErrors.NewValidationError("linkedin", "LinkedIn client ID and redirect URI must be configured")
+	}
+
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", c.clientID)
+	query.Set("redirect_uri", c.redirectURI)
+	query.Set("state", state)
+	query.Set("scope", strings.Join(c.scopes, " "))
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", "S256")
+
+	return c.authURL + "?" + query.Encode(), nil
+}
+
+// ExchangeAuthorizationCode implements LinkedInAuthService.ExchangeAuthorizationCode
+func (c *LinkedInAPIClient) ExchangeAuthorizationCode(ctx context.Context, code, codeVerifier string) (*TokenResponse, error) {
+	if strings.TrimSpace(code) == "" {
+		return nil, domainErrors.NewValidationError("code", "authorization code cannot be empty")
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", c.redirectURI)
+	form.Set("client_id", c.clientID)
+	form.Set("client_secret", c.clientSecret)
+	form.Set("code_verifier", codeVerifier)
+
+	return c.requestToken(ctx, "exchange_code", form)
+}
+
+// requestToken posts a form to the OAuth2 token endpoint and parses the token pair
+func (c *LinkedInAPIClient) requestToken(ctx context.Context, operation string, form url.Values) (*TokenResponse, error) {
+	encoded := form.Encode()
+
+	resp, err := c.do(ctx, operation, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.tokenURL, strings.NewReader(encoded))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token response body: %w", err)
+	}
+
+	var tokens TokenResponse
+	if err := json.Unmarshal(body, &tokens); err != nil {
+		return nil, domainErrors.NewLinkedInAPIError(operation, domainErrors.LinkedInReasonInvalidResponse, resp.StatusCode, "failed to parse token response", err)
+	}
+
+	if strings.TrimSpace(tokens.AccessToken) == "" {
+		return nil, domainErrors.NewLinkedInAPIError(operation, domainErrors.LinkedInReasonInvalidResponse, resp.StatusCode, "token response is missing access_token", nil)
+	}
+
+	return &tokens, nil
+}
+
+// resolveAuthor retrieves the member URN that owns the access token
+func (c *LinkedInAPIClient) resolveAuthor(ctx context.Context, accessToken string) (string, error) {
+	resp, err := c.do(ctx, "resolve_author", func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.apiURL+"/me", nil)
+		if err != nil {
+			return nil, err
+		}
+		setAPIHeaders(req, accessToken)
+		return req, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var profile profileResponse
+	if err := json.NewDecoder(resp.Body).Decode(&profile); err != nil {
+		return "", domainErrors.NewLinkedInAPIError("resolve_author", domainErrors.LinkedInReasonInvalidResponse, resp.StatusCode, "failed to parse profile response", err)
+	}
+
+	if strings.TrimSpace(profile.ID) == "" {
+		return "", domainErrors.NewLinkedInAPIError("resolve_author", domainErrors.LinkedInReasonInvalidResponse, resp.StatusCode, "profile response is missing id", nil)
+	}
+
+	return "urn:li:person:" + profile.ID, nil
+}
+
+// publish sends a JSON payload to a publishing endpoint and parses the created URN
+func (c *LinkedInAPIClient) publish(ctx context.Context, operation, endpoint string, payload interface{}, accessToken string) (*interfaces.LinkedInPostResponse, error) {
+	reqBody, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doOnce(ctx, operation, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(reqBody))
+		if err != nil {
+			return nil, err
+		}
+		setAPIHeaders(req, accessToken)
+		req.Header.Set("Content-Type", "application/json")
+		return req, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	return parsePublishResponse(operation, resp, body)
+}
+
+// parsePublishResponse extracts the created URN from the body or the X-RestLi-Id header
+func parsePublishResponse(operation string, resp *http.Response, body []byte) (*interfaces.LinkedInPostResponse, error) {
+	headerID := strings.TrimSpace(resp.Header.Get("X-RestLi-Id"))
+
+	var parsed publishResponse
+	if len(bytes.TrimSpace(body)) > 0 {
+		if err := json.Unmarshal(body, &parsed); err != nil && headerID == "" {
+			return nil, domainErrors.NewLinkedInAPIError(operation, domainErrors.LinkedInReasonInvalidResponse, resp.StatusCode, "failed to parse publish response", err)
+		}
+	}
+
+	id := strings.TrimSpace(parsed.ID)
+	if id == "" {
+		id = headerID
+	}
+
+	if id == "" {
+		return nil, domainErrors.NewLinkedInAPIError(operation, domainErrors.LinkedInReasonInvalidResponse, resp.StatusCode, "publish response is missing id", nil)
+	}
+
+	return &interfaces.LinkedInPostResponse{
+		ID:      id,
+		URN:     id,
+		Success: true,
+	}, nil
+}
+
+// do executes a request with rate limiting and retry, returning only 2xx responses.
+// Only idempotent requests (the profile lookup and the token endpoint) are sent through do
+func (c *LinkedInAPIClient) do(ctx context.Context, operation string, build func() (*http.Request, error)) (*http.Response, error) {
+	if err := c.allow(operation); err != nil {
+		return nil, err
+	}
+
+	var last *http.Response
+	lastStatus := 0
+
+	resp, err := llm.ExecuteWithRetry(ctx, c.retryConfig, func() (*http.Response, error) {
+		// Release the body of a response that is about to be retried
+		if last != nil {
+			last.Body.Close()
+			last = nil
+		}
+
+		req, err := build()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if resp != nil {
+			last = resp
+			lastStatus = resp.StatusCode
+		}
+		return resp, err
+	})
+
+	if err != nil {
+		if last != nil {
+			last.Body.Close()
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if lastStatus >= 500 {
+			return nil, domainErrors.NewLinkedInAPIError(operation, domainErrors.LinkedInReasonServerError, lastStatus, "", err)
+		}
+		return nil, fmt.Errorf("linkedin request failed (%s): %w", operation, err)
+	}
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+
+	defer resp.Body.Close()
+	return nil, mapErrorResponse(operation, resp)
+}
+
+// doOnce executes a request with rate limiting in a single attempt, returning only 2xx responses.
+// Publishing isn't idempotent: LinkedIn may have created the post when a request times out or
+// fails with a 5xx, so retrying could publish it twice and the error is returned as is
+func (c *LinkedInAPIClient) doOnce(ctx context.Context, operation string, build func() (*http.Request, error)) (*http.Response, error) {
+	if err := c.allow(operation); err != nil {
+		return nil, err
+	}
+
+	req, err := build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, fmt.Errorf("linkedin request failed (%s): %w", operation, err)
+	}
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+
+	defer resp.Body.Close()
+	return nil, mapErrorResponse(operation, resp)
+}
+
+// allow applies the client-side rate limit
+func (c *LinkedInAPIClient) allow(operation string) error {
+	if wait, ok := c.limiter.allow(time.Now()); !ok {
+		apiErr := domainErrors.NewLinkedInAPIError(operation, domainErrors.LinkedInReasonRateLimit, http.StatusTooManyRequests, "client-side rate limit reached", nil)
+		apiErr.RetryAfter = wait
+		return apiErr
+	}
+	return nil
+}
+
+// mapErrorResponse converts a non-2xx LinkedIn response into a domain error
+func mapErrorResponse(operation string, resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+
+	message := strings.TrimSpace(string(body))
+	var apiErr apiErrorResponse
+	if json.Unmarshal(body, &apiErr) == nil && apiErr.Message != "" {
+		message = apiErr.Message
+	}
+
+	var reason domainErrors.LinkedInErrorReason
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		reason = domainErrors.LinkedInReasonTokenInvalid
+	case resp.StatusCode == http.StatusTooManyRequests:
+		reason = domainErrors.LinkedInReasonRateLimit
+	case resp.StatusCode >= 500:
+		reason = domainErrors.LinkedInReasonServerError
+	default:
+		reason = domainErrors.LinkedInReasonBadRequest
+	}
+
+	result := domainErrors.NewLinkedInAPIError(operation, reason, resp.StatusCode, message, nil)
+	if reason == domainErrors.LinkedInReasonRateLimit {
+		result.RetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+	}
+
+	return result
+}
+
+// parseRetryAfter parses a Retry-After header expressed in seconds or as an HTTP date
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if at, err := http.ParseTime(value); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+
+	return 0
+}
+
+// setAPIHeaders sets the headers required by the LinkedIn REST API
+func setAPIHeaders(req *http.Request, accessToken string) {
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("X-Restli-Protocol-Version", restliProtocolVersion)
+	req.Header.Set("Accept", "application/json")
+}
+
+// publicVisibility returns the visibility block for publicly visible content
+func publicVisibility() map[string]interface{} {
+	return map[string]interface{}{
+		"com.linkedin.ugc.MemberNetworkVisibility": "PUBLIC",
+	}
+}
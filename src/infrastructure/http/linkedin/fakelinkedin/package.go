@@ -0,0 +1,10 @@
+// Package fakelinkedin provides an in-memory stand-in for the LinkedIn API used in
+// development and tests.
+// This package handles:
+// - Publishing posts and articles through the UGC Posts and Articles endpoints
+// - Resolving the member behind an access token (/me)
+// - OAuth2 authorization code (with PKCE) and refresh token grants
+// - Token introspection
+// - Fault injection: error statuses, 429s with Retry-After, and latency
+// - An httptest helper that wires the LinkedIn client to the fake
+package fakelinkedin
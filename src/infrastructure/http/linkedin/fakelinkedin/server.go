@@ -0,0 +1,752 @@
+package fakelinkedin
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/linkedin"
+)
+
+const (
+	// APIPrefix is the path prefix of the REST API endpoints
+	APIPrefix = "/v2"
+	// TokenPath is the path of the OAuth2 token endpoint
+	TokenPath = "/oauth/v2/accessToken"
+	// AuthPath is the path of the OAuth2 authorization endpoint
+	AuthPath = "/oauth/v2/authorization"
+	// IntrospectPath is the path of the OAuth2 token introspection endpoint
+	IntrospectPath = "/oauth/v2/introspectToken"
+	// AdminPrefix is the path prefix of the endpoints that control the fake itself
+	AdminPrefix = "/_fake"
+
+	// DefaultMemberID is the member that authorizes through the authorization endpoint
+	DefaultMemberID = "fake-member"
+
+	defaultAccessTokenTTL  = 60 * 24 * time.Hour
+	defaultRefreshTokenTTL = 365 * 24 * time.Hour
+	defaultScope           = "r_liteprofile w_member_social"
+
+	// maxCommentaryLength is the longest share commentary LinkedIn accepts
+	maxCommentaryLength = 3000
+
+	serviceErrorInvalidToken = 65600
+	serviceErrorExpiredToken = 65601
+)
+
+// Post types recorded by the fake
+const (
+	PostTypePost    = "POST"
+	PostTypeArticle = "ARTICLE"
+)
+
+// Post represents content published through the fake
+type Post struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	Author    string    `json:"author"`
+	Title     string    `json:"title,omitempty"`
+	Text      string    `json:"text"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Token represents an OAuth2 token pair known to the fake
+type Token struct {
+	AccessToken      string
+	RefreshToken     string
+	MemberID         string
+	Scope            string
+	ExpiresAt        time.Time
+	RefreshExpiresAt time.Time
+	Revoked          bool
+}
+
+// Fault makes matching requests misbehave
+type Fault struct {
+	// Path limits the fault to one endpoint, e.g. "/v2/ugcPosts"; empty matches every endpoint
+	Path string
+	// Status is the error status returned; zero only applies Latency
+	Status int
+	// RetryAfter is sent as the Retry-After header, rounded up to whole seconds
+	RetryAfter time.Duration
+	// Latency delays the response before anything else happens
+	Latency time.Duration
+	// Times is how many requests the fault applies to; zero keeps it until cleared
+	Times int
+}
+
+// Options holds configuration for the fake
+type Options struct {
+	// ClientID and ClientSecret, when set, must be presented to the token endpoint
+	ClientID     string
+	ClientSecret string
+	// RedirectURI, when set, is the only redirect URI authorization accepts
+	RedirectURI string
+	// MemberID is the member that authorizes through the authorization endpoint
+	MemberID        string
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+	// Latency is applied to every API request
+	Latency time.Duration
+	Now     func() time.Time
+}
+
+// authCode represents an issued authorization code awaiting exchange
+type authCode struct {
+	memberID      string
+	redirectURI   string
+	codeChallenge string
+}
+
+// Server is an in-memory LinkedIn API implementing http.Handler
+type Server struct {
+	router  *mux.Router
+	options Options
+	now     func() time.Time
+
+	mu            sync.Mutex
+	tokens        map[string]*Token
+	refreshTokens map[string]*Token
+	codes         map[string]authCode
+	posts         []Post
+	faults        []*Fault
+	latency       time.Duration
+	requests      map[string]int
+	nextID        int64
+}
+
+// New creates a new fake LinkedIn API
+func New(options Options) *Server {
+	if options.MemberID == "" {
+		options.MemberID = DefaultMemberID
+	}
+	if options.AccessTokenTTL <= 0 {
+		options.AccessTokenTTL = defaultAccessTokenTTL
+	}
+	if options.RefreshTokenTTL <= 0 {
+		options.RefreshTokenTTL = defaultRefreshTokenTTL
+	}
+
+	now := options.Now
+	if now == nil {
+		now = time.Now
+	}
+
+	s := &Server{
+		options:       options,
+		now:           now,
+		tokens:        make(map[string]*Token),
+		refreshTokens: make(map[string]*Token),
+		codes:         make(map[string]authCode),
+		latency:       options.Latency,
+		requests:      make(map[string]int),
+	}
+
+	router := mux.NewRouter()
+	router.HandleFunc(APIPrefix+"/me", s.handleMe).Methods(http.MethodGet)
+	router.HandleFunc(APIPrefix+"/ugcPosts", s.handleUGCPost).Methods(http.MethodPost)
+	router.HandleFunc(APIPrefix+"/articles", s.handleArticle).Methods(http.MethodPost)
+	router.HandleFunc(TokenPath, s.handleToken).Methods(http.MethodPost)
+	router.HandleFunc(AuthPath, s.handleAuthorize).Methods(http.MethodGet)
+	router.HandleFunc(IntrospectPath, s.handleIntrospect).Methods(http.MethodPost)
+	router.HandleFunc(AdminPrefix+"/posts", s.handleAdminPosts).Methods(http.MethodGet)
+	router.HandleFunc(AdminPrefix+"/tokens", s.handleAdminIssueToken).Methods(http.MethodPost)
+	router.HandleFunc(AdminPrefix+"/tokens/{token}", s.handleAdminRevokeToken).Methods(http.MethodDelete)
+	router.HandleFunc(AdminPrefix+"/faults", s.handleAdminInjectFault).Methods(http.MethodPost)
+	router.HandleFunc(AdminPrefix+"/faults", s.handleAdminClearFaults).Methods(http.MethodDelete)
+	router.HandleFunc(AdminPrefix+"/reset", s.handleAdminReset).Methods(http.MethodPost)
+	s.router = router
+
+	return s
+}
+
+// ServeHTTP applies latency and injected faults, then routes the request
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasPrefix(r.URL.Path, AdminPrefix) {
+		s.router.ServeHTTP(w, r)
+		return
+	}
+
+	fault, latency := s.takeFault(r.URL.Path)
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	if fault != nil && fault.Status != 0 {
+		if fault.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(fault.RetryAfter.Seconds()))))
+		}
+		writeAPIError(w, fault.Status, 0, "injected fault: "+http.StatusText(fault.Status))
+		return
+	}
+
+	s.router.ServeHTTP(w, r)
+}
+
+// IssueToken creates a new token pair for a member
+func (s *Server) IssueToken(memberID string) Token {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return *s.issueTokenLocked(memberID, "")
+}
+
+// AddToken registers a token pair with known values, e.g. a static development token
+func (s *Server) AddToken(token Token) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if token.MemberID == "" {
+		token.MemberID = s.options.MemberID
+	}
+	if token.Scope == "" {
+		token.Scope = defaultScope
+	}
+	if token.ExpiresAt.IsZero() {
+		token.ExpiresAt = now.Add(s.options.AccessTokenTTL)
+	}
+	if token.RefreshToken != "" && token.RefreshExpiresAt.IsZero() {
+		token.RefreshExpiresAt = now.Add(s.options.RefreshTokenTTL)
+	}
+
+	s.storeTokenLocked(&token)
+}
+
+// ExpireToken makes an access token expire immediately
+func (s *Server) ExpireToken(accessToken string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if token, ok := s.tokens[accessToken]; ok {
+		token.ExpiresAt = s.now().Add(-time.Second)
+	}
+}
+
+// RevokeToken revokes an access token; its refresh token stays usable
+func (s *Server) RevokeToken(accessToken string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if token, ok := s.tokens[accessToken]; ok {
+		token.Revoked = true
+	}
+}
+
+// InjectFault adds a fault; faults are matched in the order they were added
+func (s *Server) InjectFault(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults removes every injected fault
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// SetLatency sets the latency applied to every API request
+func (s *Server) SetLatency(latency time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = latency
+}
+
+// Posts returns the published posts and articles, oldest first
+func (s *Server) Posts() []Post {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Post(nil), s.posts...)
+}
+
+// Requests returns how many requests reached a path, including faulted ones
+func (s *Server) Requests(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[path]
+}
+
+// Reset clears posts, faults and request counts; tokens stay valid
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.posts = nil
+	s.faults = nil
+	s.requests = make(map[string]int)
+	s.latency = s.options.Latency
+}
+
+// takeFault counts the request and consumes the first fault matching the path
+func (s *Server) takeFault(path string) (*Fault, time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests[path]++
+
+	for i, fault := range s.faults {
+		if fault.Path != "" && fault.Path != path {
+			continue
+		}
+
+		matched := *fault
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return &matched, s.latency + matched.Latency
+	}
+
+	return nil, s.latency
+}
+
+// handleMe handles GET /v2/me
+func (s *Server) handleMe(w http.ResponseWriter, r *http.Request) {
+	token, ok := s.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{
+		"id":                 token.MemberID,
+		"localizedFirstName": "Fake",
+		"localizedLastName":  "Member",
+	})
+}
+
+// ugcPostBody is the subset of a UGC Posts request the fake inspects
+type ugcPostBody struct {
+	Author          string `json:"author"`
+	SpecificContent struct {
+		ShareContent struct {
+			ShareCommentary struct {
+				Text string `json:"text"`
+			} `json:"shareCommentary"`
+		} `json:"com.linkedin.ugc.ShareContent"`
+	} `json:"specificContent"`
+}
+
+// handleUGCPost handles POST /v2/ugcPosts
+func (s *Server) handleUGCPost(w http.ResponseWriter, r *http.Request) {
+	token, ok := s.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	var body ugcPostBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeAPIError(w, http.StatusBadRequest, 0, "invalid request body")
+		return
+	}
+
+	s.createPost(w, token, PostTypePost, "urn:li:share:", body.Author, "", body.SpecificContent.ShareContent.ShareCommentary.Text)
+}
+
+// articleBody is the subset of an Articles request the fake inspects
+type articleBody struct {
+	Author  string `json:"author"`
+	Title   string `json:"title"`
+	Content struct {
+		Text string `json:"text"`
+	} `json:"content"`
+}
+
+// handleArticle handles POST /v2/articles
+func (s *Server) handleArticle(w http.ResponseWriter, r *http.Request) {
+	token, ok := s.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	var body articleBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeAPIError(w, http.StatusBadRequest, 0, "invalid request body")
+		return
+	}
+
+	if strings.TrimSpace(body.Title) == "" {
+		writeAPIError(w, http.StatusUnprocessableEntity, 0, "/title :: field is required but not found")
+		return
+	}
+
+	s.createPost(w, token, PostTypeArticle, "urn:li:article:", body.Author, body.Title, body.Content.Text)
+}
+
+// createPost validates and stores published content, replying like LinkedIn does
+func (s *Server) createPost(w http.ResponseWriter, token Token, postType, urnPrefix, author, title, text string) {
+	if author != "urn:li:person:"+token.MemberID {
+		writeAPIError(w, http.StatusForbidden, 0, "author does not match the authenticated member")
+		return
+	}
+	if strings.TrimSpace(text) == "" {
+		writeAPIError(w, http.StatusUnprocessableEntity, 0, "text :: field is required but not found")
+		return
+	}
+	if len([]rune(text)) > maxCommentaryLength {
+		writeAPIError(w, http.StatusUnprocessableEntity, 0, fmt.Sprintf("text :: length exceeds %d characters", maxCommentaryLength))
+		return
+	}
+
+	s.mu.Lock()
+	s.nextID++
+	post := Post{
+		ID:        urnPrefix + strconv.FormatInt(s.nextID, 10),
+		Type:      postType,
+		Author:    author,
+		Title:     title,
+		Text:      text,
+		CreatedAt: s.now(),
+	}
+	s.posts = append(s.posts, post)
+	s.mu.Unlock()
+
+	w.Header().Set("X-RestLi-Id", post.ID)
+	writeJSON(w, http.StatusCreated, map[string]string{"id": post.ID})
+}
+
+// authenticate resolves the bearer token of an API request, replying 401 when it is not usable
+func (s *Server) authenticate(w http.ResponseWriter, r *http.Request) (Token, bool) {
+	accessToken, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !found || strings.TrimSpace(accessToken) == "" {
+		writeAPIError(w, http.StatusUnauthorized, serviceErrorInvalidToken, "Empty oauth2 access token")
+		return Token{}, false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token, ok := s.tokens[accessToken]
+	switch {
+	case !ok || token.Revoked:
+		writeAPIError(w, http.StatusUnauthorized, serviceErrorInvalidToken, "Invalid access token")
+		return Token{}, false
+	case !s.now().Before(token.ExpiresAt):
+		writeAPIError(w, http.StatusUnauthorized, serviceErrorExpiredToken, "The token used in the request has expired")
+		return Token{}, false
+	}
+
+	return *token, true
+}
+
+// handleToken handles POST /oauth/v2/accessToken
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_request", "malformed form body")
+		return
+	}
+
+	if s.options.ClientID != "" && (r.PostForm.Get("client_id") != s.options.ClientID || r.PostForm.Get("client_secret") != s.options.ClientSecret) {
+		writeOAuthError(w, http.StatusUnauthorized, "invalid_client", "client authentication failed")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var token *Token
+	switch r.PostForm.Get("grant_type") {
+	case "refresh_token":
+		existing, ok := s.refreshTokens[r.PostForm.Get("refresh_token")]
+		if !ok || !s.now().Before(existing.RefreshExpiresAt) {
+			writeOAuthError(w, http.StatusBadRequest, "invalid_grant", "the refresh token is invalid or expired")
+			return
+		}
+		// LinkedIn keeps the refresh token and its original expiry
+		token = s.issueTokenLocked(existing.MemberID, existing.RefreshToken)
+		token.RefreshExpiresAt = existing.RefreshExpiresAt
+
+	case "authorization_code":
+		code := r.PostForm.Get("code")
+		issued, ok := s.codes[code]
+		if !ok {
+			writeOAuthError(w, http.StatusBadRequest, "invalid_grant", "the authorization code is invalid or was already used")
+			return
+		}
+		delete(s.codes, code)
+
+		if issued.redirectURI != r.PostForm.Get("redirect_uri") {
+			writeOAuthError(w, http.StatusBadRequest, "invalid_grant", "redirect_uri does not match the authorization request")
+			return
+		}
+		if issued.codeChallenge != "" && issued.codeChallenge != pkceChallenge(r.PostForm.Get("code_verifier")) {
+			writeOAuthError(w, http.StatusBadRequest, "invalid_grant", "code_verifier does not match the code challenge")
+			return
+		}
+		token = s.issueTokenLocked(issued.memberID, "")
+
+	default:
+		writeOAuthError(w, http.StatusBadRequest, "unsupported_grant_type", "grant_type must be authorization_code or refresh_token")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.tokenResponseLocked(token))
+}
+
+// handleAuthorize handles GET /oauth/v2/authorization by consenting straight away
+func (s *Server) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	if query.Get("response_type") != "code" {
+		writeOAuthError(w, http.StatusBadRequest, "unsupported_response_type", "response_type must be code")
+		return
+	}
+	if s.options.ClientID != "" && query.Get("client_id") != s.options.ClientID {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_client", "unknown client_id")
+		return
+	}
+
+	redirectURI, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil || !redirectURI.IsAbs() {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_request", "redirect_uri must be an absolute URL")
+		return
+	}
+	if s.options.RedirectURI != "" && query.Get("redirect_uri") != s.options.RedirectURI {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_request", "redirect_uri is not registered")
+		return
+	}
+
+	code := randomString()
+	s.mu.Lock()
+	s.codes[code] = authCode{
+		memberID:      s.options.MemberID,
+		redirectURI:   query.Get("redirect_uri"),
+		codeChallenge: query.Get("code_challenge"),
+	}
+	s.mu.Unlock()
+
+	callback := redirectURI.Query()
+	callback.Set("code", code)
+	callback.Set("state", query.Get("state"))
+	redirectURI.RawQuery = callback.Encode()
+
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+// introspectionResponse represents the token introspection response
+type introspectionResponse struct {
+	Active    bool   `json:"active"`
+	Status    string `json:"status,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Scope     string `json:"scope,omitempty"`
+	AuthType  string `json:"auth_type,omitempty"`
+	ExpiresAt int64  `json:"expires_at,omitempty"`
+}
+
+// handleIntrospect handles POST /oauth/v2/introspectToken
+func (s *Server) handleIntrospect(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_request", "malformed form body")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token, ok := s.tokens[r.PostForm.Get("token")]
+	if !ok {
+		writeJSON(w, http.StatusOK, introspectionResponse{Active: false})
+		return
+	}
+
+	status := "active"
+	switch {
+	case token.Revoked:
+		status = "revoked"
+	case !s.now().Before(token.ExpiresAt):
+		status = "expired"
+	}
+
+	writeJSON(w, http.StatusOK, introspectionResponse{
+		Active:    status == "active",
+		Status:    status,
+		ClientID:  s.options.ClientID,
+		Scope:     token.Scope,
+		AuthType:  "3L",
+		ExpiresAt: token.ExpiresAt.Unix(),
+	})
+}
+
+// handleAdminPosts handles GET /_fake/posts
+func (s *Server) handleAdminPosts(w http.ResponseWriter, r *http.Request) {
+	posts := s.Posts()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"posts": posts,
+		"count": len(posts),
+	})
+}
+
+// issueTokenRequest represents the body of POST /_fake/tokens
+type issueTokenRequest struct {
+	MemberID   string `json:"member_id"`
+	TTLSeconds int    `json:"ttl_seconds"`
+}
+
+// handleAdminIssueToken handles POST /_fake/tokens
+func (s *Server) handleAdminIssueToken(w http.ResponseWriter, r *http.Request) {
+	var req issueTokenRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeAPIError(w, http.StatusBadRequest, 0, "invalid request body")
+			return
+		}
+	}
+	if req.MemberID == "" {
+		req.MemberID = s.options.MemberID
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token := s.issueTokenLocked(req.MemberID, "")
+	if req.TTLSeconds > 0 {
+		token.ExpiresAt = s.now().Add(time.Duration(req.TTLSeconds) * time.Second)
+	}
+
+	writeJSON(w, http.StatusCreated, s.tokenResponseLocked(token))
+}
+
+// handleAdminRevokeToken handles DELETE /_fake/tokens/{token}
+func (s *Server) handleAdminRevokeToken(w http.ResponseWriter, r *http.Request) {
+	s.RevokeToken(mux.Vars(r)["token"])
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// faultRequest represents the body of POST /_fake/faults
+type faultRequest struct {
+	Path              string `json:"path"`
+	Status            int    `json:"status"`
+	RetryAfterSeconds int    `json:"retry_after_seconds"`
+	LatencyMS         int    `json:"latency_ms"`
+	Times             int    `json:"times"`
+}
+
+// handleAdminInjectFault handles POST /_fake/faults
+func (s *Server) handleAdminInjectFault(w http.ResponseWriter, r *http.Request) {
+	var req faultRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeAPIError(w, http.StatusBadRequest, 0, "invalid request body")
+		return
+	}
+	if req.Status != 0 && (req.Status < 400 || req.Status > 599) {
+		writeAPIError(w, http.StatusBadRequest, 0, "status must be a 4xx or 5xx code")
+		return
+	}
+
+	s.InjectFault(Fault{
+		Path:       req.Path,
+		Status:     req.Status,
+		RetryAfter: time.Duration(req.RetryAfterSeconds) * time.Second,
+		Latency:    time.Duration(req.LatencyMS) * time.Millisecond,
+		Times:      req.Times,
+	})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleAdminClearFaults handles DELETE /_fake/faults
+func (s *Server) handleAdminClearFaults(w http.ResponseWriter, r *http.Request) {
+	s.ClearFaults()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleAdminReset handles POST /_fake/reset
+func (s *Server) handleAdminReset(w http.ResponseWriter, r *http.Request) {
+	s.Reset()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// issueTokenLocked creates a token pair; an empty refreshToken gets a new one
+func (s *Server) issueTokenLocked(memberID, refreshToken string) *Token {
+	now := s.now()
+
+	token := &Token{
+		AccessToken:      randomString(),
+		RefreshToken:     refreshToken,
+		MemberID:         memberID,
+		Scope:            defaultScope,
+		ExpiresAt:        now.Add(s.options.AccessTokenTTL),
+		RefreshExpiresAt: now.Add(s.options.RefreshTokenTTL),
+	}
+	if token.RefreshToken == "" {
+		token.RefreshToken = randomString()
+	}
+
+	s.storeTokenLocked(token)
+	return token
+}
+
+// storeTokenLocked indexes a token by its access and refresh tokens
+func (s *Server) storeTokenLocked(token *Token) {
+	s.tokens[token.AccessToken] = token
+	if token.RefreshToken != "" {
+		s.refreshTokens[token.RefreshToken] = token
+	}
+}
+
+// tokenResponseLocked converts a token into the token endpoint response
+func (s *Server) tokenResponseLocked(token *Token) linkedin.TokenResponse {
+	now := s.now()
+	return linkedin.TokenResponse{
+		AccessToken:           token.AccessToken,
+		ExpiresIn:             int64(token.ExpiresAt.Sub(now).Seconds()),
+		RefreshToken:          token.RefreshToken,
+		RefreshTokenExpiresIn: int64(token.RefreshExpiresAt.Sub(now).Seconds()),
+		Scope:                 token.Scope,
+	}
+}
+
+// pkceChallenge derives the S256 code challenge of a verifier
+func pkceChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// randomString returns an opaque random identifier
+func randomString() string {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		panic(fmt.Sprintf("failed to read random bytes: %v", err))
+	}
+	return hex.EncodeToString(buf)
+}
+
+// writeAPIError writes an error in the LinkedIn REST API format
+func writeAPIError(w http.ResponseWriter, status, serviceErrorCode int, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"message":          message,
+		"serviceErrorCode": serviceErrorCode,
+		"status":           status,
+	})
+}
+
+// writeOAuthError writes an error in the OAuth2 format used by the token endpoints
+func writeOAuthError(w http.ResponseWriter, status int, code, description string) {
+	writeJSON(w, status, map[string]string{
+		"error":             code,
+		"error_description": description,
+	})
+}
+
+// writeJSON writes a JSON response
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,46 @@
+package fakelinkedin
+
+import (
+	"net/http/httptest"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/infrastructure/http/linkedin"
+)
+
+// TestServer runs the fake on a local loopback listener for tests
+type TestServer struct {
+	*Server
+	httpServer *httptest.Server
+}
+
+// NewTestServer starts a fake LinkedIn API; callers must Close it
+func NewTestServer(options Options) *TestServer {
+	server := New(options)
+	return &TestServer{
+		Server:     server,
+		httpServer: httptest.NewServer(server),
+	}
+}
+
+// URL returns the base URL of the fake
+func (ts *TestServer) URL() string {
+	return ts.httpServer.URL
+}
+
+// ClientConfig returns a LinkedIn client configuration pointing at the fake
+func (ts *TestServer) ClientConfig() linkedin.Config {
+	return linkedin.Config{
+		APIURL:       ts.URL() + APIPrefix,
+		TokenURL:     ts.URL() + TokenPath,
+		AuthURL:      ts.URL() + AuthPath,
+		ClientID:     ts.options.ClientID,
+		ClientSecret: ts.options.ClientSecret,
+		RedirectURI:  ts.options.RedirectURI,
+		Timeout:      5 * time.Second,
+	}
+}
+
+// Close shuts down the listener
+func (ts *TestServer) Close() {
+	ts.httpServer.Close()
+}
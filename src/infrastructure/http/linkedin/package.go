@@ -0,0 +1,9 @@
+// Package linkedin provides the HTTP client implementation for LinkedIn publishing.
+// This package handles:
+// - Publishing posts through the UGC Posts API
+// - Publishing articles through the Articles API
+// - OAuth2 authorization code flow with PKCE
+// - Access token validation and refresh
+// - Client-side rate limiting and retry with exponential backoff of idempotent requests
+// - Mapping LinkedIn error responses to domain errors
+package linkedin
@@ -0,0 +1,50 @@
+package linkedin
+
+import (
+	"sync"
+	"time"
+)
+
+// rateLimiter enforces LinkedInAPIConfig.RateLimit requests per RateLimitWindow
+// using a sliding window of request timestamps
+type rateLimiter struct {
+	limit  int
+	window time.Duration
+	calls  []time.Time
+	mu     sync.Mutex
+}
+
+// newRateLimiter creates a limiter; a non-positive limit disables limiting
+func newRateLimiter(limit int, window time.Duration) *rateLimiter {
+	return &rateLimiter{
+		limit:  limit,
+		window: window,
+	}
+}
+
+// allow records a call at now if the window has capacity.
+// When the window is full it returns the time until the next slot frees up.
+func (r *rateLimiter) allow(now time.Time) (time.Duration, bool) {
+	if r == nil || r.limit <= 0 {
+		return 0, true
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cutoff := now.Add(-r.window)
+	kept := r.calls[:0]
+	for _, call := range r.calls {
+		if call.After(cutoff) {
+			kept = append(kept, call)
+		}
+	}
+	r.calls = kept
+
+	if len(r.calls) >= r.limit {
+		return r.calls[0].Add(r.window).Sub(now), false
+	}
+
+	r.calls = append(r.calls, now)
+	return 0, true
+}
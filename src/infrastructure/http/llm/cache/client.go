@@ -0,0 +1,177 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/structured"
+	"go.uber.org/zap"
+)
+
+// Config holds configuration for the caching client
+type Config struct {
+	// Model is part of the key, so a configuration change doesn't serve another model's
+	// responses; it is the model of the primary backend
+	Model  string
+	Logger *zap.Logger
+}
+
+// Client is an LLMService decorator that reuses a job's earlier responses.
+//
+// Only raw and prompt requests made for a job, with a JobID in the LLMCallInfo of the context,
+// are cached: a retried job renders the same prompts, so it gets back the responses the first
+// attempt already paid for. Responses from a model other than the one in the key, such as a
+// fallback backend's, aren't cached. The typed methods and streams are passed through.
+type Client struct {
+	delegate interfaces.LLMService
+	store    interfaces.LLMResponseCache
+	model    string
+	logger   *zap.Logger
+}
+
+// NewClient creates a caching client storing responses in store
+func NewClient(delegate interfaces.LLMService, store interfaces.LLMResponseCache, config Config) (*Client, error) {
+	if delegate == nil {
+		return nil, fmt.Errorf("LLM service cannot be nil")
+	}
+	if store == nil {
+		return nil, fmt.Errorf("response cache cannot be nil")
+	}
+
+	logger := config.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	return &Client{
+		delegate: delegate,
+		store:    store,
+		model:    config.Model,
+		logger:   logger,
+	}, nil
+}
+
+// SendRequest implements LLMService.SendRequest
+func (c *Client) SendRequest(ctx context.Context, prompt string) (string, error) {
+	return c.SendPromptRequest(ctx, interfaces.PromptRequest{Prompt: prompt})
+}
+
+// SendPromptRequest implements LLMService.SendPromptRequest, answering from the cache when
+// the job already received a good response to the same request
+func (c *Client) SendPromptRequest(ctx context.Context, req interfaces.PromptRequest) (string, error) {
+	jobID := interfaces.LLMCallInfoFromContext(ctx).JobID
+	if jobID == "" {
+		return c.delegate.SendPromptRequest(ctx, req)
+	}
+
+	model := c.requestModel(req)
+	key := c.key(jobID, model, req)
+
+	// The cache only saves money: a failing store is a miss, never a failed call
+	response, ok, err := c.store.Get(ctx, key)
+	if err != nil {
+		c.logger.Warn("Failed to read cached LLM response", zap.String("job_id", jobID), zap.Error(err))
+	}
+	if ok {
+		return response, nil
+	}
+
+	var answeredBy string
+	observed := interfaces.WithLLMModelObserver(ctx, func(provider, model string) {
+		answeredBy = model
+	})
+	response, err = c.delegate.SendPromptRequest(observed, req)
+	if err != nil {
+		return "", err
+	}
+
+	// A degraded fallback answer would otherwise be served as the primary model's until it
+	// expires. Backends that don't report a model are taken to be the configured one.
+	if answeredBy != "" && answeredBy != model {
+		c.logger.Debug("Not caching LLM response from another model",
+			zap.String("job_id", jobID),
+			zap.String("model", model),
+			zap.String("answered_by", answeredBy),
+		)
+		return response, nil
+	}
+
+	// A response that needs repairing would fail the retry the same way
+	if req.Schema != nil {
+		schema, err := structured.FromResponse(req.Schema)
+		if err != nil || structured.Decode(response, schema, nil) != nil {
+			return response, nil
+		}
+	}
+
+	if err := c.store.Set(ctx, key, response); err != nil {
+		c.logger.Warn("Failed to cache LLM response", zap.String("job_id", jobID), zap.Error(err))
+	}
+	return response, nil
+}
+
+// GenerateIdeas implements LLMService.GenerateIdeas
+func (c *Client) GenerateIdeas(ctx context.Context, topic string, count int) ([]string, error) {
+	return c.delegate.GenerateIdeas(ctx, topic, count)
+}
+
+// GenerateDrafts implements LLMService.GenerateDrafts
+func (c *Client) GenerateDrafts(ctx context.Context, idea string, userContext string) (interfaces.DraftSet, error) {
+	return c.delegate.GenerateDrafts(ctx, idea, userContext)
+}
+
+// RefineDraft implements LLMService.RefineDraft
+func (c *Client) RefineDraft(ctx context.Context, draft string, userPrompt string, history []string) (string, error) {
+	return c.delegate.RefineDraft(ctx, draft, userPrompt, history)
+}
+
+// StreamRequest implements StreamingLLMService.StreamRequest
+func (c *Client) StreamRequest(ctx context.Context, prompt string, onChunk interfaces.LLMStreamHandler) (string, error) {
+	return interfaces.Streaming(c.delegate).StreamRequest(ctx, prompt, onChunk)
+}
+
+// RefineDraftStream implements StreamingLLMService.RefineDraftStream
+func (c *Client) RefineDraftStream(ctx context.Context, draft string, userPrompt string, history []string, onChunk interfaces.LLMStreamHandler) (string, error) {
+	return interfaces.Streaming(c.delegate).RefineDraftStream(ctx, draft, userPrompt, history, onChunk)
+}
+
+// cacheKey is everything in a request that changes the response
+type cacheKey struct {
+	System        string   `json:"system,omitempty"`
+	Prompt        string   `json:"prompt"`
+	Temperature   *float64 `json:"temperature,omitempty"`
+	MaxTokens     int      `json:"max_tokens,omitempty"`
+	StopSequences []string `json:"stop,omitempty"`
+	Schema        string   `json:"schema,omitempty"`
+}
+
+// requestModel returns the model the primary backend answers request with
+func (c *Client) requestModel(request interfaces.PromptRequest) string {
+	if request.Parameters.Model != "" {
+		return request.Parameters.Model
+	}
+	return c.model
+}
+
+// key builds the cache key of a request: the job, the model and a hash of the rendered prompt
+// with its system message, parameters and schema
+func (c *Client) key(jobID, model string, request interfaces.PromptRequest) string {
+	fields := cacheKey{
+		System:        request.System,
+		Prompt:        request.Prompt,
+		Temperature:   request.Parameters.Temperature,
+		MaxTokens:     request.Parameters.MaxTokens,
+		StopSequences: request.Parameters.StopSequences,
+	}
+	if request.Schema != nil {
+		fields.Schema = request.Schema.Name
+	}
+
+	encoded, _ := json.Marshal(fields)
+	sum := sha256.Sum256(encoded)
+	return fmt.Sprintf("%s:%s:%s", jobID, model, hex.EncodeToString(sum[:]))
+}
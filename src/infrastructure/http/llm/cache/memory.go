@@ -0,0 +1,116 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore is an in-memory LRU cache of LLM responses with a TTL
+type MemoryStore struct {
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	now      func() time.Time
+	entries  map[string]*list.Element
+	// order holds the entries from most to least recently used
+	order *list.List
+}
+
+// memoryEntry is one cached response
+type memoryEntry struct {
+	key       string
+	response  string
+	expiresAt time.Time
+}
+
+// MemoryConfig holds configuration for the in-memory cache
+type MemoryConfig struct {
+	// Capacity is the number of responses kept before evicting the least recently used
+	Capacity int
+	// TTL is how long a response stays valid
+	TTL time.Duration
+	// Now is the clock used for expiry, time.Now when nil
+	Now func() time.Time
+}
+
+// Default settings of the in-memory cache
+const (
+	DefaultCapacity = 256
+	DefaultTTL      = 24 * time.Hour
+)
+
+// NewMemoryStore creates an in-memory LRU cache; zero settings select the defaults
+func NewMemoryStore(config MemoryConfig) *MemoryStore {
+	if config.Capacity <= 0 {
+		config.Capacity = DefaultCapacity
+	}
+	if config.TTL <= 0 {
+		config.TTL = DefaultTTL
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	return &MemoryStore{
+		capacity: config.Capacity,
+		ttl:      config.TTL,
+		now:      config.Now,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get implements LLMResponseCache.Get
+func (s *MemoryStore) Get(ctx context.Context, key string) (string, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	element, ok := s.entries[key]
+	if !ok {
+		return "", false, nil
+	}
+
+	entry := element.Value.(*memoryEntry)
+	if !s.now().Before(entry.expiresAt) {
+		s.remove(element)
+		return "", false, nil
+	}
+
+	s.order.MoveToFront(element)
+	return entry.response, true, nil
+}
+
+// Set implements LLMResponseCache.Set, evicting the least recently used response when full
+func (s *MemoryStore) Set(ctx context.Context, key string, response string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expiresAt := s.now().Add(s.ttl)
+	if element, ok := s.entries[key]; ok {
+		entry := element.Value.(*memoryEntry)
+		entry.response = response
+		entry.expiresAt = expiresAt
+		s.order.MoveToFront(element)
+		return nil
+	}
+
+	s.entries[key] = s.order.PushFront(&memoryEntry{key: key, response: response, expiresAt: expiresAt})
+	for s.order.Len() > s.capacity {
+		s.remove(s.order.Back())
+	}
+	return nil
+}
+
+// Len returns the number of cached responses, expired ones included until they are evicted
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.order.Len()
+}
+
+// remove drops an entry; the caller holds the lock
+func (s *MemoryStore) remove(element *list.Element) {
+	s.order.Remove(element)
+	delete(s.entries, element.Value.(*memoryEntry).key)
+}
@@ -0,0 +1,7 @@
+// Package cache provides a response cache decorator for the LLM service.
+// This package handles:
+// - Reusing a job's earlier responses when the job is retried, instead of paying for them again
+// - Keying responses by job, model and a hash of the rendered prompt and its parameters
+// - An in-memory LRU tier in front of a shared MongoDB tier with TTL
+// - Caching only responses that match the schema the caller asked for
+package cache
@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"context"
+	"errors"
+
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+)
+
+// TieredStore looks responses up in each tier in order, from fastest to slowest
+type TieredStore struct {
+	tiers []interfaces.LLMResponseCache
+}
+
+// NewTieredStore creates a cache over tiers, ordered from fastest to slowest
+func NewTieredStore(tiers ...interfaces.LLMResponseCache) *TieredStore {
+	return &TieredStore{tiers: tiers}
+}
+
+// Get implements LLMResponseCache.Get. A hit in a slower tier is copied into the faster ones;
+// a failing tier is skipped, and its error only returned when no tier has the response.
+func (s *TieredStore) Get(ctx context.Context, key string) (string, bool, error) {
+	var errs []error
+	for i, tier := range s.tiers {
+		response, ok, err := tier.Get(ctx, key)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		for _, faster := range s.tiers[:i] {
+			faster.Set(ctx, key, response)
+		}
+		return response, true, nil
+	}
+	return "", false, errors.Join(errs...)
+}
+
+// Set implements LLMResponseCache.Set, writing to every tier
+func (s *TieredStore) Set(ctx context.Context, key string, response string) error {
+	var errs []error
+	for _, tier := range s.tiers {
+		if err := tier.Set(ctx, key, response); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -11,16 +12,34 @@ import (
 	"strings"
 	"time"
 
+	"github.com/linkgen-ai/backend/src/domain/entities"
 	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
 	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/structured"
 )
 
+// ErrInvalidRequest indicates the request was rejected before reaching the LLM
+var ErrInvalidRequest = errors.New("invalid LLM request")
+
+// UsageRecorder receives the token usage of every successful LLM call
+type UsageRecorder interface {
+	RecordLLMUsage(ctx context.Context, usage *entities.LLMUsage)
+}
+
 // LLMHTTPClient implements the LLMService interface using HTTP
 type LLMHTTPClient struct {
-	baseURL     string
+	provider    Provider
 	httpClient  *http.Client
 	retryConfig RetryConfig
 	model       string
+	temperature float64
+	maxTokens   int
+	// promptModel lets prompts replace the model
+	promptModel bool
+	usage       UsageRecorder
+	keyResolver interfaces.LLMKeyResolver
+	// structuredOutput asks providers for schema-constrained output when a request has a schema
+	structuredOutput bool
 }
 
 // Config holds configuration for LLM HTTP client
@@ -29,14 +48,66 @@ type Config struct {
 	Timeout    time.Duration
 	MaxRetries int
 	Model      string
+	// Provider selects the wire format: openai (default), anthropic or ollama
+	Provider string
+	// APIKey is sent with the provider's authentication header when set
+	APIKey string
+	// Temperature and MaxTokens apply to prompts that don't set their own;
+	// zero selects DefaultTemperature and DefaultMaxTokens
+	Temperature float64
+	MaxTokens   int
+	// IgnorePromptModel keeps the configured model for prompts that set their own
+	IgnorePromptModel bool
+	// DisableStructuredOutput stops asking for schema-constrained output, for
+	// OpenAI-compatible servers that reject response_format; responses are still validated
+	DisableStructuredOutput bool
 }
 
+// Defaults of the model parameters, used when neither the config nor the prompt sets them
+const (
+	DefaultTemperature = 0.7
+	DefaultMaxTokens   = 2000
+)
+
 // LLMRequest represents a request to the LLM API
 type LLMRequest struct {
-	Model       string    `json:"model"`
-	Messages    []Message `json:"messages"`
-	Temperature float64   `json:"temperature,omitempty"`
-	MaxTokens   int       `json:"max_tokens,omitempty"`
+	Model    string    `json:"model"`
+	Messages []Message `json:"messages"`
+	// Temperature is a pointer so that a prompt can ask for 0
+	Temperature *float64 `json:"temperature,omitempty"`
+	MaxTokens   int      `json:"max_tokens,omitempty"`
+	Stop        []string `json:"stop,omitempty"`
+	// ResponseFormat asks for output matching a JSON schema
+	ResponseFormat *ResponseFormat `json:"response_format,omitempty"`
+	// Stream asks for the response as Server-Sent Events
+	Stream        bool           `json:"stream,omitempty"`
+	StreamOptions *StreamOptions `json:"stream_options,omitempty"`
+}
+
+// ResponseFormat is the OpenAI response_format request field
+type ResponseFormat struct {
+	Type       string              `json:"type"`
+	JSONSchema *ResponseJSONSchema `json:"json_schema,omitempty"`
+}
+
+// ResponseJSONSchema names the schema a response must match
+type ResponseJSONSchema struct {
+	Name   string          `json:"name"`
+	Schema json.RawMessage `json:"schema"`
+	Strict bool            `json:"strict"`
+}
+
+// newResponseFormat builds the response_format of a schema; the built-in schemas
+// close every object and require every property, so they qualify for strict mode
+func newResponseFormat(schema *interfaces.ResponseSchema) *ResponseFormat {
+	return &ResponseFormat{
+		Type: "json_schema",
+		JSONSchema: &ResponseJSONSchema{
+			Name:   schema.Name,
+			Schema: schema.Definition,
+			Strict: true,
+		},
+	}
 }
 
 // Message represents a chat message
@@ -48,9 +119,17 @@ type Message struct {
 // LLMResponse represents a response from the LLM API
 type LLMResponse struct {
 	Choices []Choice  `json:"choices"`
+	Usage   *Usage    `json:"usage,omitempty"`
 	Error   *APIError `json:"error,omitempty"`
 }
 
+// Usage represents the token counts of a response
+type Usage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
 // Choice represents a completion choice
 type Choice struct {
 	Message      Message `json:"message"`
@@ -59,9 +138,15 @@ type Choice struct {
 
 // APIError represents an error from the LLM API
 type APIError struct {
-	Message string `json:"message"`
-	Type    string `json:"type"`
-	Code    string `json:"code"`
+	StatusCode int    `json:"-"`
+	Message    string `json:"message"`
+	Type       string `json:"type"`
+	Code       string `json:"code"`
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	return fmt.Sprintf("LLM API error (%d): %s", e.StatusCode, e.Message)
 }
 
 // IdeasResponse represents the response for idea generation
@@ -86,12 +171,17 @@ func NewLLMHTTPClient(config Config) (*LLMHTTPClient, error) {
 		return nil, fmt.Errorf("invalid configuration: %w", err)
 	}
 
-	// Ensure base URL doesn't have trailing slash
-	baseURL := strings.TrimSuffix(config.BaseURL, "/")
+	provider, err := NewProvider(config.Provider, config.BaseURL, config.APIKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
 
 	// Set default model if not provided
 	model := config.Model
 	if model == "" {
+		if provider.Name() != ProviderOpenAI {
+			return nil, fmt.Errorf("invalid configuration: model is required for the %s provider", provider.Name())
+		}
 		model = "gpt-3.5-turbo" // Default model
 	}
 
@@ -106,11 +196,25 @@ func NewLLMHTTPClient(config Config) (*LLMHTTPClient, error) {
 		retryConfig.MaxRetries = config.MaxRetries
 	}
 
+	temperature := config.Temperature
+	if temperature == 0 {
+		temperature = DefaultTemperature
+	}
+	maxTokens := config.MaxTokens
+	if maxTokens == 0 {
+		maxTokens = DefaultMaxTokens
+	}
+
 	return &LLMHTTPClient{
-		baseURL:     baseURL,
+		provider:    provider,
 		httpClient:  httpClient,
 		retryConfig: retryConfig,
 		model:       model,
+		temperature: temperature,
+		maxTokens:   maxTokens,
+		promptModel: !config.IgnorePromptModel,
+
+		structuredOutput: !config.DisableStructuredOutput,
 	}, nil
 }
 
@@ -138,9 +242,33 @@ func validateConfig(config Config) error {
 		return fmt.Errorf("max retries cannot be negative")
 	}
 
+	if config.Temperature < 0 || config.Temperature > 2 {
+		return fmt.Errorf("temperature must be between 0.0 and 2.0")
+	}
+
+	if config.MaxTokens < 0 {
+		return fmt.Errorf("max tokens cannot be negative")
+	}
+
 	return nil
 }
 
+// SetRetryConfig overrides the retry behaviour of the client
+func (c *LLMHTTPClient) SetRetryConfig(config RetryConfig) {
+	c.retryConfig = config
+}
+
+// SetUsageRecorder reports the token usage of every successful call to recorder
+func (c *LLMHTTPClient) SetUsageRecorder(recorder UsageRecorder) {
+	c.usage = recorder
+}
+
+// SetKeyResolver makes calls attributed to a user authenticate with the user's own key
+// for the provider when they have one, instead of the configured key
+func (c *LLMHTTPClient) SetKeyResolver(resolver interfaces.LLMKeyResolver) {
+	c.keyResolver = resolver
+}
+
 // GenerateIdeas implements LLMService.GenerateIdeas
 func (c *LLMHTTPClient) GenerateIdeas(ctx context.Context, topic string, count int) ([]string, error) {
 	if err := validateIdeaRequest(topic, count); err != nil {
@@ -149,14 +277,9 @@ func (c *LLMHTTPClient) GenerateIdeas(ctx context.Context, topic string, count i
 
 	prompt := BuildIdeasPrompt(topic, count)
 
-	response, err := c.sendRequest(ctx, prompt)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate ideas: %w", err)
-	}
-
 	var ideasResp IdeasResponse
-	if err := json.Unmarshal([]byte(response), &ideasResp); err != nil {
-		return nil, fmt.Errorf("failed to parse ideas response: %w", err)
+	if _, err := structured.Complete(ctx, c, interfaces.PromptRequest{Prompt: prompt}, structured.IdeasSchema, &ideasResp); err != nil {
+		return nil, fmt.Errorf("failed to generate ideas: %w", err)
 	}
 
 	if len(ideasResp.Ideas) == 0 {
@@ -174,22 +297,13 @@ func (c *LLMHTTPClient) GenerateDrafts(ctx context.Context, idea string, userCon
 
 	prompt := BuildDraftsPrompt(idea, userContext)
 
-	response, err := c.sendRequest(ctx, prompt)
+	// Unparsable responses come back as an LLMResponseError once the repair attempt fails
+	var draftsResp DraftsResponse
+	response, err := structured.Complete(ctx, c, interfaces.PromptRequest{Prompt: prompt}, structured.DraftsSchema, &draftsResp)
 	if err != nil {
 		return interfaces.DraftSet{}, fmt.Errorf("failed to generate drafts: %w", err)
 	}
 
-	var draftsResp DraftsResponse
-	if err := json.Unmarshal([]byte(response), &draftsResp); err != nil {
-		return interfaces.DraftSet{}, domainErrors.NewLLMResponseError(
-			"drafts_unmarshal",
-			"failed to parse drafts response",
-			prompt,
-			response,
-			err,
-		)
-	}
-
 	if len(draftsResp.Posts) == 0 {
 		return interfaces.DraftSet{}, domainErrors.NewLLMResponseError(
 			"drafts_posts",
@@ -276,14 +390,9 @@ func (c *LLMHTTPClient) RefineDraft(ctx context.Context, draft string, userPromp
 
 	prompt := BuildRefinementPrompt(draft, userPrompt, history)
 
-	response, err := c.sendRequest(ctx, prompt)
-	if err != nil {
-		return "", fmt.Errorf("failed to refine draft: %w", err)
-	}
-
 	var refinementResp RefinementResponse
-	if err := json.Unmarshal([]byte(response), &refinementResp); err != nil {
-		return "", fmt.Errorf("failed to parse refinement response: %w", err)
+	if _, err := structured.Complete(ctx, c, interfaces.PromptRequest{Prompt: prompt}, structured.RefinementSchema, &refinementResp); err != nil {
+		return "", fmt.Errorf("failed to refine draft: %w", err)
 	}
 
 	if strings.TrimSpace(refinementResp.Refined) == "" {
@@ -295,94 +404,195 @@ func (c *LLMHTTPClient) RefineDraft(ctx context.Context, draft string, userPromp
 
 // SendRequest implements LLMService.SendRequest - sends a raw prompt to the LLM
 func (c *LLMHTTPClient) SendRequest(ctx context.Context, prompt string) (string, error) {
-	if prompt == "" {
+	return c.SendPromptRequest(ctx, interfaces.PromptRequest{Prompt: prompt})
+}
+
+// SendPromptRequest implements LLMService.SendPromptRequest
+func (c *LLMHTTPClient) SendPromptRequest(ctx context.Context, req interfaces.PromptRequest) (string, error) {
+	if req.Prompt == "" {
 		return "", fmt.Errorf("prompt cannot be empty")
 	}
 
-	return c.sendRequest(ctx, prompt)
+	return c.sendRequest(ctx, req)
 }
 
 // sendRequest sends a request to the LLM API with retry logic
-func (c *LLMHTTPClient) sendRequest(ctx context.Context, prompt string) (string, error) {
-	endpoint := c.baseURL + "/v1/chat/completions"
+func (c *LLMHTTPClient) sendRequest(ctx context.Context, req interfaces.PromptRequest) (string, error) {
+	llmReq := c.newChatRequest(req)
 
-	llmReq := LLMRequest{
-		Model: c.model,
-		Messages: []Message{
-			{
-				Role:    "user",
-				Content: prompt,
-			},
-		},
-		Temperature: 0.7,
-		MaxTokens:   2000,
+	start := time.Now()
+	resp, err := c.send(ctx, llmReq)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	reqBody, err := json.Marshal(llmReq)
+	completion, err := c.provider.ParseResponse(body)
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal request: %w", err)
+		return "", err
+	}
+
+	c.recordUsage(ctx, llmReq.Model, completion.Usage, time.Since(start))
+
+	content := strings.TrimSpace(completion.Content)
+	if content == "" {
+		return "", fmt.Errorf("LLM returned empty content")
+	}
+
+	return content, nil
+}
+
+// newChatRequest builds the chat request of a prompt, with its parameters overriding the
+// client's settings
+func (c *LLMHTTPClient) newChatRequest(promptReq interfaces.PromptRequest) LLMRequest {
+	params := promptReq.Parameters
+
+	llmReq := LLMRequest{
+		Model:       c.model,
+		Temperature: &c.temperature,
+		MaxTokens:   c.maxTokens,
+		Stop:        params.StopSequences,
+	}
+	if params.Model != "" && c.promptModel {
+		llmReq.Model = params.Model
+	}
+	if params.Temperature != nil {
+		llmReq.Temperature = params.Temperature
+	}
+	if params.MaxTokens > 0 {
+		llmReq.MaxTokens = params.MaxTokens
+	}
+
+	if promptReq.System != "" {
+		llmReq.Messages = append(llmReq.Messages, Message{Role: "system", Content: promptReq.System})
 	}
+	llmReq.Messages = append(llmReq.Messages, Message{Role: "user", Content: promptReq.Prompt})
 
-	var resp *http.Response
-	resp, err = ExecuteWithRetry(ctx, c.retryConfig, func() (*http.Response, error) {
-		req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(reqBody))
+	if promptReq.Schema != nil && c.structuredOutput {
+		llmReq.ResponseFormat = newResponseFormat(promptReq.Schema)
+	}
+	return llmReq
+}
+
+// execute sends a chat request with retry logic, authenticated with apiKey when set; the
+// response it returns has a 2xx status and must be closed by the caller
+func (c *LLMHTTPClient) execute(ctx context.Context, llmReq LLMRequest, apiKey string) (*http.Response, error) {
+	var lastAPIErr *APIError
+	resp, err := ExecuteWithRetry(ctx, c.retryConfig, func() (*http.Response, error) {
+		req, err := c.provider.NewRequest(ctx, llmReq, apiKey)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create request: %w", err)
+			return nil, err
 		}
 
-		req.Header.Set("Content-Type", "application/json")
+		resp, err := c.httpClient.Do(req)
+		if err != nil || resp.StatusCode < 400 {
+			return resp, err
+		}
 
-		return c.httpClient.Do(req)
+		// Map failed attempts as they happen so the API error survives exhausted retries
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		lastAPIErr = c.provider.ParseError(resp.StatusCode, body)
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		return resp, nil
 	})
 
 	if err != nil {
-		return "", err
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", fmt.Errorf("failed to read response body: %w", err)
+		if lastAPIErr != nil {
+			return nil, fmt.Errorf("%w: %w", err, lastAPIErr)
+		}
+		return nil, err
 	}
 
 	// Check for HTTP errors
 	if resp.StatusCode >= 400 {
-		var apiErr APIError
-		if json.Unmarshal(body, &apiErr) == nil && apiErr.Message != "" {
-			return "", fmt.Errorf("LLM API error (%d): %s", resp.StatusCode, apiErr.Message)
-		}
-		return "", fmt.Errorf("LLM API error (%d): %s", resp.StatusCode, string(body))
+		resp.Body.Close()
+		return nil, lastAPIErr
 	}
 
-	var llmResp LLMResponse
-	if err := json.Unmarshal(body, &llmResp); err != nil {
-		return "", fmt.Errorf("failed to parse LLM response: %w", err)
+	return resp, nil
+}
+
+// send executes llmReq with the key resolved for the call. A user's own key that the
+// provider rejects is retried once with the configured key, so a revoked user key neither
+// fails the call nor counts against the backend's circuit breaker, which all users share.
+func (c *LLMHTTPClient) send(ctx context.Context, llmReq LLMRequest) (*http.Response, error) {
+	apiKey := c.resolveAPIKey(ctx)
+	if apiKey == "" {
+		interfaces.NotifyLLMKeySource(ctx, c.provider.Name(), interfaces.LLMKeySourcePlatform)
+		return c.execute(ctx, llmReq, "")
 	}
 
-	if llmResp.Error != nil {
-		return "", fmt.Errorf("LLM API error: %s", llmResp.Error.Message)
+	interfaces.NotifyLLMKeySource(ctx, c.provider.Name(), interfaces.LLMKeySourceUser)
+	resp, err := c.execute(ctx, llmReq, apiKey)
+	if err == nil || !isAuthError(err) {
+		return resp, err
 	}
 
-	if len(llmResp.Choices) == 0 {
-		return "", fmt.Errorf("LLM returned no choices")
+	interfaces.NotifyLLMKeySource(ctx, c.provider.Name(), interfaces.LLMKeySourcePlatform)
+	return c.execute(ctx, llmReq, "")
+}
+
+// resolveAPIKey picks the key of the call: the user's own key for the provider when the
+// call is attributed to a user who has one, or "" for the configured key. A failed lookup
+// also falls back to the configured key rather than failing the call.
+func (c *LLMHTTPClient) resolveAPIKey(ctx context.Context) string {
+	userID := interfaces.LLMCallInfoFromContext(ctx).UserID
+	if c.keyResolver == nil || userID == "" {
+		return ""
 	}
 
-	content := strings.TrimSpace(llmResp.Choices[0].Message.Content)
-	if content == "" {
-		return "", fmt.Errorf("LLM returned empty content")
+	key, err := c.keyResolver.ResolveLLMKey(ctx, userID, c.provider.Name())
+	if err != nil {
+		return ""
 	}
+	return key
+}
 
-	return content, nil
+// isAuthError checks whether the provider rejected the API key of a call
+func isAuthError(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden
+}
+
+// recordUsage reports a completed call to model, attributed through the LLMCallInfo in ctx
+func (c *LLMHTTPClient) recordUsage(ctx context.Context, model string, usage TokenUsage, latency time.Duration) {
+	interfaces.NotifyLLMModel(ctx, c.provider.Name(), model)
+
+	if c.usage == nil {
+		return
+	}
+
+	info := interfaces.LLMCallInfoFromContext(ctx)
+	c.usage.RecordLLMUsage(ctx, &entities.LLMUsage{
+		UserID:           info.UserID,
+		JobID:            info.JobID,
+		PromptName:       info.PromptName,
+		Provider:         c.provider.Name(),
+		Model:            model,
+		PromptTokens:     usage.PromptTokens,
+		CompletionTokens: usage.CompletionTokens,
+		Latency:          latency,
+		CreatedAt:        time.Now().UTC(),
+	})
 }
 
 // validateIdeaRequest validates idea generation request parameters
 func validateIdeaRequest(topic string, count int) error {
 	if strings.TrimSpace(topic) == "" {
-		return fmt.Errorf("topic cannot be empty")
+		return fmt.Errorf("%w: topic cannot be empty", ErrInvalidRequest)
 	}
 
 	if count <= 0 {
-		return fmt.Errorf("count must be greater than 0")
+		return fmt.Errorf("%w: count must be greater than 0", ErrInvalidRequest)
 	}
 
 	return nil
@@ -391,11 +601,11 @@ func validateIdeaRequest(topic string, count int) error {
 // validateDraftRequest validates draft generation request parameters
 func validateDraftRequest(idea string, userContext string) error {
 	if strings.TrimSpace(idea) == "" {
-		return fmt.Errorf("idea cannot be empty")
+		return fmt.Errorf("%w: idea cannot be empty", ErrInvalidRequest)
 	}
 
 	if strings.TrimSpace(userContext) == "" {
-		return fmt.Errorf("user context cannot be empty")
+		return fmt.Errorf("%w: user context cannot be empty", ErrInvalidRequest)
 	}
 
 	return nil
@@ -404,11 +614,11 @@ func validateDraftRequest(idea string, userContext string) error {
 // validateRefinementRequest validates refinement request parameters
 func validateRefinementRequest(draft string, userPrompt string) error {
 	if strings.TrimSpace(draft) == "" {
-		return fmt.Errorf("draft cannot be empty")
+		return fmt.Errorf("%w: draft cannot be empty", ErrInvalidRequest)
 	}
 
 	if strings.TrimSpace(userPrompt) == "" {
-		return fmt.Errorf("user prompt cannot be empty")
+		return fmt.Errorf("%w: user prompt cannot be empty", ErrInvalidRequest)
 	}
 
 	return nil
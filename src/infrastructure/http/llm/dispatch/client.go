@@ -0,0 +1,146 @@
+package dispatch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm"
+)
+
+// Config holds configuration for the dispatcher
+type Config struct {
+	// MaxInFlight bounds the requests sent at once; zero is unlimited
+	MaxInFlight int
+	// TokensPerMinute bounds the estimated prompt and completion tokens sent per minute; zero is unlimited
+	TokensPerMinute int
+	// CompletionTokens is the completion estimate of prompts that don't set max_tokens;
+	// zero selects llm.DefaultMaxTokens
+	CompletionTokens int
+}
+
+// Client is an LLMService decorator that makes every caller wait for a slot.
+//
+// Refinements are interactive; everything else is background work. A request weighs its
+// estimated prompt tokens plus the completion tokens it may receive, which is an upper bound
+// of what the provider counts against a tokens-per-minute limit.
+type Client struct {
+	delegate         interfaces.LLMService
+	queue            *queue
+	completionTokens int
+}
+
+// NewClient creates a dispatcher in front of delegate
+func NewClient(delegate interfaces.LLMService, config Config) (*Client, error) {
+	if delegate == nil {
+		return nil, fmt.Errorf("LLM service cannot be nil")
+	}
+	if config.MaxInFlight < 0 {
+		return nil, fmt.Errorf("max in-flight requests cannot be negative")
+	}
+	if config.TokensPerMinute < 0 {
+		return nil, fmt.Errorf("tokens per minute cannot be negative")
+	}
+
+	completionTokens := config.CompletionTokens
+	if completionTokens <= 0 {
+		completionTokens = llm.DefaultMaxTokens
+	}
+
+	return &Client{
+		delegate:         delegate,
+		queue:            newQueue(config.MaxInFlight, config.TokensPerMinute),
+		completionTokens: completionTokens,
+	}, nil
+}
+
+// Stats returns a snapshot of the requests in flight and queued
+func (c *Client) Stats() Stats {
+	return c.queue.stats()
+}
+
+// SendRequest implements LLMService.SendRequest
+func (c *Client) SendRequest(ctx context.Context, prompt string) (string, error) {
+	release, err := c.acquire(ctx, PriorityBackground, interfaces.PromptRequest{Prompt: prompt})
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return c.delegate.SendRequest(ctx, prompt)
+}
+
+// SendPromptRequest implements LLMService.SendPromptRequest
+func (c *Client) SendPromptRequest(ctx context.Context, req interfaces.PromptRequest) (string, error) {
+	release, err := c.acquire(ctx, PriorityBackground, req)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return c.delegate.SendPromptRequest(ctx, req)
+}
+
+// GenerateIdeas implements LLMService.GenerateIdeas
+func (c *Client) GenerateIdeas(ctx context.Context, topic string, count int) ([]string, error) {
+	release, err := c.acquire(ctx, PriorityBackground, interfaces.PromptRequest{Prompt: llm.BuildIdeasPrompt(topic, count)})
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return c.delegate.GenerateIdeas(ctx, topic, count)
+}
+
+// GenerateDrafts implements LLMService.GenerateDrafts
+func (c *Client) GenerateDrafts(ctx context.Context, idea string, userContext string) (interfaces.DraftSet, error) {
+	release, err := c.acquire(ctx, PriorityBackground, interfaces.PromptRequest{Prompt: llm.BuildDraftsPrompt(idea, userContext)})
+	if err != nil {
+		return interfaces.DraftSet{}, err
+	}
+	defer release()
+	return c.delegate.GenerateDrafts(ctx, idea, userContext)
+}
+
+// RefineDraft implements LLMService.RefineDraft
+func (c *Client) RefineDraft(ctx context.Context, draft string, userPrompt string, history []string) (string, error) {
+	release, err := c.acquire(ctx, PriorityInteractive, interfaces.PromptRequest{Prompt: llm.BuildRefinementPrompt(draft, userPrompt, history)})
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return c.delegate.RefineDraft(ctx, draft, userPrompt, history)
+}
+
+// StreamRequest implements StreamingLLMService.StreamRequest, holding the slot until the stream ends
+func (c *Client) StreamRequest(ctx context.Context, prompt string, onChunk interfaces.LLMStreamHandler) (string, error) {
+	release, err := c.acquire(ctx, PriorityBackground, interfaces.PromptRequest{Prompt: prompt})
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return interfaces.Streaming(c.delegate).StreamRequest(ctx, prompt, onChunk)
+}
+
+// RefineDraftStream implements StreamingLLMService.RefineDraftStream, holding the slot until the stream ends
+func (c *Client) RefineDraftStream(ctx context.Context, draft string, userPrompt string, history []string, onChunk interfaces.LLMStreamHandler) (string, error) {
+	release, err := c.acquire(ctx, PriorityInteractive, interfaces.PromptRequest{Prompt: llm.BuildRefinementStreamPrompt(draft, userPrompt, history)})
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return interfaces.Streaming(c.delegate).RefineDraftStream(ctx, draft, userPrompt, history, onChunk)
+}
+
+// acquire waits for a slot for request, weighed by its prompt, system message and max tokens
+func (c *Client) acquire(ctx context.Context, priority Priority, request interfaces.PromptRequest) (func(), error) {
+	completion := c.completionTokens
+	if request.Parameters.MaxTokens > 0 {
+		completion = request.Parameters.MaxTokens
+	}
+	return c.queue.acquire(ctx, priority, estimateTokens(request.System, request.Prompt)+completion)
+}
+
+// estimateTokens approximates the tokens of texts at four characters per token
+func estimateTokens(texts ...string) int {
+	return utf8.RuneCountInString(strings.Join(texts, "\n"))/4 + 1
+}
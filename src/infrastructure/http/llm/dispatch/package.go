@@ -0,0 +1,8 @@
+// Package dispatch provides a shared concurrency limiter for the LLM service.
+// This package handles:
+// - Bounding the number of LLM requests in flight
+// - Limiting the estimated tokens sent per minute
+// - Queueing callers by priority, interactive refinements ahead of background generation
+// - Giving up on a queued request when its context is cancelled
+// - Reporting queue depth for health checks
+package dispatch
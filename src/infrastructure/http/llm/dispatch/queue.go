@@ -0,0 +1,199 @@
+package dispatch
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Priority orders queued LLM requests
+type Priority int
+
+const (
+	// PriorityBackground is for work nobody is waiting on, like idea generation
+	PriorityBackground Priority = iota
+	// PriorityInteractive is for requests a user is waiting on, like refinements
+	PriorityInteractive
+)
+
+// String returns the name of the priority
+func (p Priority) String() string {
+	if p == PriorityInteractive {
+		return "interactive"
+	}
+	return "background"
+}
+
+// waiter is a request waiting for a slot
+type waiter struct {
+	priority Priority
+	weight   float64
+	element  *list.Element
+	granted  bool
+	ready    chan struct{}
+}
+
+// queue grants slots to waiters, highest priority first and in arrival order within a priority.
+// A slot needs a free in-flight place and, when tokens are limited, the waiter's weight in a
+// token bucket holding one minute of tokens.
+type queue struct {
+	mu              sync.Mutex
+	maxInFlight     int
+	tokensPerMinute float64
+	inFlight        int
+	tokens          float64
+	refilledAt      time.Time
+	waiting         [PriorityInteractive + 1]*list.List
+	timer           *time.Timer
+}
+
+// newQueue creates a queue; zero limits are unlimited
+func newQueue(maxInFlight int, tokensPerMinute int) *queue {
+	q := &queue{
+		maxInFlight:     maxInFlight,
+		tokensPerMinute: float64(tokensPerMinute),
+		tokens:          float64(tokensPerMinute),
+		refilledAt:      time.Now(),
+	}
+	for i := range q.waiting {
+		q.waiting[i] = list.New()
+	}
+	return q
+}
+
+// acquire waits for a slot for a request of weight tokens. The returned function releases
+// the slot and must be called once the request is done.
+func (q *queue) acquire(ctx context.Context, priority Priority, weight int) (func(), error) {
+	w := &waiter{priority: priority, weight: float64(weight), ready: make(chan struct{})}
+	if q.tokensPerMinute > 0 && w.weight > q.tokensPerMinute {
+		// Larger requests than a minute's budget would wait forever
+		w.weight = q.tokensPerMinute
+	}
+
+	q.mu.Lock()
+	w.element = q.waiting[priority].PushBack(w)
+	q.dispatch()
+	q.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return q.releaser(), nil
+	case <-ctx.Done():
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if w.granted {
+		// Granted while giving up: hand the slot back
+		q.inFlight--
+		if q.tokensPerMinute > 0 {
+			q.tokens = min(q.tokens+w.weight, q.tokensPerMinute)
+		}
+	} else {
+		q.waiting[priority].Remove(w.element)
+	}
+	q.dispatch()
+	return nil, fmt.Errorf("gave up waiting for an LLM slot: %w", ctx.Err())
+}
+
+// releaser returns the release function of a granted slot
+func (q *queue) releaser() func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			q.mu.Lock()
+			defer q.mu.Unlock()
+			q.inFlight--
+			q.dispatch()
+		})
+	}
+}
+
+// dispatch grants slots while the head waiter fits; the caller holds the lock.
+// Lower priorities never overtake a higher priority waiter that doesn't fit yet.
+func (q *queue) dispatch() {
+	for {
+		w := q.head()
+		if w == nil {
+			return
+		}
+		if q.maxInFlight > 0 && q.inFlight >= q.maxInFlight {
+			return
+		}
+		if q.tokensPerMinute > 0 {
+			q.refill()
+			if q.tokens < w.weight {
+				q.wakeAfter(time.Duration((w.weight - q.tokens) / q.tokensPerMinute * float64(time.Minute)))
+				return
+			}
+			q.tokens -= w.weight
+		}
+
+		q.waiting[w.priority].Remove(w.element)
+		q.inFlight++
+		w.granted = true
+		close(w.ready)
+	}
+}
+
+// head returns the next waiter to serve, or nil
+func (q *queue) head() *waiter {
+	for priority := len(q.waiting) - 1; priority >= 0; priority-- {
+		if front := q.waiting[priority].Front(); front != nil {
+			return front.Value.(*waiter)
+		}
+	}
+	return nil
+}
+
+// refill adds the tokens earned since the last refill; the caller holds the lock
+func (q *queue) refill() {
+	now := time.Now()
+	earned := now.Sub(q.refilledAt).Minutes() * q.tokensPerMinute
+	q.tokens = min(q.tokens+earned, q.tokensPerMinute)
+	q.refilledAt = now
+}
+
+// wakeAfter dispatches again once enough tokens have been earned; the caller holds the lock
+func (q *queue) wakeAfter(wait time.Duration) {
+	if q.timer != nil {
+		q.timer.Stop()
+	}
+	q.timer = time.AfterFunc(wait, func() {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		q.timer = nil
+		q.dispatch()
+	})
+}
+
+// Stats is a snapshot of the dispatcher
+type Stats struct {
+	InFlight          int
+	MaxInFlight       int
+	QueuedInteractive int
+	QueuedBackground  int
+	TokensPerMinute   int
+	AvailableTokens   int
+}
+
+// stats returns a snapshot of the queue
+func (q *queue) stats() Stats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	stats := Stats{
+		InFlight:          q.inFlight,
+		MaxInFlight:       q.maxInFlight,
+		QueuedInteractive: q.waiting[PriorityInteractive].Len(),
+		QueuedBackground:  q.waiting[PriorityBackground].Len(),
+		TokensPerMinute:   int(q.tokensPerMinute),
+	}
+	if q.tokensPerMinute > 0 {
+		q.refill()
+		stats.AvailableTokens = int(q.tokens)
+	}
+	return stats
+}
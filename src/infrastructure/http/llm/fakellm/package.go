@@ -0,0 +1,9 @@
+// Package fakellm provides a scripted stand-in for an OpenAI-compatible LLM used in
+// development and tests.
+// This package handles:
+// - Serving /v1/chat/completions from rules matched by regex or prompt hash, streamed when asked
+// - Scripted failures: malformed JSON, short lists, code fences, 429s and timeouts
+// - Recording the prompts it received
+// - Loading rules from JSON script files
+// - An httptest helper that wires the LLM client to the fake
+package fakellm
@@ -0,0 +1,103 @@
+package fakellm
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MalformedJSON is a truncated JSON object, as returned when a model stops mid-answer
+const MalformedJSON = `{"posts": ["El primer post quedó a medias`
+
+// IdeasJSON returns an ideas response as expected by idea generation
+func IdeasJSON(ideas ...string) string {
+	return mustJSON(map[string][]string{"ideas": orEmpty(ideas)})
+}
+
+// DraftsJSON returns a drafts response as expected by draft generation
+func DraftsJSON(posts []string, articles []string) string {
+	return mustJSON(map[string][]string{"posts": orEmpty(posts), "articles": orEmpty(articles)})
+}
+
+// orEmpty encodes nil lists as [] rather than null, as models do
+func orEmpty(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// RefinedJSON returns a refinement response
+func RefinedJSON(refined string) string {
+	return mustJSON(map[string]string{"refined": refined})
+}
+
+// CodeFenced wraps content in a markdown json code fence, as chat models often do
+func CodeFenced(content string) string {
+	return "```json\n" + content + "\n```"
+}
+
+// SampleIdeas returns n distinct ideas
+func SampleIdeas(n int) []string {
+	ideas := make([]string, n)
+	for i := range ideas {
+		ideas[i] = fmt.Sprintf("Idea de ejemplo %d: cómo aplicar lo aprendido en proyectos reales", i+1)
+	}
+	return ideas
+}
+
+// SamplePosts returns n distinct posts long enough to be valid drafts
+func SamplePosts(n int) []string {
+	posts := make([]string, n)
+	for i := range posts {
+		posts[i] = fmt.Sprintf("Post de ejemplo %d. Compartir lo aprendido en público acelera el aprendizaje de todo el equipo.", i+1)
+	}
+	return posts
+}
+
+// SampleArticles returns n distinct articles long enough to be valid drafts
+func SampleArticles(n int) []string {
+	articles := make([]string, n)
+	for i := range articles {
+		articles[i] = fmt.Sprintf("Artículo de ejemplo %d\n\n%s", i+1,
+			strings.Repeat("Documentar las decisiones técnicas evita repetir los mismos debates cada trimestre. ", 3))
+	}
+	return articles
+}
+
+// DefaultRules answer the built-in refinement (JSON and streamed), drafts and ideas prompts with valid content.
+// They are meant as a fallback after more specific rules.
+func DefaultRules() []Rule {
+	return []Rule{
+		{
+			Name:     "default-refinement-stream",
+			Pattern:  regexp.MustCompile(`Return ONLY the refined draft text`),
+			Response: Response{Content: "Versión refinada del borrador, más clara y directa para la audiencia de LinkedIn."},
+		},
+		{
+			Name:     "default-refinement",
+			Pattern:  regexp.MustCompile(`"refined"`),
+			Response: Response{Content: RefinedJSON("Versión refinada del borrador, más clara y directa para la audiencia de LinkedIn.")},
+		},
+		{
+			Name:     "default-drafts",
+			Pattern:  regexp.MustCompile(`"posts"`),
+			Response: Response{Content: DraftsJSON(SamplePosts(5), SampleArticles(1))},
+		},
+		{
+			Name:     "default-ideas",
+			Pattern:  regexp.MustCompile(`"ideas"`),
+			Response: Response{Content: IdeasJSON(SampleIdeas(5)...)},
+		},
+	}
+}
+
+// mustJSON marshals values that cannot fail to encode
+func mustJSON(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Sprintf("failed to marshal canned response: %v", err))
+	}
+	return string(data)
+}
@@ -0,0 +1,100 @@
+package fakellm
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+)
+
+// Script is the JSON file format loaded by LoadScript
+type Script struct {
+	Rules []ScriptRule `json:"rules"`
+}
+
+// ScriptRule is the JSON form of a Rule.
+// ContentFile is read relative to the script file.
+type ScriptRule struct {
+	Name              string `json:"name"`
+	Match             string `json:"match"`
+	PromptHash        string `json:"prompt_hash"`
+	Content           string `json:"content"`
+	ContentFile       string `json:"content_file"`
+	CodeFenced        bool   `json:"code_fenced"`
+	Status            int    `json:"status"`
+	RetryAfterSeconds int    `json:"retry_after_seconds"`
+	LatencyMS         int    `json:"latency_ms"`
+	FinishReason      string `json:"finish_reason"`
+	Times             int    `json:"times"`
+}
+
+// LoadScript reads rules from a JSON script file
+func LoadScript(path string) ([]Rule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read script: %w", err)
+	}
+
+	var script Script
+	if err := json.Unmarshal(data, &script); err != nil {
+		return nil, fmt.Errorf("failed to parse script %s: %w", path, err)
+	}
+
+	rules := make([]Rule, 0, len(script.Rules))
+	for i, scripted := range script.Rules {
+		rule, err := scripted.toRule(filepath.Dir(path))
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule %d in %s: %w", i+1, path, err)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// toRule converts the JSON form into a Rule, resolving content files against baseDir
+func (s ScriptRule) toRule(baseDir string) (Rule, error) {
+	rule := Rule{
+		Name:       s.Name,
+		PromptHash: s.PromptHash,
+		Times:      s.Times,
+		Response: Response{
+			Content:      s.Content,
+			Status:       s.Status,
+			RetryAfter:   time.Duration(s.RetryAfterSeconds) * time.Second,
+			Latency:      time.Duration(s.LatencyMS) * time.Millisecond,
+			FinishReason: s.FinishReason,
+		},
+	}
+
+	if s.Match != "" {
+		pattern, err := regexp.Compile(s.Match)
+		if err != nil {
+			return Rule{}, fmt.Errorf("invalid match pattern: %w", err)
+		}
+		rule.Pattern = pattern
+	}
+
+	if s.ContentFile != "" {
+		if baseDir == "" {
+			return Rule{}, fmt.Errorf("content_file is only supported in script files")
+		}
+		data, err := os.ReadFile(filepath.Join(baseDir, s.ContentFile))
+		if err != nil {
+			return Rule{}, fmt.Errorf("failed to read content file: %w", err)
+		}
+		rule.Response.Content = string(data)
+	}
+
+	if s.CodeFenced {
+		rule.Response.Content = CodeFenced(rule.Response.Content)
+	}
+
+	if s.Status != 0 && (s.Status < 200 || s.Status > 599) {
+		return Rule{}, fmt.Errorf("status must be a valid HTTP status code")
+	}
+
+	return rule, nil
+}
@@ -0,0 +1,411 @@
+package fakellm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm"
+)
+
+const (
+	// CompletionsPath is the path of the chat completions endpoint
+	CompletionsPath = "/v1/chat/completions"
+	// AdminPrefix is the path prefix of the endpoints that control the fake itself
+	AdminPrefix = "/_fake"
+)
+
+// Response is what a rule answers with
+type Response struct {
+	// Content is returned as the assistant message
+	Content string
+	// Status, when not 2xx, returns an OpenAI style error instead of Content
+	Status int
+	// RetryAfter is sent as the Retry-After header, rounded up to whole seconds
+	RetryAfter time.Duration
+	// Latency delays the response; longer than the client timeout simulates a timeout
+	Latency time.Duration
+	// FinishReason defaults to "stop"
+	FinishReason string
+}
+
+// Rule maps matching prompts to a canned response.
+// A rule with neither Pattern nor PromptHash matches every prompt.
+type Rule struct {
+	// Name identifies the rule in the request log
+	Name string
+	// Pattern matches anywhere in the prompt
+	Pattern *regexp.Regexp
+	// PromptHash matches the exact prompt, see PromptHash
+	PromptHash string
+	Response   Response
+	// Times is how many requests the rule answers; zero answers every match
+	Times int
+}
+
+// newRule copies a rule, naming it after what it matches when unnamed
+func newRule(rule Rule) *Rule {
+	if rule.Name == "" {
+		switch {
+		case rule.PromptHash != "":
+			rule.Name = "hash:" + rule.PromptHash
+		case rule.Pattern != nil:
+			rule.Name = "pattern:" + rule.Pattern.String()
+		default:
+			rule.Name = "catch-all"
+		}
+	}
+	return &rule
+}
+
+// matches checks if the rule applies to a prompt
+func (r *Rule) matches(prompt, hash string) bool {
+	if r.PromptHash != "" && r.PromptHash != hash {
+		return false
+	}
+	if r.Pattern != nil && !r.Pattern.MatchString(prompt) {
+		return false
+	}
+	return true
+}
+
+// Request represents a chat completion request received by the fake
+type Request struct {
+	Model       string        `json:"model"`
+	Messages    []llm.Message `json:"messages"`
+	Temperature *float64      `json:"temperature,omitempty"`
+	MaxTokens   int           `json:"max_tokens,omitempty"`
+	Stop        []string      `json:"stop,omitempty"`
+	Prompt      string        `json:"prompt"`
+	PromptHash  string        `json:"prompt_hash"`
+	// ResponseFormat is the structured output the client asked for, if any
+	ResponseFormat *llm.ResponseFormat `json:"response_format,omitempty"`
+	Stream         bool                `json:"stream,omitempty"`
+	// Rule is the name of the rule that answered, empty when nothing matched
+	Rule       string    `json:"rule,omitempty"`
+	ReceivedAt time.Time `json:"received_at"`
+}
+
+// Options holds configuration for the fake
+type Options struct {
+	// Rules are matched in order before rules added later
+	Rules []Rule
+	// Latency is applied to every completion
+	Latency time.Duration
+}
+
+// Server is a scripted OpenAI-compatible chat completions API implementing http.Handler
+type Server struct {
+	router  *mux.Router
+	options Options
+
+	mu       sync.Mutex
+	rules    []*Rule
+	requests []Request
+	nextID   int64
+}
+
+// New creates a new fake LLM API
+func New(options Options) *Server {
+	s := &Server{options: options}
+	s.resetLocked()
+
+	router := mux.NewRouter()
+	router.HandleFunc(CompletionsPath, s.handleCompletion).Methods(http.MethodPost)
+	router.HandleFunc("/health", s.handleHealth).Methods(http.MethodGet)
+	router.HandleFunc(AdminPrefix+"/requests", s.handleAdminRequests).Methods(http.MethodGet)
+	router.HandleFunc(AdminPrefix+"/rules", s.handleAdminAddRule).Methods(http.MethodPost)
+	router.HandleFunc(AdminPrefix+"/rules", s.handleAdminClearRules).Methods(http.MethodDelete)
+	router.HandleFunc(AdminPrefix+"/reset", s.handleAdminReset).Methods(http.MethodPost)
+	s.router = router
+
+	return s
+}
+
+// ServeHTTP routes the request
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.router.ServeHTTP(w, r)
+}
+
+// AddRule appends a rule; rules are matched in the order they were added
+func (s *Server) AddRule(rule Rule) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rules = append(s.rules, newRule(rule))
+}
+
+// Respond is a shorthand for a rule answering prompts matching pattern with content
+func (s *Server) Respond(pattern string, content string) {
+	s.AddRule(Rule{Pattern: regexp.MustCompile(pattern), Response: Response{Content: content}})
+}
+
+// ClearRules removes every rule, including the ones passed in Options
+func (s *Server) ClearRules() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rules = nil
+}
+
+// Requests returns the completion requests received, oldest first
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Reset restores the rules passed in Options and clears the request log
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resetLocked()
+}
+
+// resetLocked restores the initial rules and clears the request log
+func (s *Server) resetLocked() {
+	s.rules = make([]*Rule, 0, len(s.options.Rules))
+	for _, rule := range s.options.Rules {
+		s.rules = append(s.rules, newRule(rule))
+	}
+	s.requests = nil
+}
+
+// PromptHash returns the key used to match an exact prompt: the hex SHA-256 of the
+// message contents joined by newlines
+func PromptHash(prompt string) string {
+	sum := sha256.Sum256([]byte(prompt))
+	return hex.EncodeToString(sum[:])
+}
+
+// promptOf joins the message contents into the text rules are matched against
+func promptOf(messages []llm.Message) string {
+	contents := make([]string, len(messages))
+	for i, message := range messages {
+		contents[i] = message.Content
+	}
+	return strings.Join(contents, "\n")
+}
+
+// match records the request and consumes the first rule matching it
+func (s *Server) match(req Request) (*Response, string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	defer func() { s.requests = append(s.requests, req) }()
+
+	for i, rule := range s.rules {
+		if !rule.matches(req.Prompt, req.PromptHash) {
+			continue
+		}
+
+		response := rule.Response
+		if rule.Times > 0 {
+			rule.Times--
+			if rule.Times == 0 {
+				s.rules = append(s.rules[:i], s.rules[i+1:]...)
+			}
+		}
+
+		req.Rule = rule.Name
+		s.nextID++
+		return &response, "chatcmpl-fake-" + strconv.FormatInt(s.nextID, 10)
+	}
+
+	return nil, ""
+}
+
+// completionResponse represents an OpenAI chat completion
+type completionResponse struct {
+	ID      string             `json:"id"`
+	Object  string             `json:"object"`
+	Created int64              `json:"created"`
+	Model   string             `json:"model"`
+	Choices []completionChoice `json:"choices"`
+	Usage   completionUsage    `json:"usage"`
+}
+
+// completionChoice represents a single completion choice
+type completionChoice struct {
+	Index        int         `json:"index"`
+	Message      llm.Message `json:"message"`
+	FinishReason string      `json:"finish_reason"`
+}
+
+// completionUsage reports approximate token counts
+type completionUsage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
+// handleCompletion handles POST /v1/chat/completions
+func (s *Server) handleCompletion(w http.ResponseWriter, r *http.Request) {
+	var body llm.LLMRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request_error", "invalid_json", "request body is not valid JSON")
+		return
+	}
+	if len(body.Messages) == 0 {
+		writeError(w, http.StatusBadRequest, "invalid_request_error", "missing_messages", "messages must not be empty")
+		return
+	}
+
+	prompt := promptOf(body.Messages)
+	response, id := s.match(Request{
+		Model:          body.Model,
+		Messages:       body.Messages,
+		Temperature:    body.Temperature,
+		MaxTokens:      body.MaxTokens,
+		Stop:           body.Stop,
+		Prompt:         prompt,
+		PromptHash:     PromptHash(prompt),
+		ResponseFormat: body.ResponseFormat,
+		Stream:         body.Stream,
+		ReceivedAt:     time.Now(),
+	})
+
+	latency := s.options.Latency
+	if response != nil {
+		latency += response.Latency
+	}
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	if response == nil {
+		writeError(w, http.StatusNotFound, "invalid_request_error", "no_scripted_response", "no scripted response matches the prompt")
+		return
+	}
+
+	if response.Status != 0 && (response.Status < 200 || response.Status > 299) {
+		if response.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(response.RetryAfter.Seconds()))))
+		}
+		writeError(w, response.Status, errorType(response.Status), "scripted_error", "scripted failure: "+http.StatusText(response.Status))
+		return
+	}
+
+	finishReason := response.FinishReason
+	if finishReason == "" {
+		finishReason = "stop"
+	}
+
+	promptTokens, completionTokens := estimateTokens(prompt), estimateTokens(response.Content)
+	usage := completionUsage{
+		PromptTokens:     promptTokens,
+		CompletionTokens: completionTokens,
+		TotalTokens:      promptTokens + completionTokens,
+	}
+
+	if body.Stream {
+		if body.StreamOptions == nil || !body.StreamOptions.IncludeUsage {
+			usage = completionUsage{}
+		}
+		writeStream(w, id, body.Model, response.Content, finishReason, usage)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, completionResponse{
+		ID:      id,
+		Object:  "chat.completion",
+		Created: time.Now().Unix(),
+		Model:   body.Model,
+		Choices: []completionChoice{{
+			Message:      llm.Message{Role: "assistant", Content: response.Content},
+			FinishReason: finishReason,
+		}},
+		Usage: usage,
+	})
+}
+
+// handleHealth handles GET /health
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// handleAdminRequests handles GET /_fake/requests
+func (s *Server) handleAdminRequests(w http.ResponseWriter, r *http.Request) {
+	requests := s.Requests()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"requests": requests,
+		"count":    len(requests),
+	})
+}
+
+// handleAdminAddRule handles POST /_fake/rules with a single script rule
+func (s *Server) handleAdminAddRule(w http.ResponseWriter, r *http.Request) {
+	var scripted ScriptRule
+	if err := json.NewDecoder(r.Body).Decode(&scripted); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request_error", "invalid_json", "request body is not valid JSON")
+		return
+	}
+
+	rule, err := scripted.toRule("")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request_error", "invalid_rule", err.Error())
+		return
+	}
+
+	s.AddRule(rule)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleAdminClearRules handles DELETE /_fake/rules
+func (s *Server) handleAdminClearRules(w http.ResponseWriter, r *http.Request) {
+	s.ClearRules()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleAdminReset handles POST /_fake/reset
+func (s *Server) handleAdminReset(w http.ResponseWriter, r *http.Request) {
+	s.Reset()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// estimateTokens approximates a token count at four characters per token
+func estimateTokens(text string) int {
+	return (len(text) + 3) / 4
+}
+
+// errorType returns the OpenAI error type for a status
+func errorType(status int) string {
+	switch {
+	case status == http.StatusTooManyRequests:
+		return "rate_limit_error"
+	case status == http.StatusUnauthorized:
+		return "authentication_error"
+	case status >= 500:
+		return "server_error"
+	default:
+		return "invalid_request_error"
+	}
+}
+
+// writeError writes an error in the OpenAI format
+func writeError(w http.ResponseWriter, status int, errType, code, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"error": map[string]string{
+			"message": message,
+			"type":    errType,
+			"code":    code,
+		},
+	})
+}
+
+// writeJSON writes a JSON response
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,61 @@
+package fakellm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// streamChunk represents one event of an OpenAI streamed chat completion
+type streamChunk struct {
+	ID      string              `json:"id"`
+	Object  string              `json:"object"`
+	Created int64               `json:"created"`
+	Model   string              `json:"model"`
+	Choices []streamChunkChoice `json:"choices"`
+	Usage   *completionUsage    `json:"usage,omitempty"`
+}
+
+// streamChunkChoice represents the delta of a choice in a streamed chunk
+type streamChunkChoice struct {
+	Index        int               `json:"index"`
+	Delta        map[string]string `json:"delta"`
+	FinishReason *string           `json:"finish_reason"`
+}
+
+// writeStream answers with content as Server-Sent Events, one word per chunk, like
+// OpenAI: a role chunk, the content chunks, a finish chunk, a usage chunk when usage
+// has counts, then [DONE]
+func writeStream(w http.ResponseWriter, id, model, content, finishReason string, usage completionUsage) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	flusher, _ := w.(http.Flusher)
+	created := time.Now().Unix()
+	send := func(choices []streamChunkChoice, usage *completionUsage) {
+		data, _ := json.Marshal(streamChunk{ID: id, Object: "chat.completion.chunk", Created: created, Model: model, Choices: choices, Usage: usage})
+		fmt.Fprintf(w, "data: %s\n\n", data)
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+
+	send([]streamChunkChoice{{Delta: map[string]string{"role": "assistant"}}}, nil)
+	for _, word := range strings.SplitAfter(content, " ") {
+		if word != "" {
+			send([]streamChunkChoice{{Delta: map[string]string{"content": word}}}, nil)
+		}
+	}
+	send([]streamChunkChoice{{Delta: map[string]string{}, FinishReason: &finishReason}}, nil)
+	if usage.TotalTokens > 0 {
+		send([]streamChunkChoice{}, &usage)
+	}
+
+	fmt.Fprint(w, "data: [DONE]\n\n")
+	if flusher != nil {
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,50 @@
+package fakellm
+
+import (
+	"net/http/httptest"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm"
+)
+
+// TestServer runs the fake on a local loopback listener for tests
+type TestServer struct {
+	*Server
+	httpServer *httptest.Server
+}
+
+// NewTestServer starts a fake LLM API; callers must Close it
+func NewTestServer(options Options) *TestServer {
+	server := New(options)
+	return &TestServer{
+		Server:     server,
+		httpServer: httptest.NewServer(server),
+	}
+}
+
+// URL returns the base URL of the fake
+func (ts *TestServer) URL() string {
+	return ts.httpServer.URL
+}
+
+// ClientConfig returns an LLM client configuration pointing at the fake
+func (ts *TestServer) ClientConfig() llm.Config {
+	return llm.Config{
+		BaseURL: ts.URL(),
+		Timeout: 5 * time.Second,
+		Model:   "fake-model",
+	}
+}
+
+// FastRetryConfig retries like the client does by default, without the backoff delays
+func FastRetryConfig() llm.RetryConfig {
+	config := llm.DefaultRetryConfig()
+	config.InitialDelay = time.Millisecond
+	config.MaxDelay = time.Millisecond
+	return config
+}
+
+// Close shuts down the listener
+func (ts *TestServer) Close() {
+	ts.httpServer.Close()
+}
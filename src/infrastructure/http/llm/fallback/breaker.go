@@ -0,0 +1,150 @@
+package fallback
+
+import (
+	"sync"
+	"time"
+)
+
+// State is the state of a circuit breaker
+type State string
+
+const (
+	// StateClosed lets every call through
+	StateClosed State = "closed"
+	// StateOpen rejects calls until the cooldown elapses
+	StateOpen State = "open"
+	// StateHalfOpen lets a single trial call through to decide whether to close again
+	StateHalfOpen State = "half-open"
+)
+
+// BreakerConfig holds configuration for a circuit breaker
+type BreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures that opens the breaker
+	FailureThreshold int
+	// Cooldown is how long the breaker stays open before allowing a trial call
+	Cooldown time.Duration
+	// Now overrides the clock, for tests
+	Now func() time.Time
+}
+
+// DefaultBreakerConfig returns default circuit breaker configuration
+func DefaultBreakerConfig() BreakerConfig {
+	return BreakerConfig{
+		FailureThreshold: 5,
+		Cooldown:         30 * time.Second,
+	}
+}
+
+// BreakerStatus is a snapshot of a circuit breaker
+type BreakerStatus struct {
+	Backend             string
+	State               State
+	ConsecutiveFailures int
+	OpenedAt            time.Time
+	LastError           string
+}
+
+// CircuitBreaker tracks consecutive failures of one backend
+type CircuitBreaker struct {
+	config BreakerConfig
+
+	mu        sync.Mutex
+	state     State
+	failures  int
+	openedAt  time.Time
+	trialing  bool
+	lastError string
+}
+
+// NewCircuitBreaker creates a closed circuit breaker
+func NewCircuitBreaker(config BreakerConfig) *CircuitBreaker {
+	defaults := DefaultBreakerConfig()
+	if config.FailureThreshold <= 0 {
+		config.FailureThreshold = defaults.FailureThreshold
+	}
+	if config.Cooldown <= 0 {
+		config.Cooldown = defaults.Cooldown
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+
+	return &CircuitBreaker{
+		config: config,
+		state:  StateClosed,
+	}
+}
+
+// Allow reports whether a call may proceed.
+// Once the cooldown has elapsed, an open breaker turns half-open and lets one trial call through.
+func (b *CircuitBreaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case StateOpen:
+		if b.config.Now().Sub(b.openedAt) < b.config.Cooldown {
+			return false
+		}
+		b.state = StateHalfOpen
+		b.trialing = true
+		return true
+	case StateHalfOpen:
+		if b.trialing {
+			return false
+		}
+		b.trialing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// RecordSuccess closes the breaker
+func (b *CircuitBreaker) RecordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = StateClosed
+	b.failures = 0
+	b.trialing = false
+	b.lastError = ""
+}
+
+// RecordFailure counts a failure, opening the breaker at the threshold or when a trial call fails
+func (b *CircuitBreaker) RecordFailure(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	if err != nil {
+		b.lastError = err.Error()
+	}
+
+	if b.state == StateHalfOpen || b.failures >= b.config.FailureThreshold {
+		b.state = StateOpen
+		b.openedAt = b.config.Now()
+	}
+	b.trialing = false
+}
+
+// Release ends a call that neither succeeded nor failed, such as one canceled by the caller
+func (b *CircuitBreaker) Release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.trialing = false
+}
+
+// Status returns a snapshot of the breaker
+func (b *CircuitBreaker) Status() BreakerStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return BreakerStatus{
+		State:               b.state,
+		ConsecutiveFailures: b.failures,
+		OpenedAt:            b.openedAt,
+		LastError:           b.lastError,
+	}
+}
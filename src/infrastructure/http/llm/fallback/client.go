@@ -0,0 +1,245 @@
+package fallback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/structured"
+)
+
+var (
+	// ErrNoBackends indicates the client was created without backends
+	ErrNoBackends = errors.New("at least one LLM backend is required")
+	// ErrAllBackendsUnavailable indicates every backend failed or has an open circuit breaker
+	ErrAllBackendsUnavailable = errors.New("all LLM backends are unavailable")
+	// ErrInvalidJSON indicates a backend answered a raw request with something that isn't JSON
+	ErrInvalidJSON = errors.New("LLM returned invalid JSON")
+)
+
+// Backend is one provider/model in the fallback chain
+type Backend struct {
+	// Name identifies the backend in health checks and errors, e.g. "openai/gpt-4o"
+	Name    string
+	Service interfaces.LLMService
+}
+
+// Config holds configuration for the fallback client
+type Config struct {
+	Breaker BreakerConfig
+	// RequireJSON counts SendRequest responses without a JSON document as failures,
+	// since every prompt in the application asks for JSON
+	RequireJSON bool
+}
+
+// backend pairs a Backend with its circuit breaker
+type backend struct {
+	Backend
+	breaker *CircuitBreaker
+}
+
+// Client is an LLMService that tries its backends in order, skipping those with an open breaker
+type Client struct {
+	backends    []*backend
+	requireJSON bool
+}
+
+// NewClient creates a fallback client; backends are tried in the given order
+func NewClient(backends []Backend, config Config) (*Client, error) {
+	if len(backends) == 0 {
+		return nil, ErrNoBackends
+	}
+
+	client := &Client{requireJSON: config.RequireJSON}
+	for i, b := range backends {
+		if b.Service == nil {
+			return nil, fmt.Errorf("LLM backend %d has no service", i+1)
+		}
+		if b.Name == "" {
+			b.Name = fmt.Sprintf("backend-%d", i+1)
+		}
+		client.backends = append(client.backends, &backend{Backend: b, breaker: NewCircuitBreaker(config.Breaker)})
+	}
+
+	return client, nil
+}
+
+// Status returns the circuit breaker state of every backend, in fallback order
+func (c *Client) Status() []BreakerStatus {
+	statuses := make([]BreakerStatus, 0, len(c.backends))
+	for _, b := range c.backends {
+		status := b.breaker.Status()
+		status.Backend = b.Name
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// SendRequest implements LLMService.SendRequest
+func (c *Client) SendRequest(ctx context.Context, prompt string) (string, error) {
+	return c.send(ctx, func(service interfaces.LLMService) (string, error) {
+		return service.SendRequest(ctx, prompt)
+	})
+}
+
+// SendPromptRequest implements LLMService.SendPromptRequest
+func (c *Client) SendPromptRequest(ctx context.Context, req interfaces.PromptRequest) (string, error) {
+	return c.send(ctx, func(service interfaces.LLMService) (string, error) {
+		return service.SendPromptRequest(ctx, req)
+	})
+}
+
+// send makes a raw request through the backends, treating a response without JSON as a
+// failure when JSON is required
+func (c *Client) send(ctx context.Context, fn func(service interfaces.LLMService) (string, error)) (string, error) {
+	var response string
+	err := c.call(ctx, func(service interfaces.LLMService) error {
+		result, err := fn(service)
+		if err != nil {
+			return err
+		}
+		if c.requireJSON && !containsJSON(result) {
+			return ErrInvalidJSON
+		}
+		response = result
+		return nil
+	})
+	return response, err
+}
+
+// GenerateIdeas implements LLMService.GenerateIdeas
+func (c *Client) GenerateIdeas(ctx context.Context, topic string, count int) ([]string, error) {
+	var ideas []string
+	err := c.call(ctx, func(service interfaces.LLMService) error {
+		result, err := service.GenerateIdeas(ctx, topic, count)
+		ideas = result
+		return err
+	})
+	return ideas, err
+}
+
+// GenerateDrafts implements LLMService.GenerateDrafts
+func (c *Client) GenerateDrafts(ctx context.Context, idea string, userContext string) (interfaces.DraftSet, error) {
+	var drafts interfaces.DraftSet
+	err := c.call(ctx, func(service interfaces.LLMService) error {
+		result, err := service.GenerateDrafts(ctx, idea, userContext)
+		drafts = result
+		return err
+	})
+	return drafts, err
+}
+
+// RefineDraft implements LLMService.RefineDraft
+func (c *Client) RefineDraft(ctx context.Context, draft string, userPrompt string, history []string) (string, error) {
+	var refined string
+	err := c.call(ctx, func(service interfaces.LLMService) error {
+		result, err := service.RefineDraft(ctx, draft, userPrompt, history)
+		refined = result
+		return err
+	})
+	return refined, err
+}
+
+// StreamRequest implements StreamingLLMService.StreamRequest.
+// RequireJSON doesn't apply: streams are read as they arrive, before they could be checked.
+func (c *Client) StreamRequest(ctx context.Context, prompt string, onChunk interfaces.LLMStreamHandler) (string, error) {
+	var response string
+	err := c.stream(ctx, onChunk, func(service interfaces.StreamingLLMService, onChunk interfaces.LLMStreamHandler) error {
+		result, err := service.StreamRequest(ctx, prompt, onChunk)
+		response = result
+		return err
+	})
+	return response, err
+}
+
+// RefineDraftStream implements StreamingLLMService.RefineDraftStream
+func (c *Client) RefineDraftStream(ctx context.Context, draft string, userPrompt string, history []string, onChunk interfaces.LLMStreamHandler) (string, error) {
+	var refined string
+	err := c.stream(ctx, onChunk, func(service interfaces.StreamingLLMService, onChunk interfaces.LLMStreamHandler) error {
+		result, err := service.RefineDraftStream(ctx, draft, userPrompt, history, onChunk)
+		refined = result
+		return err
+	})
+	return refined, err
+}
+
+// stream runs fn like call, but only fails over while nothing has been streamed:
+// the caller can't take back the chunks of a backend that fails mid-stream
+func (c *Client) stream(ctx context.Context, onChunk interfaces.LLMStreamHandler, fn func(service interfaces.StreamingLLMService, onChunk interfaces.LLMStreamHandler) error) error {
+	streamed := false
+	forward := func(chunk string) error {
+		streamed = true
+		return onChunk(chunk)
+	}
+
+	return c.try(ctx, func() bool { return streamed }, func(service interfaces.LLMService) error {
+		return fn(interfaces.Streaming(service), forward)
+	})
+}
+
+// call runs fn against each available backend until one succeeds.
+// Invalid requests and caller cancellations are returned straight away without touching the breakers.
+func (c *Client) call(ctx context.Context, fn func(service interfaces.LLMService) error) error {
+	return c.try(ctx, nil, fn)
+}
+
+// try implements call; when committed is set and reports true after a failure,
+// the failure is returned instead of moving on to the next backend
+func (c *Client) try(ctx context.Context, committed func() bool, fn func(service interfaces.LLMService) error) error {
+	var failures []string
+	var lastErr error
+
+	for _, b := range c.backends {
+		if !b.breaker.Allow() {
+			failures = append(failures, b.Name+": circuit open")
+			continue
+		}
+
+		err := fn(b.Service)
+		if err == nil {
+			b.breaker.RecordSuccess()
+			return nil
+		}
+
+		if ctx.Err() != nil || errors.Is(err, llm.ErrInvalidRequest) {
+			b.breaker.Release()
+			return err
+		}
+
+		b.breaker.RecordFailure(err)
+		if committed != nil && committed() {
+			return err
+		}
+		failures = append(failures, fmt.Sprintf("%s: %v", b.Name, err))
+		lastErr = err
+	}
+
+	return &unavailableError{summary: strings.Join(failures, "; "), last: lastErr}
+}
+
+// unavailableError lists what happened on each backend and wraps the last backend error
+type unavailableError struct {
+	summary string
+	last    error
+}
+
+func (e *unavailableError) Error() string {
+	return fmt.Sprintf("%v: %s", ErrAllBackendsUnavailable, e.summary)
+}
+
+func (e *unavailableError) Unwrap() []error {
+	if e.last == nil {
+		return []error{ErrAllBackendsUnavailable}
+	}
+	return []error{ErrAllBackendsUnavailable, e.last}
+}
+
+// containsJSON checks for a JSON document, allowing the code fences, surrounding prose and
+// trailing commas that structured.Extract cleans up
+func containsJSON(response string) bool {
+	_, err := structured.Extract(response)
+	return err == nil
+}
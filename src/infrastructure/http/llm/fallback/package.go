@@ -0,0 +1,8 @@
+// Package fallback provides an LLM service that fails over across an ordered chain of backends.
+// This package handles:
+// - Trying provider/model backends in order until one succeeds
+// - A circuit breaker per backend (closed, open, half-open)
+// - Counting invalid JSON responses as backend failures
+// - Failing over streams only until their first chunk has been delivered
+// - Reporting breaker state for health checks
+package fallback
@@ -1,6 +1,8 @@
 // Package llm provides HTTP client implementation for LLM service communication.
 // This package handles:
 // - HTTP communication with local LLM service
+// - Provider adapters for the OpenAI, Anthropic Messages and Ollama chat formats
+// - Streaming chat completions over Server-Sent Events
 // - Automatic retry with exponential backoff
 // - Timeout management
 // - Error handling and validation
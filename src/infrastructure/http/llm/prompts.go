@@ -69,6 +69,26 @@ VERIFICACIÓN FINAL: Antes de responder, verifica que:
 func BuildRefinementPrompt(draft string, userPrompt string, history []string) string {
 	var sb strings.Builder
 
+	writeRefinementRequest(&sb, draft, userPrompt, history)
+	sb.WriteString("Return ONLY a JSON object with this exact format:\n")
+	sb.WriteString(`{"refined": "refined draft content here"}`)
+
+	return sb.String()
+}
+
+// BuildRefinementStreamPrompt generates a refinement prompt whose answer is the refined
+// draft itself, so it can be shown to the user as it streams in
+func BuildRefinementStreamPrompt(draft string, userPrompt string, history []string) string {
+	var sb strings.Builder
+
+	writeRefinementRequest(&sb, draft, userPrompt, history)
+	sb.WriteString("Return ONLY the refined draft text, without JSON, quotes, headings or comments about the changes.")
+
+	return sb.String()
+}
+
+// writeRefinementRequest writes the part of the refinement prompts before the output format
+func writeRefinementRequest(sb *strings.Builder, draft string, userPrompt string, history []string) {
 	sb.WriteString("You are an expert LinkedIn content editor. Refine the following draft based on user feedback.\n\n")
 	sb.WriteString(fmt.Sprintf("Current Draft:\n%s\n\n", draft))
 
@@ -86,8 +106,4 @@ func BuildRefinementPrompt(draft string, userPrompt string, history []string) st
 	sb.WriteString("- Maintain professional tone\n")
 	sb.WriteString("- Keep the core message intact\n")
 	sb.WriteString("- Improve clarity and engagement\n\n")
-	sb.WriteString("Return ONLY a JSON object with this exact format:\n")
-	sb.WriteString(`{"refined": "refined draft content here"}`)
-
-	return sb.String()
 }
@@ -0,0 +1,361 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Supported provider names, selected through Config.Provider
+const (
+	ProviderOpenAI    = "openai"
+	ProviderAnthropic = "anthropic"
+	ProviderOllama    = "ollama"
+)
+
+// AnthropicVersion is the Messages API version sent in the anthropic-version header
+const AnthropicVersion = "2023-06-01"
+
+// Completion is the provider-neutral result of a chat request
+type Completion struct {
+	Content      string
+	FinishReason string
+	Usage        TokenUsage
+}
+
+// TokenUsage holds the token counts reported by the provider
+type TokenUsage struct {
+	PromptTokens     int
+	CompletionTokens int
+}
+
+// Provider adapts the client to the wire format of one LLM API
+type Provider interface {
+	// Name returns the provider name
+	Name() string
+	// NewRequest builds an authenticated HTTP request for a chat request; it is called once
+	// per attempt. apiKey replaces the configured key when set.
+	NewRequest(ctx context.Context, req LLMRequest, apiKey string) (*http.Request, error)
+	// ParseResponse extracts the completion from a successful response body
+	ParseResponse(body []byte) (Completion, error)
+	// ParseError maps an error response body into an APIError
+	ParseError(statusCode int, body []byte) *APIError
+}
+
+// NewProvider creates the provider adapter for a name; an empty name selects OpenAI
+func NewProvider(name, baseURL, apiKey string) (Provider, error) {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", ProviderOpenAI:
+		return &openAIProvider{baseURL: baseURL, apiKey: apiKey}, nil
+	case ProviderAnthropic:
+		return &anthropicProvider{baseURL: baseURL, apiKey: apiKey}, nil
+	case ProviderOllama:
+		return &ollamaProvider{baseURL: baseURL, apiKey: apiKey}, nil
+	default:
+		return nil, fmt.Errorf("unsupported LLM provider %q", name)
+	}
+}
+
+// requestAPIKey returns the per-call key when set, or the configured one
+func requestAPIKey(key, configured string) string {
+	if key != "" {
+		return key
+	}
+	return configured
+}
+
+// newJSONRequest creates a POST request with a JSON body
+func newJSONRequest(ctx context.Context, endpoint string, body interface{}) (*http.Request, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return req, nil
+}
+
+// fallbackAPIError builds an APIError from a body that isn't in the provider's error format
+func fallbackAPIError(statusCode int, body []byte) *APIError {
+	message := strings.TrimSpace(string(body))
+	if message == "" {
+		message = http.StatusText(statusCode)
+	}
+	return &APIError{StatusCode: statusCode, Message: message}
+}
+
+// openAIProvider speaks the OpenAI chat completions format, also used by most local proxies
+type openAIProvider struct {
+	baseURL string
+	apiKey  string
+}
+
+func (p *openAIProvider) Name() string {
+	return ProviderOpenAI
+}
+
+func (p *openAIProvider) NewRequest(ctx context.Context, req LLMRequest, apiKey string) (*http.Request, error) {
+	httpReq, err := newJSONRequest(ctx, p.baseURL+"/v1/chat/completions", req)
+	if err != nil {
+		return nil, err
+	}
+	if key := requestAPIKey(apiKey, p.apiKey); key != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+key)
+	}
+	return httpReq, nil
+}
+
+func (p *openAIProvider) ParseResponse(body []byte) (Completion, error) {
+	var resp LLMResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return Completion{}, fmt.Errorf("failed to parse LLM response: %w", err)
+	}
+
+	if resp.Error != nil {
+		return Completion{}, fmt.Errorf("LLM API error: %s", resp.Error.Message)
+	}
+
+	if len(resp.Choices) == 0 {
+		return Completion{}, fmt.Errorf("LLM returned no choices")
+	}
+
+	completion := Completion{
+		Content:      resp.Choices[0].Message.Content,
+		FinishReason: resp.Choices[0].FinishReason,
+	}
+	if resp.Usage != nil {
+		completion.Usage = TokenUsage{
+			PromptTokens:     resp.Usage.PromptTokens,
+			CompletionTokens: resp.Usage.CompletionTokens,
+		}
+	}
+	return completion, nil
+}
+
+func (p *openAIProvider) ParseError(statusCode int, body []byte) *APIError {
+	// OpenAI nests the error; some proxies return it at the top level
+	var nested struct {
+		Error *APIError `json:"error"`
+	}
+	if json.Unmarshal(body, &nested) == nil && nested.Error != nil && nested.Error.Message != "" {
+		nested.Error.StatusCode = statusCode
+		return nested.Error
+	}
+
+	var flat APIError
+	if json.Unmarshal(body, &flat) == nil && flat.Message != "" {
+		flat.StatusCode = statusCode
+		return &flat
+	}
+
+	return fallbackAPIError(statusCode, body)
+}
+
+// anthropicProvider speaks the Anthropic Messages API
+type anthropicProvider struct {
+	baseURL string
+	apiKey  string
+}
+
+// anthropicRequest is the Messages API request body
+type anthropicRequest struct {
+	Model         string    `json:"model"`
+	System        string    `json:"system,omitempty"`
+	Messages      []Message `json:"messages"`
+	MaxTokens     int       `json:"max_tokens"`
+	Temperature   *float64  `json:"temperature,omitempty"`
+	StopSequences []string  `json:"stop_sequences,omitempty"`
+}
+
+// anthropicResponse is the Messages API response body
+type anthropicResponse struct {
+	Content []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"content"`
+	StopReason string `json:"stop_reason"`
+	Usage      struct {
+		InputTokens  int `json:"input_tokens"`
+		OutputTokens int `json:"output_tokens"`
+	} `json:"usage"`
+}
+
+// anthropicDefaultMaxTokens is sent when the request sets none, since the API requires it
+const anthropicDefaultMaxTokens = 2000
+
+func (p *anthropicProvider) Name() string {
+	return ProviderAnthropic
+}
+
+func (p *anthropicProvider) NewRequest(ctx context.Context, req LLMRequest, apiKey string) (*http.Request, error) {
+	body := anthropicRequest{
+		Model:         req.Model,
+		MaxTokens:     req.MaxTokens,
+		Temperature:   req.Temperature,
+		StopSequences: req.Stop,
+	}
+	if body.MaxTokens <= 0 {
+		body.MaxTokens = anthropicDefaultMaxTokens
+	}
+
+	// The Messages API has no response_format; responses are validated and repaired instead
+
+	// System prompts are a top-level field rather than a message role
+	var system []string
+	for _, msg := range req.Messages {
+		if msg.Role == "system" {
+			system = append(system, msg.Content)
+			continue
+		}
+		body.Messages = append(body.Messages, msg)
+	}
+	body.System = strings.Join(system, "\n\n")
+
+	httpReq, err := newJSONRequest(ctx, p.baseURL+"/v1/messages", body)
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("anthropic-version", AnthropicVersion)
+	if key := requestAPIKey(apiKey, p.apiKey); key != "" {
+		httpReq.Header.Set("x-api-key", key)
+	}
+	return httpReq, nil
+}
+
+func (p *anthropicProvider) ParseResponse(body []byte) (Completion, error) {
+	var resp anthropicResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return Completion{}, fmt.Errorf("failed to parse LLM response: %w", err)
+	}
+
+	if len(resp.Content) == 0 {
+		return Completion{}, fmt.Errorf("LLM returned no content")
+	}
+
+	var text strings.Builder
+	for _, block := range resp.Content {
+		if block.Type == "text" {
+			text.WriteString(block.Text)
+		}
+	}
+
+	return Completion{
+		Content:      text.String(),
+		FinishReason: resp.StopReason,
+		Usage:        TokenUsage{PromptTokens: resp.Usage.InputTokens, CompletionTokens: resp.Usage.OutputTokens},
+	}, nil
+}
+
+func (p *anthropicProvider) ParseError(statusCode int, body []byte) *APIError {
+	var resp struct {
+		Error struct {
+			Type    string `json:"type"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(body, &resp) == nil && resp.Error.Message != "" {
+		return &APIError{StatusCode: statusCode, Message: resp.Error.Message, Type: resp.Error.Type}
+	}
+
+	return fallbackAPIError(statusCode, body)
+}
+
+// ollamaProvider speaks the Ollama /api/chat format
+type ollamaProvider struct {
+	baseURL string
+	apiKey  string
+}
+
+// ollamaRequest is the /api/chat request body
+type ollamaRequest struct {
+	Model    string        `json:"model"`
+	Messages []Message     `json:"messages"`
+	Stream   bool          `json:"stream"`
+	Options  ollamaOptions `json:"options"`
+	// Format is the JSON schema the response must match
+	Format json.RawMessage `json:"format,omitempty"`
+}
+
+// ollamaOptions holds the model parameters of an Ollama request
+type ollamaOptions struct {
+	Temperature *float64 `json:"temperature,omitempty"`
+	NumPredict  int      `json:"num_predict,omitempty"`
+	Stop        []string `json:"stop,omitempty"`
+}
+
+// ollamaResponse is the non-streaming /api/chat response body
+type ollamaResponse struct {
+	Message    Message `json:"message"`
+	Done       bool    `json:"done"`
+	DoneReason string  `json:"done_reason"`
+	Error      string  `json:"error"`
+	// PromptEvalCount and EvalCount are the prompt and completion token counts
+	PromptEvalCount int `json:"prompt_eval_count"`
+	EvalCount       int `json:"eval_count"`
+}
+
+func (p *ollamaProvider) Name() string {
+	return ProviderOllama
+}
+
+func (p *ollamaProvider) NewRequest(ctx context.Context, req LLMRequest, apiKey string) (*http.Request, error) {
+	body := ollamaRequest{
+		Model:    req.Model,
+		Messages: req.Messages,
+		Stream:   false,
+		Options: ollamaOptions{
+			Temperature: req.Temperature,
+			NumPredict:  req.MaxTokens,
+			Stop:        req.Stop,
+		},
+	}
+	if req.ResponseFormat != nil && req.ResponseFormat.JSONSchema != nil {
+		body.Format = req.ResponseFormat.JSONSchema.Schema
+	}
+
+	httpReq, err := newJSONRequest(ctx, p.baseURL+"/api/chat", body)
+	if err != nil {
+		return nil, err
+	}
+	// Ollama itself is unauthenticated, but it is often run behind an authenticating proxy
+	if key := requestAPIKey(apiKey, p.apiKey); key != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+key)
+	}
+	return httpReq, nil
+}
+
+func (p *ollamaProvider) ParseResponse(body []byte) (Completion, error) {
+	var resp ollamaResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return Completion{}, fmt.Errorf("failed to parse LLM response: %w", err)
+	}
+
+	if resp.Error != "" {
+		return Completion{}, fmt.Errorf("LLM API error: %s", resp.Error)
+	}
+
+	return Completion{
+		Content:      resp.Message.Content,
+		FinishReason: resp.DoneReason,
+		Usage:        TokenUsage{PromptTokens: resp.PromptEvalCount, CompletionTokens: resp.EvalCount},
+	}, nil
+}
+
+func (p *ollamaProvider) ParseError(statusCode int, body []byte) *APIError {
+	var resp ollamaResponse
+	if json.Unmarshal(body, &resp) == nil && resp.Error != "" {
+		return &APIError{StatusCode: statusCode, Message: resp.Error}
+	}
+
+	return fallbackAPIError(statusCode, body)
+}
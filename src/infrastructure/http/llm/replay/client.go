@@ -0,0 +1,184 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+)
+
+// Mode selects whether the Client records or replays
+type Mode string
+
+const (
+	// ModeRecord forwards requests to the live service and saves the responses
+	ModeRecord Mode = "record"
+	// ModeReplay serves saved responses and never calls the live service
+	ModeReplay Mode = "replay"
+)
+
+// ParseMode parses a mode name
+func ParseMode(value string) (Mode, error) {
+	switch mode := Mode(strings.ToLower(strings.TrimSpace(value))); mode {
+	case ModeRecord, ModeReplay:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid replay mode %q: must be %q or %q", value, ModeRecord, ModeReplay)
+	}
+}
+
+// Config holds configuration for the record-and-replay client
+type Config struct {
+	Mode Mode
+	// Dir is the root directory of the fixture files
+	Dir string
+	// Model is part of the fixture key, so recordings from different models don't mix
+	Model string
+}
+
+// Client is an LLMService decorator that records or replays responses
+type Client struct {
+	delegate interfaces.LLMService
+	store    *FixtureStore
+	mode     Mode
+	model    string
+}
+
+// NewClient creates a record-and-replay client.
+// The delegate is only called in record mode and may be nil in replay mode.
+func NewClient(delegate interfaces.LLMService, config Config) (*Client, error) {
+	if _, err := ParseMode(string(config.Mode)); err != nil {
+		return nil, err
+	}
+	if config.Dir == "" {
+		return nil, fmt.Errorf("fixture directory cannot be empty")
+	}
+	if config.Mode == ModeRecord && delegate == nil {
+		return nil, fmt.Errorf("record mode requires an LLM service to record from")
+	}
+
+	return &Client{
+		delegate: delegate,
+		store:    NewFixtureStore(config.Dir),
+		mode:     config.Mode,
+		model:    config.Model,
+	}, nil
+}
+
+// Mode returns the mode the client runs in
+func (c *Client) Mode() Mode {
+	return c.mode
+}
+
+// SendRequest implements LLMService.SendRequest
+func (c *Client) SendRequest(ctx context.Context, prompt string) (string, error) {
+	return c.SendPromptRequest(ctx, interfaces.PromptRequest{Prompt: prompt})
+}
+
+// SendPromptRequest implements LLMService.SendPromptRequest; raw prompts and prompt requests
+// share fixtures
+func (c *Client) SendPromptRequest(ctx context.Context, req interfaces.PromptRequest) (string, error) {
+	// The system message changes the answer as much as the prompt does
+	key := req.Prompt
+	if req.System != "" {
+		key = fmt.Sprintf("system: %s\nuser: %s", req.System, req.Prompt)
+	}
+
+	fixture, err := c.do(OperationSendRequest, key, func() (*Fixture, error) {
+		response, err := c.delegate.SendPromptRequest(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return &Fixture{Response: response}, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return fixture.Response, nil
+}
+
+// GenerateIdeas implements LLMService.GenerateIdeas
+func (c *Client) GenerateIdeas(ctx context.Context, topic string, count int) ([]string, error) {
+	key := fmt.Sprintf("topic: %s\ncount: %d", topic, count)
+	fixture, err := c.do(OperationGenerateIdeas, key, func() (*Fixture, error) {
+		ideas, err := c.delegate.GenerateIdeas(ctx, topic, count)
+		if err != nil {
+			return nil, err
+		}
+		return &Fixture{Ideas: ideas}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fixture.Ideas, nil
+}
+
+// GenerateDrafts implements LLMService.GenerateDrafts
+func (c *Client) GenerateDrafts(ctx context.Context, idea string, userContext string) (interfaces.DraftSet, error) {
+	key := fmt.Sprintf("idea: %s\nuser_context: %s", idea, userContext)
+	fixture, err := c.do(OperationGenerateDrafts, key, func() (*Fixture, error) {
+		drafts, err := c.delegate.GenerateDrafts(ctx, idea, userContext)
+		if err != nil {
+			return nil, err
+		}
+		return &Fixture{Drafts: &DraftFixture{
+			Posts:       drafts.Posts,
+			Articles:    drafts.Articles,
+			RawResponse: drafts.RawResponse,
+			Prompt:      drafts.Prompt,
+		}}, nil
+	})
+	if err != nil {
+		return interfaces.DraftSet{}, err
+	}
+	if fixture.Drafts == nil {
+		return interfaces.DraftSet{}, nil
+	}
+	return interfaces.DraftSet{
+		Posts:       fixture.Drafts.Posts,
+		Articles:    fixture.Drafts.Articles,
+		RawResponse: fixture.Drafts.RawResponse,
+		Prompt:      fixture.Drafts.Prompt,
+	}, nil
+}
+
+// RefineDraft implements LLMService.RefineDraft
+func (c *Client) RefineDraft(ctx context.Context, draft string, userPrompt string, history []string) (string, error) {
+	key := fmt.Sprintf("draft: %s\nuser_prompt: %s\nhistory: %s", draft, userPrompt, strings.Join(history, "\n---\n"))
+	fixture, err := c.do(OperationRefineDraft, key, func() (*Fixture, error) {
+		refined, err := c.delegate.RefineDraft(ctx, draft, userPrompt, history)
+		if err != nil {
+			return nil, err
+		}
+		return &Fixture{Response: refined}, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return fixture.Response, nil
+}
+
+// do replays the fixture for a prompt, or calls the live service and records its answer.
+// Failed calls are returned as-is and never recorded.
+func (c *Client) do(operation, prompt string, call func() (*Fixture, error)) (*Fixture, error) {
+	if c.mode == ModeReplay {
+		return c.store.Load(c.model, operation, prompt)
+	}
+
+	fixture, err := call()
+	if err != nil {
+		return nil, err
+	}
+
+	fixture.Model = c.model
+	fixture.Operation = operation
+	fixture.Prompt = prompt
+	fixture.RecordedAt = time.Now().UTC()
+	if err := c.store.Save(fixture); err != nil {
+		return nil, fmt.Errorf("failed to record LLM response: %w", err)
+	}
+
+	return fixture, nil
+}
@@ -0,0 +1,128 @@
+package replay
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+)
+
+// ErrFixtureNotFound indicates replay mode received a request that was never recorded
+var ErrFixtureNotFound = errors.New("llm fixture not found")
+
+// Operations identify which LLMService method a fixture was recorded for
+const (
+	OperationSendRequest    = "send_request"
+	OperationGenerateIdeas  = "generate_ideas"
+	OperationGenerateDrafts = "generate_drafts"
+	OperationRefineDraft    = "refine_draft"
+)
+
+// Fixture is a recorded request/response pair
+type Fixture struct {
+	Model     string `json:"model"`
+	Operation string `json:"operation"`
+	// Prompt is the raw prompt for send_request, and a canonical rendering of the arguments otherwise
+	Prompt     string        `json:"prompt"`
+	PromptHash string        `json:"prompt_hash"`
+	Response   string        `json:"response,omitempty"`
+	Ideas      []string      `json:"ideas,omitempty"`
+	Drafts     *DraftFixture `json:"drafts,omitempty"`
+	RecordedAt time.Time     `json:"recorded_at"`
+}
+
+// DraftFixture is the recorded form of a DraftSet
+type DraftFixture struct {
+	Posts       []string `json:"posts"`
+	Articles    []string `json:"articles"`
+	RawResponse string   `json:"raw_response,omitempty"`
+	Prompt      string   `json:"prompt,omitempty"`
+}
+
+// PromptHash returns the hex SHA-256 of a prompt
+func PromptHash(prompt string) string {
+	sum := sha256.Sum256([]byte(prompt))
+	return hex.EncodeToString(sum[:])
+}
+
+var unsafePathChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// FixtureStore reads and writes fixtures as <dir>/<model>/<operation>/<prompt hash>.json
+type FixtureStore struct {
+	dir string
+}
+
+// NewFixtureStore creates a store rooted at dir
+func NewFixtureStore(dir string) *FixtureStore {
+	return &FixtureStore{dir: dir}
+}
+
+// Path returns the file a fixture is stored in
+func (s *FixtureStore) Path(model, operation, promptHash string) string {
+	modelDir := unsafePathChars.ReplaceAllString(model, "_")
+	if modelDir == "" {
+		modelDir = "default"
+	}
+	return filepath.Join(s.dir, modelDir, operation, promptHash+".json")
+}
+
+// Load reads the fixture recorded for a prompt
+func (s *FixtureStore) Load(model, operation, prompt string) (*Fixture, error) {
+	hash := PromptHash(prompt)
+	path := s.Path(model, operation, hash)
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s for model %q with prompt hash %s (expected %s)", ErrFixtureNotFound, operation, model, hash, path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture: %w", err)
+	}
+
+	var fixture Fixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return nil, fmt.Errorf("failed to parse fixture %s: %w", path, err)
+	}
+
+	return &fixture, nil
+}
+
+// Save writes a fixture, replacing any previous recording of the same prompt
+func (s *FixtureStore) Save(fixture *Fixture) error {
+	fixture.PromptHash = PromptHash(fixture.Prompt)
+	path := s.Path(fixture.Model, fixture.Operation, fixture.PromptHash)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create fixture directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode fixture: %w", err)
+	}
+
+	// Write through a temporary file so concurrent readers never see a partial fixture
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".fixture-*")
+	if err != nil {
+		return fmt.Errorf("failed to create fixture file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write fixture: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write fixture: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write fixture: %w", err)
+	}
+
+	return nil
+}
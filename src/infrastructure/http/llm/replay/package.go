@@ -0,0 +1,6 @@
+// Package replay provides a record-and-replay decorator for the LLM service.
+// This package handles:
+// - Recording request/response pairs from a live LLM service to fixture files
+// - Serving recorded responses offline, failing on unrecorded requests
+// - Keying fixtures by model, operation and prompt hash
+package replay
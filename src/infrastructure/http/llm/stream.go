@@ -0,0 +1,202 @@
+package llm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+)
+
+// maxStreamLineSize bounds a single line of a Server-Sent Events stream
+const maxStreamLineSize = 1024 * 1024
+
+// StreamOptions is the OpenAI stream_options request field
+type StreamOptions struct {
+	// IncludeUsage asks for a final chunk with the token counts of the request
+	IncludeUsage bool `json:"include_usage"`
+}
+
+// streamingProvider is implemented by providers that can parse a streamed response
+type streamingProvider interface {
+	// ParseStream reads a streamed response body, passing each piece of content to onDelta,
+	// and returns the completion once the stream ends
+	ParseStream(body io.Reader, onDelta interfaces.LLMStreamHandler) (Completion, error)
+}
+
+// StreamRequest implements StreamingLLMService.StreamRequest
+func (c *LLMHTTPClient) StreamRequest(ctx context.Context, prompt string, onChunk interfaces.LLMStreamHandler) (string, error) {
+	if prompt == "" {
+		return "", fmt.Errorf("prompt cannot be empty")
+	}
+
+	return c.streamRequest(ctx, prompt, onChunk)
+}
+
+// RefineDraftStream implements StreamingLLMService.RefineDraftStream
+func (c *LLMHTTPClient) RefineDraftStream(ctx context.Context, draft string, userPrompt string, history []string, onChunk interfaces.LLMStreamHandler) (string, error) {
+	if err := validateRefinementRequest(draft, userPrompt); err != nil {
+		return "", err
+	}
+
+	// The streamed prompt asks for plain text, so chunks can be shown as they arrive
+	refined, err := c.streamRequest(ctx, BuildRefinementStreamPrompt(draft, userPrompt, history), onChunk)
+	if err != nil {
+		return "", fmt.Errorf("failed to refine draft: %w", err)
+	}
+
+	return refined, nil
+}
+
+// streamRequest sends a streaming request to the LLM API. Retries only cover the
+// connection: once content has been passed to onChunk the stream can't be restarted.
+// Providers without a streaming format answer in one piece.
+func (c *LLMHTTPClient) streamRequest(ctx context.Context, prompt string, onChunk interfaces.LLMStreamHandler) (string, error) {
+	streamer, ok := c.provider.(streamingProvider)
+	if !ok {
+		content, err := c.sendRequest(ctx, interfaces.PromptRequest{Prompt: prompt})
+		if err != nil {
+			return "", err
+		}
+		if err := onChunk(content); err != nil {
+			return "", err
+		}
+		return content, nil
+	}
+
+	llmReq := c.newChatRequest(interfaces.PromptRequest{Prompt: prompt})
+	llmReq.Stream = true
+	llmReq.StreamOptions = &StreamOptions{IncludeUsage: true}
+
+	start := time.Now()
+	resp, err := c.send(ctx, llmReq)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	completion, err := streamer.ParseStream(resp.Body, onChunk)
+	if err != nil {
+		return "", err
+	}
+
+	c.recordUsage(ctx, llmReq.Model, completion.Usage, time.Since(start))
+
+	content := strings.TrimSpace(completion.Content)
+	if content == "" {
+		return "", fmt.Errorf("LLM returned empty content")
+	}
+
+	return content, nil
+}
+
+// openAIStreamChunk is the data of one event of a streamed chat completion
+type openAIStreamChunk struct {
+	Choices []struct {
+		Delta        Message `json:"delta"`
+		FinishReason string  `json:"finish_reason"`
+	} `json:"choices"`
+	// Usage is only set on the final chunk, when stream_options.include_usage is set
+	Usage *Usage    `json:"usage,omitempty"`
+	Error *APIError `json:"error,omitempty"`
+}
+
+// openAIStreamDone is the data of the event that ends a stream
+const openAIStreamDone = "[DONE]"
+
+func (p *openAIProvider) ParseStream(body io.Reader, onDelta interfaces.LLMStreamHandler) (Completion, error) {
+	var completion Completion
+	var content strings.Builder
+
+	handle := func(data string) error {
+		var chunk openAIStreamChunk
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return fmt.Errorf("failed to parse LLM stream chunk: %w", err)
+		}
+
+		if chunk.Error != nil {
+			return fmt.Errorf("LLM API error: %s", chunk.Error.Message)
+		}
+
+		if chunk.Usage != nil {
+			completion.Usage = TokenUsage{
+				PromptTokens:     chunk.Usage.PromptTokens,
+				CompletionTokens: chunk.Usage.CompletionTokens,
+			}
+		}
+
+		if len(chunk.Choices) == 0 {
+			return nil
+		}
+		if reason := chunk.Choices[0].FinishReason; reason != "" {
+			completion.FinishReason = reason
+		}
+		if delta := chunk.Choices[0].Delta.Content; delta != "" {
+			content.WriteString(delta)
+			return onDelta(delta)
+		}
+		return nil
+	}
+
+	done, err := readServerSentEvents(body, openAIStreamDone, handle)
+	if err != nil {
+		return Completion{}, err
+	}
+	if !done {
+		return Completion{}, fmt.Errorf("LLM stream ended before completion")
+	}
+
+	completion.Content = content.String()
+	return completion, nil
+}
+
+// readServerSentEvents passes the data of each event in body to handle, until the event
+// whose data is doneData; it reports whether that event was seen
+func readServerSentEvents(body io.Reader, doneData string, handle func(data string) error) (bool, error) {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxStreamLineSize)
+
+	// Events are separated by blank lines; an event may spread its data over several lines
+	var data bytes.Buffer
+	dispatch := func() (bool, error) {
+		if data.Len() == 0 {
+			return false, nil
+		}
+		event := data.String()
+		data.Reset()
+		if event == doneData {
+			return true, nil
+		}
+		return false, handle(event)
+	}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			if done, err := dispatch(); done || err != nil {
+				return done, err
+			}
+			continue
+		}
+
+		// Comments, event names and ids carry nothing the client needs
+		value, ok := strings.CutPrefix(line, "data:")
+		if !ok {
+			continue
+		}
+		if data.Len() > 0 {
+			data.WriteByte('\n')
+		}
+		data.WriteString(strings.TrimPrefix(value, " "))
+	}
+	if err := scanner.Err(); err != nil {
+		return false, fmt.Errorf("failed to read LLM stream: %w", err)
+	}
+
+	return dispatch()
+}
@@ -0,0 +1,84 @@
+package structured
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+)
+
+// Decode extracts the JSON document of a response, validates it against schema and
+// unmarshals it into out
+func Decode(response string, schema *Schema, out interface{}) error {
+	document, err := Extract(response)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal JSON response: %w", err)
+	}
+
+	var value interface{}
+	if err := json.Unmarshal([]byte(document), &value); err != nil {
+		return fmt.Errorf("failed to unmarshal JSON response: %w", err)
+	}
+	if err := schema.Validate(value); err != nil {
+		return fmt.Errorf("response does not match the %s schema: %w", schema.Name, err)
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(document), out); err != nil {
+		return fmt.Errorf("failed to unmarshal JSON response: %w", err)
+	}
+	return nil
+}
+
+// Complete sends req asking for output matching schema and decodes the response into out.
+// A response that doesn't decode is sent back to the model once to be repaired, with the
+// system message and parameters of req; when the repair fails too, Complete returns an
+// *errors.LLMResponseError with the last response.
+func Complete(ctx context.Context, service interfaces.LLMService, req interfaces.PromptRequest, schema *Schema, out interface{}) (string, error) {
+	req.Schema = schema.Response()
+
+	response, err := service.SendPromptRequest(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	decodeErr := Decode(response, schema, out)
+	if decodeErr == nil {
+		return response, nil
+	}
+
+	repair := req
+	repair.Prompt = RepairPrompt(response, schema, decodeErr)
+	repaired, err := service.SendPromptRequest(ctx, repair)
+	if err == nil {
+		if decodeErr = Decode(repaired, schema, out); decodeErr == nil {
+			return repaired, nil
+		}
+		response = repaired
+	}
+
+	return response, domainErrors.NewLLMResponseError(
+		schema.Name+"_schema",
+		"invalid response after a repair attempt",
+		req.Prompt,
+		response,
+		decodeErr,
+	)
+}
+
+// RepairPrompt asks the model to fix a response that didn't match schema
+func RepairPrompt(response string, schema *Schema, problem error) string {
+	return fmt.Sprintf(`Tu respuesta anterior no es un JSON válido para el formato pedido.
+
+Problema: %v
+
+Respuesta anterior:
+%s
+
+Corrige la respuesta conservando su contenido. Responde ÚNICAMENTE con el JSON corregido, sin texto adicional ni bloques de código, siguiendo este JSON schema:
+%s`, problem, response, schema.JSON())
+}
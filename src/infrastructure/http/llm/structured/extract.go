@@ -0,0 +1,120 @@
+package structured
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrNoJSON indicates a response without anything that looks like a JSON document
+	ErrNoJSON = errors.New("no JSON document in LLM response")
+	// ErrInvalidJSON indicates a JSON document that can't be parsed even after cleanup
+	ErrInvalidJSON = errors.New("invalid JSON in LLM response")
+)
+
+// Extract finds the JSON document in a model response. It accepts code fences, prose
+// before and after the document, and trailing commas, which models add despite the prompt.
+func Extract(response string) (string, error) {
+	text := strings.TrimSpace(unfence(response))
+	if json.Valid([]byte(text)) {
+		return text, nil
+	}
+
+	start := strings.IndexAny(text, "{[")
+	if start < 0 {
+		return "", ErrNoJSON
+	}
+
+	// The document ends at its matching bracket, so prose after it is dropped
+	candidate := text[start:]
+	if end := documentEnd(candidate); end > 0 {
+		candidate = candidate[:end]
+	}
+	candidate = removeTrailingCommas(candidate)
+
+	var value interface{}
+	if err := json.Unmarshal([]byte(candidate), &value); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+	}
+
+	return candidate, nil
+}
+
+// unfence returns the contents of the first fenced code block, or the text itself when there is none
+func unfence(text string) string {
+	open := strings.Index(text, "```")
+	if open < 0 {
+		return text
+	}
+
+	// Skip the language tag on the opening fence line
+	body := text[open+3:]
+	if newline := strings.Index(body, "\n"); newline >= 0 {
+		body = body[newline+1:]
+	} else {
+		body = strings.TrimLeft(body, "json")
+	}
+
+	if end := strings.Index(body, "```"); end >= 0 {
+		return body[:end]
+	}
+	return body
+}
+
+// documentEnd returns the index just past the bracket closing the document that opens text,
+// or -1 when the document is never closed
+func documentEnd(text string) int {
+	depth := 0
+	inString, escaped := false, false
+
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case escaped:
+			escaped = false
+		case inString && c == '\\':
+			escaped = true
+		case c == '"':
+			inString = !inString
+		case inString:
+		case c == '{' || c == '[':
+			depth++
+		case c == '}' || c == ']':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+
+	return -1
+}
+
+// removeTrailingCommas drops commas directly before a closing bracket, outside of strings
+func removeTrailingCommas(text string) string {
+	var out strings.Builder
+	out.Grow(len(text))
+	inString, escaped := false, false
+
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case escaped:
+			escaped = false
+		case inString && c == '\\':
+			escaped = true
+		case c == '"':
+			inString = !inString
+		case !inString && c == ',':
+			next := strings.TrimLeft(text[i+1:], " \t\r\n")
+			if next != "" && (next[0] == '}' || next[0] == ']') {
+				continue
+			}
+		}
+		out.WriteByte(c)
+	}
+
+	return out.String()
+}
@@ -0,0 +1,7 @@
+// Package structured validates JSON responses from the LLM against JSON schemas.
+// This package handles:
+// - A JSON schema subset for the ideas, drafts and refinement responses
+// - Tolerant extraction of JSON wrapped in code fences or prose, or with trailing commas
+// - Asking providers for schema-constrained output through the PromptRequest
+// - One repair round-trip to the model before surfacing an LLMResponseError
+package structured
@@ -0,0 +1,162 @@
+package structured
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+)
+
+// Schema is the subset of JSON schema used to describe LLM responses
+type Schema struct {
+	// Name identifies the schema in provider requests and errors
+	Name       string             `json:"-"`
+	Type       string             `json:"type"`
+	Properties map[string]*Schema `json:"properties,omitempty"`
+	Required   []string           `json:"required,omitempty"`
+	Items      *Schema            `json:"items,omitempty"`
+	// AdditionalProperties is only checked when false
+	AdditionalProperties *bool `json:"additionalProperties,omitempty"`
+}
+
+// ValidationError reports where a value doesn't match its schema
+type ValidationError struct {
+	Path    string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// JSON returns the schema as a JSON document
+func (s *Schema) JSON() json.RawMessage {
+	data, _ := json.Marshal(s)
+	return data
+}
+
+// Response returns the schema as the ResponseSchema of a PromptRequest
+func (s *Schema) Response() *interfaces.ResponseSchema {
+	return &interfaces.ResponseSchema{Name: s.Name, Definition: s.JSON()}
+}
+
+// FromResponse parses the ResponseSchema of a PromptRequest back into a Schema
+func FromResponse(schema *interfaces.ResponseSchema) (*Schema, error) {
+	var parsed Schema
+	if err := json.Unmarshal(schema.Definition, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid %s schema: %w", schema.Name, err)
+	}
+	parsed.Name = schema.Name
+	return &parsed, nil
+}
+
+// Validate checks a decoded JSON value against the schema
+func (s *Schema) Validate(value interface{}) error {
+	return s.validate("$", value)
+}
+
+func (s *Schema) validate(path string, value interface{}) error {
+	switch s.Type {
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return &ValidationError{Path: path, Message: "expected an object, got " + typeName(value)}
+		}
+		for _, name := range s.Required {
+			if _, ok := object[name]; !ok {
+				return &ValidationError{Path: path, Message: fmt.Sprintf("missing required property %q", name)}
+			}
+		}
+		for name, field := range object {
+			property, ok := s.Properties[name]
+			if !ok {
+				if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+					return &ValidationError{Path: path, Message: fmt.Sprintf("unexpected property %q", name)}
+				}
+				continue
+			}
+			if err := property.validate(path+"."+name, field); err != nil {
+				return err
+			}
+		}
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			return &ValidationError{Path: path, Message: "expected an array, got " + typeName(value)}
+		}
+		if s.Items != nil {
+			for i, item := range items {
+				if err := s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+					return err
+				}
+			}
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			return &ValidationError{Path: path, Message: "expected a string, got " + typeName(value)}
+		}
+	case "number":
+		if _, ok := value.(float64); !ok {
+			return &ValidationError{Path: path, Message: "expected a number, got " + typeName(value)}
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return &ValidationError{Path: path, Message: "expected a boolean, got " + typeName(value)}
+		}
+	}
+
+	return nil
+}
+
+// typeName names the JSON type of a decoded value
+func typeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case map[string]interface{}:
+		return "an object"
+	case []interface{}:
+		return "an array"
+	case string:
+		return "a string"
+	case float64:
+		return "a number"
+	case bool:
+		return "a boolean"
+	default:
+		return strings.TrimPrefix(fmt.Sprintf("%T", value), "*")
+	}
+}
+
+// objectOf builds a closed object schema whose properties are all required
+func objectOf(name string, properties map[string]*Schema) *Schema {
+	closed := false
+	required := make([]string, 0, len(properties))
+	for property := range properties {
+		required = append(required, property)
+	}
+	sort.Strings(required)
+
+	return &Schema{
+		Name:                 name,
+		Type:                 "object",
+		Properties:           properties,
+		Required:             required,
+		AdditionalProperties: &closed,
+	}
+}
+
+var stringList = &Schema{Type: "array", Items: &Schema{Type: "string"}}
+
+// Schemas of the responses asked for by the built-in prompts. Counts and empty entries
+// are checked by the callers, which report them with their own error stages.
+var (
+	// IdeasSchema is {"ideas": [string]}
+	IdeasSchema = objectOf("ideas", map[string]*Schema{"ideas": stringList})
+	// DraftsSchema is {"posts": [string], "articles": [string]}
+	DraftsSchema = objectOf("drafts", map[string]*Schema{"posts": stringList, "articles": stringList})
+	// RefinementSchema is {"refined": string}
+	RefinementSchema = objectOf("refinement", map[string]*Schema{"refined": {Type: "string"}})
+)
@@ -0,0 +1,146 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+)
+
+// DiffOp is the operation of a line in a diff
+type DiffOp string
+
+const (
+	DiffOpEqual  DiffOp = "equal"
+	DiffOpInsert DiffOp = "insert"
+	DiffOpDelete DiffOp = "delete"
+)
+
+// DiffLine is a line of a diff
+type DiffLine struct {
+	Op   DiffOp `json:"op"`
+	Text string `json:"text"`
+}
+
+// PromptParameterChange is a model parameter that differs between two versions
+type PromptParameterChange struct {
+	Name string `json:"name"`
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// PromptVersionDiff is what changed from one version of a prompt to another
+type PromptVersionDiff struct {
+	PromptID      string                  `json:"prompt_id"`
+	From          int                     `json:"from"`
+	To            int                     `json:"to"`
+	Template      []DiffLine              `json:"template"`
+	SystemMessage []DiffLine              `json:"system_message"`
+	Parameters    []PromptParameterChange `json:"parameters"`
+}
+
+// DiffPromptVersions compares two versions of a prompt line by line
+func DiffPromptVersions(from, to *entities.PromptVersion) PromptVersionDiff {
+	return PromptVersionDiff{
+		PromptID:      to.PromptID,
+		From:          from.Version,
+		To:            to.Version,
+		Template:      DiffLines(from.PromptTemplate, to.PromptTemplate),
+		SystemMessage: DiffLines(from.SystemMessage, to.SystemMessage),
+		Parameters:    diffParameters(from.Parameters, to.Parameters),
+	}
+}
+
+// DiffLines returns the line diff turning a into b, from their longest common subsequence
+func DiffLines(a, b string) []DiffLine {
+	x, y := splitLines(a), splitLines(b)
+
+	// Common prefix and suffix don't need the quadratic table
+	prefix := 0
+	for prefix < len(x) && prefix < len(y) && x[prefix] == y[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(x)-prefix && suffix < len(y)-prefix && x[len(x)-1-suffix] == y[len(y)-1-suffix] {
+		suffix++
+	}
+
+	diff := make([]DiffLine, 0, len(x)+len(y))
+	for _, line := range x[:prefix] {
+		diff = append(diff, DiffLine{Op: DiffOpEqual, Text: line})
+	}
+
+	mx, my := x[prefix:len(x)-suffix], y[prefix:len(y)-suffix]
+	// lcs[i][j] is the length of the longest common subsequence of mx[i:] and my[j:]
+	lcs := make([][]int, len(mx)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(my)+1)
+	}
+	for i := len(mx) - 1; i >= 0; i-- {
+		for j := len(my) - 1; j >= 0; j-- {
+			if mx[i] == my[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(mx) || j < len(my) {
+		switch {
+		case i < len(mx) && j < len(my) && mx[i] == my[j]:
+			diff = append(diff, DiffLine{Op: DiffOpEqual, Text: mx[i]})
+			i++
+			j++
+		case j < len(my) && (i == len(mx) || lcs[i][j+1] >= lcs[i+1][j]):
+			diff = append(diff, DiffLine{Op: DiffOpInsert, Text: my[j]})
+			j++
+		default:
+			diff = append(diff, DiffLine{Op: DiffOpDelete, Text: mx[i]})
+			i++
+		}
+	}
+
+	for _, line := range x[len(x)-suffix:] {
+		diff = append(diff, DiffLine{Op: DiffOpEqual, Text: line})
+	}
+	return diff
+}
+
+// splitLines splits text into lines; empty text has none
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
+
+// diffParameters lists the model parameters that differ
+func diffParameters(from, to entities.PromptParameters) []PromptParameterChange {
+	changes := make([]PromptParameterChange, 0)
+	add := func(name, a, b string) {
+		if a != b {
+			changes = append(changes, PromptParameterChange{Name: name, From: a, To: b})
+		}
+	}
+
+	temperature := func(t *float64) string {
+		if t == nil {
+			return ""
+		}
+		return fmt.Sprintf("%g", *t)
+	}
+	maxTokens := func(n int) string {
+		if n == 0 {
+			return ""
+		}
+		return fmt.Sprintf("%d", n)
+	}
+
+	add("temperature", temperature(from.Temperature), temperature(to.Temperature))
+	add("max_tokens", maxTokens(from.MaxTokens), maxTokens(to.MaxTokens))
+	add("model", from.Model, to.Model)
+	add("stop", strings.Join(from.StopSequences, ", "), strings.Join(to.StopSequences, ", "))
+	return changes
+}
@@ -16,7 +16,7 @@ import (
 // PromptEngine handles processing of prompts with variable substitution and caching
 type PromptEngine struct {
 	repository  interfaces.PromptsRepository
-	cache       map[string]string
+	cache       map[string]interfaces.PromptRequest
 	logger      interfaces.Logger
 	mu          sync.RWMutex
 	cacheHits   int
@@ -29,13 +29,14 @@ type PromptEngine struct {
 func NewPromptEngine(repository interfaces.PromptsRepository, logger interfaces.Logger) *PromptEngine {
 	return &PromptEngine{
 		repository: repository,
-		cache:      make(map[string]string),
+		cache:      make(map[string]interfaces.PromptRequest),
 		logger:     logger,
 		logs:       make([]PromptLogEntry, 0),
 	}
 }
 
-// ProcessPrompt processes a prompt with variable substitution
+// ProcessPrompt processes a prompt with variable substitution into a request carrying the
+// prompt's system message and model parameters
 func (p *PromptEngine) ProcessPrompt(
 	ctx context.Context,
 	userID string,
@@ -44,32 +45,32 @@ func (p *PromptEngine) ProcessPrompt(
 	topic *entities.Topic,
 	idea *entities.Idea,
 	user *entities.User,
-) (string, error) {
+) (interfaces.PromptRequest, error) {
 	startTime := time.Now()
 	p.logActivity(userID, promptName, string(promptType), "process_start", true, "")
 
 	if user == nil {
 		p.logActivity(userID, promptName, string(promptType), "process_error", false, "user is required")
-		return "", fmt.Errorf("user is required")
+		return interfaces.PromptRequest{}, fmt.Errorf("user is required")
 	}
 
 	// Validate required parameters based on prompt type
 	if promptType == entities.PromptTypeIdeas && topic == nil {
 		p.logActivity(userID, promptName, string(promptType), "process_error", false, "topic is required for ideas prompts")
-		return "", fmt.Errorf("topic is required for ideas prompts")
+		return interfaces.PromptRequest{}, fmt.Errorf("topic is required for ideas prompts")
 	}
 
 	if promptType == entities.PromptTypeDrafts && idea == nil {
 		p.logActivity(userID, promptName, string(promptType), "process_error", false, "idea is required for drafts prompts")
-		return "", fmt.Errorf("idea is required for drafts prompts")
+		return interfaces.PromptRequest{}, fmt.Errorf("idea is required for drafts prompts")
 	}
 
 	// Check cache first
 	cacheKey := p.buildCacheKey(userID, promptName, promptType, topic, idea)
-	if cachedPrompt, exists := p.GetFromCache(cacheKey); exists {
+	if cachedRequest, exists := p.GetFromCache(cacheKey); exists {
 		p.cacheHits++
 		p.logActivity(userID, promptName, string(promptType), "cache_hit", true, "")
-		return cachedPrompt, nil
+		return cachedRequest, nil
 	}
 	p.cacheMisses++
 
@@ -77,15 +78,16 @@ func (p *PromptEngine) ProcessPrompt(
 	prompt, err := p.repository.FindByName(ctx, userID, promptName)
 	if err != nil {
 		p.logActivity(userID, promptName, string(promptType), "repo_error", false, err.Error())
-		return "", fmt.Errorf("failed to find prompt: %w", err)
+		return interfaces.PromptRequest{}, fmt.Errorf("failed to find prompt: %w", err)
 	}
 
 	// If no custom prompt found, use default
-	if prompt == nil {
+	isDefaultPrompt := prompt == nil
+	if isDefaultPrompt {
 		defaultPrompt := p.getDefaultPrompt(promptType)
 		if defaultPrompt == "" {
 			p.logActivity(userID, promptName, string(promptType), "process_error", false, "no default prompt found")
-			return "", fmt.Errorf("no prompt found for name '%s' and type '%s'", promptName, promptType)
+			return interfaces.PromptRequest{}, fmt.Errorf("no prompt found for name '%s' and type '%s'", promptName, promptType)
 		}
 
 		prompt = &entities.Prompt{
@@ -105,12 +107,22 @@ func (p *PromptEngine) ProcessPrompt(
 	processedPrompt, err := p.substituteVariables(prompt.PromptTemplate, topic, idea, user, promptType)
 	if err != nil {
 		p.logActivity(userID, promptName, string(promptType), "substitute_error", false, err.Error())
-		return "", fmt.Errorf("failed to substitute variables: %w", err)
+		return interfaces.PromptRequest{}, fmt.Errorf("failed to substitute variables: %w", err)
+	}
+
+	request := interfaces.PromptRequest{
+		Prompt:     processedPrompt,
+		System:     prompt.SystemMessage,
+		Parameters: prompt.Parameters,
+	}
+	if !isDefaultPrompt {
+		request.PromptID = prompt.ID
+		request.PromptVersion = prompt.Version
 	}
 
 	// Cache the processed prompt
 	p.mu.Lock()
-	p.cache[cacheKey] = processedPrompt
+	p.cache[cacheKey] = request
 	p.mu.Unlock()
 
 	processingTime := time.Since(startTime)
@@ -125,7 +137,7 @@ func (p *PromptEngine) ProcessPrompt(
 			"processing_time", processingTime)
 	}
 
-	return processedPrompt, nil
+	return request, nil
 }
 
 // BuildUserContext builds user context string from user profile
@@ -185,7 +197,7 @@ func (p *PromptEngine) BuildUserContext(user *entities.User) string {
 	return strings.Join(parts, "\n")
 }
 
-// substituteVariables replaces template variables with actual values
+// substituteVariables renders the prompt template with the topic, idea and user
 func (p *PromptEngine) substituteVariables(
 	template string,
 	topic *entities.Topic,
@@ -193,44 +205,21 @@ func (p *PromptEngine) substituteVariables(
 	user *entities.User,
 	promptType entities.PromptType,
 ) (string, error) {
-	result := template
-
-	// Common substitutions
-	if user != nil {
-		userContext := p.BuildUserContext(user)
-		result = strings.ReplaceAll(result, "{user_context}", userContext)
-	}
-
-	// Ideas prompt substitutions
-	if promptType == entities.PromptTypeIdeas && topic != nil {
-		if topic.Name == "" {
-			return "", fmt.Errorf("missing required variable: {name} (topic name is empty)")
-		}
-
-		result = strings.ReplaceAll(result, "{name}", topic.Name)
-		result = strings.ReplaceAll(result, "{ideas}", fmt.Sprintf("%d", topic.Ideas))
-
-		// Handle related topics array
-		if len(topic.RelatedTopics) > 0 {
-			relatedTopicsStr := strings.Join(topic.RelatedTopics, ", ")
-			result = strings.ReplaceAll(result, "{[related_topics]}", relatedTopicsStr)
-		} else {
-			// Remove the entire section when there are no related topics
-			result = strings.ReplaceAll(result, "Temas relacionados: {[related_topics]}", "")
-			result = strings.ReplaceAll(result, "{[related_topics]}", "")
-		}
+	if promptType == entities.PromptTypeIdeas && topic != nil && topic.Name == "" {
+		return "", fmt.Errorf("missing required variable: {name} (topic name is empty)")
 	}
 
-	// Drafts prompt substitutions
-	if promptType == entities.PromptTypeDrafts && idea != nil {
-		if idea.Content == "" {
-			return "", fmt.Errorf("missing required variable: {content} (idea content is empty)")
-		}
-
-		result = strings.ReplaceAll(result, "{content}", idea.Content)
+	if promptType == entities.PromptTypeDrafts && idea != nil && idea.Content == "" {
+		return "", fmt.Errorf("missing required variable: {content} (idea content is empty)")
 	}
 
-	return result, nil
+	return RenderPromptTemplate(template, PromptTemplateData{
+		Topic:       topic,
+		Idea:        idea,
+		User:        user,
+		Type:        promptType,
+		UserContext: p.BuildUserContext(user),
+	})
 }
 
 // buildCacheKey creates a unique cache key based on parameters
@@ -242,18 +231,19 @@ func (p *PromptEngine) buildCacheKey(userID string, promptName string, promptTyp
 
 	// Include relevant data for caching
 	if topic != nil {
-		fmt.Fprintf(hash, ":topic:%s:%d:%s", topic.Name, topic.Ideas, strings.Join(topic.RelatedTopics, ","))
+		fmt.Fprintf(hash, ":topic:%s:%d:%s:%s:%s:%d", topic.Name, topic.Ideas, strings.Join(topic.RelatedTopics, ","),
+			topic.Description, topic.Category, topic.Priority)
 	}
 
 	if idea != nil {
-		fmt.Fprintf(hash, ":idea:%s", idea.Content)
+		fmt.Fprintf(hash, ":idea:%s:%s", idea.Content, idea.TopicName)
 	}
 
 	return fmt.Sprintf("%x", hash.Sum(nil))
 }
 
 // GetFromCache retrieves a processed prompt from cache
-func (p *PromptEngine) GetFromCache(key string) (string, bool) {
+func (p *PromptEngine) GetFromCache(key string) (interfaces.PromptRequest, bool) {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
 
@@ -266,7 +256,7 @@ func (p *PromptEngine) ClearCache() {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	p.cache = make(map[string]string)
+	p.cache = make(map[string]interfaces.PromptRequest)
 	p.cacheHits = 0
 	p.cacheMisses = 0
 
@@ -280,14 +270,14 @@ func (p *PromptEngine) GetRepository() interfaces.PromptsRepository {
 	return p.repository
 }
 
-// GetCacheContents returns a copy of the cache contents
+// GetCacheContents returns a copy of the processed prompts in the cache
 func (p *PromptEngine) GetCacheContents() map[string]string {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
 
 	contents := make(map[string]string)
 	for k, v := range p.cache {
-		contents[k] = v
+		contents[k] = v.Prompt
 	}
 	return contents
 }
@@ -430,6 +420,20 @@ func (p *PromptEngine) GetDiagnostics(ctx context.Context, userID string) *Promp
 		UserPromptCount:    userPromptCount,
 		CacheSize:          p.CacheSize(),
 		SupportedVariables: []string{
+			"{{.topic.name}}",
+			"{{.topic.description}}",
+			"{{.topic.category}}",
+			"{{.topic.priority}}",
+			"{{.topic.ideas}}",
+			"{{.topic.related_topics}}",
+			"{{.idea.content}}",
+			"{{.idea.topic_name}}",
+			"{{.user.context}}",
+			"{{.user.language}}",
+			"{{.user.config}}",
+			"{{.draft.type}}",
+			"{{.draft.title}}",
+			"{{.draft.content}}",
 			"{name}",
 			"{ideas}",
 			"{[related_topics]}",
@@ -439,9 +443,21 @@ func (p *PromptEngine) GetDiagnostics(ctx context.Context, userID string) *Promp
 	}
 }
 
-// ProcessTemplate processes a template string with variable substitution
-// This is a simplified version for testing purposes
+// ProcessTemplate processes a template string with variable substitution, without a user
+// or a stored prompt. Go templates render like in ProcessPrompt, with the idea content and
+// user context taken from the "content" and "user_context" variables and the draft from
+// the "draft" variable, an *entities.Draft.
 func (p *PromptEngine) ProcessTemplate(template string, topic *entities.Topic, variables map[string]interface{}) (string, error) {
+	if strings.Contains(template, "{{") {
+		data := PromptTemplateData{Topic: topic}
+		if content, ok := variables["content"].(string); ok {
+			data.Idea = &entities.Idea{Content: content}
+		}
+		data.Draft, _ = variables["draft"].(*entities.Draft)
+		data.UserContext, _ = variables["user_context"].(string)
+		return RenderPromptTemplate(template, data)
+	}
+
 	result := template
 
 	// Handle topic variables
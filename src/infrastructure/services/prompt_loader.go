@@ -31,6 +31,8 @@ type PromptFile struct {
 	Name           string
 	Type           string
 	PromptTemplate string
+	SystemMessage  string
+	Parameters     entities.PromptParameters
 }
 
 // LoadPromptsFromDir loads all prompt files from a directory
@@ -77,6 +79,11 @@ func (pl *PromptLoader) LoadPromptsFromDir(dirPath string) ([]*PromptFile, error
 	return promptFiles, nil
 }
 
+// LoadPromptFile loads a single prompt file
+func (pl *PromptLoader) LoadPromptFile(filePath string) (*PromptFile, error) {
+	return pl.parsePromptFile(filePath)
+}
+
 // parsePromptFile parses a markdown file with YAML front-matter
 func (pl *PromptLoader) parsePromptFile(filePath string) (*PromptFile, error) {
 	content, err := os.ReadFile(filePath)
@@ -92,8 +99,13 @@ func (pl *PromptLoader) parsePromptFile(filePath string) (*PromptFile, error) {
 
 	// Parse YAML front-matter
 	var meta struct {
-		Name string `yaml:"name"`
-		Type string `yaml:"type"`
+		Name        string   `yaml:"name"`
+		Type        string   `yaml:"type"`
+		System      string   `yaml:"system"`
+		Temperature *float64 `yaml:"temperature"`
+		MaxTokens   int      `yaml:"max_tokens"`
+		Model       string   `yaml:"model"`
+		Stop        []string `yaml:"stop"`
 	}
 
 	if err := yaml.Unmarshal([]byte(parts[1]), &meta); err != nil {
@@ -120,11 +132,26 @@ func (pl *PromptLoader) parsePromptFile(filePath string) (*PromptFile, error) {
 	if templateContent == "" {
 		return nil, fmt.Errorf("empty template content in %s", filePath)
 	}
+	if err := ValidatePromptVariables(templateContent, entities.PromptType(promptType)); err != nil {
+		return nil, fmt.Errorf("invalid template in %s: %w", filePath, err)
+	}
+
+	parameters := entities.PromptParameters{
+		Temperature:   meta.Temperature,
+		MaxTokens:     meta.MaxTokens,
+		Model:         strings.TrimSpace(meta.Model),
+		StopSequences: meta.Stop,
+	}
+	if err := parameters.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid model parameters in front-matter of %s: %w", filePath, err)
+	}
 
 	return &PromptFile{
 		Name:           name,
 		Type:           promptType,
 		PromptTemplate: templateContent,
+		SystemMessage:  strings.TrimSpace(meta.System),
+		Parameters:     parameters,
 	}, nil
 }
 
@@ -141,6 +168,8 @@ func (pl *PromptLoader) CreatePromptsFromFile(userID string, promptFiles []*Prom
 			Name:           promptFile.Name,
 			StyleName:      promptFile.Name, // For backward compatibility
 			PromptTemplate: promptFile.PromptTemplate,
+			SystemMessage:  promptFile.SystemMessage,
+			Parameters:     promptFile.Parameters,
 			Active:         true,
 			CreatedAt:      now,
 			UpdatedAt:      now,
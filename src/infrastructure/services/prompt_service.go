@@ -140,6 +140,10 @@ func (ps *PromptService) CreateCustomPrompt(
 		return nil, fmt.Errorf("prompt template cannot be empty")
 	}
 
+	if err := ps.ValidatePromptTemplate(template, promptType); err != nil {
+		return nil, fmt.Errorf("invalid prompt template: %w", err)
+	}
+
 	// Check if prompt with this name already exists for user
 	existing, err := ps.promptsRepo.FindByName(ctx, userID, name)
 	if err != nil {
@@ -235,6 +239,9 @@ func (ps *PromptService) UpdateCustomPrompt(
 
 	// Update template if provided
 	if template != "" {
+		if err := ps.ValidatePromptTemplate(template, prompt.Type); err != nil {
+			return nil, fmt.Errorf("invalid prompt template: %w", err)
+		}
 		if err := prompt.UpdateTemplate(template); err != nil {
 			return nil, fmt.Errorf("invalid template: %w", err)
 		}
@@ -411,9 +418,13 @@ func (ps *PromptService) syncUserPrompts(ctx context.Context, userID string, pro
 
 		// Check if prompt already exists
 		if existing, exists := existingMap[promptEntity.Name]; exists {
-			// Update existing prompt if template changed
-			if existing.PromptTemplate != promptEntity.PromptTemplate {
+			// Update existing prompt if template, system message or parameters changed
+			if existing.PromptTemplate != promptEntity.PromptTemplate ||
+				existing.SystemMessage != promptEntity.SystemMessage ||
+				!existing.Parameters.Equal(promptEntity.Parameters) {
 				existing.PromptTemplate = promptEntity.PromptTemplate
+				existing.SystemMessage = promptEntity.SystemMessage
+				existing.Parameters = promptEntity.Parameters
 				existing.UpdatedAt = now
 
 				if err := ps.promptsRepo.Update(ctx, existing); err != nil {
@@ -486,31 +497,13 @@ func (ps *PromptService) ValidatePromptTemplate(template string, promptType enti
 		return fmt.Errorf("template cannot be empty")
 	}
 
-	// Check for expected variables based on prompt type
-	if promptType == entities.PromptTypeIdeas {
-		if !strings.Contains(template, "{name}") {
-			ps.logger.Warn("Ideas prompt missing recommended variable", "variable", "{name}")
-		}
-		if !strings.Contains(template, "{ideas}") {
-			ps.logger.Warn("Ideas prompt missing recommended variable", "variable", "{ideas}")
-		}
-	}
-
-	if promptType == entities.PromptTypeDrafts {
-		if !strings.Contains(template, "{content}") {
-			ps.logger.Warn("Drafts prompt missing recommended variable", "variable", "{content}")
-		}
-		if !strings.Contains(template, "{user_context}") {
-			ps.logger.Warn("Drafts prompt missing recommended variable", "variable", "{user_context}")
-		}
+	// Check the variables against the prompt type's schema
+	if err := ValidatePromptVariables(template, promptType); err != nil {
+		return err
 	}
 
-	// Check for malformed variable patterns
-	variables := ps.ExtractVariables(template)
-	for _, variable := range variables {
-		if !strings.HasPrefix(variable, "{") || !strings.HasSuffix(variable, "}") {
-			return fmt.Errorf("malformed variable format: %s", variable)
-		}
+	if promptType == entities.PromptTypeDrafts && !strings.Contains(template, "{user_context}") && !strings.Contains(template, ".user.") {
+		ps.logger.Warn("Drafts prompt missing recommended variable", "variable", "{user_context}")
 	}
 
 	return nil
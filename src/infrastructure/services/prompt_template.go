@@ -0,0 +1,240 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+)
+
+// MaxRenderedPromptLength bounds the output of a prompt template, so that loops can't blow up a prompt
+const MaxRenderedPromptLength = 50000
+
+// ErrPromptTooLong indicates a template rendered past MaxRenderedPromptLength
+var ErrPromptTooLong = errors.New("rendered prompt too long")
+
+// PromptTemplateData holds what a prompt template can read. Every field is optional.
+type PromptTemplateData struct {
+	Topic *entities.Topic
+	Idea  *entities.Idea
+	User  *entities.User
+	Draft *entities.Draft
+	// Type, when set, restricts the template to the variables of its prompt type
+	Type entities.PromptType
+	// UserContext is the summary of the user's profile, see PromptEngine.BuildUserContext
+	UserContext string
+}
+
+// legacyPlaceholders rewrites the placeholders of the original prompt syntax into template
+// actions. The related topics line of the seed prompts disappears when there are none.
+var legacyPlaceholders = strings.NewReplacer(
+	"Temas relacionados: {[related_topics]}", `{{if .topic.related_topics}}Temas relacionados: {{join ", " .topic.related_topics}}{{end}}`,
+	"Temas relacionados: {related_topics}", `{{if .topic.related_topics}}Temas relacionados: {{join ", " .topic.related_topics}}{{end}}`,
+	"{[related_topics]}", `{{join ", " .topic.related_topics}}`,
+	"{related_topics}", `{{join ", " .topic.related_topics}}`,
+	"{name}", "{{.topic.name}}",
+	"{ideas}", "{{.topic.ideas}}",
+	"{content}", "{{.idea.content}}",
+	"{user_context}", "{{.user.context}}",
+)
+
+// promptTemplateFuncs are the filters available to prompt templates
+var promptTemplateFuncs = template.FuncMap{
+	"default":  defaultValue,
+	"join":     joinValues,
+	"upper":    strings.ToUpper,
+	"lower":    strings.ToLower,
+	"trim":     strings.TrimSpace,
+	"truncate": truncate,
+	"bullets":  bullets,
+}
+
+// ParsePromptTemplate parses a prompt template written with Go template syntax, for example
+//
+//	Tema: {{.topic.name}}
+//	{{if .topic.related_topics}}Relacionados: {{.topic.related_topics | join ", "}}{{end}}
+//	Tono: {{index .user.config "tone_preference" | default "profesional"}}
+//
+// The legacy placeholders {name}, {ideas}, {[related_topics]}, {content} and {user_context}
+// keep working; anything else written like them is an ErrUnresolvedPlaceholder. Templates only
+// see plain values, never entities or their methods.
+func ParsePromptTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("prompt").
+		Funcs(promptTemplateFuncs).
+		Option("missingkey=error").
+		Parse(legacyPlaceholders.Replace(text))
+	if err != nil {
+		return nil, fmt.Errorf("invalid prompt template: %w", err)
+	}
+	if err := checkUnresolvedPlaceholders(tmpl.Tree.Root); err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+// ValidatePromptTemplate checks the syntax of a prompt template and the fields it reads
+func ValidatePromptTemplate(text string) error {
+	_, err := RenderPromptTemplate(text, PromptTemplateData{})
+	return err
+}
+
+// RenderPromptTemplate renders a prompt template with data
+func RenderPromptTemplate(text string, data PromptTemplateData) (string, error) {
+	tmpl, err := ParsePromptTemplate(text)
+	if err != nil {
+		return "", err
+	}
+	if data.Type != "" {
+		if err := checkPromptVariables(templateVariables(tmpl), data.Type); err != nil {
+			return "", err
+		}
+	}
+
+	var out strings.Builder
+	if err := tmpl.Execute(&limitedWriter{w: &out, remaining: MaxRenderedPromptLength}, data.values()); err != nil {
+		if errors.Is(err, ErrPromptTooLong) {
+			return "", fmt.Errorf("%w (maximum %d bytes)", ErrPromptTooLong, MaxRenderedPromptLength)
+		}
+		return "", fmt.Errorf("failed to render prompt template: %w", err)
+	}
+	return out.String(), nil
+}
+
+// values converts the data to the maps templates read. Every field is present, zero when its
+// entity is missing, so that a misspelt field fails instead of rendering nothing.
+func (d PromptTemplateData) values() map[string]interface{} {
+	topic := map[string]interface{}{
+		"name": "", "description": "", "category": "", "priority": 0, "ideas": 0, "related_topics": []string(nil),
+	}
+	if d.Topic != nil {
+		topic["name"] = d.Topic.Name
+		topic["description"] = d.Topic.Description
+		topic["category"] = d.Topic.Category
+		topic["priority"] = d.Topic.Priority
+		topic["ideas"] = d.Topic.Ideas
+		topic["related_topics"] = d.Topic.RelatedTopics
+	}
+
+	idea := map[string]interface{}{"content": "", "topic_name": ""}
+	if d.Idea != nil {
+		idea["content"] = d.Idea.Content
+		idea["topic_name"] = d.Idea.TopicName
+	}
+
+	// Only the profile is exposed: never tokens or API keys
+	user := map[string]interface{}{"language": "", "context": d.UserContext, "config": map[string]string{}}
+	if d.User != nil {
+		user["language"] = d.User.Language
+		config := make(map[string]string, len(d.User.Configuration))
+		for key, value := range d.User.Configuration {
+			if value != nil && !isSecretConfigKey(key) {
+				config[key] = fmt.Sprintf("%v", value)
+			}
+		}
+		user["config"] = config
+	}
+
+	draft := map[string]interface{}{"type": "", "title": "", "content": ""}
+	if d.Draft != nil {
+		draft["type"] = string(d.Draft.Type)
+		draft["title"] = d.Draft.Title
+		draft["content"] = d.Draft.Content
+	}
+
+	return map[string]interface{}{"topic": topic, "idea": idea, "user": user, "draft": draft}
+}
+
+// isSecretConfigKey reports whether a configuration key looks like a credential
+func isSecretConfigKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, word := range []string{"key", "token", "secret", "password"} {
+		if strings.Contains(key, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultValue returns fallback when value is empty: {{.topic.description | default "..."}}
+func defaultValue(fallback interface{}, value interface{}) interface{} {
+	if value == nil {
+		return fallback
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		if v.Len() == 0 {
+			return fallback
+		}
+	default:
+		if v.IsZero() {
+			return fallback
+		}
+	}
+	return value
+}
+
+// joinValues joins a list: {{.topic.related_topics | join ", "}}
+func joinValues(separator string, values interface{}) (string, error) {
+	items, err := stringList(values)
+	if err != nil {
+		return "", fmt.Errorf("join: %w", err)
+	}
+	return strings.Join(items, separator), nil
+}
+
+// bullets renders a list as markdown bullets, one per line
+func bullets(values interface{}) (string, error) {
+	items, err := stringList(values)
+	if err != nil {
+		return "", fmt.Errorf("bullets: %w", err)
+	}
+	if len(items) == 0 {
+		return "", nil
+	}
+	return "- " + strings.Join(items, "\n- "), nil
+}
+
+// truncate shortens s to at most length characters: {{.idea.content | truncate 200}}
+func truncate(length int, s string) string {
+	if length < 0 || utf8.RuneCountInString(s) <= length {
+		return s
+	}
+	return string([]rune(s)[:length])
+}
+
+// stringList converts a list to strings
+func stringList(values interface{}) ([]string, error) {
+	if values == nil {
+		return nil, nil
+	}
+	v := reflect.ValueOf(values)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, fmt.Errorf("expected a list, got %T", values)
+	}
+	items := make([]string, v.Len())
+	for i := range items {
+		items[i] = fmt.Sprintf("%v", v.Index(i).Interface())
+	}
+	return items, nil
+}
+
+// limitedWriter fails once more than remaining bytes are written
+type limitedWriter struct {
+	w         io.Writer
+	remaining int
+}
+
+// Write implements io.Writer
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	if len(p) > l.remaining {
+		return 0, ErrPromptTooLong
+	}
+	l.remaining -= len(p)
+	return l.w.Write(p)
+}
@@ -0,0 +1,242 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+)
+
+var (
+	// ErrUnknownPromptVariable indicates a template reads a variable its prompt type doesn't provide
+	ErrUnknownPromptVariable = errors.New("unknown prompt variable")
+	// ErrMissingPromptVariable indicates a template doesn't use a variable its prompt type requires
+	ErrMissingPromptVariable = errors.New("missing required prompt variable")
+	// ErrUnresolvedPlaceholder indicates a {placeholder} that no variable replaces
+	ErrUnresolvedPlaceholder = errors.New("unresolved placeholder")
+)
+
+// PromptVariable describes a variable available to prompt templates
+type PromptVariable struct {
+	// Name is the path read by templates, {{.topic.name}} reads "topic.name"
+	Name string `json:"name"`
+	// Placeholder is the legacy syntax of the variable, if any
+	Placeholder string `json:"placeholder,omitempty"`
+	Required    bool   `json:"required"`
+}
+
+// userVariables are available to every prompt type
+var userVariables = []PromptVariable{
+	{Name: "user.context", Placeholder: "{user_context}"},
+	{Name: "user.language"},
+	{Name: "user.config"},
+}
+
+// promptVariables declares the variables of each prompt type: ideas prompts render a topic,
+// drafts prompts an idea and, when there is one, the draft being rewritten
+var promptVariables = map[entities.PromptType][]PromptVariable{
+	entities.PromptTypeIdeas: append([]PromptVariable{
+		{Name: "topic.name", Placeholder: "{name}", Required: true},
+		{Name: "topic.ideas", Placeholder: "{ideas}", Required: true},
+		{Name: "topic.related_topics", Placeholder: "{[related_topics]}"},
+		{Name: "topic.description"},
+		{Name: "topic.category"},
+		{Name: "topic.priority"},
+	}, userVariables...),
+	entities.PromptTypeDrafts: append([]PromptVariable{
+		{Name: "idea.content", Placeholder: "{content}", Required: true},
+		{Name: "idea.topic_name"},
+		{Name: "draft.type"},
+		{Name: "draft.title"},
+		{Name: "draft.content"},
+	}, userVariables...),
+}
+
+// legacyPlaceholderPattern matches what looks like a placeholder of the original syntax,
+// such as {idaes} or {[keywords]}
+var legacyPlaceholderPattern = regexp.MustCompile(`\{\[?[A-Za-z_][A-Za-z0-9_]*\]?\}`)
+
+// PromptVariables returns the variables available to prompts of promptType
+func PromptVariables(promptType entities.PromptType) []PromptVariable {
+	return append([]PromptVariable(nil), promptVariables[promptType]...)
+}
+
+// ValidatePromptVariables checks that a template parses, only reads the variables of its
+// prompt type and uses the required ones
+func ValidatePromptVariables(text string, promptType entities.PromptType) error {
+	if _, ok := promptVariables[promptType]; !ok {
+		return fmt.Errorf("invalid prompt type: %s", promptType)
+	}
+
+	used, err := PromptTemplateVariables(text)
+	if err != nil {
+		return err
+	}
+	if err := checkPromptVariables(used, promptType); err != nil {
+		return err
+	}
+
+	for _, variable := range promptVariables[promptType] {
+		if variable.Required && !containsVariable(used, variable.Name) {
+			return fmt.Errorf("%w: {{.%s}}", ErrMissingPromptVariable, variable.Name)
+		}
+	}
+
+	// Also catch runtime errors, such as a filter applied to the wrong kind of value
+	return ValidatePromptTemplate(text)
+}
+
+// PromptTemplateVariables returns the variables a template reads, sorted. Fields read inside
+// a range, where dot is a list element, aren't variables and are left out.
+func PromptTemplateVariables(text string) ([]string, error) {
+	tmpl, err := ParsePromptTemplate(text)
+	if err != nil {
+		return nil, err
+	}
+	return templateVariables(tmpl), nil
+}
+
+// templateVariables returns the variables a parsed template reads, sorted
+func templateVariables(tmpl *template.Template) []string {
+	seen := make(map[string]bool)
+	collectVariables(tmpl.Tree.Root, []string{}, seen)
+
+	variables := make([]string, 0, len(seen))
+	for name := range seen {
+		variables = append(variables, name)
+	}
+	sort.Strings(variables)
+	return variables
+}
+
+// checkPromptVariables fails on the first variable promptType doesn't provide. Parents and
+// extensions of a variable are allowed: {{with .topic}} and {{.user.config.tone_preference}}.
+func checkPromptVariables(used []string, promptType entities.PromptType) error {
+	for _, name := range used {
+		known := false
+		for _, variable := range promptVariables[promptType] {
+			if name == variable.Name || strings.HasPrefix(name, variable.Name+".") || strings.HasPrefix(variable.Name, name+".") {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("%w: {{.%s}} is not available to %s prompts", ErrUnknownPromptVariable, name, promptType)
+		}
+	}
+	return nil
+}
+
+// checkUnresolvedPlaceholders fails on text that still looks like a legacy placeholder once
+// the known ones are rewritten, so that a misspelt {idaes} never reaches the LLM
+func checkUnresolvedPlaceholders(node parse.Node) error {
+	switch n := node.(type) {
+	case *parse.TextNode:
+		if placeholder := legacyPlaceholderPattern.Find(n.Text); placeholder != nil {
+			return fmt.Errorf("%w: %s", ErrUnresolvedPlaceholder, placeholder)
+		}
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			if err := checkUnresolvedPlaceholders(child); err != nil {
+				return err
+			}
+		}
+	case *parse.IfNode:
+		return checkBranchPlaceholders(&n.BranchNode)
+	case *parse.RangeNode:
+		return checkBranchPlaceholders(&n.BranchNode)
+	case *parse.WithNode:
+		return checkBranchPlaceholders(&n.BranchNode)
+	}
+	return nil
+}
+
+// checkBranchPlaceholders checks both lists of an if, range or with
+func checkBranchPlaceholders(branch *parse.BranchNode) error {
+	if err := checkUnresolvedPlaceholders(branch.List); err != nil {
+		return err
+	}
+	return checkUnresolvedPlaceholders(branch.ElseList)
+}
+
+// collectVariables adds the variables read under node to seen. dot is the path dot points
+// to, nil when it isn't a variable.
+func collectVariables(node parse.Node, dot []string, seen map[string]bool) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			collectVariables(child, dot, seen)
+		}
+	case *parse.ActionNode:
+		collectVariables(n.Pipe, dot, seen)
+	case *parse.TemplateNode:
+		collectVariables(n.Pipe, dot, seen)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			collectVariables(cmd, dot, seen)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			collectVariables(arg, dot, seen)
+		}
+	case *parse.ChainNode:
+		collectVariables(n.Node, dot, seen)
+	case *parse.FieldNode:
+		if dot != nil {
+			seen[strings.Join(append(append([]string{}, dot...), n.Ident...), ".")] = true
+		}
+	case *parse.VariableNode:
+		// $ is always the root; other variables hold values, not paths
+		if n.Ident[0] == "$" && len(n.Ident) > 1 {
+			seen[strings.Join(n.Ident[1:], ".")] = true
+		}
+	case *parse.IfNode:
+		collectVariables(n.Pipe, dot, seen)
+		collectVariables(n.List, dot, seen)
+		collectVariables(n.ElseList, dot, seen)
+	case *parse.RangeNode:
+		collectVariables(n.Pipe, dot, seen)
+		collectVariables(n.List, nil, seen)
+		collectVariables(n.ElseList, dot, seen)
+	case *parse.WithNode:
+		collectVariables(n.Pipe, dot, seen)
+		collectVariables(n.List, pipePath(n.Pipe, dot), seen)
+		collectVariables(n.ElseList, dot, seen)
+	}
+}
+
+// pipePath returns the path a with action moves dot to, nil unless its pipeline is a lone field
+func pipePath(pipe *parse.PipeNode, dot []string) []string {
+	if dot == nil || len(pipe.Decl) > 0 || len(pipe.Cmds) != 1 || len(pipe.Cmds[0].Args) != 1 {
+		return nil
+	}
+	field, ok := pipe.Cmds[0].Args[0].(*parse.FieldNode)
+	if !ok {
+		return nil
+	}
+	return append(append([]string{}, dot...), field.Ident...)
+}
+
+// containsVariable reports whether used reads name or part of it
+func containsVariable(used []string, name string) bool {
+	for _, variable := range used {
+		if variable == name || strings.HasPrefix(variable, name+".") {
+			return true
+		}
+	}
+	return false
+}
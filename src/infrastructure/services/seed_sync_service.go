@@ -29,8 +29,11 @@ type SeedSyncService struct {
 // NewSeedSyncService creates a new seed sync service
 func NewSeedSyncService(db *mongo.Database, promptLoader *PromptLoader, promptEngine *PromptEngine) *SeedSyncService {
 	return &SeedSyncService{
-		db:           db,
-		promptRepo:   repositories.NewPromptsRepository(db.Collection("prompts")),
+		db: db,
+		promptRepo: repositories.NewVersionedPromptsRepository(
+			repositories.NewPromptsRepository(db.Collection("prompts")),
+			repositories.NewPromptVersionsRepository(db.Collection("promptVersions")),
+		),
 		topicRepo:    repositories.NewTopicRepository(db.Collection("topics")),
 		promptLoader: promptLoader,
 		promptEngine: promptEngine,
@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/linkgen-ai/backend/src/application/usecases"
+	"go.uber.org/zap"
+)
+
+// AuthHandler handles LinkedIn account connection requests
+type AuthHandler struct {
+	startLinkedInAuthUseCase    *usecases.StartLinkedInAuthUseCase
+	completeLinkedInAuthUseCase *usecases.CompleteLinkedInAuthUseCase
+	logger                      *zap.Logger
+}
+
+// NewAuthHandler creates a new AuthHandler instance
+func NewAuthHandler(
+	startLinkedInAuthUseCase *usecases.StartLinkedInAuthUseCase,
+	completeLinkedInAuthUseCase *usecases.CompleteLinkedInAuthUseCase,
+	logger *zap.Logger,
+) *AuthHandler {
+	if logger == nil {
+		logger, _ = zap.NewProduction()
+	}
+
+	return &AuthHandler{
+		startLinkedInAuthUseCase:    startLinkedInAuthUseCase,
+		completeLinkedInAuthUseCase: completeLinkedInAuthUseCase,
+		logger:                      logger,
+	}
+}
+
+// StartLinkedInAuthResponse represents the response for starting the LinkedIn authorization
+type StartLinkedInAuthResponse struct {
+	AuthorizationURL string `json:"authorization_url"`
+	ExpiresAt        string `json:"expires_at"`
+}
+
+// LinkedInAuthCallbackResponse represents the response once a LinkedIn account is connected
+type LinkedInAuthCallbackResponse struct {
+	UserID         string  `json:"user_id"`
+	Connected      bool    `json:"connected"`
+	TokenExpiresAt *string `json:"token_expires_at,omitempty"`
+}
+
+// StartLinkedInAuth handles GET /v1/auth/linkedin/start
+func (h *AuthHandler) StartLinkedInAuth(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID := r.URL.Query().Get("user_id")
+
+	// Validate userID
+	if userID == "" {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, "user_id is required", nil, h.logger)
+		return
+	}
+
+	if !isValidObjectID(userID) {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, "invalid user_id format", nil, h.logger)
+		return
+	}
+
+	// Execute use case
+	result, err := h.startLinkedInAuthUseCase.Execute(ctx, usecases.StartLinkedInAuthInput{
+		UserID: userID,
+	})
+	if err != nil {
+		statusCode, code, message := MapDomainError(err, h.logger)
+		WriteError(w, statusCode, code, message, nil, h.logger)
+		return
+	}
+
+	response := StartLinkedInAuthResponse{
+		AuthorizationURL: result.AuthorizationURL,
+		ExpiresAt:        result.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	WriteJSON(w, http.StatusOK, response, h.logger)
+}
+
+// LinkedInAuthCallback handles GET /v1/auth/linkedin/callback
+func (h *AuthHandler) LinkedInAuthCallback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	query := r.URL.Query()
+
+	// LinkedIn redirects with an error when the user denies consent
+	if authErr := query.Get("error"); authErr != "" {
+		details := map[string]interface{}{"reason": authErr}
+		if description := query.Get("error_description"); description != "" {
+			details["description"] = description
+		}
+		WriteError(w, http.StatusBadRequest, ErrorCodeUnauthorized, "LinkedIn authorization was not granted", details, h.logger)
+		return
+	}
+
+	req := LinkedInAuthCallbackRequest{
+		Code:  query.Get("code"),
+		State: query.Get("state"),
+	}
+
+	// Validate request
+	if err := req.Validate(); err != nil {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, err.Error(), nil, h.logger)
+		return
+	}
+
+	// Execute use case
+	user, err := h.completeLinkedInAuthUseCase.Execute(ctx, usecases.CompleteLinkedInAuthInput{
+		Code:  req.Code,
+		State: req.State,
+	})
+	if err != nil {
+		statusCode, code, message := MapDomainError(err, h.logger)
+		WriteError(w, statusCode, code, message, nil, h.logger)
+		return
+	}
+
+	h.logger.Info("LinkedIn account connected", zap.String("user_id", user.ID))
+
+	response := LinkedInAuthCallbackResponse{
+		UserID:    user.ID,
+		Connected: true,
+	}
+
+	if user.LinkedInTokenExpiresAt != nil {
+		expiresAtStr := user.LinkedInTokenExpiresAt.Format("2006-01-02T15:04:05Z07:00")
+		response.TokenExpiresAt = &expiresAtStr
+	}
+
+	WriteJSON(w, http.StatusOK, response, h.logger)
+}
+
+// RegisterRoutes registers auth routes
+func (h *AuthHandler) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/v1/auth/linkedin/start", h.StartLinkedInAuth).Methods(http.MethodGet)
+	router.HandleFunc("/v1/auth/linkedin/callback", h.LinkedInAuthCallback).Methods(http.MethodGet)
+}
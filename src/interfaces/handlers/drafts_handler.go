@@ -1,7 +1,9 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
 	"strings"
@@ -11,29 +13,40 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/linkgen-ai/backend/src/application/usecases"
 	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
 	"github.com/linkgen-ai/backend/src/domain/interfaces"
 	"github.com/linkgen-ai/backend/src/domain/valueobjects"
-	"github.com/linkgen-ai/backend/src/infrastructure/messaging/nats"
 	"go.uber.org/zap"
 )
 
 // DraftsHandler handles draft-related HTTP requests
 type DraftsHandler struct {
-	refineDraftUseCase *usecases.RefineDraftUseCase
-	draftRepository    interfaces.DraftRepository
-	jobRepository      interfaces.JobRepository
-	ideaRepository     interfaces.IdeasRepository
-	natsPublisher      *nats.Publisher
-	logger             *zap.Logger
+	refineDraftUseCase   *usecases.RefineDraftUseCase
+	publishDraftUseCase  *usecases.PublishDraftUseCase
+	scheduleDraftUseCase *usecases.ScheduleDraftUseCase
+	draftRepository      interfaces.DraftRepository
+	jobRepository        interfaces.JobRepository
+	ideaRepository       interfaces.IdeasRepository
+	natsPublisher        DraftJobPublisher
+	quotaChecker         interfaces.LLMQuotaChecker
+	logger               *zap.Logger
+}
+
+// DraftJobPublisher queues draft generation jobs for the workers.
+// nats.Publisher satisfies this interface.
+type DraftJobPublisher interface {
+	Publish(ctx context.Context, data interface{}) error
 }
 
 // NewDraftsHandler creates a new DraftsHandler instance
 func NewDraftsHandler(
 	refineDraftUseCase *usecases.RefineDraftUseCase,
+	publishDraftUseCase *usecases.PublishDraftUseCase,
+	scheduleDraftUseCase *usecases.ScheduleDraftUseCase,
 	draftRepository interfaces.DraftRepository,
 	jobRepository interfaces.JobRepository,
 	ideaRepository interfaces.IdeasRepository,
-	natsPublisher *nats.Publisher,
+	natsPublisher DraftJobPublisher,
 	logger *zap.Logger,
 ) *DraftsHandler {
 	if logger == nil {
@@ -41,15 +54,22 @@ func NewDraftsHandler(
 	}
 
 	return &DraftsHandler{
-		refineDraftUseCase: refineDraftUseCase,
-		draftRepository:    draftRepository,
-		jobRepository:      jobRepository,
-		ideaRepository:     ideaRepository,
-		natsPublisher:      natsPublisher,
-		logger:             logger,
+		refineDraftUseCase:   refineDraftUseCase,
+		publishDraftUseCase:  publishDraftUseCase,
+		scheduleDraftUseCase: scheduleDraftUseCase,
+		draftRepository:      draftRepository,
+		jobRepository:        jobRepository,
+		ideaRepository:       ideaRepository,
+		natsPublisher:        natsPublisher,
+		logger:               logger,
 	}
 }
 
+// SetQuotaChecker rejects draft generation with 429 before queuing once the user has used up an LLM quota
+func (h *DraftsHandler) SetQuotaChecker(checker interfaces.LLMQuotaChecker) {
+	h.quotaChecker = checker
+}
+
 // DraftGenerationMessage represents the message queued to NATS
 type DraftGenerationMessage struct {
 	JobID      string    `json:"job_id"`
@@ -107,6 +127,11 @@ func (h *DraftsHandler) GenerateDrafts(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The worker would only fail the job once the quota is used up
+	if !checkLLMQuota(ctx, w, h.quotaChecker, req.UserID, h.logger) {
+		return
+	}
+
 	// Generate job ID
 	jobID := uuid.New().String()
 
@@ -188,8 +213,10 @@ type DraftDTO struct {
 	Content           string                 `json:"content"`
 	Status            string                 `json:"status"`
 	RefinementHistory []RefinementEntryDTO   `json:"refinement_history,omitempty"`
+	ScheduledAt       *string                `json:"scheduled_at,omitempty"`
 	PublishedAt       *string                `json:"published_at,omitempty"`
 	LinkedInPostID    string                 `json:"linkedin_post_id,omitempty"`
+	PublishError      *PublishErrorDTO       `json:"publish_error,omitempty"`
 	Metadata          map[string]interface{} `json:"metadata,omitempty"`
 	CreatedAt         string                 `json:"created_at"`
 	UpdatedAt         string                 `json:"updated_at"`
@@ -203,6 +230,64 @@ type RefinementEntryDTO struct {
 	Version   int    `json:"version"`
 }
 
+// PublishErrorDTO represents the last publishing failure of a draft
+type PublishErrorDTO struct {
+	Reason     string `json:"reason"`
+	Message    string `json:"message,omitempty"`
+	OccurredAt string `json:"occurred_at"`
+}
+
+// newDraftDTO converts a draft entity to its response representation
+func newDraftDTO(draft *entities.Draft) DraftDTO {
+	dto := DraftDTO{
+		ID:             draft.ID,
+		UserID:         draft.UserID,
+		IdeaID:         draft.IdeaID,
+		Type:           string(draft.Type),
+		Title:          draft.Title,
+		Content:        draft.Content,
+		Status:         string(draft.Status),
+		LinkedInPostID: draft.LinkedInPostID,
+		Metadata:       draft.Metadata,
+		CreatedAt:      draft.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:      draft.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if draft.ScheduledAt != nil {
+		scheduledAtStr := draft.ScheduledAt.Format("2006-01-02T15:04:05Z07:00")
+		dto.ScheduledAt = &scheduledAtStr
+	}
+
+	if draft.PublishedAt != nil {
+		publishedAtStr := draft.PublishedAt.Format("2006-01-02T15:04:05Z07:00")
+		dto.PublishedAt = &publishedAtStr
+	}
+
+	if draft.PublishError != nil {
+		dto.PublishError = &PublishErrorDTO{
+			Reason:     draft.PublishError.Reason,
+			Message:    draft.PublishError.Message,
+			OccurredAt: draft.PublishError.OccurredAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+
+	// Convert refinement history
+	if len(draft.RefinementHistory) > 0 {
+		refinements := make([]RefinementEntryDTO, 0, len(draft.RefinementHistory))
+		for _, entry := range draft.RefinementHistory {
+			refinements = append(refinements, RefinementEntryDTO{
+				Timestamp: entry.Timestamp.Format("2006-01-02T15:04:05Z07:00"),
+				Prompt:    entry.Prompt,
+				Content:   entry.Content,
+				Version:   entry.Version,
+			})
+		}
+		dto.RefinementHistory = refinements
+	}
+
+	return dto
+}
+
 // GetDrafts handles GET /v1/drafts/{userId}
 func (h *DraftsHandler) GetDrafts(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -277,40 +362,7 @@ func (h *DraftsHandler) GetDrafts(w http.ResponseWriter, r *http.Request) {
 	// Convert to DTOs
 	draftDTOs := make([]DraftDTO, 0, len(drafts))
 	for _, draft := range drafts {
-		dto := DraftDTO{
-			ID:             draft.ID,
-			UserID:         draft.UserID,
-			IdeaID:         draft.IdeaID,
-			Type:           string(draft.Type),
-			Title:          draft.Title,
-			Content:        draft.Content,
-			Status:         string(draft.Status),
-			LinkedInPostID: draft.LinkedInPostID,
-			Metadata:       draft.Metadata,
-			CreatedAt:      draft.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-			UpdatedAt:      draft.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		}
-
-		if draft.PublishedAt != nil {
-			publishedAtStr := draft.PublishedAt.Format("2006-01-02T15:04:05Z07:00")
-			dto.PublishedAt = &publishedAtStr
-		}
-
-		// Convert refinement history
-		if len(draft.RefinementHistory) > 0 {
-			refinements := make([]RefinementEntryDTO, 0, len(draft.RefinementHistory))
-			for _, entry := range draft.RefinementHistory {
-				refinements = append(refinements, RefinementEntryDTO{
-					Timestamp: entry.Timestamp.Format("2006-01-02T15:04:05Z07:00"),
-					Prompt:    entry.Prompt,
-					Content:   entry.Content,
-					Version:   entry.Version,
-				})
-			}
-			dto.RefinementHistory = refinements
-		}
-
-		draftDTOs = append(draftDTOs, dto)
+		draftDTOs = append(draftDTOs, newDraftDTO(draft))
 	}
 
 	// Return response
@@ -328,6 +380,7 @@ type RefineDraftResponse struct {
 }
 
 // RefineDraft handles POST /v1/drafts/{draftId}/refine
+// With Accept: text/event-stream the refined content is streamed as it is generated
 func (h *DraftsHandler) RefineDraft(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -360,60 +413,212 @@ func (h *DraftsHandler) RefineDraft(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Execute use case
-	draft, err := h.refineDraftUseCase.Execute(ctx, usecases.RefineDraftInput{
+	input := usecases.RefineDraftInput{
 		DraftID:    draftID,
 		UserPrompt: req.Prompt,
-	})
+	}
+	if wantsEventStream(r) {
+		h.streamRefinement(w, r, input)
+		return
+	}
+
+	// Execute use case
+	draft, err := h.refineDraftUseCase.Execute(ctx, input)
 
 	if err != nil {
+		details := setQuotaHeaders(w, err)
 		statusCode, code, message := MapDomainError(err, h.logger)
-		WriteError(w, statusCode, code, message, nil, h.logger)
+		WriteError(w, statusCode, code, message, details, h.logger)
 		return
 	}
 
-	// Convert to DTO
-	dto := DraftDTO{
-		ID:             draft.ID,
-		UserID:         draft.UserID,
-		IdeaID:         draft.IdeaID,
-		Type:           string(draft.Type),
-		Title:          draft.Title,
-		Content:        draft.Content,
-		Status:         string(draft.Status),
-		LinkedInPostID: draft.LinkedInPostID,
-		Metadata:       draft.Metadata,
-		CreatedAt:      draft.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:      draft.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-	}
+	h.logger.Info("draft refined",
+		zap.String("draft_id", draftID),
+		zap.Int("refinements_count", len(draft.RefinementHistory)),
+	)
 
-	if draft.PublishedAt != nil {
-		publishedAtStr := draft.PublishedAt.Format("2006-01-02T15:04:05Z07:00")
-		dto.PublishedAt = &publishedAtStr
+	// Return response
+	response := RefineDraftResponse{
+		Draft: newDraftDTO(draft),
 	}
 
-	// Convert refinement history
-	if len(draft.RefinementHistory) > 0 {
-		refinements := make([]RefinementEntryDTO, 0, len(draft.RefinementHistory))
-		for _, entry := range draft.RefinementHistory {
-			refinements = append(refinements, RefinementEntryDTO{
-				Timestamp: entry.Timestamp.Format("2006-01-02T15:04:05Z07:00"),
-				Prompt:    entry.Prompt,
-				Content:   entry.Content,
-				Version:   entry.Version,
-			})
+	WriteJSON(w, http.StatusOK, response, h.logger)
+}
+
+// streamRefinement answers a refinement with Server-Sent Events: delta events with the
+// refined content as it is generated, then a done event with the saved draft
+func (h *DraftsHandler) streamRefinement(w http.ResponseWriter, r *http.Request, input usecases.RefineDraftInput) {
+	stream := newEventStream(w)
+
+	draft, err := h.refineDraftUseCase.ExecuteStream(r.Context(), input, func(chunk string) error {
+		return stream.Send(EventDelta, StreamDelta{Content: chunk})
+	})
+	if err != nil {
+		// Nothing was streamed yet: answer like the non-streaming endpoint
+		if !stream.Started() {
+			details := setQuotaHeaders(w, err)
+			statusCode, code, message := MapDomainError(err, h.logger)
+			WriteError(w, statusCode, code, message, details, h.logger)
+			return
 		}
-		dto.RefinementHistory = refinements
+
+		_, code, message := MapDomainError(err, h.logger)
+		h.logger.Warn("draft refinement stream failed",
+			zap.String("draft_id", input.DraftID),
+			zap.Error(err),
+		)
+		if sendErr := stream.Send(EventError, ErrorDetail{Code: code, Message: message}); sendErr != nil {
+			h.logger.Debug("failed to send stream error event", zap.Error(sendErr))
+		}
+		return
 	}
 
 	h.logger.Info("draft refined",
-		zap.String("draft_id", draftID),
+		zap.String("draft_id", input.DraftID),
 		zap.Int("refinements_count", len(draft.RefinementHistory)),
+		zap.Bool("streamed", true),
+	)
+
+	if err := stream.Send(EventDone, RefineDraftResponse{Draft: newDraftDTO(draft)}); err != nil {
+		h.logger.Warn("failed to send stream done event", zap.String("draft_id", input.DraftID), zap.Error(err))
+	}
+}
+
+// PublishDraftResponse represents the response for draft publishing
+type PublishDraftResponse struct {
+	Draft DraftDTO `json:"draft"`
+}
+
+// PublishDraft handles POST /v1/drafts/{draftId}/publish
+func (h *DraftsHandler) PublishDraft(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Extract draftId from path
+	vars := mux.Vars(r)
+	draftID := vars["draftId"]
+
+	// Validate draftID
+	if draftID == "" {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, "draft_id is required", nil, h.logger)
+		return
+	}
+
+	if !isValidObjectID(draftID) {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, "invalid draft_id format", nil, h.logger)
+		return
+	}
+
+	// Parse request body
+	var req PublishDraftRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteError(w, http.StatusBadRequest, ErrorCodeInvalidInput, "Invalid request body", nil, h.logger)
+		return
+	}
+	defer r.Body.Close()
+
+	// Validate request
+	if err := req.Validate(); err != nil {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, err.Error(), nil, h.logger)
+		return
+	}
+
+	// Execute use case
+	draft, err := h.publishDraftUseCase.Execute(ctx, usecases.PublishDraftInput{
+		DraftID: draftID,
+		UserID:  req.UserID,
+	})
+
+	if err != nil {
+		var details map[string]interface{}
+		var apiErr *domainErrors.LinkedInAPIError
+		if errors.As(err, &apiErr) {
+			details = map[string]interface{}{"reason": string(apiErr.Reason)}
+			if apiErr.RetryAfter > 0 {
+				retryAfter := int(apiErr.RetryAfter.Round(time.Second) / time.Second)
+				w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+				details["retry_after_seconds"] = retryAfter
+			}
+		}
+
+		statusCode, code, message := MapDomainError(err, h.logger)
+		WriteError(w, statusCode, code, message, details, h.logger)
+		return
+	}
+
+	h.logger.Info("draft published",
+		zap.String("draft_id", draftID),
+		zap.String("user_id", req.UserID),
+		zap.String("linkedin_post_id", draft.LinkedInPostID),
 	)
 
 	// Return response
-	response := RefineDraftResponse{
-		Draft: dto,
+	response := PublishDraftResponse{
+		Draft: newDraftDTO(draft),
+	}
+
+	WriteJSON(w, http.StatusOK, response, h.logger)
+}
+
+// ScheduleDraftResponse represents the response for draft scheduling
+type ScheduleDraftResponse struct {
+	Draft DraftDTO `json:"draft"`
+}
+
+// ScheduleDraft handles POST /v1/drafts/{draftId}/schedule
+func (h *DraftsHandler) ScheduleDraft(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Extract draftId from path
+	vars := mux.Vars(r)
+	draftID := vars["draftId"]
+
+	// Validate draftID
+	if draftID == "" {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, "draft_id is required", nil, h.logger)
+		return
+	}
+
+	if !isValidObjectID(draftID) {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, "invalid draft_id format", nil, h.logger)
+		return
+	}
+
+	// Parse request body
+	var req ScheduleDraftRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteError(w, http.StatusBadRequest, ErrorCodeInvalidInput, "Invalid request body", nil, h.logger)
+		return
+	}
+	defer r.Body.Close()
+
+	// Validate request
+	if err := req.Validate(); err != nil {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, err.Error(), nil, h.logger)
+		return
+	}
+
+	// Execute use case
+	draft, err := h.scheduleDraftUseCase.Execute(ctx, usecases.ScheduleDraftInput{
+		DraftID:   draftID,
+		UserID:    req.UserID,
+		PublishAt: req.PublishAt,
+	})
+
+	if err != nil {
+		statusCode, code, message := MapDomainError(err, h.logger)
+		WriteError(w, statusCode, code, message, nil, h.logger)
+		return
+	}
+
+	h.logger.Info("draft scheduled",
+		zap.String("draft_id", draftID),
+		zap.String("user_id", req.UserID),
+		zap.Time("publish_at", *draft.ScheduledAt),
+	)
+
+	// Return response
+	response := ScheduleDraftResponse{
+		Draft: newDraftDTO(draft),
 	}
 
 	WriteJSON(w, http.StatusOK, response, h.logger)
@@ -421,14 +626,15 @@ func (h *DraftsHandler) RefineDraft(w http.ResponseWriter, r *http.Request) {
 
 // GetJobStatusResponse represents the response for job status query
 type GetJobStatusResponse struct {
-	JobID       string   `json:"job_id"`
-	Status      string   `json:"status"`
-	IdeaID      *string  `json:"idea_id,omitempty"`
-	DraftIDs    []string `json:"draft_ids,omitempty"`
-	Error       string   `json:"error,omitempty"`
-	CreatedAt   string   `json:"created_at"`
-	StartedAt   *string  `json:"started_at,omitempty"`
-	CompletedAt *string  `json:"completed_at,omitempty"`
+	JobID       string            `json:"job_id"`
+	Status      string            `json:"status"`
+	IdeaID      *string           `json:"idea_id,omitempty"`
+	DraftIDs    []string          `json:"draft_ids,omitempty"`
+	Error       string            `json:"error,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	CreatedAt   string            `json:"created_at"`
+	StartedAt   *string           `json:"started_at,omitempty"`
+	CompletedAt *string           `json:"completed_at,omitempty"`
 }
 
 // GetJobStatus handles GET /v1/drafts/jobs/{jobId}
@@ -465,6 +671,7 @@ func (h *DraftsHandler) GetJobStatus(w http.ResponseWriter, r *http.Request) {
 		IdeaID:    job.IdeaID,
 		DraftIDs:  job.DraftIDs,
 		Error:     job.Error,
+		Metadata:  job.Metadata,
 		CreatedAt: job.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 
@@ -491,5 +698,7 @@ func (h *DraftsHandler) RegisterRoutes(router *mux.Router) {
 	router.HandleFunc("/v1/drafts/generate", h.GenerateDrafts).Methods(http.MethodPost)
 	router.HandleFunc("/v1/drafts/{userId}", h.GetDrafts).Methods(http.MethodGet)
 	router.HandleFunc("/v1/drafts/{draftId}/refine", h.RefineDraft).Methods(http.MethodPost)
+	router.HandleFunc("/v1/drafts/{draftId}/publish", h.PublishDraft).Methods(http.MethodPost)
+	router.HandleFunc("/v1/drafts/{draftId}/schedule", h.ScheduleDraft).Methods(http.MethodPost)
 	router.HandleFunc("/v1/drafts/jobs/{jobId}", h.GetJobStatus).Methods(http.MethodGet)
 }
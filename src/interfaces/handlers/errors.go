@@ -1,10 +1,14 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
 	"go.uber.org/zap"
 )
 
@@ -21,6 +25,9 @@ const (
 	ErrorCodeAlreadyExists  ErrorCode = "RESOURCE_ALREADY_EXISTS"
 	ErrorCodeLimitExceeded  ErrorCode = "LIMIT_EXCEEDED"
 	ErrorCodeDatabaseError  ErrorCode = "DATABASE_ERROR"
+	ErrorCodeRateLimited    ErrorCode = "RATE_LIMITED"
+	ErrorCodeUpstreamError  ErrorCode = "UPSTREAM_ERROR"
+	ErrorCodeQuotaExceeded  ErrorCode = "QUOTA_EXCEEDED"
 )
 
 // ErrorResponse represents the standard error response format
@@ -86,10 +93,18 @@ func MapDomainError(err error, logger *zap.Logger) (statusCode int, code ErrorCo
 		return http.StatusNotFound, ErrorCodeNotFound, e.Error()
 	case *errors.ErrTopicNotFound:
 		return http.StatusNotFound, ErrorCodeNotFound, e.Error()
+	case *errors.ErrUserNotFound:
+		return http.StatusNotFound, ErrorCodeNotFound, e.Error()
+	case *errors.ErrScheduleNotFound:
+		return http.StatusNotFound, ErrorCodeNotFound, e.Error()
+	case *errors.ErrExperimentNotFound:
+		return http.StatusNotFound, ErrorCodeNotFound, e.Error()
 	case *errors.ErrIdeaExpired:
 		return http.StatusGone, ErrorCodeInvalidInput, e.Error()
 	case *errors.ErrDraftAlreadyPublished:
 		return http.StatusConflict, ErrorCodeAlreadyExists, e.Error()
+	case *errors.ErrDraftPublishInProgress:
+		return http.StatusConflict, ErrorCodeAlreadyExists, e.Error()
 	case *errors.ErrRefinementLimitExceeded:
 		return http.StatusConflict, ErrorCodeLimitExceeded, e.Error()
 	case *errors.ErrInvalidDraftType:
@@ -106,6 +121,10 @@ func MapDomainError(err error, logger *zap.Logger) (statusCode int, code ErrorCo
 		return http.StatusBadRequest, ErrorCodeInvalidInput, e.Error()
 	case *errors.ErrInvalidUserCredentials:
 		return http.StatusUnauthorized, ErrorCodeUnauthorized, e.Error()
+	case *errors.LinkedInAPIError:
+		return mapLinkedInError(e)
+	case *errors.ErrQuotaExceeded:
+		return http.StatusTooManyRequests, ErrorCodeQuotaExceeded, e.Error()
 	default:
 		// Default to internal server error
 		if logger != nil {
@@ -114,3 +133,70 @@ func MapDomainError(err error, logger *zap.Logger) (statusCode int, code ErrorCo
 		return http.StatusInternalServerError, ErrorCodeInternalServer, "An unexpected error occurred"
 	}
 }
+
+// mapLinkedInError maps LinkedIn API failures to HTTP status codes and error codes
+func mapLinkedInError(e *errors.LinkedInAPIError) (statusCode int, code ErrorCode, message string) {
+	switch e.Reason {
+	case errors.LinkedInReasonTokenInvalid:
+		return http.StatusUnauthorized, ErrorCodeUnauthorized, "LinkedIn access token is invalid or expired"
+	case errors.LinkedInReasonRateLimit:
+		return http.StatusTooManyRequests, ErrorCodeRateLimited, "LinkedIn rate limit exceeded"
+	case errors.LinkedInReasonTimeout:
+		return http.StatusGatewayTimeout, ErrorCodeServiceTimeout, "LinkedIn request timed out"
+	default:
+		return http.StatusBadGateway, ErrorCodeUpstreamError, "LinkedIn request failed"
+	}
+}
+
+// setQuotaHeaders reports the remaining LLM budget of a quota error in the response headers
+// and returns it as error details. Other errors are left untouched.
+func setQuotaHeaders(w http.ResponseWriter, err error) map[string]interface{} {
+	e, ok := err.(*errors.ErrQuotaExceeded)
+	if !ok {
+		return nil
+	}
+
+	details := map[string]interface{}{
+		"period":   e.Period,
+		"resource": e.Resource,
+		"limit":    e.Limit,
+		"used":     e.Used,
+	}
+	if e.RemainingTokens >= 0 {
+		w.Header().Set("X-Quota-Remaining-Tokens", strconv.FormatInt(e.RemainingTokens, 10))
+		details["remaining_tokens"] = e.RemainingTokens
+	}
+	if e.RemainingRequests >= 0 {
+		w.Header().Set("X-Quota-Remaining-Requests", strconv.FormatInt(e.RemainingRequests, 10))
+		details["remaining_requests"] = e.RemainingRequests
+	}
+	if !e.ResetAt.IsZero() {
+		w.Header().Set("X-Quota-Reset", e.ResetAt.UTC().Format(time.RFC3339))
+		details["reset_at"] = e.ResetAt.UTC().Format(time.RFC3339)
+
+		if retryAfter := int(time.Until(e.ResetAt).Round(time.Second) / time.Second); retryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		}
+	}
+
+	return details
+}
+
+// checkLLMQuota rejects a request that would queue LLM work once the user has used up a quota.
+// It writes the error response, with the quota headers on a 429, and reports whether the request
+// may go on. A nil checker allows every request.
+func checkLLMQuota(ctx context.Context, w http.ResponseWriter, checker interfaces.LLMQuotaChecker, userID string, logger *zap.Logger) bool {
+	if checker == nil {
+		return true
+	}
+
+	err := checker.CheckLLMQuota(ctx, userID)
+	if err == nil {
+		return true
+	}
+
+	details := setQuotaHeaders(w, err)
+	statusCode, code, message := MapDomainError(err, logger)
+	WriteError(w, statusCode, code, message, details, logger)
+	return false
+}
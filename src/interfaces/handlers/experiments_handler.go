@@ -0,0 +1,324 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/linkgen-ai/backend/src/application/usecases"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"go.uber.org/zap"
+)
+
+// ExperimentsHandler handles prompt A/B experiment HTTP requests
+type ExperimentsHandler struct {
+	manageExperimentsUseCase *usecases.ManageExperimentsUseCase
+	logger                   *zap.Logger
+}
+
+// NewExperimentsHandler creates a new ExperimentsHandler instance
+func NewExperimentsHandler(
+	manageExperimentsUseCase *usecases.ManageExperimentsUseCase,
+	logger *zap.Logger,
+) *ExperimentsHandler {
+	if logger == nil {
+		logger, _ = zap.NewProduction()
+	}
+
+	return &ExperimentsHandler{
+		manageExperimentsUseCase: manageExperimentsUseCase,
+		logger:                   logger,
+	}
+}
+
+// ExperimentArmDTO represents an experiment arm in requests and responses
+type ExperimentArmDTO struct {
+	PromptName string `json:"prompt_name"`
+	Weight     int    `json:"weight"`
+}
+
+// ExperimentDTO represents an experiment in the response
+type ExperimentDTO struct {
+	ID        string             `json:"id"`
+	UserID    string             `json:"user_id"`
+	Name      string             `json:"name,omitempty"`
+	Type      string             `json:"type"`
+	Arms      []ExperimentArmDTO `json:"arms"`
+	Active    bool               `json:"active"`
+	CreatedAt string             `json:"created_at"`
+	UpdatedAt string             `json:"updated_at"`
+}
+
+// GetExperimentsResponse represents the response for listing experiments
+type GetExperimentsResponse struct {
+	Experiments []ExperimentDTO `json:"experiments"`
+	Count       int             `json:"count"`
+}
+
+// ExperimentArmResultDTO represents the results of an experiment arm
+type ExperimentArmResultDTO struct {
+	PromptName     string   `json:"prompt_name"`
+	Weight         int      `json:"weight"`
+	Drafts         int      `json:"drafts"`
+	Refinements    int      `json:"refinements"`
+	AvgRefinements float64  `json:"avg_refinements"`
+	Published      int      `json:"published"`
+	PublishRate    float64  `json:"publish_rate"`
+	Engagement     *float64 `json:"engagement"`
+}
+
+// ExperimentResultsResponse represents the response comparing the arms of an experiment
+type ExperimentResultsResponse struct {
+	Experiment ExperimentDTO            `json:"experiment"`
+	Arms       []ExperimentArmResultDTO `json:"arms"`
+}
+
+// ExperimentRequest represents the request to create or replace an experiment
+type ExperimentRequest struct {
+	Name   string             `json:"name,omitempty"`
+	Arms   []ExperimentArmDTO `json:"arms"`
+	Active *bool              `json:"active,omitempty"`
+}
+
+// Validate validates the experiment request
+func (r *ExperimentRequest) Validate() error {
+	if len(r.Arms) < entities.MinExperimentArms {
+		return fmt.Errorf("arms must contain at least %d prompts", entities.MinExperimentArms)
+	}
+	for _, arm := range r.Arms {
+		if strings.TrimSpace(arm.PromptName) == "" {
+			return fmt.Errorf("arms must name a prompt")
+		}
+		if arm.Weight < 0 {
+			return fmt.Errorf("arm weights cannot be negative")
+		}
+	}
+	return nil
+}
+
+// toInput converts the request into use case input for the user
+func (r *ExperimentRequest) toInput(userID string) usecases.ExperimentInput {
+	arms := make([]entities.ExperimentArm, len(r.Arms))
+	for i, arm := range r.Arms {
+		arms[i] = entities.ExperimentArm{PromptName: arm.PromptName, Weight: arm.Weight}
+	}
+
+	return usecases.ExperimentInput{
+		UserID: userID,
+		Name:   r.Name,
+		Arms:   arms,
+		Active: r.Active,
+	}
+}
+
+// newExperimentDTO converts an experiment entity to its response representation
+func newExperimentDTO(experiment *entities.Experiment) ExperimentDTO {
+	arms := make([]ExperimentArmDTO, len(experiment.Arms))
+	for i, arm := range experiment.Arms {
+		arms[i] = ExperimentArmDTO{PromptName: arm.PromptName, Weight: arm.Weight}
+	}
+
+	return ExperimentDTO{
+		ID:        experiment.ID,
+		UserID:    experiment.UserID,
+		Name:      experiment.Name,
+		Type:      string(experiment.Type),
+		Arms:      arms,
+		Active:    experiment.Active,
+		CreatedAt: experiment.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: experiment.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// GetExperiments handles GET /v1/experiments/{userId}
+func (h *ExperimentsHandler) GetExperiments(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.pathUserID(w, r)
+	if !ok {
+		return
+	}
+
+	experiments, err := h.manageExperimentsUseCase.List(r.Context(), userID)
+	if err != nil {
+		statusCode, code, message := MapDomainError(err, h.logger)
+		WriteError(w, statusCode, code, message, nil, h.logger)
+		return
+	}
+
+	experimentDTOs := make([]ExperimentDTO, 0, len(experiments))
+	for _, experiment := range experiments {
+		experimentDTOs = append(experimentDTOs, newExperimentDTO(experiment))
+	}
+
+	WriteJSON(w, http.StatusOK, GetExperimentsResponse{
+		Experiments: experimentDTOs,
+		Count:       len(experimentDTOs),
+	}, h.logger)
+}
+
+// CreateExperiment handles POST /v1/experiments/{userId}
+func (h *ExperimentsHandler) CreateExperiment(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.pathUserID(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := h.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	experiment, err := h.manageExperimentsUseCase.Create(r.Context(), req.toInput(userID))
+	if err != nil {
+		statusCode, code, message := MapDomainError(err, h.logger)
+		WriteError(w, statusCode, code, message, nil, h.logger)
+		return
+	}
+
+	h.logger.Info("experiment created",
+		zap.String("experiment_id", experiment.ID),
+		zap.String("user_id", userID),
+		zap.Int("arms", len(experiment.Arms)),
+	)
+
+	WriteJSON(w, http.StatusCreated, newExperimentDTO(experiment), h.logger)
+}
+
+// GetExperiment handles GET /v1/experiments/{userId}/{experimentId}
+func (h *ExperimentsHandler) GetExperiment(w http.ResponseWriter, r *http.Request) {
+	userID, experimentID, ok := h.pathIDs(w, r)
+	if !ok {
+		return
+	}
+
+	experiment, err := h.manageExperimentsUseCase.Get(r.Context(), userID, experimentID)
+	if err != nil {
+		statusCode, code, message := MapDomainError(err, h.logger)
+		WriteError(w, statusCode, code, message, nil, h.logger)
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, newExperimentDTO(experiment), h.logger)
+}
+
+// UpdateExperiment handles PUT /v1/experiments/{userId}/{experimentId}
+func (h *ExperimentsHandler) UpdateExperiment(w http.ResponseWriter, r *http.Request) {
+	userID, experimentID, ok := h.pathIDs(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := h.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	experiment, err := h.manageExperimentsUseCase.Update(r.Context(), experimentID, req.toInput(userID))
+	if err != nil {
+		statusCode, code, message := MapDomainError(err, h.logger)
+		WriteError(w, statusCode, code, message, nil, h.logger)
+		return
+	}
+
+	h.logger.Info("experiment updated",
+		zap.String("experiment_id", experiment.ID),
+		zap.String("user_id", userID),
+		zap.Bool("active", experiment.Active),
+	)
+
+	WriteJSON(w, http.StatusOK, newExperimentDTO(experiment), h.logger)
+}
+
+// GetExperimentResults handles GET /v1/experiments/{userId}/{experimentId}/results
+func (h *ExperimentsHandler) GetExperimentResults(w http.ResponseWriter, r *http.Request) {
+	userID, experimentID, ok := h.pathIDs(w, r)
+	if !ok {
+		return
+	}
+
+	results, err := h.manageExperimentsUseCase.Results(r.Context(), userID, experimentID)
+	if err != nil {
+		statusCode, code, message := MapDomainError(err, h.logger)
+		WriteError(w, statusCode, code, message, nil, h.logger)
+		return
+	}
+
+	arms := make([]ExperimentArmResultDTO, len(results.Arms))
+	for i, arm := range results.Arms {
+		arms[i] = ExperimentArmResultDTO{
+			PromptName:     arm.PromptName,
+			Weight:         arm.Weight,
+			Drafts:         arm.Drafts,
+			Refinements:    arm.Refinements,
+			AvgRefinements: arm.AvgRefinements,
+			Published:      arm.Published,
+			PublishRate:    arm.PublishRate,
+			Engagement:     arm.Engagement,
+		}
+	}
+
+	WriteJSON(w, http.StatusOK, ExperimentResultsResponse{
+		Experiment: newExperimentDTO(results.Experiment),
+		Arms:       arms,
+	}, h.logger)
+}
+
+// pathUserID extracts and validates the userId path parameter
+func (h *ExperimentsHandler) pathUserID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userID := mux.Vars(r)["userId"]
+
+	if userID == "" {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, "user_id is required", nil, h.logger)
+		return "", false
+	}
+
+	if !isValidObjectID(userID) {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, "invalid user_id format", nil, h.logger)
+		return "", false
+	}
+
+	return userID, true
+}
+
+// pathIDs extracts and validates the userId and experimentId path parameters
+func (h *ExperimentsHandler) pathIDs(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	userID, ok := h.pathUserID(w, r)
+	if !ok {
+		return "", "", false
+	}
+
+	experimentID := mux.Vars(r)["experimentId"]
+	if !isValidObjectID(experimentID) {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, "invalid experiment_id format", nil, h.logger)
+		return "", "", false
+	}
+
+	return userID, experimentID, true
+}
+
+// decodeRequest parses and validates an experiment request body
+func (h *ExperimentsHandler) decodeRequest(w http.ResponseWriter, r *http.Request) (*ExperimentRequest, bool) {
+	var req ExperimentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteError(w, http.StatusBadRequest, ErrorCodeInvalidInput, "Invalid request body", nil, h.logger)
+		return nil, false
+	}
+	defer r.Body.Close()
+
+	if err := req.Validate(); err != nil {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, err.Error(), nil, h.logger)
+		return nil, false
+	}
+
+	return &req, true
+}
+
+// RegisterRoutes registers experiment routes
+func (h *ExperimentsHandler) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/v1/experiments/{userId}", h.GetExperiments).Methods(http.MethodGet)
+	router.HandleFunc("/v1/experiments/{userId}", h.CreateExperiment).Methods(http.MethodPost)
+	router.HandleFunc("/v1/experiments/{userId}/{experimentId}", h.GetExperiment).Methods(http.MethodGet)
+	router.HandleFunc("/v1/experiments/{userId}/{experimentId}", h.UpdateExperiment).Methods(http.MethodPut)
+	router.HandleFunc("/v1/experiments/{userId}/{experimentId}/results", h.GetExperimentResults).Methods(http.MethodGet)
+}
@@ -40,11 +40,42 @@ type NATSClient interface {
 	IsConnected() bool
 }
 
+// LLMBackendRegistry defines interface for LLM backend circuit breaker tracking
+type LLMBackendRegistry interface {
+	GetStatus() []LLMBackendStatus
+}
+
+// LLMBackendStatus represents the circuit breaker of one LLM backend
+type LLMBackendStatus struct {
+	Name                string
+	State               string
+	ConsecutiveFailures int
+	OpenedAt            time.Time
+	LastError           string
+}
+
+// LLMQueueMonitor defines interface for LLM dispatcher queue tracking
+type LLMQueueMonitor interface {
+	GetQueueStatus() LLMQueueStatus
+}
+
+// LLMQueueStatus represents the LLM requests in flight and waiting for a slot
+type LLMQueueStatus struct {
+	InFlight          int
+	MaxInFlight       int
+	QueuedInteractive int
+	QueuedBackground  int
+	TokensPerMinute   int
+	AvailableTokens   int
+}
+
 // HealthHandler handles health check requests
 type HealthHandler struct {
 	dbHealthChecker HealthChecker
 	workerRegistry  WorkerRegistry
 	natsClient      NATSClient
+	llmBackends     LLMBackendRegistry
+	llmQueue        LLMQueueMonitor
 	logger          *zap.Logger
 }
 
@@ -53,6 +84,7 @@ func NewHealthHandler(
 	dbHealthChecker HealthChecker,
 	workerRegistry WorkerRegistry,
 	natsClient NATSClient,
+	llmBackends LLMBackendRegistry,
 	logger *zap.Logger,
 ) *HealthHandler {
 	if logger == nil {
@@ -63,10 +95,16 @@ func NewHealthHandler(
 		dbHealthChecker: dbHealthChecker,
 		workerRegistry:  workerRegistry,
 		natsClient:      natsClient,
+		llmBackends:     llmBackends,
 		logger:          logger,
 	}
 }
 
+// SetLLMQueue reports the depth of the LLM dispatcher queue in health checks
+func (h *HealthHandler) SetLLMQueue(queue LLMQueueMonitor) {
+	h.llmQueue = queue
+}
+
 // HealthResponse represents the health check response
 type HealthResponse struct {
 	Status     string                   `json:"status"`
@@ -79,6 +117,8 @@ type HealthComponentsResponse struct {
 	Database string                      `json:"database"`
 	NATS     string                      `json:"nats"`
 	Workers  map[string]WorkerHealthInfo `json:"workers"`
+	LLM      []LLMBackendHealthInfo      `json:"llm,omitempty"`
+	LLMQueue *LLMQueueHealthInfo         `json:"llm_queue,omitempty"`
 }
 
 // WorkerHealthInfo represents worker health information
@@ -88,6 +128,31 @@ type WorkerHealthInfo struct {
 	Error     string    `json:"error,omitempty"`
 }
 
+// LLMBackendHealthInfo represents LLM backend health information
+type LLMBackendHealthInfo struct {
+	Name                string    `json:"name"`
+	Circuit             string    `json:"circuit"`
+	ConsecutiveFailures int       `json:"consecutive_failures"`
+	OpenedAt            time.Time `json:"opened_at,omitempty"`
+	LastError           string    `json:"last_error,omitempty"`
+}
+
+// LLMQueueHealthInfo represents LLM dispatcher queue information.
+// Zero limits are unlimited.
+type LLMQueueHealthInfo struct {
+	InFlight        int               `json:"in_flight"`
+	MaxInFlight     int               `json:"max_in_flight"`
+	Queued          LLMQueueDepthInfo `json:"queued"`
+	TokensPerMinute int               `json:"tokens_per_minute"`
+	AvailableTokens int               `json:"available_tokens,omitempty"`
+}
+
+// LLMQueueDepthInfo represents the LLM requests waiting per priority
+type LLMQueueDepthInfo struct {
+	Interactive int `json:"interactive"`
+	Background  int `json:"background"`
+}
+
 // HandleHealth handles GET /health requests
 func (h *HealthHandler) HandleHealth(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -133,6 +198,42 @@ func (h *HealthHandler) HandleHealth(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Check LLM circuit breakers; an open breaker degrades, since fallbacks may still serve requests
+	var llmHealth []LLMBackendHealthInfo
+	overallLLMStatus := "healthy"
+
+	if h.llmBackends != nil {
+		for _, backend := range h.llmBackends.GetStatus() {
+			if backend.State != "closed" {
+				overallLLMStatus = "degraded"
+			}
+
+			llmHealth = append(llmHealth, LLMBackendHealthInfo{
+				Name:                backend.Name,
+				Circuit:             backend.State,
+				ConsecutiveFailures: backend.ConsecutiveFailures,
+				OpenedAt:            backend.OpenedAt,
+				LastError:           backend.LastError,
+			})
+		}
+	}
+
+	// Queued requests are expected under load, so the queue never changes the status
+	var llmQueue *LLMQueueHealthInfo
+	if h.llmQueue != nil {
+		status := h.llmQueue.GetQueueStatus()
+		llmQueue = &LLMQueueHealthInfo{
+			InFlight:    status.InFlight,
+			MaxInFlight: status.MaxInFlight,
+			Queued: LLMQueueDepthInfo{
+				Interactive: status.QueuedInteractive,
+				Background:  status.QueuedBackground,
+			},
+			TokensPerMinute: status.TokensPerMinute,
+			AvailableTokens: status.AvailableTokens,
+		}
+	}
+
 	// Determine overall status
 	overallStatus := "healthy"
 	statusCode := http.StatusOK
@@ -140,7 +241,7 @@ func (h *HealthHandler) HandleHealth(w http.ResponseWriter, r *http.Request) {
 	if dbStatus == "unhealthy" || natsStatus == "disconnected" || overallWorkerStatus == "unhealthy" {
 		overallStatus = "unhealthy"
 		statusCode = http.StatusServiceUnavailable
-	} else if dbStatus == "degraded" || overallWorkerStatus == "degraded" {
+	} else if dbStatus == "degraded" || overallWorkerStatus == "degraded" || overallLLMStatus == "degraded" {
 		overallStatus = "degraded"
 		statusCode = http.StatusOK // Still OK, but degraded
 	}
@@ -153,6 +254,8 @@ func (h *HealthHandler) HandleHealth(w http.ResponseWriter, r *http.Request) {
 			Database: dbStatus,
 			NATS:     natsStatus,
 			Workers:  workerHealth,
+			LLM:      llmHealth,
+			LLMQueue: llmQueue,
 		},
 	}
 
@@ -162,6 +265,7 @@ func (h *HealthHandler) HandleHealth(w http.ResponseWriter, r *http.Request) {
 		zap.String("db_status", dbStatus),
 		zap.String("nats_status", natsStatus),
 		zap.String("worker_status", overallWorkerStatus),
+		zap.String("llm_status", overallLLMStatus),
 	)
 
 	// Send response
@@ -41,14 +41,16 @@ type GetIdeasResponse struct {
 
 // IdeaDTO represents an idea in the response
 type IdeaDTO struct {
-	ID           string   `json:"id"`
-	UserID       string   `json:"user_id"`
-	TopicID      string   `json:"topic_id"`
-	Content      string   `json:"content"`
-	QualityScore *float64 `json:"quality_score,omitempty"`
-	Used         bool     `json:"used"`
-	CreatedAt    string   `json:"created_at"`
-	ExpiresAt    *string  `json:"expires_at,omitempty"`
+	ID            string   `json:"id"`
+	UserID        string   `json:"user_id"`
+	TopicID       string   `json:"topic_id"`
+	Content       string   `json:"content"`
+	QualityScore  *float64 `json:"quality_score,omitempty"`
+	Used          bool     `json:"used"`
+	PromptID      string   `json:"prompt_id,omitempty"`
+	PromptVersion int      `json:"prompt_version,omitempty"`
+	CreatedAt     string   `json:"created_at"`
+	ExpiresAt     *string  `json:"expires_at,omitempty"`
 }
 
 // GetIdeas handles GET /v1/ideas/{userId}
@@ -113,13 +115,15 @@ func (h *IdeasHandler) GetIdeas(w http.ResponseWriter, r *http.Request) {
 	ideaDTOs := make([]IdeaDTO, 0, len(ideas))
 	for _, idea := range ideas {
 		dto := IdeaDTO{
-			ID:           idea.ID,
-			UserID:       idea.UserID,
-			TopicID:      idea.TopicID,
-			Content:      idea.Content,
-			QualityScore: idea.QualityScore,
-			Used:         idea.Used,
-			CreatedAt:    idea.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			ID:            idea.ID,
+			UserID:        idea.UserID,
+			TopicID:       idea.TopicID,
+			Content:       idea.Content,
+			QualityScore:  idea.QualityScore,
+			Used:          idea.Used,
+			PromptID:      idea.PromptID,
+			PromptVersion: idea.PromptVersion,
+			CreatedAt:     idea.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		}
 
 		if idea.ExpiresAt != nil {
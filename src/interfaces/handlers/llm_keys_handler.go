@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/linkgen-ai/backend/src/application/usecases"
+	"go.uber.org/zap"
+)
+
+// LLMKeysHandler handles the API keys users bring for LLM providers
+type LLMKeysHandler struct {
+	manageLLMKeysUseCase *usecases.ManageLLMKeysUseCase
+	logger               *zap.Logger
+}
+
+// NewLLMKeysHandler creates a new LLMKeysHandler instance
+func NewLLMKeysHandler(
+	manageLLMKeysUseCase *usecases.ManageLLMKeysUseCase,
+	logger *zap.Logger,
+) *LLMKeysHandler {
+	if logger == nil {
+		logger, _ = zap.NewProduction()
+	}
+
+	return &LLMKeysHandler{
+		manageLLMKeysUseCase: manageLLMKeysUseCase,
+		logger:               logger,
+	}
+}
+
+// SetLLMKeyRequest represents the request body for storing a provider key
+type SetLLMKeyRequest struct {
+	APIKey string `json:"api_key"`
+}
+
+// ListLLMKeysResponse lists the providers a user has stored a key for; keys are never returned
+type ListLLMKeysResponse struct {
+	UserID    string   `json:"user_id"`
+	Providers []string `json:"providers"`
+}
+
+// ListLLMKeys handles GET /v1/llm-keys/{userId}
+func (h *LLMKeysHandler) ListLLMKeys(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.pathUserID(w, r)
+	if !ok {
+		return
+	}
+
+	providers, err := h.manageLLMKeysUseCase.List(r.Context(), userID)
+	if err != nil {
+		statusCode, code, message := MapDomainError(err, h.logger)
+		WriteError(w, statusCode, code, message, nil, h.logger)
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, ListLLMKeysResponse{UserID: userID, Providers: providers}, h.logger)
+}
+
+// SetLLMKey handles PUT /v1/llm-keys/{userId}/{provider}
+func (h *LLMKeysHandler) SetLLMKey(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.pathUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req SetLLMKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteError(w, http.StatusBadRequest, ErrorCodeInvalidInput, "Invalid request body", nil, h.logger)
+		return
+	}
+	defer r.Body.Close()
+
+	provider := mux.Vars(r)["provider"]
+	err := h.manageLLMKeysUseCase.Set(r.Context(), usecases.SetLLMKeyInput{
+		UserID:   userID,
+		Provider: provider,
+		APIKey:   req.APIKey,
+	})
+	if err != nil {
+		statusCode, code, message := MapDomainError(err, h.logger)
+		WriteError(w, statusCode, code, message, nil, h.logger)
+		return
+	}
+
+	h.logger.Info("LLM API key stored",
+		zap.String("user_id", userID),
+		zap.String("provider", provider),
+	)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteLLMKey handles DELETE /v1/llm-keys/{userId}/{provider}
+func (h *LLMKeysHandler) DeleteLLMKey(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.pathUserID(w, r)
+	if !ok {
+		return
+	}
+
+	provider := mux.Vars(r)["provider"]
+	if err := h.manageLLMKeysUseCase.Delete(r.Context(), userID, provider); err != nil {
+		statusCode, code, message := MapDomainError(err, h.logger)
+		WriteError(w, statusCode, code, message, nil, h.logger)
+		return
+	}
+
+	h.logger.Info("LLM API key deleted",
+		zap.String("user_id", userID),
+		zap.String("provider", provider),
+	)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// pathUserID reads and validates the userId path parameter
+func (h *LLMKeysHandler) pathUserID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userID := mux.Vars(r)["userId"]
+	if userID == "" {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, "user_id is required", nil, h.logger)
+		return "", false
+	}
+
+	if !isValidObjectID(userID) {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, "invalid user_id format", nil, h.logger)
+		return "", false
+	}
+
+	return userID, true
+}
+
+// RegisterRoutes registers LLM key routes
+func (h *LLMKeysHandler) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/v1/llm-keys/{userId}", h.ListLLMKeys).Methods(http.MethodGet)
+	router.HandleFunc("/v1/llm-keys/{userId}/{provider}", h.SetLLMKey).Methods(http.MethodPut)
+	router.HandleFunc("/v1/llm-keys/{userId}/{provider}", h.DeleteLLMKey).Methods(http.MethodDelete)
+}
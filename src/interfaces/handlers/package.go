@@ -7,4 +7,8 @@
 // - DraftHandlers: Draft generation and management endpoints
 // - UserHandlers: User configuration endpoints
 // - PublishHandlers: Publishing endpoints
+// - AuthHandlers: LinkedIn account connection (OAuth2 with PKCE)
+// - ScheduleHandlers: Per-user cron schedules
+// - ExperimentHandlers: Prompt A/B experiments and their results
+// - UsageHandlers: LLM token usage and estimated cost per user
 package handlers
@@ -1,9 +1,12 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -19,6 +22,7 @@ type PromptsHandler struct {
 	promptsRepo   interfaces.PromptsRepository
 	userRepo      interfaces.UserRepository
 	promptService *services.PromptService
+	versionsRepo  interfaces.PromptVersionsRepository
 	logger        *zap.Logger
 }
 
@@ -50,25 +54,69 @@ func NewPromptsHandlerSimple(
 	return NewPromptsHandler(promptsRepo, userRepo, nil, logger)
 }
 
+// SetPromptVersions enables the version history endpoints
+func (h *PromptsHandler) SetPromptVersions(versionsRepo interfaces.PromptVersionsRepository) {
+	h.versionsRepo = versionsRepo
+}
+
 // PromptDTO represents a prompt in the response
 type PromptDTO struct {
-	ID             string `json:"id"`
-	UserID         string `json:"user_id"`
-	Type           string `json:"type"`
-	StyleName      string `json:"style_name,omitempty"`
-	PromptTemplate string `json:"prompt_template"`
-	Active         bool   `json:"active"`
-	CreatedAt      string `json:"created_at"`
-	UpdatedAt      string `json:"updated_at"`
+	ID             string               `json:"id"`
+	UserID         string               `json:"user_id"`
+	Type           string               `json:"type"`
+	StyleName      string               `json:"style_name,omitempty"`
+	PromptTemplate string               `json:"prompt_template"`
+	SystemMessage  string               `json:"system_message,omitempty"`
+	Parameters     *PromptParametersDTO `json:"parameters,omitempty"`
+	Version        int                  `json:"version,omitempty"`
+	Active         bool                 `json:"active"`
+	CreatedAt      string               `json:"created_at"`
+	UpdatedAt      string               `json:"updated_at"`
+}
+
+// PromptParametersDTO represents the model parameters of a prompt
+type PromptParametersDTO struct {
+	Temperature   *float64 `json:"temperature,omitempty"`
+	MaxTokens     int      `json:"max_tokens,omitempty"`
+	Model         string   `json:"model,omitempty"`
+	StopSequences []string `json:"stop,omitempty"`
+}
+
+// newPromptParametersDTO converts the parameters of a prompt, nil when it has none
+func newPromptParametersDTO(parameters entities.PromptParameters) *PromptParametersDTO {
+	if parameters.Equal(entities.PromptParameters{}) {
+		return nil
+	}
+	return &PromptParametersDTO{
+		Temperature:   parameters.Temperature,
+		MaxTokens:     parameters.MaxTokens,
+		Model:         parameters.Model,
+		StopSequences: parameters.StopSequences,
+	}
+}
+
+// toEntity returns the model parameters of the DTO
+func (d *PromptParametersDTO) toEntity() entities.PromptParameters {
+	if d == nil {
+		return entities.PromptParameters{}
+	}
+	return entities.PromptParameters{
+		Temperature:   d.Temperature,
+		MaxTokens:     d.MaxTokens,
+		Model:         strings.TrimSpace(d.Model),
+		StopSequences: d.StopSequences,
+	}
 }
 
 // CreatePromptRequest represents the request to create a prompt
 type CreatePromptRequest struct {
-	UserID         string `json:"user_id"`
-	Name           string `json:"name"`
-	Type           string `json:"type"`
-	StyleName      string `json:"style_name,omitempty"`
-	PromptTemplate string `json:"prompt_template"`
+	UserID         string               `json:"user_id"`
+	Name           string               `json:"name"`
+	Type           string               `json:"type"`
+	StyleName      string               `json:"style_name,omitempty"`
+	PromptTemplate string               `json:"prompt_template"`
+	SystemMessage  string               `json:"system_message,omitempty"`
+	Parameters     *PromptParametersDTO `json:"parameters,omitempty"`
 }
 
 // Validate validates the create prompt request
@@ -98,11 +146,14 @@ func (r *CreatePromptRequest) Validate() error {
 type UpdatePromptRequest struct {
 	PromptTemplate *string `json:"prompt_template,omitempty"`
 	Active         *bool   `json:"active,omitempty"`
+	SystemMessage  *string `json:"system_message,omitempty"`
+	// Parameters replaces all the model parameters of the prompt
+	Parameters *PromptParametersDTO `json:"parameters,omitempty"`
 }
 
 // Validate validates the update prompt request
 func (r *UpdatePromptRequest) Validate() error {
-	if r.PromptTemplate == nil && r.Active == nil {
+	if r.PromptTemplate == nil && r.Active == nil && r.SystemMessage == nil && r.Parameters == nil {
 		return fmt.Errorf("at least one field must be provided for update")
 	}
 	return nil
@@ -164,6 +215,9 @@ func (h *PromptsHandler) ListPrompts(w http.ResponseWriter, r *http.Request) {
 			Type:           string(prompt.Type),
 			StyleName:      prompt.StyleName,
 			PromptTemplate: prompt.PromptTemplate,
+			SystemMessage:  prompt.SystemMessage,
+			Parameters:     newPromptParametersDTO(prompt.Parameters),
+			Version:        prompt.Version,
 			Active:         prompt.Active,
 			CreatedAt:      prompt.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 			UpdatedAt:      prompt.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
@@ -212,6 +266,8 @@ func (h *PromptsHandler) CreatePrompt(w http.ResponseWriter, r *http.Request) {
 		Name:           req.Name,
 		StyleName:      req.StyleName,
 		PromptTemplate: req.PromptTemplate,
+		SystemMessage:  strings.TrimSpace(req.SystemMessage),
+		Parameters:     req.Parameters.toEntity(),
 		Active:         true,
 		CreatedAt:      now,
 		UpdatedAt:      now,
@@ -221,6 +277,10 @@ func (h *PromptsHandler) CreatePrompt(w http.ResponseWriter, r *http.Request) {
 		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, err.Error(), nil, h.logger)
 		return
 	}
+	if err := services.ValidatePromptVariables(prompt.PromptTemplate, prompt.Type); err != nil {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, err.Error(), nil, h.logger)
+		return
+	}
 
 	promptID, err := h.promptsRepo.Create(ctx, prompt)
 	if err != nil {
@@ -235,6 +295,9 @@ func (h *PromptsHandler) CreatePrompt(w http.ResponseWriter, r *http.Request) {
 		Type:           string(prompt.Type),
 		StyleName:      prompt.StyleName,
 		PromptTemplate: prompt.PromptTemplate,
+		SystemMessage:  prompt.SystemMessage,
+		Parameters:     newPromptParametersDTO(prompt.Parameters),
+		Version:        prompt.Version,
 		Active:         prompt.Active,
 		CreatedAt:      prompt.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:      prompt.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
@@ -278,12 +341,34 @@ func (h *PromptsHandler) UpdatePrompt(w http.ResponseWriter, r *http.Request) {
 
 	// Update fields
 	if req.PromptTemplate != nil {
+		if err := services.ValidatePromptVariables(*req.PromptTemplate, prompt.Type); err != nil {
+			WriteError(w, http.StatusBadRequest, ErrorCodeValidation, err.Error(), nil, h.logger)
+			return
+		}
 		if err := prompt.UpdateTemplate(*req.PromptTemplate); err != nil {
 			WriteError(w, http.StatusBadRequest, ErrorCodeValidation, err.Error(), nil, h.logger)
 			return
 		}
 	}
 
+	if req.SystemMessage != nil || req.Parameters != nil {
+		if req.SystemMessage != nil {
+			prompt.SystemMessage = strings.TrimSpace(*req.SystemMessage)
+		}
+		if req.Parameters != nil {
+			prompt.Parameters = req.Parameters.toEntity()
+		}
+		if err := prompt.ValidateSystemMessage(); err != nil {
+			WriteError(w, http.StatusBadRequest, ErrorCodeValidation, err.Error(), nil, h.logger)
+			return
+		}
+		if err := prompt.Parameters.Validate(); err != nil {
+			WriteError(w, http.StatusBadRequest, ErrorCodeValidation, err.Error(), nil, h.logger)
+			return
+		}
+		prompt.UpdatedAt = time.Now()
+	}
+
 	if req.Active != nil {
 		if *req.Active {
 			prompt.Activate()
@@ -304,6 +389,9 @@ func (h *PromptsHandler) UpdatePrompt(w http.ResponseWriter, r *http.Request) {
 		Type:           string(prompt.Type),
 		StyleName:      prompt.StyleName,
 		PromptTemplate: prompt.PromptTemplate,
+		SystemMessage:  prompt.SystemMessage,
+		Parameters:     newPromptParametersDTO(prompt.Parameters),
+		Version:        prompt.Version,
 		Active:         prompt.Active,
 		CreatedAt:      prompt.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:      prompt.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
@@ -357,6 +445,9 @@ func (h *PromptsHandler) GetPromptByName(w http.ResponseWriter, r *http.Request)
 		Type:           string(prompt.Type),
 		StyleName:      prompt.StyleName,
 		PromptTemplate: prompt.PromptTemplate,
+		SystemMessage:  prompt.SystemMessage,
+		Parameters:     newPromptParametersDTO(prompt.Parameters),
+		Version:        prompt.Version,
 		Active:         prompt.Active,
 		CreatedAt:      prompt.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:      prompt.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
@@ -593,6 +684,9 @@ func (h *PromptsHandler) CreateCustomPrompt(w http.ResponseWriter, r *http.Reque
 		Type:           string(prompt.Type),
 		StyleName:      prompt.StyleName,
 		PromptTemplate: prompt.PromptTemplate,
+		SystemMessage:  prompt.SystemMessage,
+		Parameters:     newPromptParametersDTO(prompt.Parameters),
+		Version:        prompt.Version,
 		Active:         prompt.Active,
 		CreatedAt:      prompt.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:      prompt.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
@@ -671,6 +765,9 @@ func (h *PromptsHandler) UpdateCustomPrompt(w http.ResponseWriter, r *http.Reque
 		Type:           string(prompt.Type),
 		StyleName:      prompt.StyleName,
 		PromptTemplate: prompt.PromptTemplate,
+		SystemMessage:  prompt.SystemMessage,
+		Parameters:     newPromptParametersDTO(prompt.Parameters),
+		Version:        prompt.Version,
 		Active:         prompt.Active,
 		CreatedAt:      prompt.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:      prompt.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
@@ -765,20 +862,229 @@ func (h *PromptsHandler) ValidatePromptTemplate(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	variables := h.promptService.ExtractVariables(req.PromptTemplate)
+	variables, err := services.PromptTemplateVariables(req.PromptTemplate)
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, err.Error(), nil, h.logger)
+		return
+	}
 
 	WriteJSON(w, http.StatusOK, map[string]interface{}{
 		"valid":     true,
 		"variables": variables,
+		"available": services.PromptVariables(entities.PromptType(req.Type)),
 		"type":      req.Type,
 	}, h.logger)
 }
 
+// PromptVersionDTO represents a prompt version in the response
+type PromptVersionDTO struct {
+	PromptID       string               `json:"prompt_id"`
+	Version        int                  `json:"version"`
+	Type           string               `json:"type"`
+	Name           string               `json:"name"`
+	PromptTemplate string               `json:"prompt_template"`
+	SystemMessage  string               `json:"system_message,omitempty"`
+	Parameters     *PromptParametersDTO `json:"parameters,omitempty"`
+	CreatedAt      string               `json:"created_at"`
+}
+
+// RollbackPromptRequest represents the request body for rolling back a prompt
+type RollbackPromptRequest struct {
+	Version int `json:"version"`
+}
+
+// ListPromptVersions handles GET /v1/prompts/{promptId}/versions
+func (h *PromptsHandler) ListPromptVersions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if h.versionsRepo == nil {
+		WriteError(w, http.StatusServiceUnavailable, ErrorCodeInternalServer, "Prompt versions not available", nil, h.logger)
+		return
+	}
+
+	prompt, ok := h.findPromptForVersions(w, r)
+	if !ok {
+		return
+	}
+
+	versions, err := h.versionsRepo.ListByPromptID(ctx, prompt.ID)
+	if err != nil {
+		statusCode, code, message := MapDomainError(err, h.logger)
+		WriteError(w, statusCode, code, message, nil, h.logger)
+		return
+	}
+
+	versionDTOs := make([]PromptVersionDTO, 0, len(versions))
+	for _, version := range versions {
+		versionDTOs = append(versionDTOs, newPromptVersionDTO(version))
+	}
+
+	WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"prompt_id":       prompt.ID,
+		"current_version": prompt.Version,
+		"versions":        versionDTOs,
+	}, h.logger)
+}
+
+// DiffPromptVersions handles GET /v1/prompts/{promptId}/versions/diff?from=1&to=2
+func (h *PromptsHandler) DiffPromptVersions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if h.versionsRepo == nil {
+		WriteError(w, http.StatusServiceUnavailable, ErrorCodeInternalServer, "Prompt versions not available", nil, h.logger)
+		return
+	}
+
+	query := r.URL.Query()
+	from, err := strconv.Atoi(query.Get("from"))
+	if err != nil || from < 1 {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, "from must be a version number", nil, h.logger)
+		return
+	}
+	to, err := strconv.Atoi(query.Get("to"))
+	if err != nil || to < 1 {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, "to must be a version number", nil, h.logger)
+		return
+	}
+
+	prompt, ok := h.findPromptForVersions(w, r)
+	if !ok {
+		return
+	}
+
+	fromVersion, ok := h.findPromptVersion(ctx, w, prompt.ID, from)
+	if !ok {
+		return
+	}
+	toVersion, ok := h.findPromptVersion(ctx, w, prompt.ID, to)
+	if !ok {
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, services.DiffPromptVersions(fromVersion, toVersion), h.logger)
+}
+
+// RollbackPrompt handles POST /v1/prompts/{promptId}/rollback. The prompt gets the content of
+// the requested version back as a new version, so the history stays intact.
+func (h *PromptsHandler) RollbackPrompt(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if h.versionsRepo == nil {
+		WriteError(w, http.StatusServiceUnavailable, ErrorCodeInternalServer, "Prompt versions not available", nil, h.logger)
+		return
+	}
+
+	var req RollbackPromptRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteError(w, http.StatusBadRequest, ErrorCodeInvalidInput, "Invalid request body", nil, h.logger)
+		return
+	}
+	defer r.Body.Close()
+
+	if req.Version < 1 {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, "version must be a version number", nil, h.logger)
+		return
+	}
+
+	prompt, ok := h.findPromptForVersions(w, r)
+	if !ok {
+		return
+	}
+
+	version, ok := h.findPromptVersion(ctx, w, prompt.ID, req.Version)
+	if !ok {
+		return
+	}
+
+	// Versions saved before template validation may no longer render
+	prompt.Restore(version)
+	if err := services.ValidatePromptVariables(prompt.PromptTemplate, prompt.Type); err != nil {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, fmt.Sprintf("version %d can't be restored: %v", version.Version, err), nil, h.logger)
+		return
+	}
+
+	if err := h.promptsRepo.Update(ctx, prompt); err != nil {
+		statusCode, code, message := MapDomainError(err, h.logger)
+		WriteError(w, statusCode, code, message, nil, h.logger)
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, PromptDTO{
+		ID:             prompt.ID,
+		UserID:         prompt.UserID,
+		Type:           string(prompt.Type),
+		StyleName:      prompt.StyleName,
+		PromptTemplate: prompt.PromptTemplate,
+		SystemMessage:  prompt.SystemMessage,
+		Parameters:     newPromptParametersDTO(prompt.Parameters),
+		Version:        prompt.Version,
+		Active:         prompt.Active,
+		CreatedAt:      prompt.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:      prompt.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}, h.logger)
+}
+
+// findPromptForVersions loads the prompt of the request path, writing the error response when it fails
+func (h *PromptsHandler) findPromptForVersions(w http.ResponseWriter, r *http.Request) (*entities.Prompt, bool) {
+	promptID := mux.Vars(r)["promptId"]
+	if promptID == "" {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, "prompt_id is required", nil, h.logger)
+		return nil, false
+	}
+
+	prompt, err := h.promptsRepo.FindByID(r.Context(), promptID)
+	if err != nil {
+		statusCode, code, message := MapDomainError(err, h.logger)
+		WriteError(w, statusCode, code, message, nil, h.logger)
+		return nil, false
+	}
+	if prompt == nil {
+		WriteError(w, http.StatusNotFound, ErrorCodeNotFound, "prompt not found", nil, h.logger)
+		return nil, false
+	}
+
+	return prompt, true
+}
+
+// findPromptVersion loads one version of a prompt, writing the error response when it fails
+func (h *PromptsHandler) findPromptVersion(ctx context.Context, w http.ResponseWriter, promptID string, number int) (*entities.PromptVersion, bool) {
+	version, err := h.versionsRepo.FindByPromptIDAndVersion(ctx, promptID, number)
+	if err != nil {
+		statusCode, code, message := MapDomainError(err, h.logger)
+		WriteError(w, statusCode, code, message, nil, h.logger)
+		return nil, false
+	}
+	if version == nil {
+		WriteError(w, http.StatusNotFound, ErrorCodeNotFound, fmt.Sprintf("prompt version %d not found", number), nil, h.logger)
+		return nil, false
+	}
+
+	return version, true
+}
+
+// newPromptVersionDTO converts a prompt version to its response
+func newPromptVersionDTO(version *entities.PromptVersion) PromptVersionDTO {
+	return PromptVersionDTO{
+		PromptID:       version.PromptID,
+		Version:        version.Version,
+		Type:           string(version.Type),
+		Name:           version.Name,
+		PromptTemplate: version.PromptTemplate,
+		SystemMessage:  version.SystemMessage,
+		Parameters:     newPromptParametersDTO(version.Parameters),
+		CreatedAt:      version.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
 // RegisterRoutes registers all prompt routes
 func (h *PromptsHandler) RegisterRoutes(router *mux.Router) {
 	router.HandleFunc("/v1/prompts/{userId}", h.ListPrompts).Methods(http.MethodGet)
 	router.HandleFunc("/v1/prompts", h.CreatePrompt).Methods(http.MethodPost)
 	router.HandleFunc("/v1/prompts/{promptId}", h.UpdatePrompt).Methods(http.MethodPatch)
+	// Version routes go before /v1/prompts/{userId}/{name}, which would match them too
+	router.HandleFunc("/v1/prompts/{promptId}/versions", h.ListPromptVersions).Methods(http.MethodGet)
+	router.HandleFunc("/v1/prompts/{promptId}/versions/diff", h.DiffPromptVersions).Methods(http.MethodGet)
+	router.HandleFunc("/v1/prompts/{promptId}/rollback", h.RollbackPrompt).Methods(http.MethodPost)
 	router.HandleFunc("/v1/prompts/{userId}/{name}", h.GetPromptByName).Methods(http.MethodGet)
 	router.HandleFunc("/v1/prompts/{promptId}", h.DeletePrompt).Methods(http.MethodDelete)
 
@@ -0,0 +1,306 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/linkgen-ai/backend/src/application/usecases"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"go.uber.org/zap"
+)
+
+// SchedulesHandler handles per-user cron schedule HTTP requests
+type SchedulesHandler struct {
+	manageSchedulesUseCase *usecases.ManageSchedulesUseCase
+	logger                 *zap.Logger
+}
+
+// NewSchedulesHandler creates a new SchedulesHandler instance
+func NewSchedulesHandler(
+	manageSchedulesUseCase *usecases.ManageSchedulesUseCase,
+	logger *zap.Logger,
+) *SchedulesHandler {
+	if logger == nil {
+		logger, _ = zap.NewProduction()
+	}
+
+	return &SchedulesHandler{
+		manageSchedulesUseCase: manageSchedulesUseCase,
+		logger:                 logger,
+	}
+}
+
+// ScheduleDTO represents a schedule in the response
+type ScheduleDTO struct {
+	ID             string   `json:"id"`
+	UserID         string   `json:"user_id"`
+	Name           string   `json:"name,omitempty"`
+	CronExpression string   `json:"cron_expression"`
+	TimeZone       string   `json:"time_zone"`
+	Actions        []string `json:"actions"`
+	Active         bool     `json:"active"`
+	NextRunAt      *string  `json:"next_run_at,omitempty"`
+	LastRunAt      *string  `json:"last_run_at,omitempty"`
+	CreatedAt      string   `json:"created_at"`
+	UpdatedAt      string   `json:"updated_at"`
+}
+
+// GetSchedulesResponse represents the response for listing schedules
+type GetSchedulesResponse struct {
+	Schedules []ScheduleDTO `json:"schedules"`
+	Count     int           `json:"count"`
+}
+
+// ScheduleRequest represents the request to create or replace a schedule
+type ScheduleRequest struct {
+	Name           string   `json:"name,omitempty"`
+	CronExpression string   `json:"cron_expression"`
+	TimeZone       string   `json:"time_zone,omitempty"`
+	Actions        []string `json:"actions"`
+	Active         *bool    `json:"active,omitempty"`
+}
+
+// Validate validates the schedule request
+func (r *ScheduleRequest) Validate() error {
+	if strings.TrimSpace(r.CronExpression) == "" {
+		return fmt.Errorf("cron_expression is required")
+	}
+	if len(r.Actions) == 0 {
+		return fmt.Errorf("actions must contain at least one action")
+	}
+	for _, action := range r.Actions {
+		if !entities.ScheduleAction(action).IsValid() {
+			return fmt.Errorf("unsupported action: %s", action)
+		}
+	}
+	return nil
+}
+
+// toInput converts the request into use case input for the user
+func (r *ScheduleRequest) toInput(userID string) usecases.ScheduleInput {
+	actions := make([]entities.ScheduleAction, len(r.Actions))
+	for i, action := range r.Actions {
+		actions[i] = entities.ScheduleAction(action)
+	}
+
+	return usecases.ScheduleInput{
+		UserID:         userID,
+		Name:           r.Name,
+		CronExpression: r.CronExpression,
+		TimeZone:       r.TimeZone,
+		Actions:        actions,
+		Active:         r.Active,
+	}
+}
+
+// newScheduleDTO converts a schedule entity to its response representation
+func newScheduleDTO(schedule *entities.Schedule) ScheduleDTO {
+	actions := make([]string, len(schedule.Actions))
+	for i, action := range schedule.Actions {
+		actions[i] = string(action)
+	}
+
+	dto := ScheduleDTO{
+		ID:             schedule.ID,
+		UserID:         schedule.UserID,
+		Name:           schedule.Name,
+		CronExpression: schedule.CronExpression,
+		TimeZone:       schedule.TimeZone,
+		Actions:        actions,
+		Active:         schedule.Active,
+		CreatedAt:      schedule.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:      schedule.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if schedule.NextRunAt != nil {
+		nextRunAtStr := schedule.NextRunAt.Format("2006-01-02T15:04:05Z07:00")
+		dto.NextRunAt = &nextRunAtStr
+	}
+
+	if schedule.LastRunAt != nil {
+		lastRunAtStr := schedule.LastRunAt.Format("2006-01-02T15:04:05Z07:00")
+		dto.LastRunAt = &lastRunAtStr
+	}
+
+	return dto
+}
+
+// GetSchedules handles GET /v1/schedules/{userId}
+func (h *SchedulesHandler) GetSchedules(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.pathUserID(w, r)
+	if !ok {
+		return
+	}
+
+	schedules, err := h.manageSchedulesUseCase.List(r.Context(), userID)
+	if err != nil {
+		statusCode, code, message := MapDomainError(err, h.logger)
+		WriteError(w, statusCode, code, message, nil, h.logger)
+		return
+	}
+
+	scheduleDTOs := make([]ScheduleDTO, 0, len(schedules))
+	for _, schedule := range schedules {
+		scheduleDTOs = append(scheduleDTOs, newScheduleDTO(schedule))
+	}
+
+	WriteJSON(w, http.StatusOK, GetSchedulesResponse{
+		Schedules: scheduleDTOs,
+		Count:     len(scheduleDTOs),
+	}, h.logger)
+}
+
+// CreateSchedule handles POST /v1/schedules/{userId}
+func (h *SchedulesHandler) CreateSchedule(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.pathUserID(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := h.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	schedule, err := h.manageSchedulesUseCase.Create(r.Context(), req.toInput(userID))
+	if err != nil {
+		statusCode, code, message := MapDomainError(err, h.logger)
+		WriteError(w, statusCode, code, message, nil, h.logger)
+		return
+	}
+
+	h.logger.Info("schedule created",
+		zap.String("schedule_id", schedule.ID),
+		zap.String("user_id", userID),
+		zap.String("cron_expression", schedule.CronExpression),
+	)
+
+	WriteJSON(w, http.StatusCreated, newScheduleDTO(schedule), h.logger)
+}
+
+// GetSchedule handles GET /v1/schedules/{userId}/{scheduleId}
+func (h *SchedulesHandler) GetSchedule(w http.ResponseWriter, r *http.Request) {
+	userID, scheduleID, ok := h.pathIDs(w, r)
+	if !ok {
+		return
+	}
+
+	schedule, err := h.manageSchedulesUseCase.Get(r.Context(), userID, scheduleID)
+	if err != nil {
+		statusCode, code, message := MapDomainError(err, h.logger)
+		WriteError(w, statusCode, code, message, nil, h.logger)
+		return
+	}
+
+	WriteJSON(w, http.StatusOK, newScheduleDTO(schedule), h.logger)
+}
+
+// UpdateSchedule handles PUT /v1/schedules/{userId}/{scheduleId}
+func (h *SchedulesHandler) UpdateSchedule(w http.ResponseWriter, r *http.Request) {
+	userID, scheduleID, ok := h.pathIDs(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := h.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	schedule, err := h.manageSchedulesUseCase.Update(r.Context(), scheduleID, req.toInput(userID))
+	if err != nil {
+		statusCode, code, message := MapDomainError(err, h.logger)
+		WriteError(w, statusCode, code, message, nil, h.logger)
+		return
+	}
+
+	h.logger.Info("schedule updated",
+		zap.String("schedule_id", schedule.ID),
+		zap.String("user_id", userID),
+	)
+
+	WriteJSON(w, http.StatusOK, newScheduleDTO(schedule), h.logger)
+}
+
+// DeleteSchedule handles DELETE /v1/schedules/{userId}/{scheduleId}
+func (h *SchedulesHandler) DeleteSchedule(w http.ResponseWriter, r *http.Request) {
+	userID, scheduleID, ok := h.pathIDs(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.manageSchedulesUseCase.Delete(r.Context(), userID, scheduleID); err != nil {
+		statusCode, code, message := MapDomainError(err, h.logger)
+		WriteError(w, statusCode, code, message, nil, h.logger)
+		return
+	}
+
+	h.logger.Info("schedule deleted",
+		zap.String("schedule_id", scheduleID),
+		zap.String("user_id", userID),
+	)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// pathUserID extracts and validates the userId path parameter
+func (h *SchedulesHandler) pathUserID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userID := mux.Vars(r)["userId"]
+
+	if userID == "" {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, "user_id is required", nil, h.logger)
+		return "", false
+	}
+
+	if !isValidObjectID(userID) {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, "invalid user_id format", nil, h.logger)
+		return "", false
+	}
+
+	return userID, true
+}
+
+// pathIDs extracts and validates the userId and scheduleId path parameters
+func (h *SchedulesHandler) pathIDs(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	userID, ok := h.pathUserID(w, r)
+	if !ok {
+		return "", "", false
+	}
+
+	scheduleID := mux.Vars(r)["scheduleId"]
+	if !isValidObjectID(scheduleID) {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, "invalid schedule_id format", nil, h.logger)
+		return "", "", false
+	}
+
+	return userID, scheduleID, true
+}
+
+// decodeRequest parses and validates a schedule request body
+func (h *SchedulesHandler) decodeRequest(w http.ResponseWriter, r *http.Request) (*ScheduleRequest, bool) {
+	var req ScheduleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteError(w, http.StatusBadRequest, ErrorCodeInvalidInput, "Invalid request body", nil, h.logger)
+		return nil, false
+	}
+	defer r.Body.Close()
+
+	if err := req.Validate(); err != nil {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, err.Error(), nil, h.logger)
+		return nil, false
+	}
+
+	return &req, true
+}
+
+// RegisterRoutes registers schedule routes
+func (h *SchedulesHandler) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/v1/schedules/{userId}", h.GetSchedules).Methods(http.MethodGet)
+	router.HandleFunc("/v1/schedules/{userId}", h.CreateSchedule).Methods(http.MethodPost)
+	router.HandleFunc("/v1/schedules/{userId}/{scheduleId}", h.GetSchedule).Methods(http.MethodGet)
+	router.HandleFunc("/v1/schedules/{userId}/{scheduleId}", h.UpdateSchedule).Methods(http.MethodPut)
+	router.HandleFunc("/v1/schedules/{userId}/{scheduleId}", h.DeleteSchedule).Methods(http.MethodDelete)
+}
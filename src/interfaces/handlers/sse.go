@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Server-Sent Events emitted by streaming endpoints
+const (
+	// EventDelta carries a piece of generated content
+	EventDelta = "delta"
+	// EventDone carries the final result once it has been saved
+	EventDone = "done"
+	// EventError carries an ErrorDetail when the stream fails after it started
+	EventError = "error"
+)
+
+// StreamDelta is the data of a delta event
+type StreamDelta struct {
+	Content string `json:"content"`
+}
+
+// wantsEventStream checks if the client asked for a Server-Sent Events response
+func wantsEventStream(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+}
+
+// eventStream writes Server-Sent Events. The headers are sent with the first event, so
+// errors found before anything was streamed can still be answered with WriteError.
+type eventStream struct {
+	w          http.ResponseWriter
+	controller *http.ResponseController
+	started    bool
+}
+
+// newEventStream creates an event stream on w
+func newEventStream(w http.ResponseWriter) *eventStream {
+	return &eventStream{w: w, controller: http.NewResponseController(w)}
+}
+
+// Started reports whether an event has been sent
+func (s *eventStream) Started() bool {
+	return s.started
+}
+
+// Send writes an event with data encoded as JSON and flushes it to the client
+func (s *eventStream) Send(event string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", event, err)
+	}
+
+	if !s.started {
+		s.start()
+	}
+
+	if _, err := fmt.Fprintf(s.w, "event: %s\ndata: %s\n\n", event, payload); err != nil {
+		return err
+	}
+	return s.controller.Flush()
+}
+
+// start sends the stream headers; a stream lasts as long as the LLM takes,
+// so it isn't bound by the server write timeout
+func (s *eventStream) start() {
+	s.started = true
+	_ = s.controller.SetWriteDeadline(time.Time{})
+
+	header := s.w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	// Stop reverse proxies such as nginx from buffering the events
+	header.Set("X-Accel-Buffering", "no")
+	s.w.WriteHeader(http.StatusOK)
+}
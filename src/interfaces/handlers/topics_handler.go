@@ -17,18 +17,18 @@ import (
 
 // TopicsHandler handles topic-related HTTP requests
 type TopicsHandler struct {
-	topicRepo       interfaces.TopicRepository
-	userRepo        interfaces.UserRepository
-	promptsRepo     interfaces.PromptsRepository
-	ideasRepo       interfaces.IdeasRepository
-	generateIdeasUC GenerateIdeasUseCase
-	logger          *zap.Logger
+	topicRepo    interfaces.TopicRepository
+	userRepo     interfaces.UserRepository
+	promptsRepo  interfaces.PromptsRepository
+	ideasRepo    interfaces.IdeasRepository
+	topicIdeas   TopicIdeasQueue
+	quotaChecker interfaces.LLMQuotaChecker
+	logger       *zap.Logger
 }
 
-// GenerateIdeasUseCase defines the interface for generating ideas
-type GenerateIdeasUseCase interface {
-	GenerateIdeasForUser(ctx context.Context, userID string, count int) ([]*entities.Idea, error)
-	GenerateIdeasForTopic(ctx context.Context, topicID string) ([]*entities.Idea, error)
+// TopicIdeasQueue queues idea generation for a topic without blocking the request
+type TopicIdeasQueue interface {
+	Enqueue(topicID string) error
 }
 
 // NewTopicsHandler creates a new TopicsHandler instance
@@ -37,7 +37,7 @@ func NewTopicsHandler(
 	userRepo interfaces.UserRepository,
 	promptsRepo interfaces.PromptsRepository,
 	ideasRepo interfaces.IdeasRepository,
-	generateIdeasUC GenerateIdeasUseCase,
+	topicIdeas TopicIdeasQueue,
 	logger *zap.Logger,
 ) *TopicsHandler {
 	if logger == nil {
@@ -45,15 +45,21 @@ func NewTopicsHandler(
 	}
 
 	return &TopicsHandler{
-		topicRepo:       topicRepo,
-		userRepo:        userRepo,
-		promptsRepo:     promptsRepo,
-		ideasRepo:       ideasRepo,
-		generateIdeasUC: generateIdeasUC,
-		logger:          logger,
+		topicRepo:   topicRepo,
+		userRepo:    userRepo,
+		promptsRepo: promptsRepo,
+		ideasRepo:   ideasRepo,
+		topicIdeas:  topicIdeas,
+		logger:      logger,
 	}
 }
 
+// SetQuotaChecker rejects topic changes with 429 once the user has used up an LLM quota,
+// since every created or updated topic queues idea generation
+func (h *TopicsHandler) SetQuotaChecker(checker interfaces.LLMQuotaChecker) {
+	h.quotaChecker = checker
+}
+
 // TopicDTO represents a topic in the response
 type TopicDTO struct {
 	ID            string   `json:"id"`
@@ -286,6 +292,10 @@ func (h *TopicsHandler) CreateTopic(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !checkLLMQuota(ctx, w, h.quotaChecker, topic.UserID, h.logger) {
+		return
+	}
+
 	// Save topic
 	topicID, err := h.topicRepo.Create(ctx, topic)
 	if err != nil {
@@ -295,20 +305,7 @@ func (h *TopicsHandler) CreateTopic(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Use the specific topic's prompt for idea generation instead of auto-generating
-	if h.generateIdeasUC != nil {
-		go func() {
-			generateCtx := context.Background()
-			_, generateErr := h.generateIdeasUC.GenerateIdeasForTopic(generateCtx, topicID)
-			if generateErr != nil {
-				h.logger.Warn("Failed to auto-generate ideas for new topic",
-					zap.String("topic_id", topicID),
-					zap.Error(generateErr))
-			} else {
-				h.logger.Info("Auto-generated ideas for new topic",
-					zap.String("topic_id", topicID))
-			}
-		}()
-	}
+	h.enqueueTopicIdeas(topicID)
 
 	// Return created topic
 	WriteJSON(w, http.StatusCreated, TopicDTO{
@@ -409,6 +406,11 @@ func (h *TopicsHandler) UpdateTopic(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Checked before the old ideas are deleted, so they aren't lost without a regeneration
+	if !checkLLMQuota(ctx, w, h.quotaChecker, topic.UserID, h.logger) {
+		return
+	}
+
 	// Persist changes
 	if err := h.topicRepo.Update(ctx, topic); err != nil {
 		statusCode, code, message := MapDomainError(err, h.logger)
@@ -429,20 +431,7 @@ func (h *TopicsHandler) UpdateTopic(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Regenerate ideas for the updated topic asynchronously
-	if h.generateIdeasUC != nil {
-		go func() {
-			generateCtx := context.Background()
-			_, generateErr := h.generateIdeasUC.GenerateIdeasForTopic(generateCtx, topicID)
-			if generateErr != nil {
-				h.logger.Warn("Failed to regenerate ideas for updated topic",
-					zap.String("topic_id", topicID),
-					zap.Error(generateErr))
-			} else {
-				h.logger.Info("Regenerated ideas for updated topic",
-					zap.String("topic_id", topicID))
-			}
-		}()
-	}
+	h.enqueueTopicIdeas(topicID)
 
 	// Return updated topic
 	WriteJSON(w, http.StatusOK, TopicDTO{
@@ -523,6 +512,20 @@ func (h *TopicsHandler) validatePromptReference(ctx context.Context, userID stri
 	return nil
 }
 
+// enqueueTopicIdeas queues idea generation for a topic. A full queue doesn't fail
+// the request; the idea scheduler generates the missing ideas on its next run.
+func (h *TopicsHandler) enqueueTopicIdeas(topicID string) {
+	if h.topicIdeas == nil {
+		return
+	}
+
+	if err := h.topicIdeas.Enqueue(topicID); err != nil {
+		h.logger.Warn("Failed to queue idea generation for topic, leaving it to the idea scheduler",
+			zap.String("topic_id", topicID),
+			zap.Error(err))
+	}
+}
+
 // RegisterRoutes registers all topic routes
 func (h *TopicsHandler) RegisterRoutes(router *mux.Router) {
 	router.HandleFunc("/v1/topics/{userId}", h.GetTopics).Methods(http.MethodGet)
@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/linkgen-ai/backend/src/application/usecases"
+	"go.uber.org/zap"
+)
+
+// usageDateLayout is the short date form accepted by the from and to query parameters
+const usageDateLayout = "2006-01-02"
+
+// UsageHandler handles LLM usage accounting HTTP requests
+type UsageHandler struct {
+	getLLMUsageUseCase *usecases.GetLLMUsageUseCase
+	logger             *zap.Logger
+}
+
+// NewUsageHandler creates a new UsageHandler instance
+func NewUsageHandler(
+	getLLMUsageUseCase *usecases.GetLLMUsageUseCase,
+	logger *zap.Logger,
+) *UsageHandler {
+	if logger == nil {
+		logger, _ = zap.NewProduction()
+	}
+
+	return &UsageHandler{
+		getLLMUsageUseCase: getLLMUsageUseCase,
+		logger:             logger,
+	}
+}
+
+// UsageLineDTO represents aggregated LLM usage in the response
+type UsageLineDTO struct {
+	Model            string  `json:"model,omitempty"`
+	PromptName       string  `json:"prompt_name,omitempty"`
+	Calls            int     `json:"calls"`
+	PromptTokens     int     `json:"prompt_tokens"`
+	CompletionTokens int     `json:"completion_tokens"`
+	TotalTokens      int     `json:"total_tokens"`
+	AvgLatencyMs     int64   `json:"avg_latency_ms"`
+	EstimatedCostUSD float64 `json:"estimated_cost_usd"`
+	Priced           bool    `json:"priced"`
+}
+
+// GetUsageResponse represents the response for LLM usage
+type GetUsageResponse struct {
+	UserID   string         `json:"user_id"`
+	JobID    string         `json:"job_id,omitempty"`
+	From     string         `json:"from"`
+	To       string         `json:"to"`
+	Total    UsageLineDTO   `json:"total"`
+	ByModel  []UsageLineDTO `json:"by_model"`
+	ByPrompt []UsageLineDTO `json:"by_prompt"`
+}
+
+// newUsageLineDTO converts an aggregated usage line to its response representation
+func newUsageLineDTO(line usecases.LLMUsageLine) UsageLineDTO {
+	return UsageLineDTO{
+		Model:            line.Model,
+		PromptName:       line.PromptName,
+		Calls:            line.Calls,
+		PromptTokens:     line.PromptTokens,
+		CompletionTokens: line.CompletionTokens,
+		TotalTokens:      line.TotalTokens(),
+		AvgLatencyMs:     line.AverageLatency.Milliseconds(),
+		// Round to a hundredth of a cent
+		EstimatedCostUSD: math.Round(line.EstimatedCost*10000) / 10000,
+		Priced:           line.Priced,
+	}
+}
+
+// GetUsage handles GET /v1/usage/{userId}?from=&to=&job_id=
+func (h *UsageHandler) GetUsage(w http.ResponseWriter, r *http.Request) {
+	userID := mux.Vars(r)["userId"]
+
+	if userID == "" {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, "user_id is required", nil, h.logger)
+		return
+	}
+
+	if !isValidObjectID(userID) {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, "invalid user_id format", nil, h.logger)
+		return
+	}
+
+	query := r.URL.Query()
+	from, err := parseUsageTime(query.Get("from"), false)
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, "invalid from: use RFC 3339 or YYYY-MM-DD", nil, h.logger)
+		return
+	}
+	to, err := parseUsageTime(query.Get("to"), true)
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, ErrorCodeValidation, "invalid to: use RFC 3339 or YYYY-MM-DD", nil, h.logger)
+		return
+	}
+
+	report, err := h.getLLMUsageUseCase.Execute(r.Context(), usecases.GetLLMUsageInput{
+		UserID: userID,
+		JobID:  strings.TrimSpace(query.Get("job_id")),
+		From:   from,
+		To:     to,
+	})
+	if err != nil {
+		statusCode, code, message := MapDomainError(err, h.logger)
+		WriteError(w, statusCode, code, message, nil, h.logger)
+		return
+	}
+
+	response := GetUsageResponse{
+		UserID:   report.UserID,
+		JobID:    report.JobID,
+		From:     report.From.Format(time.RFC3339),
+		To:       report.To.Format(time.RFC3339),
+		Total:    newUsageLineDTO(report.Total),
+		ByModel:  make([]UsageLineDTO, 0, len(report.ByModel)),
+		ByPrompt: make([]UsageLineDTO, 0, len(report.ByPrompt)),
+	}
+	for _, line := range report.ByModel {
+		response.ByModel = append(response.ByModel, newUsageLineDTO(line))
+	}
+	for _, line := range report.ByPrompt {
+		response.ByPrompt = append(response.ByPrompt, newUsageLineDTO(line))
+	}
+
+	WriteJSON(w, http.StatusOK, response, h.logger)
+}
+
+// parseUsageTime parses a from/to query parameter; a bare date used as the end of the
+// range includes that whole day
+func parseUsageTime(value string, endOfRange bool) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC(), nil
+	}
+
+	t, err := time.Parse(usageDateLayout, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if endOfRange {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t, nil
+}
+
+// RegisterRoutes registers usage routes
+func (h *UsageHandler) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/v1/usage/{userId}", h.GetUsage).Methods(http.MethodGet)
+}
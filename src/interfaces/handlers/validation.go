@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 )
 
 var (
@@ -62,6 +63,73 @@ func (r *RefineDraftRequest) Validate() error {
 	return nil
 }
 
+// PublishDraftRequest represents the request for draft publishing
+type PublishDraftRequest struct {
+	UserID string `json:"user_id"`
+}
+
+// Validate validates the PublishDraftRequest
+func (r *PublishDraftRequest) Validate() error {
+	r.UserID = strings.TrimSpace(r.UserID)
+
+	if r.UserID == "" {
+		return fmt.Errorf("user_id is required")
+	}
+
+	if !isValidObjectID(r.UserID) {
+		return fmt.Errorf("invalid user_id format")
+	}
+
+	return nil
+}
+
+// ScheduleDraftRequest represents the request for draft scheduling
+type ScheduleDraftRequest struct {
+	UserID    string    `json:"user_id"`
+	PublishAt time.Time `json:"publish_at"`
+}
+
+// Validate validates the ScheduleDraftRequest
+func (r *ScheduleDraftRequest) Validate() error {
+	r.UserID = strings.TrimSpace(r.UserID)
+
+	if r.UserID == "" {
+		return fmt.Errorf("user_id is required")
+	}
+
+	if !isValidObjectID(r.UserID) {
+		return fmt.Errorf("invalid user_id format")
+	}
+
+	if r.PublishAt.IsZero() {
+		return fmt.Errorf("publish_at is required")
+	}
+
+	return nil
+}
+
+// LinkedInAuthCallbackRequest represents query parameters of the LinkedIn OAuth callback
+type LinkedInAuthCallbackRequest struct {
+	Code  string
+	State string
+}
+
+// Validate validates the LinkedInAuthCallbackRequest
+func (r *LinkedInAuthCallbackRequest) Validate() error {
+	r.Code = strings.TrimSpace(r.Code)
+	r.State = strings.TrimSpace(r.State)
+
+	if r.Code == "" {
+		return fmt.Errorf("code is required")
+	}
+
+	if r.State == "" {
+		return fmt.Errorf("state is required")
+	}
+
+	return nil
+}
+
 // ListIdeasRequest represents query parameters for listing ideas
 type ListIdeasRequest struct {
 	Topic string
@@ -93,10 +161,12 @@ func (r *ListDraftsRequest) Validate() error {
 	// Status is optional, but if provided must be valid
 	if r.Status != "" {
 		validStatuses := map[string]bool{
-			"DRAFT":     true,
-			"REFINED":   true,
-			"PUBLISHED": true,
-			"FAILED":    true,
+			"DRAFT":      true,
+			"REFINED":    true,
+			"SCHEDULED":  true,
+			"PUBLISHING": true,
+			"PUBLISHED":  true,
+			"FAILED":     true,
 		}
 		if !validStatuses[strings.ToUpper(r.Status)] {
 			return fmt.Errorf("invalid status value")
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/signal"
@@ -9,6 +10,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/google/uuid"
 	appServices "github.com/linkgen-ai/backend/src/application/services"
 	"github.com/linkgen-ai/backend/src/application/usecases"
 	"github.com/linkgen-ai/backend/src/application/workers"
@@ -18,7 +20,12 @@ import (
 	"github.com/linkgen-ai/backend/src/infrastructure/database"
 	dbRepos "github.com/linkgen-ai/backend/src/infrastructure/database/repositories"
 	httpServer "github.com/linkgen-ai/backend/src/infrastructure/http"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/linkedin"
 	"github.com/linkgen-ai/backend/src/infrastructure/http/llm"
+	llmCache "github.com/linkgen-ai/backend/src/infrastructure/http/llm/cache"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/dispatch"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/fallback"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/replay"
 	"github.com/linkgen-ai/backend/src/infrastructure/messaging/nats"
 	infraServices "github.com/linkgen-ai/backend/src/infrastructure/services"
 	"github.com/linkgen-ai/backend/src/interfaces/handlers"
@@ -32,34 +39,61 @@ type Application struct {
 	logger *zap.Logger
 
 	// Infrastructure
-	dbClient   *database.Client
-	natsClient *nats.NATSClient
-	llmClient  *llm.LLMHTTPClient
+	dbClient       *database.Client
+	natsClient     *nats.NATSClient
+	llmClient      *llm.LLMHTTPClient
+	llmFallback    *fallback.Client
+	llmDispatcher  *dispatch.Client
+	llmService     interfaces.LLMService
+	linkedInClient *linkedin.LinkedInAPIClient
 
 	// Repositories
-	userRepo     interfaces.UserRepository
-	topicRepo    interfaces.TopicRepository
-	ideaRepo     interfaces.IdeasRepository
-	draftRepo    interfaces.DraftRepository
-	promptsRepo  interfaces.PromptsRepository
-	jobRepo      interfaces.JobRepository
-	jobErrorRepo interfaces.JobErrorRepository
+	userRepo           interfaces.UserRepository
+	topicRepo          interfaces.TopicRepository
+	ideaRepo           interfaces.IdeasRepository
+	draftRepo          interfaces.DraftRepository
+	promptsRepo        interfaces.PromptsRepository
+	jobRepo            interfaces.JobRepository
+	jobErrorRepo       interfaces.JobErrorRepository
+	oauthRepo          interfaces.OAuthStateRepository
+	scheduleRepo       interfaces.ScheduleRepository
+	leaseRepo          interfaces.LeaseRepository
+	llmUsageRepo       interfaces.LLMUsageRepository
+	promptVersionsRepo interfaces.PromptVersionsRepository
+	experimentRepo     interfaces.ExperimentRepository
 
 	// Services
-	promptEngine *infraServices.PromptEngine
+	promptEngine  *infraServices.PromptEngine
+	ideaScheduler *appServices.SchedulerService
+	llmQuota      *appServices.LLMQuotaService
 
 	// Use cases
-	generateDraftsUC *usecases.GenerateDraftsUseCase
-	generateIdeasUC  *usecases.GenerateIdeasUseCase
-	listIdeasUC      *usecases.ListIdeasUseCase
-	clearIdeasUC     *usecases.ClearIdeasUseCase
-	refineDraftUC    *usecases.RefineDraftUseCase
+	generateDraftsUC    *usecases.GenerateDraftsUseCase
+	generateIdeasUC     *usecases.GenerateIdeasUseCase
+	listIdeasUC         *usecases.ListIdeasUseCase
+	clearIdeasUC        *usecases.ClearIdeasUseCase
+	refineDraftUC       *usecases.RefineDraftUseCase
+	publishDraftUC      *usecases.PublishDraftUseCase
+	scheduleDraftUC     *usecases.ScheduleDraftUseCase
+	startAuthUC         *usecases.StartLinkedInAuthUseCase
+	completeAuthUC      *usecases.CompleteLinkedInAuthUseCase
+	refreshTokenUC      *usecases.RefreshLinkedInTokenUseCase
+	manageSchedulesUC   *usecases.ManageSchedulesUseCase
+	manageExperimentsUC *usecases.ManageExperimentsUseCase
+	runScheduledUC      *usecases.RunScheduledActionUseCase
+	getLLMUsageUC       *usecases.GetLLMUsageUseCase
+	manageLLMKeysUC     *usecases.ManageLLMKeysUseCase
 
 	// Workers
-	draftWorker  *workers.DraftGenerationWorker
-	workerCtx    context.Context
-	workerCancel context.CancelFunc
-	workerWg     sync.WaitGroup
+	draftWorker     *workers.DraftGenerationWorker
+	publisherWorker *workers.ScheduledPublisherWorker
+	refreshWorker   *workers.TokenRefreshWorker
+	dispatchWorker  *workers.ScheduleDispatcherWorker
+	actionWorker    *workers.ScheduledActionWorker
+	topicIdeas      *workers.TopicIdeasWorker
+	workerCtx       context.Context
+	workerCancel    context.CancelFunc
+	workerWg        sync.WaitGroup
 
 	// HTTP Server
 	httpServer *httpServer.Server
@@ -258,15 +292,58 @@ func (a *Application) initialize(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to get job errors collection: %w", err)
 	}
+	oauthStatesCol, err := dbClient.GetCollection(database.CollectionOAuthStates)
+	if err != nil {
+		return fmt.Errorf("failed to get oauth states collection: %w", err)
+	}
+	schedulesCol, err := dbClient.GetCollection(database.CollectionSchedules)
+	if err != nil {
+		return fmt.Errorf("failed to get schedules collection: %w", err)
+	}
+	leasesCol, err := dbClient.GetCollection(database.CollectionLeases)
+	if err != nil {
+		return fmt.Errorf("failed to get leases collection: %w", err)
+	}
+	llmUsageCol, err := dbClient.GetCollection(database.CollectionLLMUsage)
+	if err != nil {
+		return fmt.Errorf("failed to get llm usage collection: %w", err)
+	}
+	promptVersionsCol, err := dbClient.GetCollection(database.CollectionPromptVersions)
+	if err != nil {
+		return fmt.Errorf("failed to get prompt versions collection: %w", err)
+	}
+	experimentsCol, err := dbClient.GetCollection(database.CollectionExperiments)
+	if err != nil {
+		return fmt.Errorf("failed to get experiments collection: %w", err)
+	}
+
+	// LinkedIn tokens are always encrypted at rest; without a key no token can be stored,
+	// so the LinkedIn connection routes are not registered
+	var tokenCipher dbRepos.TokenCipher
+	if cfg.LinkedIn.TokenEncryptionKey != "" {
+		secretStore, err := config.NewSecretStoreWithKey(cfg.LinkedIn.TokenEncryptionKey)
+		if err != nil {
+			return fmt.Errorf("invalid LinkedIn token encryption key: %w", err)
+		}
+		tokenCipher = secretStore
+	} else {
+		a.logger.Warn("LinkedIn token encryption key not configured, connecting LinkedIn accounts is disabled")
+	}
 
 	// Initialize repositories
-	a.userRepo = dbRepos.NewUserRepository(usersCol)
+	a.userRepo = dbRepos.NewUserRepository(usersCol, tokenCipher)
 	a.topicRepo = dbRepos.NewTopicRepository(topicsCol)
 	a.ideaRepo = dbRepos.NewIdeasRepository(ideasCol)
 	a.draftRepo = dbRepos.NewDraftRepository(draftsCol)
-	a.promptsRepo = dbRepos.NewPromptsRepository(promptsCol)
+	a.promptVersionsRepo = dbRepos.NewPromptVersionsRepository(promptVersionsCol)
+	a.promptsRepo = dbRepos.NewVersionedPromptsRepository(dbRepos.NewPromptsRepository(promptsCol), a.promptVersionsRepo)
 	a.jobRepo = dbRepos.NewJobRepository(jobsCol)
 	a.jobErrorRepo = dbRepos.NewJobErrorRepository(jobErrorsCol)
+	a.oauthRepo = dbRepos.NewOAuthStateRepository(oauthStatesCol)
+	a.scheduleRepo = dbRepos.NewScheduleRepository(schedulesCol)
+	a.leaseRepo = dbRepos.NewLeaseRepository(leasesCol)
+	a.llmUsageRepo = dbRepos.NewLLMUsageRepository(llmUsageCol)
+	a.experimentRepo = dbRepos.NewExperimentRepository(experimentsCol)
 
 	// Initialize LLM client
 	llmConfig := llm.Config{
@@ -274,6 +351,12 @@ func (a *Application) initialize(ctx context.Context) error {
 		Timeout:    cfg.LLM.Timeout,
 		MaxRetries: 3,
 		Model:      cfg.LLM.Model,
+		Provider:   cfg.LLM.Provider,
+		APIKey:     cfg.LLM.APIKey,
+
+		Temperature:             cfg.LLM.Temperature,
+		MaxTokens:               cfg.LLM.MaxTokens,
+		DisableStructuredOutput: cfg.LLM.DisableStructuredOutput,
 	}
 	llmClient, err := llm.NewLLMHTTPClient(llmConfig)
 	if err != nil {
@@ -281,6 +364,136 @@ func (a *Application) initialize(ctx context.Context) error {
 	}
 	a.llmClient = llmClient
 
+	// Record the token usage of every backend that actually answers
+	usageRecorder := appServices.NewLLMUsageRecorder(a.llmUsageRepo, a.logger)
+	llmClient.SetUsageRecorder(usageRecorder)
+
+	// Users' own API keys are encrypted with their own key; without one, users can't store
+	// keys and every call uses the platform key
+	var llmKeyStore *config.SecretStore
+	var keyResolver interfaces.LLMKeyResolver
+	if cfg.LLM.KeyEncryptionKey != "" {
+		llmKeyStore, err = config.NewSecretStoreWithKey(cfg.LLM.KeyEncryptionKey)
+		if err != nil {
+			return fmt.Errorf("invalid LLM key encryption key: %w", err)
+		}
+		keyResolver = appServices.NewUserLLMKeyResolver(a.userRepo, llmKeyStore, a.logger)
+		llmClient.SetKeyResolver(keyResolver)
+	} else {
+		a.logger.Warn("LLM key encryption key not configured, users can't bring their own LLM API keys")
+	}
+
+	// Chain the configured fallbacks behind the primary, each with its own circuit breaker
+	backends := []fallback.Backend{{Name: llmBackendName(cfg.LLM.Provider, cfg.LLM.Model), Service: llmClient}}
+	for i, fb := range cfg.LLM.Fallbacks {
+		fbConfig := llmConfig
+		fbConfig.Model = fb.Model
+		// A fallback is a specific model; prompts that pick a model only pick the primary's
+		fbConfig.IgnorePromptModel = true
+		if fb.Provider != "" {
+			fbConfig.Provider = fb.Provider
+		}
+		if fb.Endpoint != "" {
+			fbConfig.BaseURL = fb.Endpoint
+		}
+		// Keys only carry over to a backend of the same provider
+		if fb.APIKey != "" || fbConfig.Provider != llmConfig.Provider {
+			fbConfig.APIKey = fb.APIKey
+		}
+
+		fbClient, err := llm.NewLLMHTTPClient(fbConfig)
+		if err != nil {
+			return fmt.Errorf("failed to create LLM fallback %d: %w", i+1, err)
+		}
+		fbClient.SetUsageRecorder(usageRecorder)
+		if keyResolver != nil {
+			fbClient.SetKeyResolver(keyResolver)
+		}
+		backends = append(backends, fallback.Backend{Name: llmBackendName(fbConfig.Provider, fbConfig.Model), Service: fbClient})
+	}
+
+	llmFallback, err := fallback.NewClient(backends, fallback.Config{
+		Breaker: fallback.BreakerConfig{
+			FailureThreshold: cfg.LLM.BreakerThreshold,
+			Cooldown:         cfg.LLM.BreakerCooldown,
+		},
+		RequireJSON: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create LLM fallback chain: %w", err)
+	}
+	a.llmFallback = llmFallback
+
+	// Queue every caller for a bounded number of slots, refinements ahead of background generation
+	llmDispatcher, err := dispatch.NewClient(llmFallback, dispatch.Config{
+		MaxInFlight:      cfg.LLM.MaxInFlight,
+		TokensPerMinute:  cfg.LLM.TokensPerMinute,
+		CompletionTokens: cfg.LLM.MaxTokens,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create LLM dispatcher: %w", err)
+	}
+	a.llmDispatcher = llmDispatcher
+	a.llmService = llmDispatcher
+
+	// Reuse a job's responses across its retries, in memory and in MongoDB for other replicas
+	if cfg.LLM.Cache.Enabled {
+		llmCacheCol, err := dbClient.GetCollection(database.CollectionLLMCache)
+		if err != nil {
+			return fmt.Errorf("failed to get llm cache collection: %w", err)
+		}
+		store := llmCache.NewTieredStore(
+			llmCache.NewMemoryStore(llmCache.MemoryConfig{Capacity: cfg.LLM.Cache.Size, TTL: cfg.LLM.Cache.TTL}),
+			dbRepos.NewLLMResponseCacheRepository(llmCacheCol, cfg.LLM.Cache.TTL),
+		)
+		cacheClient, err := llmCache.NewClient(a.llmService, store, llmCache.Config{
+			Model:  cfg.LLM.Model,
+			Logger: a.logger,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create LLM cache client: %w", err)
+		}
+		a.llmService = cacheClient
+	}
+
+	// Record or replay LLM responses when configured
+	if cfg.LLM.ReplayMode != "" {
+		mode, err := replay.ParseMode(cfg.LLM.ReplayMode)
+		if err != nil {
+			return err
+		}
+		replayClient, err := replay.NewClient(a.llmService, replay.Config{
+			Mode:  mode,
+			Dir:   cfg.LLM.ReplayDir,
+			Model: cfg.LLM.Model,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create LLM replay client: %w", err)
+		}
+		a.llmService = replayClient
+		a.logger.Warn("LLM record/replay enabled",
+			zap.String("mode", string(mode)),
+			zap.String("dir", cfg.LLM.ReplayDir),
+		)
+	}
+
+	// Initialize LinkedIn client
+	linkedInClient, err := linkedin.NewLinkedInAPIClient(linkedin.Config{
+		APIURL:          cfg.LinkedIn.APIURL,
+		TokenURL:        cfg.LinkedIn.TokenURL,
+		AuthURL:         cfg.LinkedIn.AuthURL,
+		ClientID:        cfg.LinkedIn.ClientID,
+		ClientSecret:    cfg.LinkedIn.ClientSecret,
+		RedirectURI:     cfg.LinkedIn.RedirectURI,
+		Timeout:         cfg.LinkedIn.Timeout,
+		RateLimit:       cfg.LinkedIn.RateLimit,
+		RateLimitWindow: cfg.LinkedIn.RateLimitWindow,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create LinkedIn client: %w", err)
+	}
+	a.linkedInClient = linkedInClient
+
 	// Initialize NATS client
 	natsClient, err := nats.NewNATSClient(nats.ClientConfig{
 		URL:            cfg.NATS.URL,
@@ -318,19 +531,83 @@ func (a *Application) initialize(ctx context.Context) error {
 		a.draftRepo,
 		a.promptsRepo,
 		a.promptEngine,
-		a.llmClient,
+		a.llmService,
 	)
+	a.generateDraftsUC.SetExperiments(a.experimentRepo)
 	a.generateIdeasUC = usecases.NewGenerateIdeasUseCase(
 		a.userRepo,
 		a.topicRepo,
 		a.ideaRepo,
 		a.promptsRepo,
 		a.promptEngine,
-		a.llmClient,
+		a.llmService,
 	)
 	a.listIdeasUC = usecases.NewListIdeasUseCase(a.userRepo, a.ideaRepo)
 	a.clearIdeasUC = usecases.NewClearIdeasUseCase(a.userRepo, a.ideaRepo)
-	a.refineDraftUC = usecases.NewRefineDraftUseCase(a.draftRepo, a.llmClient)
+	a.refineDraftUC = usecases.NewRefineDraftUseCase(a.draftRepo, a.llmService)
+	a.publishDraftUC = usecases.NewPublishDraftUseCase(
+		a.draftRepo,
+		a.userRepo,
+		a.ideaRepo,
+		a.linkedInClient,
+		a.linkedInClient,
+	)
+	a.scheduleDraftUC = usecases.NewScheduleDraftUseCase(a.draftRepo)
+	a.startAuthUC = usecases.NewStartLinkedInAuthUseCase(
+		a.userRepo,
+		a.oauthRepo,
+		a.linkedInClient,
+	)
+	a.completeAuthUC = usecases.NewCompleteLinkedInAuthUseCase(
+		a.userRepo,
+		a.oauthRepo,
+		a.linkedInClient,
+	)
+	a.refreshTokenUC = usecases.NewRefreshLinkedInTokenUseCase(
+		a.userRepo,
+		a.linkedInClient,
+	)
+	a.manageSchedulesUC = usecases.NewManageSchedulesUseCase(a.scheduleRepo, a.userRepo)
+	if llmKeyStore != nil {
+		a.manageLLMKeysUC = usecases.NewManageLLMKeysUseCase(
+			a.userRepo,
+			llmKeyStore,
+			[]string{llm.ProviderOpenAI, llm.ProviderAnthropic, llm.ProviderOllama},
+		)
+	}
+	a.manageExperimentsUC = usecases.NewManageExperimentsUseCase(a.experimentRepo, a.promptsRepo, a.draftRepo, a.userRepo)
+	a.runScheduledUC = usecases.NewRunScheduledActionUseCase(
+		a.ideaRepo,
+		a.draftRepo,
+		a.generateIdeasUC,
+		a.generateDraftsUC,
+		a.publishDraftUC,
+	)
+	a.getLLMUsageUC = usecases.NewGetLLMUsageUseCase(a.llmUsageRepo, a.userRepo, modelPrices(a.config.LLM.Prices))
+
+	// Enforce LLM quotas before any generation or refinement
+	quota := a.config.LLM.Quota
+	quotaService := appServices.NewLLMQuotaService(a.llmUsageRepo, a.userRepo, entities.LLMQuota{
+		DailyTokens:     quota.DailyTokens,
+		DailyRequests:   quota.DailyRequests,
+		MonthlyTokens:   quota.MonthlyTokens,
+		MonthlyRequests: quota.MonthlyRequests,
+	})
+	a.generateDraftsUC.SetQuotaChecker(quotaService)
+	a.generateIdeasUC.SetQuotaChecker(quotaService)
+	a.refineDraftUC.SetQuotaChecker(quotaService)
+	a.llmQuota = quotaService
+
+	// Ideas for topics created or updated through the API are generated on a bounded queue
+	topicIdeas, err := workers.NewTopicIdeasWorker(workers.TopicIdeasConfig{
+		Generator: a.generateIdeasUC,
+		Logger:    a.logger,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create topic ideas worker: %w", err)
+	}
+	a.topicIdeas = topicIdeas
+	a.workerRegistry.Register("topic_ideas")
 
 	// Seed development data
 	if err := a.seedDevelopmentData(ctx); err != nil {
@@ -362,7 +639,7 @@ func (a *Application) seedDevelopmentData(ctx context.Context) error {
 		a.ideaRepo,
 		a.promptsRepo,
 		a.promptEngine,
-		a.llmClient,
+		a.llmService,
 		a.logger,
 		nil,
 	)
@@ -408,13 +685,18 @@ func (a *Application) initializeHTTPServer() error {
 	// Create adapter for worker registry
 	workerRegistryAdapter := &workerRegistryAdapter{registry: a.workerRegistry}
 
+	// Create adapter for LLM circuit breakers
+	llmBackendAdapter := &llmBackendAdapter{client: a.llmFallback}
+
 	// Register health handler
 	healthHandler := handlers.NewHealthHandler(
 		dbHealthChecker,
 		workerRegistryAdapter,
 		a.natsClient,
+		llmBackendAdapter,
 		a.logger,
 	)
+	healthHandler.SetLLMQueue(&llmQueueAdapter{client: a.llmDispatcher})
 	router.HandleFunc("/health", healthHandler.HandleHealth).Methods("GET")
 	router.HandleFunc("/readiness", healthHandler.HandleReadiness).Methods("GET")
 	router.HandleFunc("/liveness", healthHandler.HandleLiveness).Methods("GET")
@@ -425,9 +707,10 @@ func (a *Application) initializeHTTPServer() error {
 		a.userRepo,
 		a.promptsRepo,
 		a.ideaRepo,
-		a.generateIdeasUC,
+		a.topicIdeas,
 		a.logger,
 	)
+	topicsHandler.SetQuotaChecker(a.llmQuota)
 	topicsHandler.RegisterRoutes(router)
 
 	// Register prompts handler
@@ -442,6 +725,7 @@ func (a *Application) initializeHTTPServer() error {
 		promptService,
 		a.logger,
 	)
+	promptsHandler.SetPromptVersions(a.promptVersionsRepo)
 	promptsHandler.RegisterRoutes(router)
 
 	// Register ideas handler
@@ -455,14 +739,57 @@ func (a *Application) initializeHTTPServer() error {
 	// Register drafts handler
 	draftsHandler := handlers.NewDraftsHandler(
 		a.refineDraftUC,
+		a.publishDraftUC,
+		a.scheduleDraftUC,
 		a.draftRepo,
 		a.jobRepo,
 		a.ideaRepo,
 		draftPublisher,
 		a.logger,
 	)
+	draftsHandler.SetQuotaChecker(a.llmQuota)
 	draftsHandler.RegisterRoutes(router)
 
+	// Register auth handler only when the tokens it obtains can be stored encrypted
+	if a.config.LinkedIn.TokenEncryptionKey != "" {
+		authHandler := handlers.NewAuthHandler(
+			a.startAuthUC,
+			a.completeAuthUC,
+			a.logger,
+		)
+		authHandler.RegisterRoutes(router)
+	}
+
+	// Register schedules handler
+	schedulesHandler := handlers.NewSchedulesHandler(
+		a.manageSchedulesUC,
+		a.logger,
+	)
+	schedulesHandler.RegisterRoutes(router)
+
+	// Register experiments handler
+	experimentsHandler := handlers.NewExperimentsHandler(
+		a.manageExperimentsUC,
+		a.logger,
+	)
+	experimentsHandler.RegisterRoutes(router)
+
+	// Register usage handler
+	usageHandler := handlers.NewUsageHandler(
+		a.getLLMUsageUC,
+		a.logger,
+	)
+	usageHandler.RegisterRoutes(router)
+
+	// Register LLM keys handler only when the keys it stores can be encrypted
+	if a.manageLLMKeysUC != nil {
+		llmKeysHandler := handlers.NewLLMKeysHandler(
+			a.manageLLMKeysUC,
+			a.logger,
+		)
+		llmKeysHandler.RegisterRoutes(router)
+	}
+
 	a.logger.Info("HTTP server initialized successfully")
 	return nil
 }
@@ -516,6 +843,110 @@ func (a *Application) initializeWorkers() error {
 	// Register worker in registry
 	a.workerRegistry.Register("draft_generation")
 
+	// Create scheduled publisher worker
+	if a.config.Scheduler.Enabled {
+		publisherWorker, err := workers.NewScheduledPublisherWorker(workers.ScheduledPublisherConfig{
+			DraftRepo: a.draftRepo,
+			UseCase:   &publishUseCaseAdapter{useCase: a.publishDraftUC},
+			Interval:  a.config.Scheduler.PublishInterval,
+			BatchSize: a.config.Scheduler.BatchSize,
+			Logger:    a.logger,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create scheduled publisher worker: %w", err)
+		}
+		a.publisherWorker = publisherWorker
+
+		a.workerRegistry.Register("scheduled_publisher")
+
+		refreshWorker, err := workers.NewTokenRefreshWorker(workers.TokenRefreshConfig{
+			UserRepo:  a.userRepo,
+			UseCase:   &refreshTokenUseCaseAdapter{useCase: a.refreshTokenUC},
+			Interval:  a.config.Scheduler.TokenRefreshInterval,
+			Window:    a.config.Scheduler.TokenRefreshWindow,
+			BatchSize: a.config.Scheduler.BatchSize,
+			Logger:    a.logger,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create token refresh worker: %w", err)
+		}
+		a.refreshWorker = refreshWorker
+
+		a.workerRegistry.Register("token_refresh")
+
+		// Identifies this replica in the leases that keep periodic work on a single replica
+		hostname, _ := os.Hostname()
+		leaseHolder := hostname + "-" + uuid.New().String()
+
+		ideaScheduler, err := appServices.NewSchedulerService(appServices.SchedulerServiceConfig{
+			TopicRepo:  a.topicRepo,
+			IdeasRepo:  a.ideaRepo,
+			LeaseRepo:  a.leaseRepo,
+			Generator:  a.generateIdeasUC,
+			Interval:   a.config.Scheduler.Interval,
+			BatchSize:  a.config.Scheduler.BatchSize,
+			MaxRetries: a.config.Scheduler.MaxRetries,
+			RetryDelay: a.config.Scheduler.RetryDelay,
+			Holder:     leaseHolder,
+			Logger:     a.logger,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create idea scheduler: %w", err)
+		}
+		a.ideaScheduler = ideaScheduler
+
+		a.workerRegistry.Register("idea_scheduler")
+
+		// Per-user cron schedules are fired by one replica and executed by any
+		actionPublisher, err := nats.NewPublisher(nats.PublisherConfig{
+			Client:  a.natsClient,
+			Subject: "schedule.actions",
+			Logger:  a.logger,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create schedule actions publisher: %w", err)
+		}
+
+		dispatchWorker, err := workers.NewScheduleDispatcherWorker(workers.ScheduleDispatcherConfig{
+			ScheduleRepo: a.scheduleRepo,
+			LeaseRepo:    a.leaseRepo,
+			Publisher:    actionPublisher,
+			Interval:     a.config.Scheduler.ScheduleInterval,
+			BatchSize:    a.config.Scheduler.BatchSize,
+			Holder:       leaseHolder,
+			Logger:       a.logger,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create schedule dispatcher worker: %w", err)
+		}
+		a.dispatchWorker = dispatchWorker
+
+		a.workerRegistry.Register("schedule_dispatcher")
+
+		actionConsumer, err := nats.NewConsumer(nats.ConsumerConfig{
+			Client:        a.natsClient,
+			Subject:       "schedule.actions",
+			QueueGroup:    "schedule-workers",
+			MaxConcurrent: 1,
+			Logger:        a.logger,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create schedule actions consumer: %w", err)
+		}
+
+		actionWorker, err := workers.NewScheduledActionWorker(workers.ScheduledActionConfig{
+			Consumer: actionConsumer,
+			UseCase:  &scheduledActionUseCaseAdapter{useCase: a.runScheduledUC},
+			Logger:   a.logger,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create scheduled action worker: %w", err)
+		}
+		a.actionWorker = actionWorker
+
+		a.workerRegistry.Register("scheduled_actions")
+	}
+
 	a.logger.Info("Workers initialized successfully")
 	return nil
 }
@@ -537,7 +968,23 @@ func (a *Application) start(ctx context.Context) error {
 		return fmt.Errorf("failed to start workers: %w", err)
 	}
 
-	// TODO: Start scheduler
+	// Start topic ideas worker
+	if err := a.topicIdeas.Start(a.workerCtx); err != nil {
+		a.logger.Error("Topic ideas worker failed to start", zap.Error(err))
+		a.workerRegistry.MarkStopped("topic_ideas", err)
+	} else {
+		a.workerRegistry.MarkRunning("topic_ideas")
+	}
+
+	// Start idea generation scheduler
+	if a.ideaScheduler != nil {
+		if err := a.ideaScheduler.Start(a.workerCtx); err != nil {
+			a.logger.Error("Idea scheduler failed to start", zap.Error(err))
+			a.workerRegistry.MarkStopped("idea_scheduler", err)
+		} else {
+			a.workerRegistry.MarkRunning("idea_scheduler")
+		}
+	}
 
 	a.logger.Info("Application services started successfully")
 	fmt.Printf("LinkGen AI is running on http://%s:%d\n", a.config.Server.Host, a.config.Server.Port)
@@ -570,6 +1017,46 @@ func (a *Application) startWorkers(ctx context.Context) error {
 		a.logger.Info("Draft generation worker context cancelled")
 	}()
 
+	// Start scheduled publisher worker
+	if a.publisherWorker != nil {
+		if err := a.publisherWorker.Start(ctx); err != nil {
+			a.logger.Error("Scheduled publisher worker failed to start", zap.Error(err))
+			a.workerRegistry.MarkStopped("scheduled_publisher", err)
+		} else {
+			a.workerRegistry.MarkRunning("scheduled_publisher")
+		}
+	}
+
+	// Start token refresh worker
+	if a.refreshWorker != nil {
+		if err := a.refreshWorker.Start(ctx); err != nil {
+			a.logger.Error("Token refresh worker failed to start", zap.Error(err))
+			a.workerRegistry.MarkStopped("token_refresh", err)
+		} else {
+			a.workerRegistry.MarkRunning("token_refresh")
+		}
+	}
+
+	// Start scheduled action worker before the dispatcher so fired actions are consumed
+	if a.actionWorker != nil {
+		if err := a.actionWorker.Start(ctx); err != nil {
+			a.logger.Error("Scheduled action worker failed to start", zap.Error(err))
+			a.workerRegistry.MarkStopped("scheduled_actions", err)
+		} else {
+			a.workerRegistry.MarkRunning("scheduled_actions")
+		}
+	}
+
+	// Start schedule dispatcher worker
+	if a.dispatchWorker != nil {
+		if err := a.dispatchWorker.Start(ctx); err != nil {
+			a.logger.Error("Schedule dispatcher worker failed to start", zap.Error(err))
+			a.workerRegistry.MarkStopped("schedule_dispatcher", err)
+		} else {
+			a.workerRegistry.MarkRunning("schedule_dispatcher")
+		}
+	}
+
 	// Give workers a moment to start
 	time.Sleep(100 * time.Millisecond)
 
@@ -596,6 +1083,46 @@ func (a *Application) stopWorkers(timeout time.Duration) error {
 		}
 	}
 
+	// Stop scheduled publisher worker
+	if a.publisherWorker != nil {
+		if err := a.publisherWorker.Stop(timeout); err != nil {
+			a.logger.Warn("Failed to stop scheduled publisher worker cleanly", zap.Error(err))
+			a.workerRegistry.MarkStopped("scheduled_publisher", err)
+		} else {
+			a.workerRegistry.MarkStopped("scheduled_publisher", nil)
+		}
+	}
+
+	// Stop token refresh worker
+	if a.refreshWorker != nil {
+		if err := a.refreshWorker.Stop(timeout); err != nil {
+			a.logger.Warn("Failed to stop token refresh worker cleanly", zap.Error(err))
+			a.workerRegistry.MarkStopped("token_refresh", err)
+		} else {
+			a.workerRegistry.MarkStopped("token_refresh", nil)
+		}
+	}
+
+	// Stop schedule dispatcher worker
+	if a.dispatchWorker != nil {
+		if err := a.dispatchWorker.Stop(timeout); err != nil {
+			a.logger.Warn("Failed to stop schedule dispatcher worker cleanly", zap.Error(err))
+			a.workerRegistry.MarkStopped("schedule_dispatcher", err)
+		} else {
+			a.workerRegistry.MarkStopped("schedule_dispatcher", nil)
+		}
+	}
+
+	// Stop scheduled action worker
+	if a.actionWorker != nil {
+		if err := a.actionWorker.Stop(timeout); err != nil {
+			a.logger.Warn("Failed to stop scheduled action worker cleanly", zap.Error(err))
+			a.workerRegistry.MarkStopped("scheduled_actions", err)
+		} else {
+			a.workerRegistry.MarkStopped("scheduled_actions", nil)
+		}
+	}
+
 	// Wait for workers to finish with timeout
 	done := make(chan struct{})
 	go func() {
@@ -630,7 +1157,25 @@ func (a *Application) shutdown(ctx context.Context) error {
 		a.logger.Error("Error stopping workers", zap.Error(err))
 	}
 
-	// TODO: Stop scheduler
+	// Stop topic ideas worker
+	if a.topicIdeas != nil {
+		if err := a.topicIdeas.Stop(workerShutdownTimeout); err != nil {
+			a.logger.Warn("Failed to stop topic ideas worker cleanly", zap.Error(err))
+			a.workerRegistry.MarkStopped("topic_ideas", err)
+		} else {
+			a.workerRegistry.MarkStopped("topic_ideas", nil)
+		}
+	}
+
+	// Stop idea generation scheduler
+	if a.ideaScheduler != nil {
+		if err := a.ideaScheduler.Stop(workerShutdownTimeout); err != nil {
+			a.logger.Warn("Failed to stop idea scheduler cleanly", zap.Error(err))
+			a.workerRegistry.MarkStopped("idea_scheduler", err)
+		} else {
+			a.workerRegistry.MarkStopped("idea_scheduler", nil)
+		}
+	}
 
 	// Disconnect from NATS
 	if a.natsClient != nil {
@@ -685,6 +1230,51 @@ func (uca *useCaseAdapter) Execute(ctx context.Context, input workers.GenerateDr
 	return workerDrafts, nil
 }
 
+// publishUseCaseAdapter adapts usecases.PublishDraftUseCase to workers.PublishDraftUseCase
+type publishUseCaseAdapter struct {
+	useCase *usecases.PublishDraftUseCase
+}
+
+// Execute adapts the interface
+func (pua *publishUseCaseAdapter) Execute(ctx context.Context, input workers.PublishDraftInput) error {
+	_, err := pua.useCase.Execute(ctx, usecases.PublishDraftInput{
+		DraftID: input.DraftID,
+		UserID:  input.UserID,
+	})
+	return err
+}
+
+// refreshTokenUseCaseAdapter adapts usecases.RefreshLinkedInTokenUseCase to workers.RefreshLinkedInTokenUseCase
+type refreshTokenUseCaseAdapter struct {
+	useCase *usecases.RefreshLinkedInTokenUseCase
+}
+
+// Execute adapts the interface
+func (rua *refreshTokenUseCaseAdapter) Execute(ctx context.Context, input workers.RefreshLinkedInTokenInput) error {
+	_, err := rua.useCase.Execute(ctx, usecases.RefreshLinkedInTokenInput{
+		UserID: input.UserID,
+	})
+	return err
+}
+
+// scheduledActionUseCaseAdapter adapts usecases.RunScheduledActionUseCase to workers.RunScheduledActionUseCase
+type scheduledActionUseCaseAdapter struct {
+	useCase *usecases.RunScheduledActionUseCase
+}
+
+// Execute adapts the interface
+func (sua *scheduledActionUseCaseAdapter) Execute(ctx context.Context, input workers.RunScheduledActionInput) error {
+	err := sua.useCase.Execute(ctx, usecases.RunScheduledActionInput{
+		ScheduleID: input.ScheduleID,
+		UserID:     input.UserID,
+		Action:     entities.ScheduleAction(input.Action),
+	})
+	if errors.Is(err, usecases.ErrNothingScheduled) {
+		return workers.ErrNoScheduledWork
+	}
+	return err
+}
+
 // dbHealthAdapter adapts database.Client to handlers.HealthChecker
 type dbHealthAdapter struct {
 	client *database.Client
@@ -725,6 +1315,68 @@ func (a *workerRegistryAdapter) IsHealthy() bool {
 	return a.registry.IsHealthy()
 }
 
+// llmBackendAdapter adapts fallback.Client to handlers.LLMBackendRegistry
+type llmBackendAdapter struct {
+	client *fallback.Client
+}
+
+// GetStatus adapts the circuit breaker status interface
+func (a *llmBackendAdapter) GetStatus() []handlers.LLMBackendStatus {
+	statuses := a.client.Status()
+	adapted := make([]handlers.LLMBackendStatus, 0, len(statuses))
+	for _, status := range statuses {
+		adapted = append(adapted, handlers.LLMBackendStatus{
+			Name:                status.Backend,
+			State:               string(status.State),
+			ConsecutiveFailures: status.ConsecutiveFailures,
+			OpenedAt:            status.OpenedAt,
+			LastError:           status.LastError,
+		})
+	}
+	return adapted
+}
+
+// llmQueueAdapter adapts dispatch.Client to handlers.LLMQueueMonitor
+type llmQueueAdapter struct {
+	client *dispatch.Client
+}
+
+// GetQueueStatus adapts the dispatcher stats interface
+func (a *llmQueueAdapter) GetQueueStatus() handlers.LLMQueueStatus {
+	stats := a.client.Stats()
+	return handlers.LLMQueueStatus{
+		InFlight:          stats.InFlight,
+		MaxInFlight:       stats.MaxInFlight,
+		QueuedInteractive: stats.QueuedInteractive,
+		QueuedBackground:  stats.QueuedBackground,
+		TokensPerMinute:   stats.TokensPerMinute,
+		AvailableTokens:   stats.AvailableTokens,
+	}
+}
+
+// llmBackendName names an LLM backend in health checks and errors
+func llmBackendName(provider, model string) string {
+	if provider == "" {
+		provider = llm.ProviderOpenAI
+	}
+	if model == "" {
+		return provider
+	}
+	return provider + "/" + model
+}
+
+// modelPrices converts the configured price table for the usage use case
+func modelPrices(prices map[string]config.LLMPrice) map[string]usecases.ModelPrice {
+	converted := make(map[string]usecases.ModelPrice, len(prices))
+	for model, price := range prices {
+		converted[model] = usecases.ModelPrice{
+			PromptPerMillion:     price.Prompt,
+			CompletionPerMillion: price.Completion,
+		}
+	}
+	return converted
+}
+
 // jobRepositoryAdapter adapts interfaces.JobRepository to workers.JobRepository
 type jobRepositoryAdapter struct {
 	repo interfaces.JobRepository
@@ -748,6 +1400,7 @@ func (a *jobRepositoryAdapter) FindByID(ctx context.Context, jobID string) (*wor
 		IdeaID:      job.IdeaID,
 		DraftIDs:    job.DraftIDs,
 		Error:       job.Error,
+		Metadata:    job.Metadata,
 		CreatedAt:   job.CreatedAt,
 		UpdatedAt:   job.UpdatedAt,
 		StartedAt:   job.StartedAt,
@@ -765,6 +1418,7 @@ func (a *jobRepositoryAdapter) Update(ctx context.Context, job *workers.Job) err
 		IdeaID:      job.IdeaID,
 		DraftIDs:    job.DraftIDs,
 		Error:       job.Error,
+		Metadata:    job.Metadata,
 		CreatedAt:   job.CreatedAt,
 		UpdatedAt:   job.UpdatedAt,
 		StartedAt:   job.StartedAt,
@@ -1,13 +1,7 @@
 package services
 
 import (
-	"context"
 	"testing"
-	"time"
-
-	"github.com/linkgen-ai/backend/src/domain/entities"
-	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/require"
 )
 
 // TestDataMigration tests data migration from old to new structures
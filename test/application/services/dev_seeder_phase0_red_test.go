@@ -1,3 +1,7 @@
+//go:build legacy
+
+// Not built by default: written against APIs src doesn't provide.
+
 package services
 
 import (
@@ -217,6 +221,9 @@ func (r *inMemoryTopicRepo) Delete(ctx context.Context, topicID string) error
 func (r *inMemoryTopicRepo) FindByPrompt(ctx context.Context, userID string, promptName string) ([]*entities.Topic, error) {
 	return nil, nil
 }
+func (r *inMemoryTopicRepo) ListActive(ctx context.Context, limit int) ([]*entities.Topic, error) {
+	return r.topics, nil
+}
 func (r *inMemoryTopicRepo) FindByIdeasRange(ctx context.Context, userID string, minIdeas, maxIdeas int) ([]*entities.Topic, error) {
 	return nil, nil
 }
@@ -238,6 +245,12 @@ func (s *stubUserRepo) Update(ctx context.Context, userID string, updates map[st
 func (s *stubUserRepo) UpdateLinkedInToken(ctx context.Context, userID string, token string) error {
 	return nil
 }
+func (s *stubUserRepo) UpdateLinkedInCredentials(ctx context.Context, user *entities.User) error {
+	return nil
+}
+func (s *stubUserRepo) FindWithExpiringLinkedInToken(ctx context.Context, before time.Time, limit int) ([]*entities.User, error) {
+	return nil, nil
+}
 func (s *stubUserRepo) Delete(ctx context.Context, userID string) error { return nil }
 
 type stubIdeasRepo struct{}
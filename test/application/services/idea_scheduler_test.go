@@ -0,0 +1,423 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/application/services"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"go.uber.org/zap"
+)
+
+// schedulerTopicRepo serves a fixed list of active topics
+type schedulerTopicRepo struct {
+	topics  []*entities.Topic
+	listErr error
+}
+
+func (r *schedulerTopicRepo) Create(ctx context.Context, topic *entities.Topic) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+func (r *schedulerTopicRepo) FindByID(ctx context.Context, topicID string) (*entities.Topic, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *schedulerTopicRepo) ListByUserID(ctx context.Context, userID string) ([]*entities.Topic, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *schedulerTopicRepo) FindRandomByUserID(ctx context.Context, userID string) (*entities.Topic, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *schedulerTopicRepo) Update(ctx context.Context, topic *entities.Topic) error {
+	return errors.New("not implemented")
+}
+
+func (r *schedulerTopicRepo) Delete(ctx context.Context, topicID string) error {
+	return errors.New("not implemented")
+}
+
+func (r *schedulerTopicRepo) FindByPrompt(ctx context.Context, userID string, promptName string) ([]*entities.Topic, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *schedulerTopicRepo) ListActive(ctx context.Context, limit int) ([]*entities.Topic, error) {
+	if r.listErr != nil {
+		return nil, r.listErr
+	}
+	return r.topics, nil
+}
+
+func (r *schedulerTopicRepo) FindByIdeasRange(ctx context.Context, userID string, minIdeas, maxIdeas int) ([]*entities.Topic, error) {
+	return nil, errors.New("not implemented")
+}
+
+// schedulerIdeasRepo counts unused ideas per topic
+type schedulerIdeasRepo struct {
+	mu     sync.Mutex
+	unused map[string]int64
+}
+
+func (r *schedulerIdeasRepo) CreateBatch(ctx context.Context, ideas []*entities.Idea) error {
+	return errors.New("not implemented")
+}
+
+func (r *schedulerIdeasRepo) FindByID(ctx context.Context, ideaID string) (*entities.Idea, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *schedulerIdeasRepo) Update(ctx context.Context, ideaID string, updates map[string]interface{}) error {
+	return errors.New("not implemented")
+}
+
+func (r *schedulerIdeasRepo) ListByUserID(ctx context.Context, userID string, topicID string, limit int) ([]*entities.Idea, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *schedulerIdeasRepo) CountByUserID(ctx context.Context, userID string) (int64, error) {
+	return 0, errors.New("not implemented")
+}
+
+func (r *schedulerIdeasRepo) CountUnusedByTopicID(ctx context.Context, topicID string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.unused[topicID], nil
+}
+
+func (r *schedulerIdeasRepo) ClearByUserID(ctx context.Context, userID string) error {
+	return errors.New("not implemented")
+}
+
+func (r *schedulerIdeasRepo) DeleteByTopicID(ctx context.Context, topicID string) error {
+	return errors.New("not implemented")
+}
+
+// fakeIdeaGenerator records generation calls and adds unused ideas to the backlog
+type fakeIdeaGenerator struct {
+	ideas    *schedulerIdeasRepo
+	mu       sync.Mutex
+	calls    []string
+	failures map[string]int
+}
+
+func (g *fakeIdeaGenerator) GenerateIdeasForTopic(ctx context.Context, topicID string) ([]*entities.Idea, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.calls = append(g.calls, topicID)
+	if g.failures[topicID] > 0 {
+		g.failures[topicID]--
+		return nil, errors.New("LLM service error")
+	}
+
+	g.ideas.mu.Lock()
+	g.ideas.unused[topicID] += 2
+	g.ideas.mu.Unlock()
+
+	return []*entities.Idea{{ID: topicID + "-1"}, {ID: topicID + "-2"}}, nil
+}
+
+func (g *fakeIdeaGenerator) generatedTopics() []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]string(nil), g.calls...)
+}
+
+func newSchedulerTopic(id string, priority int) *entities.Topic {
+	return &entities.Topic{
+		ID:       id,
+		UserID:   "000000000000000000000001",
+		Name:     "Topic " + id,
+		Priority: priority,
+		Ideas:    2,
+		Active:   true,
+	}
+}
+
+// memoryLeaseRepository is an in-memory LeaseRepository
+type memoryLeaseRepository struct {
+	mu      sync.Mutex
+	holders map[string]string
+	expires map[string]time.Time
+}
+
+func newMemoryLeaseRepository() *memoryLeaseRepository {
+	return &memoryLeaseRepository{holders: map[string]string{}, expires: map[string]time.Time{}}
+}
+
+func (r *memoryLeaseRepository) Acquire(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	if current, ok := r.holders[name]; ok && current != holder && r.expires[name].After(now) {
+		return false, nil
+	}
+
+	r.holders[name] = holder
+	r.expires[name] = now.Add(ttl)
+	return true, nil
+}
+
+func (r *memoryLeaseRepository) Release(ctx context.Context, name, holder string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.holders[name] == holder {
+		delete(r.holders, name)
+		delete(r.expires, name)
+	}
+	return nil
+}
+
+func (r *memoryLeaseRepository) heldBy(name, holder string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.holders[name] == holder
+}
+
+func newTestScheduler(t *testing.T, topics *schedulerTopicRepo, ideas *schedulerIdeasRepo, generator *fakeIdeaGenerator, config services.SchedulerServiceConfig) *services.SchedulerService {
+	t.Helper()
+
+	config.TopicRepo = topics
+	config.IdeasRepo = ideas
+	config.Generator = generator
+	config.Logger = zap.NewNop()
+	if config.LeaseRepo == nil {
+		config.LeaseRepo = newMemoryLeaseRepository()
+	}
+	if config.Holder == "" {
+		config.Holder = "replica-1"
+	}
+	if config.RetryDelay == 0 {
+		config.RetryDelay = time.Millisecond
+	}
+
+	scheduler, err := services.NewSchedulerService(config)
+	if err != nil {
+		t.Fatalf("failed to create scheduler: %v", err)
+	}
+	return scheduler
+}
+
+func TestSchedulerService_SkipsTopicsWithBacklog(t *testing.T) {
+	ctx := context.Background()
+
+	topics := &schedulerTopicRepo{topics: []*entities.Topic{
+		newSchedulerTopic("empty", 5),
+		newSchedulerTopic("partial", 5),
+		newSchedulerTopic("full", 5),
+	}}
+	ideas := &schedulerIdeasRepo{unused: map[string]int64{"partial": 1, "full": 2}}
+	generator := &fakeIdeaGenerator{ideas: ideas}
+
+	scheduler := newTestScheduler(t, topics, ideas, generator, services.SchedulerServiceConfig{})
+
+	if got := scheduler.RunOnce(ctx); got != 2 {
+		t.Fatalf("expected 2 topics generated, got %d", got)
+	}
+	generated := generator.generatedTopics()
+	sort.Strings(generated)
+	if !reflect.DeepEqual(generated, []string{"empty", "partial"}) {
+		t.Errorf("expected empty and partial to be generated, got %v", generated)
+	}
+
+	// Generated ideas fill the backlog, so the next run has nothing to do
+	if got := scheduler.RunOnce(ctx); got != 0 {
+		t.Errorf("expected no topics generated, got %d", got)
+	}
+
+	metrics := scheduler.GetMetrics()
+	expected := map[string]int64{
+		"runs_total":             2,
+		"topics_generated_total": 2,
+		"ideas_generated_total":  4,
+		"topics_skipped_total":   4,
+	}
+	for name, value := range expected {
+		if metrics[name] != value {
+			t.Errorf("expected %s %d, got %d", name, value, metrics[name])
+		}
+	}
+	if scheduler.LastRun().IsZero() {
+		t.Error("expected last run to be recorded")
+	}
+}
+
+func TestSchedulerService_WeightsTopicsByPriority(t *testing.T) {
+	topics := &schedulerTopicRepo{topics: []*entities.Topic{
+		newSchedulerTopic("low", entities.MinPriority),
+		newSchedulerTopic("high", entities.MaxPriority),
+	}}
+
+	selections := map[string]int{}
+	for i := 0; i < 500; i++ {
+		ideas := &schedulerIdeasRepo{unused: map[string]int64{}}
+		generator := &fakeIdeaGenerator{ideas: ideas}
+		scheduler := newTestScheduler(t, topics, ideas, generator, services.SchedulerServiceConfig{BatchSize: 1})
+
+		if got := scheduler.RunOnce(context.Background()); got != 1 {
+			t.Fatalf("expected 1 topic generated, got %d", got)
+		}
+		selections[generator.generatedTopics()[0]]++
+	}
+
+	// Priority 10 against priority 1 is picked about 10 out of 11 times
+	if selections["high"] <= 400 {
+		t.Errorf("high priority topic should dominate: %v", selections)
+	}
+	if selections["low"] == 0 {
+		t.Errorf("low priority topic must not be starved: %v", selections)
+	}
+}
+
+func TestSchedulerService_RetriesFailedTopics(t *testing.T) {
+	t.Run("recovers within max retries", func(t *testing.T) {
+		topics := &schedulerTopicRepo{topics: []*entities.Topic{newSchedulerTopic("flaky", 5), newSchedulerTopic("stable", 5)}}
+		ideas := &schedulerIdeasRepo{unused: map[string]int64{}}
+		generator := &fakeIdeaGenerator{ideas: ideas, failures: map[string]int{"flaky": 2}}
+
+		scheduler := newTestScheduler(t, topics, ideas, generator, services.SchedulerServiceConfig{MaxRetries: 2})
+
+		if got := scheduler.RunOnce(context.Background()); got != 2 {
+			t.Errorf("expected 2 topics generated, got %d", got)
+		}
+		expected := []string{"flaky", "stable", "flaky", "flaky"}
+		if got := generator.generatedTopics(); !reflect.DeepEqual(got, expected) {
+			t.Errorf("expected calls %v, got %v", expected, got)
+		}
+		if failures := scheduler.GetMetrics()["failures_total"]; failures != 0 {
+			t.Errorf("expected no failures, got %d", failures)
+		}
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		topics := &schedulerTopicRepo{topics: []*entities.Topic{newSchedulerTopic("broken", 5)}}
+		ideas := &schedulerIdeasRepo{unused: map[string]int64{}}
+		generator := &fakeIdeaGenerator{ideas: ideas, failures: map[string]int{"broken": 10}}
+
+		scheduler := newTestScheduler(t, topics, ideas, generator, services.SchedulerServiceConfig{MaxRetries: 1})
+
+		if got := scheduler.RunOnce(context.Background()); got != 0 {
+			t.Errorf("expected no topics generated, got %d", got)
+		}
+		if calls := len(generator.generatedTopics()); calls != 2 {
+			t.Errorf("expected 2 calls, got %d", calls)
+		}
+		if failures := scheduler.GetMetrics()["failures_total"]; failures != 1 {
+			t.Errorf("expected 1 failure, got %d", failures)
+		}
+	})
+
+	t.Run("counts repository errors", func(t *testing.T) {
+		topics := &schedulerTopicRepo{listErr: fmt.Errorf("connection refused")}
+		ideas := &schedulerIdeasRepo{unused: map[string]int64{}}
+		generator := &fakeIdeaGenerator{ideas: ideas}
+
+		scheduler := newTestScheduler(t, topics, ideas, generator, services.SchedulerServiceConfig{})
+
+		if got := scheduler.RunOnce(context.Background()); got != 0 {
+			t.Errorf("expected no topics generated, got %d", got)
+		}
+		if pollErrors := scheduler.GetMetrics()["poll_errors_total"]; pollErrors != 1 {
+			t.Errorf("expected 1 poll error, got %d", pollErrors)
+		}
+	})
+}
+
+func TestSchedulerService_RunsOnLeaseHolderOnly(t *testing.T) {
+	leases := newMemoryLeaseRepository()
+	topics := &schedulerTopicRepo{topics: []*entities.Topic{newSchedulerTopic("topic", 5)}}
+	ideas := &schedulerIdeasRepo{unused: map[string]int64{}}
+	generator := &fakeIdeaGenerator{ideas: ideas}
+
+	first := newTestScheduler(t, topics, ideas, generator, services.SchedulerServiceConfig{LeaseRepo: leases, Holder: "replica-1"})
+	second := newTestScheduler(t, topics, ideas, generator, services.SchedulerServiceConfig{LeaseRepo: leases, Holder: "replica-2"})
+
+	if got := first.RunOnce(context.Background()); got != 1 {
+		t.Fatalf("expected the lease holder to generate, got %d", got)
+	}
+
+	// The backlog is consumed, so only the lease keeps the second replica from generating
+	ideas.mu.Lock()
+	ideas.unused["topic"] = 0
+	ideas.mu.Unlock()
+
+	if got := second.RunOnce(context.Background()); got != 0 {
+		t.Errorf("expected the other replica to skip the run, got %d", got)
+	}
+	metrics := second.GetMetrics()
+	if metrics["lease_misses_total"] != 1 {
+		t.Errorf("expected 1 lease miss, got %d", metrics["lease_misses_total"])
+	}
+	if metrics["runs_total"] != 0 {
+		t.Errorf("expected no runs, got %d", metrics["runs_total"])
+	}
+	if calls := len(generator.generatedTopics()); calls != 1 {
+		t.Errorf("expected 1 generation call, got %d", calls)
+	}
+}
+
+func TestSchedulerService_Lifecycle(t *testing.T) {
+	if _, err := services.NewSchedulerService(services.SchedulerServiceConfig{}); !errors.Is(err, services.ErrNilIdeaGenerator) {
+		t.Errorf("expected ErrNilIdeaGenerator, got %v", err)
+	}
+
+	leases := newMemoryLeaseRepository()
+	topics := &schedulerTopicRepo{topics: []*entities.Topic{newSchedulerTopic("topic", 5)}}
+	ideas := &schedulerIdeasRepo{unused: map[string]int64{}}
+	generator := &fakeIdeaGenerator{ideas: ideas}
+
+	if _, err := services.NewSchedulerService(services.SchedulerServiceConfig{TopicRepo: topics, IdeasRepo: ideas, Generator: generator}); !errors.Is(err, services.ErrNilLeaseRepository) {
+		t.Errorf("expected ErrNilLeaseRepository, got %v", err)
+	}
+
+	scheduler := newTestScheduler(t, topics, ideas, generator, services.SchedulerServiceConfig{
+		LeaseRepo: leases,
+		Interval:  50 * time.Millisecond,
+	})
+
+	if err := scheduler.Start(context.Background()); err != nil {
+		t.Fatalf("failed to start: %v", err)
+	}
+	if err := scheduler.Start(context.Background()); !errors.Is(err, services.ErrSchedulerRunning) {
+		t.Errorf("expected ErrSchedulerRunning, got %v", err)
+	}
+	if !scheduler.IsRunning() {
+		t.Error("expected scheduler to be running")
+	}
+
+	// The first run waits one interval, so a restart doesn't trigger an extra run
+	if calls := len(generator.generatedTopics()); calls != 0 {
+		t.Errorf("expected no run on start, got %d calls", calls)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for len(generator.generatedTopics()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if calls := len(generator.generatedTopics()); calls != 1 {
+		t.Fatalf("expected 1 run after one interval, got %d calls", calls)
+	}
+
+	if err := scheduler.Stop(time.Second); err != nil {
+		t.Fatalf("failed to stop: %v", err)
+	}
+	if scheduler.IsRunning() {
+		t.Error("expected scheduler to be stopped")
+	}
+	if leases.heldBy(services.IdeaSchedulerLease, "replica-1") {
+		t.Error("expected the lease to be released on stop")
+	}
+	if err := scheduler.Stop(time.Second); !errors.Is(err, services.ErrSchedulerNotRunning) {
+		t.Errorf("expected ErrSchedulerNotRunning, got %v", err)
+	}
+}
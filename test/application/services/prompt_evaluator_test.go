@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/linkgen-ai/backend/src/application/services"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	infraServices "github.com/linkgen-ai/backend/src/infrastructure/services"
+)
+
+// evalLLMService answers drafts prompts with well-formed posts unless the prompt asks for
+// short ones, and records the rendered prompts
+type evalLLMService struct {
+	interfaces.LLMService
+	prompts []string
+}
+
+func (s *evalLLMService) SendPromptRequest(ctx context.Context, req interfaces.PromptRequest) (string, error) {
+	s.prompts = append(s.prompts, req.Prompt)
+
+	body := strings.Repeat("La observabilidad es una parte del diseño de un sistema y no un añadido para cuando algo falla. ", 8)
+	post := body + "\n\n¿Qué opinas de medir desde el primer sprint?\n\n#backend #observabilidad"
+	if strings.Contains(req.Prompt, "breves") {
+		post = "Post corto sin pregunta final para la red."
+	}
+
+	response, err := json.Marshal(map[string][]string{
+		"posts":    {post, post, post, post, post},
+		"articles": {"Título\n\n" + body},
+	})
+	return string(response), err
+}
+
+func newTestPromptEvaluator(t *testing.T, llmService interfaces.LLMService) *services.PromptEvaluator {
+	t.Helper()
+
+	evaluator, err := services.NewPromptEvaluator(infraServices.NewPromptEngine(nil, nil), llmService, services.PromptEvaluatorConfig{})
+	if err != nil {
+		t.Fatalf("failed to create evaluator: %v", err)
+	}
+	return evaluator
+}
+
+// TestPromptEvaluator_Evaluate validates the scoring of drafts prompts and the comparison with the baseline
+func TestPromptEvaluator_Evaluate(t *testing.T) {
+	llmService := &evalLLMService{}
+	evaluator := newTestPromptEvaluator(t, llmService)
+
+	corpus := &services.PromptEvalCorpus{Cases: []services.PromptEvalCase{
+		{ID: "observabilidad", Type: entities.PromptTypeDrafts, Idea: "Observabilidad desde el primer sprint", UserContext: "Tech lead"},
+		{ID: "sin-contexto", Type: entities.PromptTypeDrafts, Idea: "Migrar a TypeScript"},
+	}}
+	if err := corpus.Validate(); err != nil {
+		t.Fatalf("expected a valid corpus, got %v", err)
+	}
+
+	report, err := evaluator.Evaluate(context.Background(), corpus, []services.PromptEvalCandidate{
+		{Name: "profesional", Type: entities.PromptTypeDrafts, Template: "Idea: {content}\nContexto: {user_context}"},
+		{Name: "breve", Type: entities.PromptTypeDrafts, Template: "Idea: {{.idea.content}}\nEscribe posts breves."},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(report.Prompts) != 2 {
+		t.Fatalf("expected 2 prompts in the report, got %d", len(report.Prompts))
+	}
+
+	if !strings.Contains(llmService.prompts[0], "Idea: Observabilidad desde el primer sprint\nContexto: Tech lead") {
+		t.Errorf("expected the legacy template to be rendered, got %q", llmService.prompts[0])
+	}
+	if !strings.Contains(llmService.prompts[2], "Idea: Observabilidad desde el primer sprint\nEscribe posts breves.") {
+		t.Errorf("expected the template to be rendered, got %q", llmService.prompts[2])
+	}
+
+	baseline, candidate := report.Prompts[0], report.Prompts[1]
+	if baseline.Score != 1.0 || baseline.Passed != 2 || baseline.Baseline != "" {
+		t.Errorf("expected the baseline to pass every case, got score %.2f, passed %d, baseline %q", baseline.Score, baseline.Passed, baseline.Baseline)
+	}
+
+	if candidate.Baseline != "profesional" || candidate.Passed != 0 {
+		t.Errorf("expected the candidate to fail every case against profesional, got passed %d, baseline %q", candidate.Passed, candidate.Baseline)
+	}
+	wantChecks := map[string]float64{
+		services.EvalCheckPostCount: 1.0,
+		services.EvalCheckWordCount: 0.0,
+		services.EvalCheckCTA:       0.0,
+	}
+	for name, want := range wantChecks {
+		if got := candidate.Checks[name]; got != want {
+			t.Errorf("expected %s score %.2f, got %.2f", name, want, got)
+		}
+	}
+	if candidate.Delta >= 0 {
+		t.Errorf("expected a negative delta, got %.2f", candidate.Delta)
+	}
+
+	markdown := report.Markdown()
+	for _, want := range []string{
+		"| profesional | 1.00 | baseline | 2/2 |",
+		"### Failed checks of breve",
+		"`observabilidad` cta: no call to action in post 1",
+	} {
+		if !strings.Contains(markdown, want) {
+			t.Errorf("expected the markdown report to contain %q, got:\n%s", want, markdown)
+		}
+	}
+
+	data, err := report.JSON()
+	if err != nil {
+		t.Fatalf("failed to encode report: %v", err)
+	}
+	if !strings.Contains(string(data), `"baseline": "profesional"`) {
+		t.Errorf("expected the JSON report to name the baseline, got %s", data)
+	}
+}
+
+// TestPromptEvaluator_InvalidResponse validates that a response that isn't valid JSON fails every check
+func TestPromptEvaluator_InvalidResponse(t *testing.T) {
+	llmService := &evalLLMService{}
+	evaluator := newTestPromptEvaluator(t, llmService)
+
+	corpus := &services.PromptEvalCorpus{Cases: []services.PromptEvalCase{
+		{ID: "backend", Type: entities.PromptTypeIdeas, Topic: &services.PromptEvalTopic{Name: "Backend", Ideas: 3}},
+	}}
+
+	report, err := evaluator.Evaluate(context.Background(), corpus, []services.PromptEvalCandidate{
+		{Name: "base1", Type: entities.PromptTypeIdeas, Template: "Genera {ideas} ideas sobre {name}"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result := report.Prompts[0].Cases[0]
+	if llmService.prompts[0] != "Genera 3 ideas sobre Backend" {
+		t.Errorf("expected the rendered prompt, got %q", llmService.prompts[0])
+	}
+	if result.Score != 0.0 {
+		t.Errorf("expected score 0, got %.2f", result.Score)
+	}
+	if len(result.Checks) != 3 {
+		t.Fatalf("expected 3 checks, got %d", len(result.Checks))
+	}
+	for _, check := range result.Checks {
+		if check.Passed {
+			t.Errorf("expected check %s to fail", check.Name)
+		}
+	}
+}
+
+// TestPromptEvalCorpus_Validate validates the rejection of incomplete corpora
+func TestPromptEvalCorpus_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		cases []services.PromptEvalCase
+	}{
+		{"empty", nil},
+		{"duplicate ID", []services.PromptEvalCase{
+			{ID: "a", Type: entities.PromptTypeDrafts, Idea: "x"},
+			{ID: "a", Type: entities.PromptTypeDrafts, Idea: "y"},
+		}},
+		{"drafts without idea", []services.PromptEvalCase{{ID: "a", Type: entities.PromptTypeDrafts}}},
+		{"ideas without topic", []services.PromptEvalCase{{ID: "a", Type: entities.PromptTypeIdeas}}},
+		{"unknown type", []services.PromptEvalCase{{ID: "a", Type: "posts", Idea: "x"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			corpus := services.PromptEvalCorpus{Cases: tt.cases}
+			if err := corpus.Validate(); err == nil {
+				t.Error("expected the corpus to be rejected")
+			}
+		})
+	}
+}
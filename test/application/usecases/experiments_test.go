@@ -0,0 +1,193 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/linkgen-ai/backend/src/application/usecases"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/fakellm"
+)
+
+const experimentTestID = "6650a1b2c3d4e5f6a7b8c9d0"
+
+// experimentPromptsRepository serves the two active drafts prompts of an experiment
+type experimentPromptsRepository struct {
+	defaultPromptsRepository
+}
+
+func (r experimentPromptsRepository) FindActiveByUserIDAndType(ctx context.Context, userID string, promptType entities.PromptType) ([]*entities.Prompt, error) {
+	return []*entities.Prompt{
+		{ID: "control", UserID: userID, Name: "control", Type: entities.PromptTypeDrafts, Active: true},
+		{ID: "casual", UserID: userID, Name: "casual", Type: entities.PromptTypeDrafts, Active: true},
+	}, nil
+}
+
+func (r experimentPromptsRepository) FindByName(ctx context.Context, userID string, name string) (*entities.Prompt, error) {
+	return &entities.Prompt{
+		ID:             name,
+		UserID:         userID,
+		Name:           name,
+		Type:           entities.PromptTypeDrafts,
+		PromptTemplate: `Escribe 5 posts y 1 artículo sobre: {content}. Responde en JSON: {"posts": [], "articles": []}`,
+		Active:         true,
+	}, nil
+}
+
+// singleExperimentRepository serves one active experiment
+type singleExperimentRepository struct {
+	interfaces.ExperimentRepository
+	experiment *entities.Experiment
+}
+
+func (r singleExperimentRepository) FindActiveByUserIDAndType(ctx context.Context, userID string, promptType entities.PromptType) (*entities.Experiment, error) {
+	return r.experiment, nil
+}
+
+func (r singleExperimentRepository) FindByID(ctx context.Context, experimentID string) (*entities.Experiment, error) {
+	return r.experiment, nil
+}
+
+// TestGenerateDraftsUseCase_ExperimentArm validates that an active experiment picks the prompt by weight and stamps its arm
+func TestGenerateDraftsUseCase_ExperimentArm(t *testing.T) {
+	server := fakellm.NewTestServer(fakellm.Options{Rules: fakellm.DefaultRules()})
+	defer server.Close()
+
+	client, err := llm.NewLLMHTTPClient(server.ClientConfig())
+	if err != nil {
+		t.Fatalf("failed to create LLM client: %v", err)
+	}
+
+	experiment := &entities.Experiment{
+		ID:     experimentTestID,
+		UserID: publishTestUserID,
+		Type:   entities.PromptTypeDrafts,
+		Arms:   []entities.ExperimentArm{{PromptName: "control", Weight: 0}, {PromptName: "casual", Weight: 1}},
+		Active: true,
+	}
+
+	uc := newReplayDraftsUseCase(experimentPromptsRepository{}, client)
+	uc.SetExperiments(singleExperimentRepository{experiment: experiment})
+
+	input := usecases.GenerateDraftsInput{UserID: publishTestUserID, IdeaID: fakeLLMIdeaID}
+	drafts, err := uc.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("failed to generate drafts: %v", err)
+	}
+	if len(drafts) == 0 {
+		t.Fatal("expected drafts")
+	}
+
+	for _, draft := range drafts {
+		if draft.Metadata[usecases.DraftMetadataExperimentID] != experimentTestID || draft.Metadata[usecases.DraftMetadataExperimentArm] != "casual" {
+			t.Errorf("expected drafts from the casual arm, got %v", draft.Metadata)
+		}
+		if draft.Metadata[usecases.DraftMetadataPromptID] != "casual" {
+			t.Errorf("expected the casual prompt to generate the drafts, got %v", draft.Metadata)
+		}
+	}
+}
+
+// TestGenerateDraftsUseCase_ExperimentArmPerJob validates that the arm is picked per job, so a
+// retried job generates with the same prompt while jobs are spread over the arms
+func TestGenerateDraftsUseCase_ExperimentArmPerJob(t *testing.T) {
+	server := fakellm.NewTestServer(fakellm.Options{Rules: fakellm.DefaultRules()})
+	defer server.Close()
+
+	client, err := llm.NewLLMHTTPClient(server.ClientConfig())
+	if err != nil {
+		t.Fatalf("failed to create LLM client: %v", err)
+	}
+
+	experiment := &entities.Experiment{
+		ID:     experimentTestID,
+		UserID: publishTestUserID,
+		Type:   entities.PromptTypeDrafts,
+		Arms:   []entities.ExperimentArm{{PromptName: "control", Weight: 1}, {PromptName: "casual", Weight: 1}},
+		Active: true,
+	}
+
+	uc := newReplayDraftsUseCase(experimentPromptsRepository{}, client)
+	uc.SetExperiments(singleExperimentRepository{experiment: experiment})
+
+	generateArm := func(jobID string) interface{} {
+		ctx := interfaces.WithLLMCallInfo(context.Background(), interfaces.LLMCallInfo{JobID: jobID})
+		drafts, err := uc.Execute(ctx, usecases.GenerateDraftsInput{UserID: publishTestUserID, IdeaID: fakeLLMIdeaID})
+		if err != nil {
+			t.Fatalf("failed to generate drafts for job %s: %v", jobID, err)
+		}
+		if len(drafts) == 0 {
+			t.Fatalf("expected drafts for job %s", jobID)
+		}
+		return drafts[0].Metadata[usecases.DraftMetadataExperimentArm]
+	}
+
+	arms := make(map[interface{}]int)
+	for i := 0; i < 16; i++ {
+		jobID := fmt.Sprintf("job-%d", i)
+		arm := generateArm(jobID)
+		if retried := generateArm(jobID); retried != arm {
+			t.Errorf("expected job %s to keep arm %v when retried, got %v", jobID, arm, retried)
+		}
+		arms[arm]++
+	}
+
+	if arms["control"] == 0 || arms["casual"] == 0 {
+		t.Errorf("expected jobs spread over both arms, got %v", arms)
+	}
+}
+
+// TestManageExperimentsUseCase_Results validates the comparison of arms on refinements and publish rate
+func TestManageExperimentsUseCase_Results(t *testing.T) {
+	experiment := &entities.Experiment{
+		ID:     experimentTestID,
+		UserID: publishTestUserID,
+		Type:   entities.PromptTypeDrafts,
+		Arms:   []entities.ExperimentArm{{PromptName: "control", Weight: 1}, {PromptName: "casual", Weight: 1}},
+		Active: true,
+	}
+	armMetadata := func(experimentID, arm string) map[string]interface{} {
+		return map[string]interface{}{
+			usecases.DraftMetadataExperimentID:  experimentID,
+			usecases.DraftMetadataExperimentArm: arm,
+		}
+	}
+	refinements := func(n int) []entities.RefinementEntry {
+		return make([]entities.RefinementEntry, n)
+	}
+
+	drafts := &MockDraftRepository{
+		ListByUserIDFunc: func(ctx context.Context, userID string, status entities.DraftStatus, draftType entities.DraftType) ([]*entities.Draft, error) {
+			return []*entities.Draft{
+				{Status: entities.DraftStatusPublished, Metadata: armMetadata(experimentTestID, "control")},
+				{Status: entities.DraftStatusRefined, RefinementHistory: refinements(3), Metadata: armMetadata(experimentTestID, "control")},
+				{Status: entities.DraftStatusPublished, RefinementHistory: refinements(1), Metadata: armMetadata(experimentTestID, "casual")},
+				{Status: entities.DraftStatusPublished, Metadata: armMetadata("another", "casual")},
+				{Status: entities.DraftStatusDraft},
+			}, nil
+		},
+	}
+
+	uc := usecases.NewManageExperimentsUseCase(singleExperimentRepository{experiment: experiment}, experimentPromptsRepository{}, drafts, fakeLLMUsers())
+	results, err := uc.Results(context.Background(), publishTestUserID, experimentTestID)
+	if err != nil {
+		t.Fatalf("failed to compare arms: %v", err)
+	}
+
+	if len(results.Arms) != 2 {
+		t.Fatalf("expected 2 arms, got %d", len(results.Arms))
+	}
+	control, casual := results.Arms[0], results.Arms[1]
+	if control.Drafts != 2 || control.Refinements != 3 || control.AvgRefinements != 1.5 || control.PublishRate != 0.5 {
+		t.Errorf("unexpected control results: %+v", control)
+	}
+	if casual.Drafts != 1 || casual.AvgRefinements != 1 || casual.PublishRate != 1 {
+		t.Errorf("unexpected casual results: %+v", casual)
+	}
+	if control.Engagement != nil {
+		t.Errorf("expected no engagement yet, got %v", *control.Engagement)
+	}
+}
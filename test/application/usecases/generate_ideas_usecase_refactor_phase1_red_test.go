@@ -1,3 +1,7 @@
+//go:build legacy
+
+// Not built by default: written against APIs src doesn't provide.
+
 package usecases
 
 import (
@@ -229,6 +233,14 @@ func (s *stubUserRepo) UpdateLinkedInToken(ctx context.Context, userID string, t
 	return fmt.Errorf("UpdateLinkedInToken not implemented")
 }
 
+func (s *stubUserRepo) UpdateLinkedInCredentials(ctx context.Context, user *entities.User) error {
+	return fmt.Errorf("UpdateLinkedInCredentials not implemented")
+}
+
+func (s *stubUserRepo) FindWithExpiringLinkedInToken(ctx context.Context, before time.Time, limit int) ([]*entities.User, error) {
+	return nil, fmt.Errorf("FindWithExpiringLinkedInToken not implemented")
+}
+
 func (s *stubUserRepo) Delete(ctx context.Context, userID string) error {
 	return fmt.Errorf("Delete not implemented")
 }
@@ -277,6 +289,10 @@ func (s *stubTopicRepo) FindByPrompt(ctx context.Context, userID string, promptN
 	return nil, fmt.Errorf("FindByPrompt not implemented")
 }
 
+func (s *stubTopicRepo) ListActive(ctx context.Context, limit int) ([]*entities.Topic, error) {
+	return nil, fmt.Errorf("ListActive not implemented")
+}
+
 func (s *stubTopicRepo) FindByIdeasRange(ctx context.Context, userID string, minIdeas, maxIdeas int) ([]*entities.Topic, error) {
 	return nil, fmt.Errorf("FindByIdeasRange not implemented")
 }
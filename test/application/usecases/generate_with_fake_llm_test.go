@@ -0,0 +1,244 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/application/usecases"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/fakellm"
+	"github.com/linkgen-ai/backend/src/infrastructure/services"
+)
+
+const (
+	fakeLLMIdeaID  = "675337baf901e2d790aabc01"
+	fakeLLMTopicID = "675337baf901e2d790aabc02"
+)
+
+// defaultPromptsRepository has no custom prompts, so the PromptEngine falls back to its defaults
+type defaultPromptsRepository struct {
+	interfaces.PromptsRepository
+}
+
+func (r defaultPromptsRepository) FindByName(ctx context.Context, userID string, name string) (*entities.Prompt, error) {
+	return nil, nil
+}
+
+func (r defaultPromptsRepository) FindActiveByUserIDAndType(ctx context.Context, userID string, promptType entities.PromptType) ([]*entities.Prompt, error) {
+	return nil, nil
+}
+
+// singleTopicRepository serves one topic by ID
+type singleTopicRepository struct {
+	interfaces.TopicRepository
+	topic *entities.Topic
+}
+
+func (r singleTopicRepository) FindByID(ctx context.Context, topicID string) (*entities.Topic, error) {
+	if topicID != r.topic.ID {
+		return nil, nil
+	}
+	return r.topic, nil
+}
+
+// newFakeLLMClient points the real LLM client at a fake server with one scripted response
+func newFakeLLMClient(t *testing.T, response fakellm.Response) *llm.LLMHTTPClient {
+	t.Helper()
+
+	server := fakellm.NewTestServer(fakellm.Options{Rules: []fakellm.Rule{{Response: response}}})
+	t.Cleanup(server.Close)
+
+	config := server.ClientConfig()
+	config.Timeout = 100 * time.Millisecond
+	client, err := llm.NewLLMHTTPClient(config)
+	if err != nil {
+		t.Fatalf("failed to create LLM client: %v", err)
+	}
+	client.SetRetryConfig(fakellm.FastRetryConfig())
+	return client
+}
+
+func fakeLLMUsers() *MockUserRepository {
+	return &MockUserRepository{
+		FindByIDFunc: func(ctx context.Context, userID string) (*entities.User, error) {
+			return &entities.User{ID: userID, Email: "test@example.com"}, nil
+		},
+	}
+}
+
+// TestGenerateDraftsUseCase_FakeLLMResponses validates draft set parsing and validation against scripted LLM output
+func TestGenerateDraftsUseCase_FakeLLMResponses(t *testing.T) {
+	tests := []struct {
+		name      string
+		response  fakellm.Response
+		wantErr   string
+		wantStage string
+	}{
+		{
+			name:     "code fenced drafts",
+			response: fakellm.Response{Content: fakellm.CodeFenced(fakellm.DraftsJSON(fakellm.SamplePosts(5), fakellm.SampleArticles(1)))},
+		},
+		{
+			name:      "short post list",
+			response:  fakellm.Response{Content: fakellm.DraftsJSON(fakellm.SamplePosts(3), fakellm.SampleArticles(1))},
+			wantErr:   "insufficient posts generated",
+			wantStage: "drafts_validation",
+		},
+		{
+			name:      "missing article",
+			response:  fakellm.Response{Content: fakellm.DraftsJSON(fakellm.SamplePosts(5), nil)},
+			wantErr:   "no articles generated",
+			wantStage: "drafts_validation",
+		},
+		{
+			name:     "malformed json",
+			response: fakellm.Response{Content: fakellm.MalformedJSON},
+			wantErr:  "failed to parse LLM response",
+		},
+		{
+			name:     "rate limited",
+			response: fakellm.Response{Status: 429},
+			wantErr:  "status code 429",
+		},
+		{
+			name:     "timeout",
+			response: fakellm.Response{Content: fakellm.DraftsJSON(fakellm.SamplePosts(5), fakellm.SampleArticles(1)), Latency: time.Second},
+			wantErr:  "LLM service error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var saved []*entities.Draft
+			ideas := &MockIdeasRepository{
+				ListByUserIDFunc: func(ctx context.Context, userID string, topicID string, limit int) ([]*entities.Idea, error) {
+					return []*entities.Idea{{ID: fakeLLMIdeaID, UserID: userID, Content: "Automatizar la publicación en LinkedIn"}}, nil
+				},
+			}
+			drafts := &MockDraftRepository{
+				CreateFunc: func(ctx context.Context, draft *entities.Draft) (string, error) {
+					saved = append(saved, draft)
+					return draft.ID, nil
+				},
+			}
+
+			prompts := defaultPromptsRepository{}
+			uc := usecases.NewGenerateDraftsUseCase(
+				fakeLLMUsers(), ideas, drafts, prompts,
+				services.NewPromptEngine(prompts, nil),
+				newFakeLLMClient(t, tt.response),
+			)
+
+			result, err := uc.Execute(context.Background(), usecases.GenerateDraftsInput{UserID: publishTestUserID, IdeaID: fakeLLMIdeaID})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if len(result) != 6 || len(saved) != 6 {
+					t.Errorf("expected 6 drafts generated and saved, got %d and %d", len(result), len(saved))
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+			var llmErr *domainErrors.LLMResponseError
+			if tt.wantStage != "" && (!errors.As(err, &llmErr) || llmErr.Operation != tt.wantStage) {
+				t.Errorf("expected LLMResponseError %q, got %v", tt.wantStage, err)
+			}
+			if len(saved) != 0 {
+				t.Errorf("expected nothing saved on failure, got %d drafts", len(saved))
+			}
+		})
+	}
+}
+
+// TestGenerateIdeasUseCase_FakeLLMResponses validates ideas parsing against scripted LLM output
+func TestGenerateIdeasUseCase_FakeLLMResponses(t *testing.T) {
+	tests := []struct {
+		name      string
+		response  fakellm.Response
+		wantErr   string
+		wantIdeas int
+	}{
+		{
+			name:      "code fenced ideas",
+			response:  fakellm.Response{Content: fakellm.CodeFenced(fakellm.IdeasJSON(fakellm.SampleIdeas(3)...))},
+			wantIdeas: 3,
+		},
+		{
+			name:     "empty ideas list",
+			response: fakellm.Response{Content: fakellm.IdeasJSON()},
+			wantErr:  "LLM returned empty ideas list",
+		},
+		{
+			name:     "malformed json",
+			response: fakellm.Response{Content: `{"ideas": ["sin cerrar"`},
+			wantErr:  "failed to unmarshal JSON response",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var saved []*entities.Idea
+			ideas := &MockIdeasRepository{
+				CreateBatchFunc: func(ctx context.Context, batch []*entities.Idea) error {
+					saved = append(saved, batch...)
+					return nil
+				},
+			}
+			topics := singleTopicRepository{topic: &entities.Topic{
+				ID:     fakeLLMTopicID,
+				UserID: publishTestUserID,
+				Name:   "Automatización",
+				Ideas:  3,
+			}}
+
+			prompts := defaultPromptsRepository{}
+			uc := usecases.NewGenerateIdeasUseCase(
+				fakeLLMUsers(), topics, ideas, prompts,
+				services.NewPromptEngine(prompts, nil),
+				newFakeLLMClient(t, tt.response),
+			)
+
+			result, err := uc.GenerateIdeasForTopic(context.Background(), fakeLLMTopicID)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(result) != tt.wantIdeas || len(saved) != tt.wantIdeas {
+				t.Errorf("expected %d ideas generated and saved, got %d and %d", tt.wantIdeas, len(result), len(saved))
+			}
+		})
+	}
+}
+
+// TestFakeLLMDefaultRulesRouteByPromptType validates the default rules answer the default prompts
+func TestFakeLLMDefaultRulesRouteByPromptType(t *testing.T) {
+	engine := services.NewPromptEngine(defaultPromptsRepository{}, nil)
+	for _, promptType := range []entities.PromptType{entities.PromptTypeIdeas, entities.PromptTypeDrafts} {
+		prompt := engine.GetDefaultPrompt(promptType)
+		var matched string
+		for _, rule := range fakellm.DefaultRules() {
+			if rule.Pattern != nil && rule.Pattern.MatchString(prompt) {
+				matched = rule.Name
+				break
+			}
+		}
+		if matched != "default-"+string(promptType) {
+			t.Errorf("expected the %s default prompt to match its rule, matched %q", promptType, matched)
+		}
+	}
+}
@@ -0,0 +1,240 @@
+package usecases
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/application/usecases"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/database"
+)
+
+// memoryOAuthStateRepository is an in-memory OAuthStateRepository
+type memoryOAuthStateRepository struct {
+	mu     sync.Mutex
+	states map[string]*entities.OAuthState
+}
+
+func newMemoryOAuthStateRepository() *memoryOAuthStateRepository {
+	return &memoryOAuthStateRepository{states: make(map[string]*entities.OAuthState)}
+}
+
+func (r *memoryOAuthStateRepository) Create(ctx context.Context, state *entities.OAuthState) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := *state
+	r.states[state.State] = &stored
+	return nil
+}
+
+func (r *memoryOAuthStateRepository) Consume(ctx context.Context, state string) (*entities.OAuthState, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.states[state]
+	if !ok {
+		return nil, database.ErrEntityNotFound
+	}
+	delete(r.states, state)
+	return stored, nil
+}
+
+// fakeLinkedInAuth records the PKCE parameters and emulates the token endpoint
+type fakeLinkedInAuth struct {
+	challenges   map[string]string
+	exchangeFn   func(code, codeVerifier string) (*interfaces.LinkedInTokenResponse, error)
+	refreshFn    func(refreshToken string) (*interfaces.LinkedInTokenResponse, error)
+	refreshCalls int
+}
+
+func (f *fakeLinkedInAuth) AuthorizationURL(state, codeChallenge string) (string, error) {
+	if f.challenges == nil {
+		f.challenges = make(map[string]string)
+	}
+	f.challenges[state] = codeChallenge
+	return "https://www.linkedin.com/oauth/v2/authorization?" + url.Values{"state": {state}}.Encode(), nil
+}
+
+func (f *fakeLinkedInAuth) ExchangeAuthorizationCode(ctx context.Context, code, codeVerifier string) (*interfaces.LinkedInTokenResponse, error) {
+	return f.exchangeFn(code, codeVerifier)
+}
+
+func (f *fakeLinkedInAuth) RefreshTokenPair(ctx context.Context, refreshToken string) (*interfaces.LinkedInTokenResponse, error) {
+	f.refreshCalls++
+	return f.refreshFn(refreshToken)
+}
+
+func startAuthState(t *testing.T, uc *usecases.StartLinkedInAuthUseCase) string {
+	t.Helper()
+
+	result, err := uc.Execute(context.Background(), usecases.StartLinkedInAuthInput{UserID: publishTestUserID})
+	if err != nil {
+		t.Fatalf("unexpected start error: %v", err)
+	}
+
+	parsed, err := url.Parse(result.AuthorizationURL)
+	if err != nil {
+		t.Fatalf("invalid authorization URL: %v", err)
+	}
+
+	return parsed.Query().Get("state")
+}
+
+// TestLinkedInAuthUseCases_RoundTrip validates the authorization code flow with PKCE
+func TestLinkedInAuthUseCases_RoundTrip(t *testing.T) {
+	user := &entities.User{ID: publishTestUserID, Email: "user@example.com", Active: true}
+
+	var saved *entities.User
+	userRepo := &MockUserRepository{
+		FindByIDFunc: func(ctx context.Context, userID string) (*entities.User, error) {
+			return user, nil
+		},
+		UpdateLinkedInCredentialsFunc: func(ctx context.Context, u *entities.User) error {
+			saved = u
+			return nil
+		},
+	}
+
+	auth := &fakeLinkedInAuth{}
+	auth.exchangeFn = func(code, codeVerifier string) (*interfaces.LinkedInTokenResponse, error) {
+		if code != "auth-code" {
+			t.Errorf("expected auth-code, got %q", code)
+		}
+		return &interfaces.LinkedInTokenResponse{AccessToken: "access", RefreshToken: "refresh", ExpiresIn: 3600}, nil
+	}
+
+	stateRepo := newMemoryOAuthStateRepository()
+	start := usecases.NewStartLinkedInAuthUseCase(userRepo, stateRepo, auth)
+	complete := usecases.NewCompleteLinkedInAuthUseCase(userRepo, stateRepo, auth)
+
+	state := startAuthState(t, start)
+	if state == "" {
+		t.Fatal("expected state in authorization URL")
+	}
+
+	stored := stateRepo.states[state]
+	sum := sha256.Sum256([]byte(stored.CodeVerifier))
+	if want := base64.RawURLEncoding.EncodeToString(sum[:]); auth.challenges[state] != want {
+		t.Errorf("code challenge %q does not match S256 of the stored verifier", auth.challenges[state])
+	}
+
+	before := time.Now()
+	result, err := complete.Execute(context.Background(), usecases.CompleteLinkedInAuthInput{Code: "auth-code", State: state})
+	if err != nil {
+		t.Fatalf("unexpected complete error: %v", err)
+	}
+
+	if saved == nil || saved.LinkedInToken != "access" || saved.LinkedInRefreshToken != "refresh" {
+		t.Fatalf("expected credentials to be persisted, got %+v", saved)
+	}
+	if result.LinkedInTokenExpiresAt == nil || result.LinkedInTokenExpiresAt.Before(before.Add(59*time.Minute)) {
+		t.Errorf("expected expiry about an hour from now, got %v", result.LinkedInTokenExpiresAt)
+	}
+
+	// The state is single-use
+	_, err = complete.Execute(context.Background(), usecases.CompleteLinkedInAuthInput{Code: "auth-code", State: state})
+	var validationErr *domainErrors.ErrValidation
+	if !errors.As(err, &validationErr) || validationErr.Field != "state" {
+		t.Errorf("expected state validation error on replay, got %v", err)
+	}
+}
+
+// TestLinkedInAuthUseCases_Errors validates rejected authorization attempts
+func TestLinkedInAuthUseCases_Errors(t *testing.T) {
+	activeUser := func() *MockUserRepository {
+		return &MockUserRepository{
+			FindByIDFunc: func(ctx context.Context, userID string) (*entities.User, error) {
+				return &entities.User{ID: userID, Active: true}, nil
+			},
+			UpdateLinkedInCredentialsFunc: func(ctx context.Context, u *entities.User) error {
+				t.Error("credentials must not be persisted")
+				return nil
+			},
+		}
+	}
+
+	t.Run("start rejects unknown user", func(t *testing.T) {
+		userRepo := &MockUserRepository{
+			FindByIDFunc: func(ctx context.Context, userID string) (*entities.User, error) {
+				return nil, database.ErrEntityNotFound
+			},
+		}
+		uc := usecases.NewStartLinkedInAuthUseCase(userRepo, newMemoryOAuthStateRepository(), &fakeLinkedInAuth{})
+
+		_, err := uc.Execute(context.Background(), usecases.StartLinkedInAuthInput{UserID: publishTestUserID})
+		var notFound *domainErrors.ErrUserNotFound
+		if !errors.As(err, &notFound) {
+			t.Errorf("expected user not found error, got %v", err)
+		}
+	})
+
+	t.Run("start rejects inactive user", func(t *testing.T) {
+		userRepo := &MockUserRepository{
+			FindByIDFunc: func(ctx context.Context, userID string) (*entities.User, error) {
+				return &entities.User{ID: userID}, nil
+			},
+		}
+		stateRepo := newMemoryOAuthStateRepository()
+		uc := usecases.NewStartLinkedInAuthUseCase(userRepo, stateRepo, &fakeLinkedInAuth{})
+
+		_, err := uc.Execute(context.Background(), usecases.StartLinkedInAuthInput{UserID: publishTestUserID})
+		var credentialsErr *domainErrors.ErrInvalidUserCredentials
+		if !errors.As(err, &credentialsErr) {
+			t.Errorf("expected invalid credentials error, got %v", err)
+		}
+		if len(stateRepo.states) != 0 {
+			t.Error("no state must be stored for an inactive user")
+		}
+	})
+
+	t.Run("complete rejects unknown state", func(t *testing.T) {
+		uc := usecases.NewCompleteLinkedInAuthUseCase(activeUser(), newMemoryOAuthStateRepository(), &fakeLinkedInAuth{})
+
+		_, err := uc.Execute(context.Background(), usecases.CompleteLinkedInAuthInput{Code: "auth-code", State: "forged"})
+		var validationErr *domainErrors.ErrValidation
+		if !errors.As(err, &validationErr) || validationErr.Field != "state" {
+			t.Errorf("expected state validation error, got %v", err)
+		}
+	})
+
+	t.Run("complete rejects expired state", func(t *testing.T) {
+		stateRepo := newMemoryOAuthStateRepository()
+		_ = stateRepo.Create(context.Background(), &entities.OAuthState{
+			State:        "expired",
+			UserID:       publishTestUserID,
+			CodeVerifier: "verifier",
+			CreatedAt:    time.Now().Add(-time.Hour),
+			ExpiresAt:    time.Now().Add(-time.Minute),
+		})
+		uc := usecases.NewCompleteLinkedInAuthUseCase(activeUser(), stateRepo, &fakeLinkedInAuth{})
+
+		_, err := uc.Execute(context.Background(), usecases.CompleteLinkedInAuthInput{Code: "auth-code", State: "expired"})
+		var validationErr *domainErrors.ErrValidation
+		if !errors.As(err, &validationErr) || validationErr.Field != "state" {
+			t.Errorf("expected state validation error, got %v", err)
+		}
+	})
+
+	t.Run("complete maps rejected authorization code", func(t *testing.T) {
+		userRepo := activeUser()
+		auth := &fakeLinkedInAuth{exchangeFn: func(code, codeVerifier string) (*interfaces.LinkedInTokenResponse, error) {
+			return nil, domainErrors.NewLinkedInAPIError("exchange_code", domainErrors.LinkedInReasonBadRequest, 400, "invalid_grant", nil)
+		}}
+		stateRepo := newMemoryOAuthStateRepository()
+		state := startAuthState(t, usecases.NewStartLinkedInAuthUseCase(userRepo, stateRepo, auth))
+		uc := usecases.NewCompleteLinkedInAuthUseCase(userRepo, stateRepo, auth)
+
+		_, err := uc.Execute(context.Background(), usecases.CompleteLinkedInAuthInput{Code: "stale", State: state})
+		var validationErr *domainErrors.ErrValidation
+		if !errors.As(err, &validationErr) || validationErr.Field != "code" {
+			t.Errorf("expected code validation error, got %v", err)
+		}
+	})
+}
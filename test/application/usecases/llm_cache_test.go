@@ -0,0 +1,81 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/linkgen-ai/backend/src/application/usecases"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/cache"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/fakellm"
+	"github.com/linkgen-ai/backend/src/infrastructure/services"
+)
+
+// TestGenerateDraftsUseCase_RetryReusesCachedResponse validates that a job retried after a
+// database failure doesn't call the model again
+func TestGenerateDraftsUseCase_RetryReusesCachedResponse(t *testing.T) {
+	server := fakellm.NewTestServer(fakellm.Options{Rules: fakellm.DefaultRules()})
+	defer server.Close()
+
+	client, err := llm.NewLLMHTTPClient(server.ClientConfig())
+	if err != nil {
+		t.Fatalf("failed to create LLM client: %v", err)
+	}
+	cached, err := cache.NewClient(client, cache.NewMemoryStore(cache.MemoryConfig{}), cache.Config{Model: "fake-model"})
+	if err != nil {
+		t.Fatalf("failed to create cache client: %v", err)
+	}
+
+	// The first save fails after the model already answered
+	saves := 0
+	drafts := &MockDraftRepository{
+		CreateFunc: func(ctx context.Context, draft *entities.Draft) (string, error) {
+			saves++
+			if saves == 1 {
+				return "", errors.New("connection reset by peer")
+			}
+			return draft.ID, nil
+		},
+	}
+	ideas := &MockIdeasRepository{
+		ListByUserIDFunc: func(ctx context.Context, userID string, topicID string, limit int) ([]*entities.Idea, error) {
+			return []*entities.Idea{{ID: fakeLLMIdeaID, UserID: userID, Content: "Automatizar la publicación en LinkedIn"}}, nil
+		},
+	}
+	prompts := defaultPromptsRepository{}
+	uc := usecases.NewGenerateDraftsUseCase(fakeLLMUsers(), ideas, drafts, prompts, services.NewPromptEngine(prompts, nil), cached)
+
+	input := usecases.GenerateDraftsInput{UserID: publishTestUserID, IdeaID: fakeLLMIdeaID}
+	execute := func(jobID string) error {
+		ctx := context.Background()
+		if jobID != "" {
+			ctx = interfaces.WithLLMCallInfo(ctx, interfaces.LLMCallInfo{JobID: jobID})
+		}
+		_, err := uc.Execute(ctx, input)
+		return err
+	}
+
+	if err := execute("job-1"); err == nil {
+		t.Fatal("expected the first attempt to fail on save")
+	}
+	if err := execute("job-1"); err != nil {
+		t.Fatalf("expected the retry to succeed, got %v", err)
+	}
+	if got := len(server.Requests()); got != 1 {
+		t.Fatalf("expected the retry to reuse the response, got %d LLM requests", got)
+	}
+
+	// Other jobs and calls outside a job still reach the model
+	if err := execute("job-2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := execute(""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := len(server.Requests()); got != 3 {
+		t.Errorf("expected 3 LLM requests, got %d", got)
+	}
+}
@@ -0,0 +1,110 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/application/services"
+	"github.com/linkgen-ai/backend/src/application/usecases"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+)
+
+// quotaUsers returns a user repository whose user has the given quota, nil for the default one
+func quotaUsers(quota *entities.LLMQuota) *MockUserRepository {
+	return &MockUserRepository{
+		FindByIDFunc: func(ctx context.Context, userID string) (*entities.User, error) {
+			return &entities.User{ID: userID, Email: "test@example.com", LLMQuota: quota}, nil
+		},
+	}
+}
+
+// TestLLMQuotaService_DailyAndMonthlyLimits validates that usage is measured per window and user overrides win
+func TestLLMQuotaService_DailyAndMonthlyLimits(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	repo := &memoryLLMUsageRepository{}
+	record := func(tokens int, at time.Time) {
+		t.Helper()
+		_, err := repo.Create(ctx, &entities.LLMUsage{UserID: publishTestUserID, Model: "gpt-4o", PromptTokens: tokens, CreatedAt: at})
+		if err != nil {
+			t.Fatalf("failed to record usage: %v", err)
+		}
+	}
+	record(600, now)
+
+	// No quota at all
+	if err := services.NewLLMQuotaService(repo, quotaUsers(nil), entities.LLMQuota{}).CheckLLMQuota(ctx, publishTestUserID); err != nil {
+		t.Fatalf("expected unlimited usage, got %v", err)
+	}
+
+	defaults := entities.LLMQuota{DailyTokens: 1000, MonthlyRequests: 10}
+	checker := services.NewLLMQuotaService(repo, quotaUsers(nil), defaults)
+	if err := checker.CheckLLMQuota(ctx, publishTestUserID); err != nil {
+		t.Fatalf("expected usage within the quota, got %v", err)
+	}
+
+	record(400, now)
+	err := checker.CheckLLMQuota(ctx, publishTestUserID)
+	var quotaErr *domainErrors.ErrQuotaExceeded
+	if !errors.As(err, &quotaErr) {
+		t.Fatalf("expected ErrQuotaExceeded, got %v", err)
+	}
+	tomorrow := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+	if quotaErr.Period != services.QuotaPeriodDaily || quotaErr.Resource != services.QuotaResourceTokens || quotaErr.Used != 1000 {
+		t.Errorf("unexpected quota error: %+v", quotaErr)
+	}
+	if quotaErr.RemainingTokens != 0 || quotaErr.RemainingRequests != 8 || !quotaErr.ResetAt.Equal(tomorrow) {
+		t.Errorf("unexpected remaining budget: %+v", quotaErr)
+	}
+
+	// A user's own quota replaces the default one
+	generous := services.NewLLMQuotaService(repo, quotaUsers(&entities.LLMQuota{DailyTokens: 5000}), defaults)
+	if err := generous.CheckLLMQuota(ctx, publishTestUserID); err != nil {
+		t.Errorf("expected the user's quota to apply, got %v", err)
+	}
+
+	// Requests are counted across the calendar month, not just today
+	monthly := services.NewLLMQuotaService(repo, quotaUsers(&entities.LLMQuota{MonthlyRequests: 2}), defaults)
+	err = monthly.CheckLLMQuota(ctx, publishTestUserID)
+	if !errors.As(err, &quotaErr) || quotaErr.Period != services.QuotaPeriodMonthly || quotaErr.Resource != services.QuotaResourceRequests {
+		t.Errorf("expected the monthly request quota to be exceeded, got %v", err)
+	}
+}
+
+// TestRefineDraftUseCase_QuotaExceeded validates that refinement stops before calling the LLM
+func TestRefineDraftUseCase_QuotaExceeded(t *testing.T) {
+	ctx := context.Background()
+	repo := &memoryLLMUsageRepository{}
+	_, err := repo.Create(ctx, &entities.LLMUsage{UserID: publishTestUserID, Model: "gpt-4o", PromptTokens: 10, CreatedAt: time.Now()})
+	if err != nil {
+		t.Fatalf("failed to record usage: %v", err)
+	}
+
+	drafts := &MockDraftRepository{
+		FindByIDFunc: func(ctx context.Context, draftID string) (*entities.Draft, error) {
+			return &entities.Draft{ID: draftID, UserID: publishTestUserID, Status: entities.DraftStatusDraft, Content: "Un borrador"}, nil
+		},
+	}
+	llmCalls := 0
+	llmService := &MockLLMService{
+		RefineDraftFunc: func(ctx context.Context, draft string, userPrompt string, history []string) (string, error) {
+			llmCalls++
+			return draft, nil
+		},
+	}
+
+	uc := usecases.NewRefineDraftUseCase(drafts, llmService)
+	uc.SetQuotaChecker(services.NewLLMQuotaService(repo, quotaUsers(&entities.LLMQuota{DailyRequests: 1}), entities.LLMQuota{}))
+
+	_, err = uc.Execute(ctx, usecases.RefineDraftInput{DraftID: publishTestDraftID, UserPrompt: "Hazlo más corto y directo"})
+	// Handlers type-switch on the error, so it must not be wrapped
+	if _, ok := err.(*domainErrors.ErrQuotaExceeded); !ok {
+		t.Fatalf("expected an unwrapped ErrQuotaExceeded, got %v", err)
+	}
+	if llmCalls != 0 {
+		t.Errorf("expected no LLM call over quota, got %d", llmCalls)
+	}
+}
@@ -0,0 +1,145 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/linkgen-ai/backend/src/application/usecases"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/fakellm"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/replay"
+	"github.com/linkgen-ai/backend/src/infrastructure/services"
+)
+
+// templatePromptsRepository serves one custom drafts prompt under every name
+type templatePromptsRepository struct {
+	defaultPromptsRepository
+	template string
+}
+
+func (r templatePromptsRepository) FindByName(ctx context.Context, userID string, name string) (*entities.Prompt, error) {
+	return &entities.Prompt{ID: "custom", UserID: userID, Name: name, Type: entities.PromptTypeDrafts, PromptTemplate: r.template, Active: true}, nil
+}
+
+func newReplayDraftsUseCase(prompts interfaces.PromptsRepository, llmService interfaces.LLMService) *usecases.GenerateDraftsUseCase {
+	ideas := &MockIdeasRepository{
+		ListByUserIDFunc: func(ctx context.Context, userID string, topicID string, limit int) ([]*entities.Idea, error) {
+			return []*entities.Idea{{ID: fakeLLMIdeaID, UserID: userID, Content: "Automatizar la publicación en LinkedIn"}}, nil
+		},
+	}
+	return usecases.NewGenerateDraftsUseCase(
+		fakeLLMUsers(), ideas, &MockDraftRepository{}, prompts,
+		services.NewPromptEngine(prompts, nil),
+		llmService,
+	)
+}
+
+// TestGenerateDraftsUseCase_RecordAndReplay validates the prompt pipeline against recorded LLM output
+func TestGenerateDraftsUseCase_RecordAndReplay(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	input := usecases.GenerateDraftsInput{UserID: publishTestUserID, IdeaID: fakeLLMIdeaID}
+
+	server := fakellm.NewTestServer(fakellm.Options{Rules: fakellm.DefaultRules()})
+	live, err := llm.NewLLMHTTPClient(server.ClientConfig())
+	if err != nil {
+		t.Fatalf("failed to create LLM client: %v", err)
+	}
+
+	recorder, err := replay.NewClient(live, replay.Config{Mode: replay.ModeRecord, Dir: dir, Model: "fake-model"})
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	recorded, err := newReplayDraftsUseCase(defaultPromptsRepository{}, recorder).Execute(ctx, input)
+	if err != nil {
+		t.Fatalf("failed to record: %v", err)
+	}
+	server.Close()
+
+	// Replay never touches the network, so the closed server doesn't matter
+	player, err := replay.NewClient(nil, replay.Config{Mode: replay.ModeReplay, Dir: dir, Model: "fake-model"})
+	if err != nil {
+		t.Fatalf("failed to create player: %v", err)
+	}
+	replayed, err := newReplayDraftsUseCase(defaultPromptsRepository{}, player).Execute(ctx, input)
+	if err != nil {
+		t.Fatalf("failed to replay: %v", err)
+	}
+	if len(replayed) != len(recorded) {
+		t.Fatalf("expected %d replayed drafts, got %d", len(recorded), len(replayed))
+	}
+	for i := range recorded {
+		if replayed[i].Content != recorded[i].Content {
+			t.Errorf("draft %d differs between recording and replay", i)
+		}
+	}
+
+	// A changed prompt was never recorded
+	changed := templatePromptsRepository{template: "Escribe 5 posts y 1 artículo sobre: {content}"}
+	_, err = newReplayDraftsUseCase(changed, player).Execute(ctx, input)
+	if !errors.Is(err, replay.ErrFixtureNotFound) {
+		t.Errorf("expected ErrFixtureNotFound for a changed prompt, got %v", err)
+	}
+
+	// Another model has its own recordings
+	otherModel, _ := replay.NewClient(nil, replay.Config{Mode: replay.ModeReplay, Dir: dir, Model: "other-model"})
+	if _, err := newReplayDraftsUseCase(defaultPromptsRepository{}, otherModel).Execute(ctx, input); !errors.Is(err, replay.ErrFixtureNotFound) {
+		t.Errorf("expected ErrFixtureNotFound for another model, got %v", err)
+	}
+}
+
+// TestReplayClient_RecordsEveryOperation validates the typed LLMService methods round-trip through fixtures
+func TestReplayClient_RecordsEveryOperation(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	live := &MockLLMService{
+		GenerateIdeasFunc: func(ctx context.Context, topic string, count int) ([]string, error) {
+			return []string{"idea about " + topic}, nil
+		},
+		GenerateDraftsFunc: func(ctx context.Context, idea string, userContext string) (interfaces.DraftSet, error) {
+			return interfaces.DraftSet{Posts: []string{"post"}, Articles: []string{"article"}}, nil
+		},
+		RefineDraftFunc: func(ctx context.Context, draft string, userPrompt string, history []string) (string, error) {
+			return draft + " (" + userPrompt + ")", nil
+		},
+	}
+
+	recorder, err := replay.NewClient(live, replay.Config{Mode: replay.ModeRecord, Dir: dir, Model: "gpt/4o:mini"})
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	if _, err := recorder.GenerateIdeas(ctx, "go", 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := recorder.GenerateDrafts(ctx, "idea", "context"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := recorder.RefineDraft(ctx, "draft", "shorter", []string{"v1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	player, _ := replay.NewClient(nil, replay.Config{Mode: replay.ModeReplay, Dir: dir, Model: "gpt/4o:mini"})
+	if ideas, err := player.GenerateIdeas(ctx, "go", 1); err != nil || len(ideas) != 1 || ideas[0] != "idea about go" {
+		t.Errorf("unexpected replayed ideas: %v (%v)", ideas, err)
+	}
+	if drafts, err := player.GenerateDrafts(ctx, "idea", "context"); err != nil || len(drafts.Posts) != 1 || drafts.Articles[0] != "article" {
+		t.Errorf("unexpected replayed drafts: %+v (%v)", drafts, err)
+	}
+	if refined, err := player.RefineDraft(ctx, "draft", "shorter", []string{"v1"}); err != nil || refined != "draft (shorter)" {
+		t.Errorf("unexpected replayed refinement: %q (%v)", refined, err)
+	}
+	if _, err := player.RefineDraft(ctx, "draft", "shorter", nil); !errors.Is(err, replay.ErrFixtureNotFound) {
+		t.Errorf("expected different history to miss, got %v", err)
+	}
+
+	if _, err := replay.NewClient(nil, replay.Config{Mode: replay.ModeRecord, Dir: dir}); err == nil {
+		t.Error("expected record mode without a live service to be rejected")
+	}
+	if _, err := replay.ParseMode("rewind"); err == nil {
+		t.Error("expected an unknown mode to be rejected")
+	}
+}
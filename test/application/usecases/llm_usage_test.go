@@ -0,0 +1,185 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/application/services"
+	"github.com/linkgen-ai/backend/src/application/usecases"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/fakellm"
+)
+
+// memoryLLMUsageRepository keeps usage records in memory and aggregates them like the MongoDB pipeline
+type memoryLLMUsageRepository struct {
+	mu     sync.Mutex
+	usages []*entities.LLMUsage
+}
+
+func (r *memoryLLMUsageRepository) Create(ctx context.Context, usage *entities.LLMUsage) (string, error) {
+	if err := usage.Validate(); err != nil {
+		return "", err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *usage
+	r.usages = append(r.usages, &copied)
+	return "", nil
+}
+
+func (r *memoryLLMUsageRepository) Aggregate(ctx context.Context, filter entities.LLMUsageFilter) ([]*entities.LLMUsageTotals, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	grouped := map[[2]string]*entities.LLMUsageTotals{}
+	var totals []*entities.LLMUsageTotals
+	for _, u := range r.usages {
+		if u.UserID != filter.UserID || u.CreatedAt.Before(filter.From) || !u.CreatedAt.Before(filter.To) {
+			continue
+		}
+		if filter.JobID != "" && u.JobID != filter.JobID {
+			continue
+		}
+		key := [2]string{u.Model, u.PromptName}
+		if grouped[key] == nil {
+			grouped[key] = &entities.LLMUsageTotals{Model: u.Model, PromptName: u.PromptName}
+			totals = append(totals, grouped[key])
+		}
+		grouped[key].Calls++
+		grouped[key].PromptTokens += u.PromptTokens
+		grouped[key].CompletionTokens += u.CompletionTokens
+		grouped[key].TotalLatency += u.Latency
+	}
+	return totals, nil
+}
+
+func (r *memoryLLMUsageRepository) records() []*entities.LLMUsage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*entities.LLMUsage(nil), r.usages...)
+}
+
+// TestLLMUsage_RecordedPerJobAndPrompt validates that a generation records attributed usage end to end
+func TestLLMUsage_RecordedPerJobAndPrompt(t *testing.T) {
+	server := fakellm.NewTestServer(fakellm.Options{Rules: fakellm.DefaultRules()})
+	defer server.Close()
+
+	client, err := llm.NewLLMHTTPClient(server.ClientConfig())
+	if err != nil {
+		t.Fatalf("failed to create LLM client: %v", err)
+	}
+	repo := &memoryLLMUsageRepository{}
+	recorder := services.NewLLMUsageRecorder(repo, nil)
+	client.SetUsageRecorder(recorder)
+
+	// Calls made outside a user's request are not billed to anyone
+	recorder.RecordLLMUsage(context.Background(), &entities.LLMUsage{Model: "fake-model", PromptTokens: 10})
+	if len(repo.records()) != 0 {
+		t.Fatalf("expected unattributed calls to be skipped, got %d records", len(repo.records()))
+	}
+
+	// The worker sets the job; the use case adds the user and prompt
+	ctx := interfaces.WithLLMCallInfo(context.Background(), interfaces.LLMCallInfo{JobID: "job-1"})
+	input := usecases.GenerateDraftsInput{UserID: publishTestUserID, IdeaID: fakeLLMIdeaID}
+	if _, err := newReplayDraftsUseCase(defaultPromptsRepository{}, client).Execute(ctx, input); err != nil {
+		t.Fatalf("failed to generate drafts: %v", err)
+	}
+
+	records := repo.records()
+	if len(records) != 1 {
+		t.Fatalf("expected 1 usage record, got %d", len(records))
+	}
+	usage := records[0]
+	if usage.UserID != publishTestUserID || usage.JobID != "job-1" || usage.PromptName != "profesional" {
+		t.Errorf("unexpected attribution: %+v", usage)
+	}
+	if usage.Provider != llm.ProviderOpenAI || usage.Model != "fake-model" {
+		t.Errorf("unexpected backend: %s/%s", usage.Provider, usage.Model)
+	}
+	if usage.PromptTokens <= 0 || usage.CompletionTokens <= 0 || usage.Latency <= 0 {
+		t.Errorf("expected token counts and latency, got %+v", usage)
+	}
+
+	report, err := usecases.NewGetLLMUsageUseCase(repo, fakeLLMUsers(), nil).Execute(context.Background(), usecases.GetLLMUsageInput{
+		UserID: publishTestUserID,
+		JobID:  "job-1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.Total.Calls != 1 || report.Total.TotalTokens() != usage.TotalTokens() || report.Total.Priced {
+		t.Errorf("expected one unpriced call in the report, got %+v", report.Total)
+	}
+}
+
+// TestGetLLMUsageUseCase_EstimatesCost validates aggregation, the price table and the time range
+func TestGetLLMUsageUseCase_EstimatesCost(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	repo := &memoryLLMUsageRepository{}
+
+	record := func(model, prompt, jobID string, promptTokens, completionTokens int, latency time.Duration, at time.Time) {
+		t.Helper()
+		_, err := repo.Create(ctx, &entities.LLMUsage{
+			UserID: publishTestUserID, JobID: jobID, PromptName: prompt, Model: model,
+			PromptTokens: promptTokens, CompletionTokens: completionTokens, Latency: latency, CreatedAt: at,
+		})
+		if err != nil {
+			t.Fatalf("failed to record usage: %v", err)
+		}
+	}
+	record("gpt-4o-2024-08-06", "profesional", "job-1", 1_000_000, 100_000, time.Second, now.Add(-time.Hour))
+	record("gpt-4o-2024-08-06", "base1", "", 200_000, 0, 3*time.Second, now.Add(-2*time.Hour))
+	record("llama3:8b", "base1", "", 50_000, 50_000, 2*time.Second, now.Add(-time.Hour))
+	record("gpt-4o-2024-08-06", "base1", "", 1_000_000, 0, time.Second, now.Add(-40*24*time.Hour))
+
+	uc := usecases.NewGetLLMUsageUseCase(repo, fakeLLMUsers(), map[string]usecases.ModelPrice{
+		"gpt-4o":      {PromptPerMillion: 2.5, CompletionPerMillion: 10},
+		"gpt-4o-mini": {PromptPerMillion: 0.15, CompletionPerMillion: 0.6},
+	})
+
+	// The default window covers the last 30 days
+	report, err := uc.Execute(ctx, usecases.GetLLMUsageInput{UserID: publishTestUserID})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.Total.Calls != 3 || report.Total.PromptTokens != 1_250_000 || report.Total.AverageLatency != 2*time.Second {
+		t.Errorf("unexpected totals: %+v", report.Total)
+	}
+	// 1.2M prompt tokens at 2.5 plus 100k completion tokens at 10, through the gpt-4o prefix
+	if math.Abs(report.Total.EstimatedCost-4.0) > 1e-9 || report.Total.Priced {
+		t.Errorf("expected a $4 lower bound with an unpriced model, got %+v", report.Total)
+	}
+
+	if len(report.ByModel) != 2 || report.ByModel[0].Model != "gpt-4o-2024-08-06" || !report.ByModel[0].Priced || report.ByModel[1].Priced {
+		t.Errorf("unexpected per-model lines: %+v", report.ByModel)
+	}
+	if len(report.ByPrompt) != 2 || report.ByPrompt[0].PromptName != "profesional" || report.ByPrompt[1].Calls != 2 {
+		t.Errorf("unexpected per-prompt lines: %+v", report.ByPrompt)
+	}
+
+	// A job only sees its own calls
+	report, _ = uc.Execute(ctx, usecases.GetLLMUsageInput{UserID: publishTestUserID, JobID: "job-1"})
+	if report.Total.Calls != 1 || math.Abs(report.Total.EstimatedCost-3.5) > 1e-9 {
+		t.Errorf("unexpected job totals: %+v", report.Total)
+	}
+
+	// Explicit ranges reach older calls
+	report, _ = uc.Execute(ctx, usecases.GetLLMUsageInput{UserID: publishTestUserID, From: now.Add(-60 * 24 * time.Hour), To: now})
+	if report.Total.Calls != 4 {
+		t.Errorf("expected 4 calls in the last 60 days, got %d", report.Total.Calls)
+	}
+
+	_, err = uc.Execute(ctx, usecases.GetLLMUsageInput{UserID: publishTestUserID, From: now, To: now.Add(-time.Hour)})
+	var validationErr *domainErrors.ErrValidation
+	if !errors.As(err, &validationErr) {
+		t.Errorf("expected a validation error for an inverted range, got %v", err)
+	}
+}
@@ -0,0 +1,199 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/application/services"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/config"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/fallback"
+)
+
+// TestLLMClient_UsesUserAPIKey validates that calls made for a user use their own key when they have one
+func TestLLMClient_UsesUserAPIKey(t *testing.T) {
+	var mu sync.Mutex
+	var authHeaders []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		authHeaders = append(authHeaders, r.Header.Get("Authorization"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"hola"},"finish_reason":"stop"}]}`))
+	}))
+	defer server.Close()
+
+	store, err := config.NewSecretStoreWithKey("a-test-passphrase-that-is-long-enough")
+	if err != nil {
+		t.Fatalf("failed to create secret store: %v", err)
+	}
+	encrypted, err := store.EncryptSecret("sk-user")
+	if err != nil {
+		t.Fatalf("failed to encrypt key: %v", err)
+	}
+
+	const byoUserID, otherUserID, brokenUserID = "user-with-key", "user-without-key", "user-with-broken-key"
+	users := &MockUserRepository{
+		FindByIDFunc: func(ctx context.Context, userID string) (*entities.User, error) {
+			keys := map[string]string{}
+			switch userID {
+			case byoUserID:
+				keys[llm.ProviderOpenAI] = encrypted
+			case otherUserID:
+				keys[llm.ProviderAnthropic] = encrypted
+			case brokenUserID:
+				keys[llm.ProviderOpenAI] = "not-a-ciphertext"
+			}
+			return &entities.User{ID: userID, APIKeys: keys}, nil
+		},
+	}
+
+	client, err := llm.NewLLMHTTPClient(llm.Config{BaseURL: server.URL, Timeout: 5 * time.Second, APIKey: "sk-platform"})
+	if err != nil {
+		t.Fatalf("failed to create LLM client: %v", err)
+	}
+	client.SetKeyResolver(services.NewUserLLMKeyResolver(users, store, nil))
+
+	var sources []string
+	observe := func(provider, source string) { sources = append(sources, provider+"/"+source) }
+	call := func(userID string) error {
+		ctx := interfaces.WithLLMKeyObserver(context.Background(), observe)
+		ctx = interfaces.WithLLMCallInfo(ctx, interfaces.LLMCallInfo{UserID: userID})
+		_, err := client.SendRequest(ctx, "prompt")
+		return err
+	}
+
+	for _, userID := range []string{byoUserID, otherUserID, ""} {
+		if err := call(userID); err != nil {
+			t.Fatalf("unexpected error for %q: %v", userID, err)
+		}
+	}
+
+	want := []string{"Bearer sk-user", "Bearer sk-platform", "Bearer sk-platform"}
+	for i, header := range want {
+		if authHeaders[i] != header {
+			t.Errorf("call %d: expected %q, got %q", i, header, authHeaders[i])
+		}
+	}
+	wantSources := []string{"openai/user", "openai/platform", "openai/platform"}
+	for i, source := range wantSources {
+		if sources[i] != source {
+			t.Errorf("call %d: expected key source %q, got %q", i, source, sources[i])
+		}
+	}
+
+	// A key that can't be decrypted is ignored and the call uses the platform key
+	if err := call(brokenUserID); err != nil {
+		t.Fatalf("unexpected error for an undecryptable key: %v", err)
+	}
+	if authHeaders[3] != "Bearer sk-platform" {
+		t.Errorf("expected the platform key for an undecryptable key, got %q", authHeaders[3])
+	}
+	if sources[3] != "openai/platform" {
+		t.Errorf("expected key source %q for an undecryptable key, got %q", "openai/platform", sources[3])
+	}
+}
+
+// TestLLMClient_RejectedUserAPIKey validates that a user key the provider rejects falls back to
+// the platform key without counting against the backend's circuit breaker
+func TestLLMClient_RejectedUserAPIKey(t *testing.T) {
+	var mu sync.Mutex
+	var authHeaders []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		mu.Lock()
+		authHeaders = append(authHeaders, header)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if header != "Bearer sk-platform" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":{"message":"Incorrect API key provided","type":"invalid_request_error","code":"invalid_api_key"}}`))
+			return
+		}
+		w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"hola"},"finish_reason":"stop"}]}`))
+	}))
+	defer server.Close()
+
+	store, err := config.NewSecretStoreWithKey("a-test-passphrase-that-is-long-enough")
+	if err != nil {
+		t.Fatalf("failed to create secret store: %v", err)
+	}
+	encrypted, err := store.EncryptSecret("sk-revoked")
+	if err != nil {
+		t.Fatalf("failed to encrypt key: %v", err)
+	}
+
+	const revokedUserID, unknownUserID = "user-with-revoked-key", "user-lookup-fails"
+	users := &MockUserRepository{
+		FindByIDFunc: func(ctx context.Context, userID string) (*entities.User, error) {
+			if userID == unknownUserID {
+				return nil, errors.New("connection reset by peer")
+			}
+			return &entities.User{ID: userID, APIKeys: map[string]string{llm.ProviderOpenAI: encrypted}}, nil
+		},
+	}
+
+	httpClient, err := llm.NewLLMHTTPClient(llm.Config{BaseURL: server.URL, Timeout: 5 * time.Second, APIKey: "sk-platform"})
+	if err != nil {
+		t.Fatalf("failed to create LLM client: %v", err)
+	}
+	httpClient.SetKeyResolver(services.NewUserLLMKeyResolver(users, store, nil))
+
+	client, err := fallback.NewClient([]fallback.Backend{{Name: "openai", Service: httpClient}}, fallback.Config{
+		Breaker: fallback.BreakerConfig{FailureThreshold: 1, Cooldown: time.Minute},
+	})
+	if err != nil {
+		t.Fatalf("failed to create fallback client: %v", err)
+	}
+
+	var sources []string
+	observe := func(provider, source string) { sources = append(sources, provider+"/"+source) }
+	call := func(userID string) error {
+		ctx := interfaces.WithLLMKeyObserver(context.Background(), observe)
+		ctx = interfaces.WithLLMCallInfo(ctx, interfaces.LLMCallInfo{UserID: userID})
+		_, err := client.SendRequest(ctx, "prompt")
+		return err
+	}
+
+	// The rejected user key is retried once with the platform key
+	for i := 0; i < 2; i++ {
+		if err := call(revokedUserID); err != nil {
+			t.Fatalf("call %d: unexpected error for a revoked key: %v", i, err)
+		}
+	}
+
+	want := []string{"Bearer sk-revoked", "Bearer sk-platform", "Bearer sk-revoked", "Bearer sk-platform"}
+	if len(authHeaders) != len(want) {
+		t.Fatalf("expected %d requests, got %d: %v", len(want), len(authHeaders), authHeaders)
+	}
+	for i, header := range want {
+		if authHeaders[i] != header {
+			t.Errorf("request %d: expected %q, got %q", i, header, authHeaders[i])
+		}
+	}
+	if last := sources[len(sources)-1]; last != "openai/platform" {
+		t.Errorf("expected key source %q after the fallback, got %q", "openai/platform", last)
+	}
+
+	status := client.Status()[0]
+	if status.State != fallback.StateClosed || status.ConsecutiveFailures != 0 {
+		t.Errorf("expected the breaker to stay closed, got %s with %d failures", status.State, status.ConsecutiveFailures)
+	}
+
+	// A user that can't be looked up gets the platform key instead of a failed call
+	authHeaders = nil
+	if err := call(unknownUserID); err != nil {
+		t.Fatalf("unexpected error when the user lookup fails: %v", err)
+	}
+	if len(authHeaders) != 1 || authHeaders[0] != "Bearer sk-platform" {
+		t.Errorf("expected a single call with the platform key, got %v", authHeaders)
+	}
+}
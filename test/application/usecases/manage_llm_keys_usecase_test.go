@@ -0,0 +1,144 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/linkgen-ai/backend/src/application/usecases"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/infrastructure/config"
+)
+
+// llmKeysFixture stores a user's keys in memory and records the updates
+type llmKeysFixture struct {
+	store   *config.SecretStore
+	apiKeys map[string]string
+	updates []map[string]interface{}
+	useCase *usecases.ManageLLMKeysUseCase
+}
+
+func newLLMKeysFixture(t *testing.T, apiKeys map[string]string) *llmKeysFixture {
+	t.Helper()
+
+	store, err := config.NewSecretStoreWithKey("a-test-passphrase-that-is-long-enough")
+	if err != nil {
+		t.Fatalf("failed to create secret store: %v", err)
+	}
+
+	f := &llmKeysFixture{store: store, apiKeys: apiKeys}
+	users := &MockUserRepository{
+		FindByIDFunc: func(ctx context.Context, userID string) (*entities.User, error) {
+			return &entities.User{ID: userID, APIKeys: f.apiKeys}, nil
+		},
+		UpdateFunc: func(ctx context.Context, userID string, updates map[string]interface{}) error {
+			f.updates = append(f.updates, updates)
+			return nil
+		},
+	}
+	f.useCase = usecases.NewManageLLMKeysUseCase(users, store, []string{"openai", "anthropic"})
+	return f
+}
+
+// TestManageLLMKeysUseCase_Set validates that keys are stored encrypted
+func TestManageLLMKeysUseCase_Set(t *testing.T) {
+	t.Run("stores the key encrypted", func(t *testing.T) {
+		f := newLLMKeysFixture(t, map[string]string{})
+
+		err := f.useCase.Set(context.Background(), usecases.SetLLMKeyInput{UserID: "user-1", Provider: " OpenAI ", APIKey: "sk-user"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(f.updates) != 1 {
+			t.Fatalf("expected 1 update, got %d", len(f.updates))
+		}
+
+		stored, ok := f.updates[0]["api_keys.openai"].(string)
+		if !ok {
+			t.Fatalf("expected the key under api_keys.openai, got %v", f.updates[0])
+		}
+		if strings.Contains(stored, "sk-user") {
+			t.Errorf("expected the key to be encrypted, got %q", stored)
+		}
+		decrypted, err := f.store.DecryptSecret(stored)
+		if err != nil {
+			t.Fatalf("failed to decrypt stored key: %v", err)
+		}
+		if decrypted != "sk-user" {
+			t.Errorf("expected the stored key to decrypt to %q, got %q", "sk-user", decrypted)
+		}
+	})
+
+	t.Run("user without keys", func(t *testing.T) {
+		f := newLLMKeysFixture(t, nil)
+
+		err := f.useCase.Set(context.Background(), usecases.SetLLMKeyInput{UserID: "user-1", Provider: "anthropic", APIKey: "sk-ant"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		keys, ok := f.updates[0]["api_keys"].(map[string]string)
+		if !ok {
+			t.Fatalf("expected the whole api_keys map to be set, got %v", f.updates[0])
+		}
+		if decrypted, err := f.store.DecryptSecret(keys["anthropic"]); err != nil || decrypted != "sk-ant" {
+			t.Errorf("expected the stored key to decrypt to %q, got %q (%v)", "sk-ant", decrypted, err)
+		}
+	})
+
+	invalid := []struct {
+		name  string
+		input usecases.SetLLMKeyInput
+	}{
+		{"unsupported provider", usecases.SetLLMKeyInput{UserID: "user-1", Provider: "mistral", APIKey: "sk-user"}},
+		{"field path in provider", usecases.SetLLMKeyInput{UserID: "user-1", Provider: "openai.extra", APIKey: "sk-user"}},
+		{"empty key", usecases.SetLLMKeyInput{UserID: "user-1", Provider: "openai", APIKey: "  "}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newLLMKeysFixture(t, map[string]string{})
+
+			err := f.useCase.Set(context.Background(), tt.input)
+			var validationErr *domainErrors.ErrValidation
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("expected a validation error, got %v", err)
+			}
+			if len(f.updates) != 0 {
+				t.Errorf("expected no update, got %v", f.updates)
+			}
+		})
+	}
+}
+
+// TestManageLLMKeysUseCase_Delete validates that deleting clears the stored key
+func TestManageLLMKeysUseCase_Delete(t *testing.T) {
+	f := newLLMKeysFixture(t, map[string]string{"openai": "ciphertext"})
+
+	if err := f.useCase.Delete(context.Background(), "user-1", "openai"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := f.useCase.Delete(context.Background(), "user-1", "anthropic"); err != nil {
+		t.Fatalf("unexpected error deleting a missing key: %v", err)
+	}
+
+	want := []map[string]interface{}{{"api_keys.openai": ""}}
+	if !reflect.DeepEqual(f.updates, want) {
+		t.Errorf("expected updates %v, got %v", want, f.updates)
+	}
+}
+
+// TestManageLLMKeysUseCase_List validates that only providers with a key are listed
+func TestManageLLMKeysUseCase_List(t *testing.T) {
+	f := newLLMKeysFixture(t, map[string]string{"openai": "ciphertext", "anthropic": "", "legacy": "ciphertext"})
+
+	providers, err := f.useCase.List(context.Background(), "user-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(providers, []string{"openai"}) {
+		t.Errorf("expected [openai], got %v", providers)
+	}
+}
@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"context"
+	"time"
 
 	"github.com/linkgen-ai/backend/src/domain/entities"
 	"github.com/linkgen-ai/backend/src/domain/interfaces"
@@ -9,7 +10,14 @@ import (
 
 // MockUserRepository is a mock implementation of interfaces.UserRepository
 type MockUserRepository struct {
-	FindByIDFunc func(ctx context.Context, userID string) (*entities.User, error)
+	FindByIDFunc                  func(ctx context.Context, userID string) (*entities.User, error)
+	UpdateFunc                    func(ctx context.Context, userID string, updates map[string]interface{}) error
+	UpdateLinkedInTokenFunc       func(ctx context.Context, userID string, token string) error
+	UpdateLinkedInCredentialsFunc func(ctx context.Context, user *entities.User) error
+}
+
+func (m *MockUserRepository) FindWithExpiringLinkedInToken(ctx context.Context, before time.Time, limit int) ([]*entities.User, error) {
+	return nil, nil
 }
 
 func (m *MockUserRepository) FindByID(ctx context.Context, userID string) (*entities.User, error) {
@@ -23,7 +31,28 @@ func (m *MockUserRepository) Create(ctx context.Context, user *entities.User) (s
 	return "", nil
 }
 
+func (m *MockUserRepository) FindByEmail(ctx context.Context, email string) (*entities.User, error) {
+	return nil, nil
+}
+
 func (m *MockUserRepository) Update(ctx context.Context, userID string, updates map[string]interface{}) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, userID, updates)
+	}
+	return nil
+}
+
+func (m *MockUserRepository) UpdateLinkedInToken(ctx context.Context, userID string, token string) error {
+	if m.UpdateLinkedInTokenFunc != nil {
+		return m.UpdateLinkedInTokenFunc(ctx, userID, token)
+	}
+	return nil
+}
+
+func (m *MockUserRepository) UpdateLinkedInCredentials(ctx context.Context, user *entities.User) error {
+	if m.UpdateLinkedInCredentialsFunc != nil {
+		return m.UpdateLinkedInCredentialsFunc(ctx, user)
+	}
 	return nil
 }
 
@@ -41,10 +70,14 @@ func (m *MockUserRepository) List(ctx context.Context, offset, limit int) ([]*en
 
 // MockIdeasRepository is a mock implementation of interfaces.IdeasRepository
 type MockIdeasRepository struct {
-	CreateBatchFunc   func(ctx context.Context, ideas []*entities.Idea) error
-	ListByUserIDFunc  func(ctx context.Context, userID string, topicID string, limit int) ([]*entities.Idea, error)
-	CountByUserIDFunc func(ctx context.Context, userID string) (int64, error)
-	ClearByUserIDFunc func(ctx context.Context, userID string) error
+	CreateBatchFunc          func(ctx context.Context, ideas []*entities.Idea) error
+	FindByIDFunc             func(ctx context.Context, ideaID string) (*entities.Idea, error)
+	UpdateFunc               func(ctx context.Context, ideaID string, updates map[string]interface{}) error
+	ListByUserIDFunc         func(ctx context.Context, userID string, topicID string, limit int) ([]*entities.Idea, error)
+	CountByUserIDFunc        func(ctx context.Context, userID string) (int64, error)
+	CountUnusedByTopicIDFunc func(ctx context.Context, topicID string) (int64, error)
+	ClearByUserIDFunc        func(ctx context.Context, userID string) error
+	DeleteByTopicIDFunc      func(ctx context.Context, topicID string) error
 }
 
 func (m *MockIdeasRepository) CreateBatch(ctx context.Context, ideas []*entities.Idea) error {
@@ -54,6 +87,20 @@ func (m *MockIdeasRepository) CreateBatch(ctx context.Context, ideas []*entities
 	return nil
 }
 
+func (m *MockIdeasRepository) FindByID(ctx context.Context, ideaID string) (*entities.Idea, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, ideaID)
+	}
+	return nil, nil
+}
+
+func (m *MockIdeasRepository) Update(ctx context.Context, ideaID string, updates map[string]interface{}) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, ideaID, updates)
+	}
+	return nil
+}
+
 func (m *MockIdeasRepository) ListByUserID(ctx context.Context, userID string, topicID string, limit int) ([]*entities.Idea, error) {
 	if m.ListByUserIDFunc != nil {
 		return m.ListByUserIDFunc(ctx, userID, topicID, limit)
@@ -68,6 +115,13 @@ func (m *MockIdeasRepository) CountByUserID(ctx context.Context, userID string)
 	return 0, nil
 }
 
+func (m *MockIdeasRepository) CountUnusedByTopicID(ctx context.Context, topicID string) (int64, error) {
+	if m.CountUnusedByTopicIDFunc != nil {
+		return m.CountUnusedByTopicIDFunc(ctx, topicID)
+	}
+	return 0, nil
+}
+
 func (m *MockIdeasRepository) ClearByUserID(ctx context.Context, userID string) error {
 	if m.ClearByUserIDFunc != nil {
 		return m.ClearByUserIDFunc(ctx, userID)
@@ -75,6 +129,13 @@ func (m *MockIdeasRepository) ClearByUserID(ctx context.Context, userID string)
 	return nil
 }
 
+func (m *MockIdeasRepository) DeleteByTopicID(ctx context.Context, topicID string) error {
+	if m.DeleteByTopicIDFunc != nil {
+		return m.DeleteByTopicIDFunc(ctx, topicID)
+	}
+	return nil
+}
+
 // MockDraftRepository is a mock implementation of interfaces.DraftRepository
 type MockDraftRepository struct {
 	CreateFunc                 func(ctx context.Context, draft *entities.Draft) (string, error)
@@ -85,6 +146,9 @@ type MockDraftRepository struct {
 	UpdateStatusFunc           func(ctx context.Context, draftID string, status entities.DraftStatus) error
 	AppendRefinementFunc       func(ctx context.Context, draftID string, entry entities.RefinementEntry) error
 	FindReadyForPublishingFunc func(ctx context.Context, userID string) ([]*entities.Draft, error)
+	FindDueForPublishingFunc   func(ctx context.Context, now time.Time, limit int) ([]*entities.Draft, error)
+	ClaimForPublishingFunc     func(ctx context.Context, draftID string, now time.Time, leaseUntil time.Time) (bool, error)
+	UpdateIfStatusFunc         func(ctx context.Context, draftID string, expected entities.DraftStatus, updates map[string]interface{}) (bool, error)
 }
 
 func (m *MockDraftRepository) Create(ctx context.Context, draft *entities.Draft) (string, error) {
@@ -108,6 +172,17 @@ func (m *MockDraftRepository) Update(ctx context.Context, draftID string, update
 	return nil
 }
 
+// UpdateIfStatus falls back to UpdateFunc, so tests that only record updates keep working
+func (m *MockDraftRepository) UpdateIfStatus(ctx context.Context, draftID string, expected entities.DraftStatus, updates map[string]interface{}) (bool, error) {
+	if m.UpdateIfStatusFunc != nil {
+		return m.UpdateIfStatusFunc(ctx, draftID, expected, updates)
+	}
+	if err := m.Update(ctx, draftID, updates); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func (m *MockDraftRepository) Delete(ctx context.Context, draftID string) error {
 	if m.DeleteFunc != nil {
 		return m.DeleteFunc(ctx, draftID)
@@ -143,12 +218,27 @@ func (m *MockDraftRepository) FindReadyForPublishing(ctx context.Context, userID
 	return nil, nil
 }
 
+func (m *MockDraftRepository) FindDueForPublishing(ctx context.Context, now time.Time, limit int) ([]*entities.Draft, error) {
+	if m.FindDueForPublishingFunc != nil {
+		return m.FindDueForPublishingFunc(ctx, now, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockDraftRepository) ClaimForPublishing(ctx context.Context, draftID string, now time.Time, leaseUntil time.Time) (bool, error) {
+	if m.ClaimForPublishingFunc != nil {
+		return m.ClaimForPublishingFunc(ctx, draftID, now, leaseUntil)
+	}
+	return false, nil
+}
+
 // MockLLMService is a mock implementation of interfaces.LLMService
 type MockLLMService struct {
-	SendRequestFunc    func(ctx context.Context, prompt string) (string, error)
-	GenerateIdeasFunc  func(ctx context.Context, topic string, count int) ([]string, error)
-	GenerateDraftsFunc func(ctx context.Context, idea string, userContext string) (interfaces.DraftSet, error)
-	RefineDraftFunc    func(ctx context.Context, draft string, userPrompt string, history []string) (string, error)
+	SendRequestFunc       func(ctx context.Context, prompt string) (string, error)
+	SendPromptRequestFunc func(ctx context.Context, req interfaces.PromptRequest) (string, error)
+	GenerateIdeasFunc     func(ctx context.Context, topic string, count int) ([]string, error)
+	GenerateDraftsFunc    func(ctx context.Context, idea string, userContext string) (interfaces.DraftSet, error)
+	RefineDraftFunc       func(ctx context.Context, draft string, userPrompt string, history []string) (string, error)
 }
 
 func (m *MockLLMService) SendRequest(ctx context.Context, prompt string) (string, error) {
@@ -158,6 +248,14 @@ func (m *MockLLMService) SendRequest(ctx context.Context, prompt string) (string
 	return "", nil
 }
 
+// SendPromptRequest falls back to SendRequestFunc, so tests that only look at the prompt keep working
+func (m *MockLLMService) SendPromptRequest(ctx context.Context, req interfaces.PromptRequest) (string, error) {
+	if m.SendPromptRequestFunc != nil {
+		return m.SendPromptRequestFunc(ctx, req)
+	}
+	return m.SendRequest(ctx, req.Prompt)
+}
+
 func (m *MockLLMService) GenerateIdeas(ctx context.Context, topic string, count int) ([]string, error) {
 	if m.GenerateIdeasFunc != nil {
 		return m.GenerateIdeasFunc(ctx, topic, count)
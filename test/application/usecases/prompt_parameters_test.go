@@ -0,0 +1,104 @@
+package usecases
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/linkgen-ai/backend/src/application/usecases"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/fakellm"
+)
+
+// parametersPromptsRepository serves a drafts prompt with a system message and model parameters
+type parametersPromptsRepository struct {
+	defaultPromptsRepository
+	parameters entities.PromptParameters
+}
+
+func (r parametersPromptsRepository) FindByName(ctx context.Context, userID string, name string) (*entities.Prompt, error) {
+	return &entities.Prompt{
+		ID:             "custom",
+		UserID:         userID,
+		Name:           name,
+		Type:           entities.PromptTypeDrafts,
+		SystemMessage:  "Eres un redactor técnico.",
+		PromptTemplate: `Escribe 5 posts y 1 artículo sobre: {content}. Responde en JSON: {"posts": [], "articles": []}`,
+		Parameters:     r.parameters,
+		Active:         true,
+	}, nil
+}
+
+// TestGenerateDraftsUseCase_SendsPromptParameters validates that prompt parameters reach the chat request
+func TestGenerateDraftsUseCase_SendsPromptParameters(t *testing.T) {
+	zero := 0.0
+	tests := []struct {
+		name              string
+		parameters        entities.PromptParameters
+		ignorePromptModel bool
+		wantTemperature   float64
+		wantMaxTokens     int
+		wantModel         string
+		wantStop          []string
+	}{
+		{
+			name:            "client defaults",
+			wantTemperature: llm.DefaultTemperature,
+			wantMaxTokens:   llm.DefaultMaxTokens,
+			wantModel:       "fake-model",
+		},
+		{
+			name:            "prompt overrides",
+			parameters:      entities.PromptParameters{Temperature: &zero, MaxTokens: 1200, Model: "fake-model-large", StopSequences: []string{"###"}},
+			wantTemperature: 0,
+			wantMaxTokens:   1200,
+			wantModel:       "fake-model-large",
+			wantStop:        []string{"###"},
+		},
+		{
+			name:              "model override ignored",
+			parameters:        entities.PromptParameters{Model: "fake-model-large"},
+			ignorePromptModel: true,
+			wantTemperature:   llm.DefaultTemperature,
+			wantMaxTokens:     llm.DefaultMaxTokens,
+			wantModel:         "fake-model",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := fakellm.NewTestServer(fakellm.Options{Rules: fakellm.DefaultRules()})
+			defer server.Close()
+
+			config := server.ClientConfig()
+			config.IgnorePromptModel = tt.ignorePromptModel
+			client, err := llm.NewLLMHTTPClient(config)
+			if err != nil {
+				t.Fatalf("failed to create LLM client: %v", err)
+			}
+
+			input := usecases.GenerateDraftsInput{UserID: publishTestUserID, IdeaID: fakeLLMIdeaID}
+			prompts := parametersPromptsRepository{parameters: tt.parameters}
+			if _, err := newReplayDraftsUseCase(prompts, client).Execute(context.Background(), input); err != nil {
+				t.Fatalf("failed to generate drafts: %v", err)
+			}
+
+			requests := server.Requests()
+			if len(requests) != 1 {
+				t.Fatalf("expected 1 request, got %d", len(requests))
+			}
+			req := requests[0]
+			if len(req.Messages) != 2 || req.Messages[0].Role != "system" || req.Messages[0].Content != "Eres un redactor técnico." || req.Messages[1].Role != "user" {
+				t.Errorf("expected a system message before the prompt, got %+v", req.Messages)
+			}
+			if req.Temperature == nil || *req.Temperature != tt.wantTemperature {
+				t.Errorf("expected temperature %v, got %v", tt.wantTemperature, req.Temperature)
+			}
+			if req.MaxTokens != tt.wantMaxTokens || req.Model != tt.wantModel || !slices.Equal(req.Stop, tt.wantStop) {
+				t.Errorf("expected %d tokens from %s stopping at %q, got %d from %s at %q",
+					tt.wantMaxTokens, tt.wantModel, tt.wantStop, req.MaxTokens, req.Model, req.Stop)
+			}
+		})
+	}
+}
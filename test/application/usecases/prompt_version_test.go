@@ -0,0 +1,58 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/linkgen-ai/backend/src/application/usecases"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/fakellm"
+)
+
+// versionedPromptsRepository serves version 4 of a custom drafts prompt
+type versionedPromptsRepository struct {
+	parametersPromptsRepository
+}
+
+func (r versionedPromptsRepository) FindByName(ctx context.Context, userID string, name string) (*entities.Prompt, error) {
+	prompt, err := r.parametersPromptsRepository.FindByName(ctx, userID, name)
+	if err != nil {
+		return nil, err
+	}
+	prompt.Version = 4
+	return prompt, nil
+}
+
+// TestGenerateDraftsUseCase_StampsPromptVersion validates that drafts and jobs learn which prompt version generated them
+func TestGenerateDraftsUseCase_StampsPromptVersion(t *testing.T) {
+	server := fakellm.NewTestServer(fakellm.Options{Rules: fakellm.DefaultRules()})
+	defer server.Close()
+
+	client, err := llm.NewLLMHTTPClient(server.ClientConfig())
+	if err != nil {
+		t.Fatalf("failed to create LLM client: %v", err)
+	}
+
+	var observedID string
+	var observedVersion int
+	ctx := interfaces.WithPromptVersionObserver(context.Background(), func(promptID string, version int) {
+		observedID, observedVersion = promptID, version
+	})
+
+	input := usecases.GenerateDraftsInput{UserID: publishTestUserID, IdeaID: fakeLLMIdeaID}
+	drafts, err := newReplayDraftsUseCase(versionedPromptsRepository{}, client).Execute(ctx, input)
+	if err != nil {
+		t.Fatalf("failed to generate drafts: %v", err)
+	}
+
+	if observedID != "custom" || observedVersion != 4 {
+		t.Errorf("expected prompt custom v4 to be observed, got %q v%d", observedID, observedVersion)
+	}
+	for _, draft := range drafts {
+		if draft.Metadata[usecases.DraftMetadataPromptID] != "custom" || draft.Metadata[usecases.DraftMetadataPromptVersion] != 4 {
+			t.Errorf("expected draft metadata to record prompt custom v4, got %v", draft.Metadata)
+		}
+	}
+}
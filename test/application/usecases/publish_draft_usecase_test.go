@@ -3,631 +3,893 @@ package usecases
 import (
 	"context"
 	"errors"
+	"strings"
+	"sync"
 	"testing"
 	"time"
+
+	"github.com/linkgen-ai/backend/src/application/usecases"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/database"
+)
+
+const (
+	publishTestDraftID = "675337baf901e2d790aabb01"
+	publishTestUserID  = "675337baf901e2d790aabb02"
+	publishTestIdeaID  = "675337baf901e2d790aabb03"
 )
 
+// publishFixture bundles the mocks used by PublishDraftUseCase tests
+type publishFixture struct {
+	draft        *entities.Draft
+	user         *entities.User
+	idea         *entities.Idea
+	draftRepo    *MockDraftRepository
+	userRepo     *MockUserRepository
+	ideasRepo    *MockIdeasRepository
+	linkedIn     *MockLinkedInService
+	auth         *fakeLinkedInAuth
+	storedStatus entities.DraftStatus
+	draftUpdates []map[string]interface{}
+	ideaUpdates  []map[string]interface{}
+}
+
+func newPublishFixture(draftType entities.DraftType) *publishFixture {
+	now := time.Now()
+	ideaID := publishTestIdeaID
+
+	f := &publishFixture{
+		draft: &entities.Draft{
+			ID:        publishTestDraftID,
+			UserID:    publishTestUserID,
+			IdeaID:    &ideaID,
+			Type:      draftType,
+			Content:   "Clean Architecture keeps business rules independent of frameworks.",
+			Status:    entities.DraftStatusDraft,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		user: &entities.User{
+			ID:            publishTestUserID,
+			Email:         "publisher@example.com",
+			LinkedInToken: "valid-token",
+			Active:        true,
+		},
+		idea: &entities.Idea{
+			ID:      publishTestIdeaID,
+			UserID:  publishTestUserID,
+			Content: "Clean Architecture in Go",
+		},
+	}
+
+	if draftType == entities.DraftTypeArticle {
+		f.draft.Title = "Clean Architecture in Go"
+		f.draft.Content = strings.Repeat("Clean Architecture keeps business rules independent. ", 4)
+	}
+
+	f.draftRepo = &MockDraftRepository{
+		FindByIDFunc: func(ctx context.Context, draftID string) (*entities.Draft, error) {
+			if draftID != f.draft.ID {
+				return nil, database.ErrEntityNotFound
+			}
+			return f.draft, nil
+		},
+		UpdateIfStatusFunc: func(ctx context.Context, draftID string, expected entities.DraftStatus, updates map[string]interface{}) (bool, error) {
+			return f.updateIfStatus(expected, updates), nil
+		},
+	}
+	f.userRepo = &MockUserRepository{
+		FindByIDFunc: func(ctx context.Context, userID string) (*entities.User, error) {
+			if userID != f.user.ID {
+				return nil, nil
+			}
+			return f.user, nil
+		},
+	}
+	f.ideasRepo = &MockIdeasRepository{
+		FindByIDFunc: func(ctx context.Context, ideaID string) (*entities.Idea, error) {
+			return f.idea, nil
+		},
+		UpdateFunc: func(ctx context.Context, ideaID string, updates map[string]interface{}) error {
+			f.ideaUpdates = append(f.ideaUpdates, updates)
+			return nil
+		},
+	}
+	f.linkedIn = &MockLinkedInService{
+		PublishPostFunc: func(ctx context.Context, content string, accessToken string) (*interfaces.LinkedInPostResponse, error) {
+			return &interfaces.LinkedInPostResponse{ID: "urn:li:share:12345"}, nil
+		},
+		PublishArticleFunc: func(ctx context.Context, title string, content string, accessToken string) (*interfaces.LinkedInPostResponse, error) {
+			return &interfaces.LinkedInPostResponse{ID: "urn:li:article:67890"}, nil
+		},
+	}
+
+	return f
+}
+
+// updateIfStatus applies a conditional update to the stored status. The claim of the
+// draft is not recorded in draftUpdates, only what the publish results in.
+func (f *publishFixture) updateIfStatus(expected entities.DraftStatus, updates map[string]interface{}) bool {
+	if expected != f.storedStatus {
+		return false
+	}
+
+	f.storedStatus = updates["status"].(entities.DraftStatus)
+	if f.storedStatus != entities.DraftStatusPublishing {
+		f.draftUpdates = append(f.draftUpdates, updates)
+	}
+	return true
+}
+
+func (f *publishFixture) useCase() *usecases.PublishDraftUseCase {
+	var auth interfaces.LinkedInAuthService
+	if f.auth != nil {
+		auth = f.auth
+	}
+	return usecases.NewPublishDraftUseCase(f.draftRepo, f.userRepo, f.ideasRepo, f.linkedIn, auth)
+}
+
+func (f *publishFixture) execute(ctx context.Context) (*entities.Draft, error) {
+	if f.storedStatus == "" {
+		f.storedStatus = f.draft.Status
+	}
+	return f.useCase().Execute(ctx, usecases.PublishDraftInput{
+		DraftID: f.draft.ID,
+		UserID:  f.user.ID,
+	})
+}
+
+func linkedInFailure(reason domainErrors.LinkedInErrorReason, status int) error {
+	return domainErrors.NewLinkedInAPIError("publish_post", reason, status, "", nil)
+}
+
 // TestPublishDraftUseCase_Success validates successful draft publishing flow
-// This test will FAIL until PublishDraftUseCase is implemented
 func TestPublishDraftUseCase_Success(t *testing.T) {
 	tests := []struct {
-		name             string
-		draftID          string
-		userID           string
-		draftType        string
-		linkedInToken    string
-		tokenExpiresAt   time.Time
-		expectedPostID   string
-		wantErr          bool
+		name           string
+		draftType      entities.DraftType
+		expectedPostID string
 	}{
 		{
 			name:           "publish post draft to LinkedIn",
-			draftID:        "draft123",
-			userID:         "user456",
-			draftType:      "POST",
-			linkedInToken:  "valid-token",
-			tokenExpiresAt: time.Now().Add(24 * time.Hour),
+			draftType:      entities.DraftTypePost,
 			expectedPostID: "urn:li:share:12345",
-			wantErr:        false,
 		},
 		{
 			name:           "publish article draft to LinkedIn",
-			draftID:        "draft789",
-			userID:         "user101",
-			draftType:      "ARTICLE",
-			linkedInToken:  "valid-token",
-			tokenExpiresAt: time.Now().Add(48 * time.Hour),
+			draftType:      entities.DraftTypeArticle,
 			expectedPostID: "urn:li:article:67890",
-			wantErr:        false,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Will fail: PublishDraftUseCase doesn't exist yet
-			t.Fatal("PublishDraftUseCase not implemented yet - TDD Red phase")
+			f := newPublishFixture(tt.draftType)
+
+			draft, err := f.execute(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if draft.Status != entities.DraftStatusPublished {
+				t.Errorf("expected status PUBLISHED, got %s", draft.Status)
+			}
+			if draft.LinkedInPostID != tt.expectedPostID {
+				t.Errorf("expected post ID %s, got %s", tt.expectedPostID, draft.LinkedInPostID)
+			}
+			if draft.PublishedAt == nil {
+				t.Error("expected PublishedAt to be set")
+			}
 		})
 	}
 }
 
 // TestPublishDraftUseCase_ValidationErrors validates input validation
-// This test will FAIL until input validation is implemented
 func TestPublishDraftUseCase_ValidationErrors(t *testing.T) {
 	tests := []struct {
 		name    string
 		draftID string
-		wantErr bool
+		userID  string
 		errMsg  string
 	}{
 		{
 			name:    "error on empty draft ID",
 			draftID: "",
-			wantErr: true,
+			userID:  publishTestUserID,
 			errMsg:  "draft ID cannot be empty",
 		},
 		{
 			name:    "error on whitespace-only draft ID",
 			draftID: "   \n\t  ",
-			wantErr: true,
+			userID:  publishTestUserID,
 			errMsg:  "draft ID cannot be empty",
 		},
+		{
+			name:    "error on empty user ID",
+			draftID: publishTestDraftID,
+			userID:  "",
+			errMsg:  "user ID cannot be empty",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Will fail: Validation logic doesn't exist yet
-			t.Fatal("PublishDraftUseCase validation not implemented yet - TDD Red phase")
+			f := newPublishFixture(entities.DraftTypePost)
+
+			_, err := f.useCase().Execute(context.Background(), usecases.PublishDraftInput{
+				DraftID: tt.draftID,
+				UserID:  tt.userID,
+			})
+
+			var validationErr *domainErrors.ErrValidation
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("expected validation error, got %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("expected error containing %q, got %q", tt.errMsg, err.Error())
+			}
 		})
 	}
 }
 
 // TestPublishDraftUseCase_DraftNotFound validates draft existence check
-// This test will FAIL until draft repository integration is implemented
 func TestPublishDraftUseCase_DraftNotFound(t *testing.T) {
-	ctx := context.Background()
-	_ = ctx
-
 	tests := []struct {
 		name    string
-		draftID string
-		wantErr bool
+		repoErr error
 		errMsg  string
 	}{
 		{
 			name:    "error when draft does not exist",
-			draftID: "nonexistent-draft",
-			wantErr: true,
+			repoErr: database.ErrEntityNotFound,
 			errMsg:  "draft not found",
 		},
 		{
 			name:    "error when draft ID is invalid",
-			draftID: "invalid-id-format",
-			wantErr: true,
+			repoErr: database.ErrInvalidID,
 			errMsg:  "invalid draft ID",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Will fail: Draft repository integration doesn't exist yet
-			t.Fatal("PublishDraftUseCase draft validation not implemented yet - TDD Red phase")
+			f := newPublishFixture(entities.DraftTypePost)
+			f.draftRepo.FindByIDFunc = func(ctx context.Context, draftID string) (*entities.Draft, error) {
+				return nil, tt.repoErr
+			}
+
+			_, err := f.execute(context.Background())
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Fatalf("expected error containing %q, got %v", tt.errMsg, err)
+			}
 		})
 	}
 }
 
-// TestPublishDraftUseCase_UserValidation validates user and token requirements
-// This test will FAIL until user validation is implemented
+// TestPublishDraftUseCase_UserValidation validates user publishing credentials
 func TestPublishDraftUseCase_UserValidation(t *testing.T) {
-	ctx := context.Background()
-	_ = ctx
-
 	tests := []struct {
-		name           string
-		draftID        string
-		userID         string
-		hasToken       bool
-		tokenExpiresAt *time.Time
-		wantErr        bool
-		errMsg         string
+		name          string
+		linkedInToken string
+		active        bool
+		wantErr       bool
+		errMsg        string
 	}{
 		{
-			name:     "success with valid token",
-			draftID:  "draft123",
-			userID:   "user456",
-			hasToken: true,
-			tokenExpiresAt: func() *time.Time {
-				t := time.Now().Add(24 * time.Hour)
-				return &t
-			}(),
-			wantErr: false,
+			name:          "success with valid token",
+			linkedInToken: "valid-token",
+			active:        true,
 		},
 		{
-			name:     "error when user has no LinkedIn token",
-			draftID:  "draft123",
-			userID:   "user789",
-			hasToken: false,
-			wantErr:  true,
-			errMsg:   "LinkedIn access token not configured",
+			name:          "error when user has no LinkedIn token",
+			linkedInToken: "",
+			active:        true,
+			wantErr:       true,
+			errMsg:        "LinkedIn access token not configured",
 		},
 		{
-			name:     "error when LinkedIn token is expired",
-			draftID:  "draft456",
-			userID:   "user101",
-			hasToken: true,
-			tokenExpiresAt: func() *time.Time {
-				t := time.Now().Add(-24 * time.Hour)
-				return &t
-			}(),
-			wantErr: true,
-			errMsg:  "LinkedIn access token expired",
+			name:          "error when user is inactive",
+			linkedInToken: "valid-token",
+			active:        false,
+			wantErr:       true,
+			errMsg:        "user is not active",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Will fail: User validation doesn't exist yet
-			t.Fatal("PublishDraftUseCase user validation not implemented yet - TDD Red phase")
+			f := newPublishFixture(entities.DraftTypePost)
+			f.user.LinkedInToken = tt.linkedInToken
+			f.user.Active = tt.active
+
+			_, err := f.execute(context.Background())
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			var credentialsErr *domainErrors.ErrInvalidUserCredentials
+			if !errors.As(err, &credentialsErr) {
+				t.Fatalf("expected credentials error, got %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("expected error containing %q, got %q", tt.errMsg, err.Error())
+			}
 		})
 	}
 }
 
-// TestPublishDraftUseCase_DraftOwnership validates draft belongs to user
-// This test will FAIL until ownership validation is implemented
+// TestPublishDraftUseCase_DraftOwnership validates ownership checks
 func TestPublishDraftUseCase_DraftOwnership(t *testing.T) {
-	ctx := context.Background()
-	_ = ctx
+	f := newPublishFixture(entities.DraftTypePost)
+	f.draft.UserID = "675337baf901e2d790aabbff"
 
-	tests := []struct {
-		name        string
-		draftID     string
-		draftUserID string
-		requestUser string
-		wantErr     bool
-		errMsg      string
-	}{
-		{
-			name:        "success when user owns draft",
-			draftID:     "draft123",
-			draftUserID: "user456",
-			requestUser: "user456",
-			wantErr:     false,
-		},
-		{
-			name:        "error when user does not own draft",
-			draftID:     "draft789",
-			draftUserID: "user101",
-			requestUser: "user999",
-			wantErr:     true,
-			errMsg:      "draft does not belong to user",
-		},
-	}
+	_, err := f.execute(context.Background())
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			// Will fail: Ownership validation doesn't exist yet
-			t.Fatal("PublishDraftUseCase ownership validation not implemented yet - TDD Red phase")
-		})
+	var accessErr *domainErrors.ErrUnauthorizedAccess
+	if !errors.As(err, &accessErr) {
+		t.Fatalf("expected unauthorized access error, got %v", err)
+	}
+	if len(f.draftUpdates) != 0 {
+		t.Error("draft must not be updated when user does not own it")
 	}
 }
 
-// TestPublishDraftUseCase_DraftStatus validates publishable status
-// This test will FAIL until status validation is implemented
+// TestPublishDraftUseCase_DraftStatus validates which statuses can be published
 func TestPublishDraftUseCase_DraftStatus(t *testing.T) {
-	ctx := context.Background()
-	_ = ctx
-
 	tests := []struct {
-		name        string
-		draftID     string
-		draftStatus string
-		canPublish  bool
-		wantErr     bool
-		errMsg      string
+		name    string
+		status  entities.DraftStatus
+		wantErr bool
+		errMsg  string
 	}{
 		{
-			name:        "success publishing DRAFT status",
-			draftID:     "draft123",
-			draftStatus: "DRAFT",
-			canPublish:  true,
-			wantErr:     false,
+			name:   "success publishing DRAFT status",
+			status: entities.DraftStatusDraft,
 		},
 		{
-			name:        "success publishing REFINED status",
-			draftID:     "draft456",
-			draftStatus: "REFINED",
-			canPublish:  true,
-			wantErr:     false,
+			name:   "success publishing REFINED status",
+			status: entities.DraftStatusRefined,
 		},
 		{
-			name:        "error publishing already PUBLISHED status",
-			draftID:     "draft789",
-			draftStatus: "PUBLISHED",
-			canPublish:  false,
-			wantErr:     true,
-			errMsg:      "draft is already published",
+			name:    "error publishing already PUBLISHED status",
+			status:  entities.DraftStatusPublished,
+			wantErr: true,
+			errMsg:  "is already published",
 		},
 		{
-			name:        "error publishing FAILED status",
-			draftID:     "draft101",
-			draftStatus: "FAILED",
-			canPublish:  false,
-			wantErr:     true,
-			errMsg:      "cannot publish failed draft",
+			name:    "error publishing FAILED status",
+			status:  entities.DraftStatusFailed,
+			wantErr: true,
+			errMsg:  "cannot publish failed draft",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Will fail: Status validation doesn't exist yet
-			t.Fatal("PublishDraftUseCase status validation not implemented yet - TDD Red phase")
+			f := newPublishFixture(entities.DraftTypePost)
+			f.draft.Status = tt.status
+
+			_, err := f.execute(context.Background())
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Fatalf("expected error containing %q, got %v", tt.errMsg, err)
+			}
 		})
 	}
 }
 
-// TestPublishDraftUseCase_LinkedInAPIPost validates LinkedIn UGC Posts API
-// This test will FAIL until LinkedIn service integration is implemented
-func TestPublishDraftUseCase_LinkedInAPIPost(t *testing.T) {
-	ctx := context.Background()
-	_ = ctx
-
+// TestPublishDraftUseCase_StatusUpdate validates status and failure reason recording
+func TestPublishDraftUseCase_StatusUpdate(t *testing.T) {
 	tests := []struct {
-		name          string
-		draftType     string
-		content       string
-		token         string
-		apiResponse   string
-		apiStatusCode int
-		wantErr       bool
+		name           string
+		publishErr     error
+		expectedStatus entities.DraftStatus
+		expectedReason string
 	}{
 		{
-			name:          "successful post to UGC Posts API",
-			draftType:     "POST",
-			content:       "This is a LinkedIn post content",
-			token:         "valid-token",
-			apiResponse:   `{"id": "urn:li:share:12345"}`,
-			apiStatusCode: 201,
-			wantErr:       false,
+			name:           "status changes to PUBLISHED on success",
+			expectedStatus: entities.DraftStatusPublished,
 		},
 		{
-			name:          "error on 401 unauthorized",
-			draftType:     "POST",
-			content:       "Some content",
-			token:         "invalid-token",
-			apiResponse:   `{"message": "Unauthorized"}`,
-			apiStatusCode: 401,
-			wantErr:       true,
+			name:           "status changes to FAILED on 401 error",
+			publishErr:     linkedInFailure(domainErrors.LinkedInReasonTokenInvalid, 401),
+			expectedStatus: entities.DraftStatusFailed,
+			expectedReason: "token_invalid",
 		},
 		{
-			name:          "error on 403 forbidden",
-			draftType:     "POST",
-			content:       "Some content",
-			token:         "expired-token",
-			apiResponse:   `{"message": "Token expired"}`,
-			apiStatusCode: 403,
-			wantErr:       true,
+			name:           "status changes to FAILED on 429 rate limit",
+			publishErr:     linkedInFailure(domainErrors.LinkedInReasonRateLimit, 429),
+			expectedStatus: entities.DraftStatusFailed,
+			expectedReason: "rate_limit",
 		},
 		{
-			name:          "error on 429 rate limit",
-			draftType:     "POST",
-			content:       "Some content",
-			token:         "valid-token",
-			apiResponse:   `{"message": "Rate limit exceeded"}`,
-			apiStatusCode: 429,
-			wantErr:       true,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			// Will fail: LinkedIn UGC Posts API integration doesn't exist yet
-			t.Fatal("PublishDraftUseCase LinkedIn UGC Posts API not implemented yet - TDD Red phase")
-		})
-	}
-}
-
-// TestPublishDraftUseCase_LinkedInAPIArticle validates LinkedIn Articles API
-// This test will FAIL until LinkedIn Articles API integration is implemented
-func TestPublishDraftUseCase_LinkedInAPIArticle(t *testing.T) {
-	ctx := context.Background()
-	_ = ctx
-
-	tests := []struct {
-		name          string
-		draftType     string
-		title         string
-		content       string
-		token         string
-		apiResponse   string
-		apiStatusCode int
-		wantErr       bool
-	}{
-		{
-			name:          "successful article to Articles API",
-			draftType:     "ARTICLE",
-			title:         "My Article Title",
-			content:       "This is article content with more than 100 characters to meet minimum requirements",
-			token:         "valid-token",
-			apiResponse:   `{"id": "urn:li:article:67890"}`,
-			apiStatusCode: 201,
-			wantErr:       false,
+			name:           "status changes to FAILED on network error",
+			publishErr:     errors.New("connection refused"),
+			expectedStatus: entities.DraftStatusFailed,
+			expectedReason: "service_unavailable",
 		},
 		{
-			name:          "error on 401 unauthorized",
-			draftType:     "ARTICLE",
-			title:         "Title",
-			content:       "Content that meets minimum length requirements for article publishing",
-			token:         "invalid-token",
-			apiResponse:   `{"message": "Unauthorized"}`,
-			apiStatusCode: 401,
-			wantErr:       true,
+			name:           "status changes to FAILED on timeout",
+			publishErr:     context.DeadlineExceeded,
+			expectedStatus: entities.DraftStatusFailed,
+			expectedReason: "timeout",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Will fail: LinkedIn Articles API integration doesn't exist yet
-			t.Fatal("PublishDraftUseCase LinkedIn Articles API not implemented yet - TDD Red phase")
+			f := newPublishFixture(entities.DraftTypePost)
+			if tt.publishErr != nil {
+				f.linkedIn.PublishPostFunc = func(ctx context.Context, content string, accessToken string) (*interfaces.LinkedInPostResponse, error) {
+					return nil, tt.publishErr
+				}
+			}
+
+			_, err := f.execute(context.Background())
+			if tt.publishErr == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(f.draftUpdates) != 1 {
+				t.Fatalf("expected 1 draft update, got %d", len(f.draftUpdates))
+			}
+			update := f.draftUpdates[0]
+			if update["status"] != tt.expectedStatus {
+				t.Errorf("expected persisted status %s, got %v", tt.expectedStatus, update["status"])
+			}
+
+			if tt.expectedReason == "" {
+				return
+			}
+
+			var apiErr *domainErrors.LinkedInAPIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected LinkedIn API error, got %v", err)
+			}
+			if string(apiErr.Reason) != tt.expectedReason {
+				t.Errorf("expected reason %s, got %s", tt.expectedReason, apiErr.Reason)
+			}
+
+			publishErr, ok := update["publish_error"].(*entities.PublishError)
+			if !ok || publishErr == nil {
+				t.Fatalf("expected publish_error to be persisted, got %v", update["publish_error"])
+			}
+			if publishErr.Reason != tt.expectedReason {
+				t.Errorf("expected persisted reason %s, got %s", tt.expectedReason, publishErr.Reason)
+			}
 		})
 	}
 }
 
-// TestPublishDraftUseCase_StatusUpdate validates status transition after publishing
-// This test will FAIL until status update is implemented
-func TestPublishDraftUseCase_StatusUpdate(t *testing.T) {
-	ctx := context.Background()
-	_ = ctx
-
-	tests := []struct {
-		name               string
-		draftID            string
-		originalStatus     string
-		publishSuccess     bool
-		expectedStatus     string
-		linkedInPostID     string
-		publishedAtSet     bool
-		wantErr            bool
-	}{
-		{
-			name:           "status changes to PUBLISHED on success",
-			draftID:        "draft123",
-			originalStatus: "DRAFT",
-			publishSuccess: true,
-			expectedStatus: "PUBLISHED",
-			linkedInPostID: "urn:li:share:12345",
-			publishedAtSet: true,
-			wantErr:        false,
-		},
-		{
-			name:           "status changes to FAILED on 401 error",
-			draftID:        "draft456",
-			originalStatus: "REFINED",
-			publishSuccess: false,
-			expectedStatus: "FAILED",
-			linkedInPostID: "",
-			publishedAtSet: false,
-			wantErr:        true,
-		},
-		{
-			name:           "status changes to FAILED on 429 rate limit",
-			draftID:        "draft789",
-			originalStatus: "DRAFT",
-			publishSuccess: false,
-			expectedStatus: "FAILED",
-			linkedInPostID: "",
-			publishedAtSet: false,
-			wantErr:        true,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			// Will fail: Status update doesn't exist yet
-			t.Fatal("PublishDraftUseCase status update not implemented yet - TDD Red phase")
-		})
+// TestPublishDraftUseCase_ErrorHandling validates invalid LinkedIn responses
+func TestPublishDraftUseCase_ErrorHandling(t *testing.T) {
+	f := newPublishFixture(entities.DraftTypePost)
+	f.linkedIn.PublishPostFunc = func(ctx context.Context, content string, accessToken string) (*interfaces.LinkedInPostResponse, error) {
+		return &interfaces.LinkedInPostResponse{}, nil
 	}
-}
 
-// TestPublishDraftUseCase_ErrorHandling validates error handling and status updates
-// This test will FAIL until error handling is implemented
-func TestPublishDraftUseCase_ErrorHandling(t *testing.T) {
-	ctx := context.Background()
-	_ = ctx
+	_, err := f.execute(context.Background())
 
-	tests := []struct {
-		name           string
-		draftID        string
-		linkedInError  error
-		expectedStatus string
-		errorMessage   string
-		wantErr        bool
-	}{
-		{
-			name:           "handle network error",
-			draftID:        "draft123",
-			linkedInError:  errors.New("connection refused"),
-			expectedStatus: "FAILED",
-			errorMessage:   "LinkedIn service unavailable",
-			wantErr:        true,
-		},
-		{
-			name:           "handle timeout error",
-			draftID:        "draft456",
-			linkedInError:  errors.New("request timeout"),
-			expectedStatus: "FAILED",
-			errorMessage:   "LinkedIn request timeout",
-			wantErr:        true,
-		},
-		{
-			name:           "handle invalid response",
-			draftID:        "draft789",
-			linkedInError:  errors.New("invalid JSON"),
-			expectedStatus: "FAILED",
-			errorMessage:   "LinkedIn response error",
-			wantErr:        true,
-		},
+	var apiErr *domainErrors.LinkedInAPIError
+	if !errors.As(err, &apiErr) || apiErr.Reason != domainErrors.LinkedInReasonInvalidResponse {
+		t.Fatalf("expected invalid_response error, got %v", err)
 	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			// Will fail: Error handling doesn't exist yet
-			t.Fatal("PublishDraftUseCase error handling not implemented yet - TDD Red phase")
-		})
+	if f.draft.Status != entities.DraftStatusFailed {
+		t.Errorf("expected status FAILED, got %s", f.draft.Status)
 	}
 }
 
-// TestPublishDraftUseCase_RepositoryUpdate validates draft persistence
-// This test will FAIL until repository update is implemented
+// TestPublishDraftUseCase_RepositoryUpdate validates persistence of the published draft
 func TestPublishDraftUseCase_RepositoryUpdate(t *testing.T) {
-	ctx := context.Background()
-	_ = ctx
+	t.Run("successfully save published draft and mark idea", func(t *testing.T) {
+		f := newPublishFixture(entities.DraftTypePost)
+
+		if _, err := f.execute(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		update := f.draftUpdates[0]
+		if update["linkedin_post_id"] != "urn:li:share:12345" {
+			t.Errorf("expected linkedin_post_id to be persisted, got %v", update["linkedin_post_id"])
+		}
+		if _, ok := update["published_at"].(time.Time); !ok {
+			t.Errorf("expected published_at to be persisted, got %v", update["published_at"])
+		}
+
+		if len(f.ideaUpdates) != 1 {
+			t.Fatalf("expected idea to be updated once, got %d", len(f.ideaUpdates))
+		}
+		if f.ideaUpdates[0]["published_draft_id"] != publishTestDraftID {
+			t.Errorf("expected published_draft_id %s, got %v", publishTestDraftID, f.ideaUpdates[0]["published_draft_id"])
+		}
+		if !f.idea.IsPublished() {
+			t.Error("expected idea to be marked as published")
+		}
+	})
 
-	tests := []struct {
-		name    string
-		draftID string
-		repoErr error
-		wantErr bool
-	}{
-		{
-			name:    "successfully save published draft",
-			draftID: "draft123",
-			repoErr: nil,
-			wantErr: false,
-		},
-		{
-			name:    "repository error during save",
-			draftID: "draft456",
-			repoErr: errors.New("database connection lost"),
-			wantErr: true,
-		},
-	}
+	t.Run("save retried after a repository error", func(t *testing.T) {
+		f := newPublishFixture(entities.DraftTypePost)
+		saveAttempts := 0
+		f.draftRepo.UpdateIfStatusFunc = func(ctx context.Context, draftID string, expected entities.DraftStatus, updates map[string]interface{}) (bool, error) {
+			if expected == entities.DraftStatusPublishing {
+				saveAttempts++
+				if saveAttempts == 1 {
+					return false, errors.New("database unavailable")
+				}
+			}
+			return f.updateIfStatus(expected, updates), nil
+		}
+
+		if _, err := f.execute(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if saveAttempts != 2 {
+			t.Errorf("expected 2 save attempts, got %d", saveAttempts)
+		}
+		if f.storedStatus != entities.DraftStatusPublished {
+			t.Errorf("expected stored status PUBLISHED, got %s", f.storedStatus)
+		}
+	})
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			// Will fail: Repository update integration doesn't exist yet
-			t.Fatal("PublishDraftUseCase repository update not implemented yet - TDD Red phase")
-		})
-	}
+	t.Run("repository error during save", func(t *testing.T) {
+		f := newPublishFixture(entities.DraftTypePost)
+		saveAttempts := 0
+		f.draftRepo.UpdateIfStatusFunc = func(ctx context.Context, draftID string, expected entities.DraftStatus, updates map[string]interface{}) (bool, error) {
+			if expected == entities.DraftStatusPublishing {
+				saveAttempts++
+				return false, errors.New("database unavailable")
+			}
+			return f.updateIfStatus(expected, updates), nil
+		}
+
+		_, err := f.execute(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "urn:li:share:12345") {
+			t.Fatalf("expected save error naming the LinkedIn post, got %v", err)
+		}
+		if saveAttempts != 3 {
+			t.Errorf("expected 3 save attempts, got %d", saveAttempts)
+		}
+		// The draft must never look publishable again once LinkedIn accepted it
+		if f.storedStatus != entities.DraftStatusPublishing {
+			t.Errorf("expected stored status PUBLISHING, got %s", f.storedStatus)
+		}
+		if len(f.ideaUpdates) != 0 {
+			t.Error("idea must not be marked when the draft could not be saved")
+		}
+	})
 }
 
-// TestPublishDraftUseCase_ContextCancellation validates context handling
-// This test will FAIL until context cancellation is implemented
+// TestPublishDraftUseCase_ContextCancellation validates failures are recorded after cancellation
 func TestPublishDraftUseCase_ContextCancellation(t *testing.T) {
-	tests := []struct {
-		name       string
-		cancelTime time.Duration
-		wantErr    bool
-		errMsg     string
-	}{
-		{
-			name:       "context cancelled before LinkedIn call",
-			cancelTime: 1 * time.Millisecond,
-			wantErr:    true,
-			errMsg:     "context cancelled",
-		},
-		{
-			name:       "context timeout during LinkedIn call",
-			cancelTime: 100 * time.Millisecond,
-			wantErr:    true,
-			errMsg:     "context deadline exceeded",
-		},
+	f := newPublishFixture(entities.DraftTypePost)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	f.linkedIn.PublishPostFunc = func(ctx context.Context, content string, accessToken string) (*interfaces.LinkedInPostResponse, error) {
+		cancel()
+		return nil, ctx.Err()
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			// Will fail: Context handling doesn't exist yet
-			t.Fatal("PublishDraftUseCase context handling not implemented yet - TDD Red phase")
-		})
+	var saveCtxErr error
+	f.draftRepo.UpdateIfStatusFunc = func(ctx context.Context, draftID string, expected entities.DraftStatus, updates map[string]interface{}) (bool, error) {
+		saveCtxErr = ctx.Err()
+		return f.updateIfStatus(expected, updates), nil
+	}
+
+	_, err := f.execute(ctx)
+	if err == nil {
+		t.Fatal("expected error after cancellation")
+	}
+	if len(f.draftUpdates) != 1 {
+		t.Fatalf("expected failure to be recorded, got %d updates", len(f.draftUpdates))
+	}
+	if saveCtxErr != nil {
+		t.Errorf("failure must be recorded with a live context, got %v", saveCtxErr)
 	}
 }
 
-// TestPublishDraftUseCase_EndToEnd validates complete workflow
-// This test will FAIL until full end-to-end flow is implemented
-func TestPublishDraftUseCase_EndToEnd(t *testing.T) {
-	ctx := context.Background()
-	_ = ctx
-
-	t.Run("complete publishing workflow", func(t *testing.T) {
-		// Steps:
-		// 1. Validate draft ID
-		// 2. Get draft from repository
-		// 3. Get user from repository
-		// 4. Verify user has LinkedIn token
-		// 5. Verify token is not expired
-		// 6. Verify draft belongs to user
-		// 7. Verify draft can be published (status check)
-		// 8. Call LinkedIn API based on draft type (POST or ARTICLE)
-		// 9. Handle LinkedIn API response
-		// 10. Update draft status to PUBLISHED or FAILED
-		// 11. Set published_at timestamp and linkedin_post_id
-		// 12. Save draft to repository
-		// 13. Return published draft or error
-
-		// Will fail: Full workflow doesn't exist yet
-		t.Fatal("PublishDraftUseCase end-to-end workflow not implemented yet - TDD Red phase")
+// TestPublishDraftUseCase_Claim validates that a draft is taken before LinkedIn is called
+func TestPublishDraftUseCase_Claim(t *testing.T) {
+	t.Run("draft already being published", func(t *testing.T) {
+		f := newPublishFixture(entities.DraftTypePost)
+		f.draft.Status = entities.DraftStatusPublishing
+		f.linkedIn.PublishPostFunc = func(ctx context.Context, content string, accessToken string) (*interfaces.LinkedInPostResponse, error) {
+			t.Fatal("LinkedIn must not be called")
+			return nil, nil
+		}
+
+		_, err := f.execute(context.Background())
+
+		var inProgressErr *domainErrors.ErrDraftPublishInProgress
+		if !errors.As(err, &inProgressErr) {
+			t.Fatalf("expected ErrDraftPublishInProgress, got %v", err)
+		}
 	})
 
-	t.Run("publish multiple drafts sequentially", func(t *testing.T) {
-		// Publish 3 drafts one after another
-
-		// Will fail: Sequential publishing doesn't exist yet
-		t.Fatal("PublishDraftUseCase sequential publishing not implemented yet - TDD Red phase")
+	t.Run("claim lost to another publisher", func(t *testing.T) {
+		f := newPublishFixture(entities.DraftTypePost)
+		f.storedStatus = entities.DraftStatusPublishing
+		f.linkedIn.PublishPostFunc = func(ctx context.Context, content string, accessToken string) (*interfaces.LinkedInPostResponse, error) {
+			t.Fatal("LinkedIn must not be called")
+			return nil, nil
+		}
+
+		_, err := f.execute(context.Background())
+
+		var inProgressErr *domainErrors.ErrDraftPublishInProgress
+		if !errors.As(err, &inProgressErr) {
+			t.Fatalf("expected ErrDraftPublishInProgress, got %v", err)
+		}
+		if len(f.draftUpdates) != 0 {
+			t.Errorf("expected no draft updates, got %d", len(f.draftUpdates))
+		}
 	})
 
-	t.Run("retry on transient LinkedIn error", func(t *testing.T) {
-		// LinkedIn fails first attempt, succeeds on retry
-
-		// Will fail: Retry logic doesn't exist yet
-		t.Fatal("PublishDraftUseCase retry logic not implemented yet - TDD Red phase")
+	t.Run("claim is leased until the outcome is saved", func(t *testing.T) {
+		for _, publishErr := range []error{nil, linkedInFailure(domainErrors.LinkedInReasonUnavailable, 503)} {
+			f := newPublishFixture(entities.DraftTypePost)
+			f.linkedIn.PublishPostFunc = func(ctx context.Context, content string, accessToken string) (*interfaces.LinkedInPostResponse, error) {
+				if publishErr != nil {
+					return nil, publishErr
+				}
+				return &interfaces.LinkedInPostResponse{ID: "urn:li:share:12345"}, nil
+			}
+
+			var claims []map[string]interface{}
+			f.draftRepo.UpdateIfStatusFunc = func(ctx context.Context, draftID string, expected entities.DraftStatus, updates map[string]interface{}) (bool, error) {
+				if updates["status"] == entities.DraftStatusPublishing {
+					claims = append(claims, updates)
+				}
+				return f.updateIfStatus(expected, updates), nil
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+			_, _ = f.execute(ctx)
+			cancel()
+
+			if len(claims) != 1 {
+				t.Fatalf("expected 1 claim, got %d", len(claims))
+			}
+			leaseUntil, ok := claims[0]["publish_lease_until"].(time.Time)
+			if !ok || !leaseUntil.After(time.Now().Add(10*time.Minute)) {
+				t.Errorf("expected the claim leased beyond the caller deadline, got %v", claims[0]["publish_lease_until"])
+			}
+
+			if len(f.draftUpdates) != 1 {
+				t.Fatalf("expected 1 draft update, got %d", len(f.draftUpdates))
+			}
+			if lease, ok := f.draftUpdates[0]["publish_lease_until"]; !ok || lease != nil {
+				t.Errorf("expected the outcome to clear the lease, got %v", lease)
+			}
+		}
 	})
 
-	t.Run("rollback when repository save fails", func(t *testing.T) {
-		// Draft published to LinkedIn but repository fails
-		// Should not mark as published
-
-		// Will fail: Rollback logic doesn't exist yet
-		t.Fatal("PublishDraftUseCase rollback logic not implemented yet - TDD Red phase")
+	t.Run("concurrent publishes post once", func(t *testing.T) {
+		f := newPublishFixture(entities.DraftTypePost)
+		f.draft.Status = entities.DraftStatusScheduled
+		f.storedStatus = entities.DraftStatusScheduled
+
+		var (
+			mu    sync.Mutex
+			posts int
+		)
+		f.draftRepo.FindByIDFunc = func(ctx context.Context, draftID string) (*entities.Draft, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			draft := *f.draft
+			draft.Status = f.storedStatus
+			return &draft, nil
+		}
+		f.draftRepo.UpdateIfStatusFunc = func(ctx context.Context, draftID string, expected entities.DraftStatus, updates map[string]interface{}) (bool, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return f.updateIfStatus(expected, updates), nil
+		}
+		f.linkedIn.PublishPostFunc = func(ctx context.Context, content string, accessToken string) (*interfaces.LinkedInPostResponse, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			posts++
+			return &interfaces.LinkedInPostResponse{ID: "urn:li:share:12345"}, nil
+		}
+
+		useCase := f.useCase()
+		input := usecases.PublishDraftInput{DraftID: f.draft.ID, UserID: f.user.ID}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _ = useCase.Execute(context.Background(), input)
+			}()
+		}
+		wg.Wait()
+
+		if posts != 1 {
+			t.Errorf("expected exactly 1 LinkedIn post, got %d", posts)
+		}
+		if f.storedStatus != entities.DraftStatusPublished {
+			t.Errorf("expected stored status PUBLISHED, got %s", f.storedStatus)
+		}
 	})
 }
 
-// TestPublishDraftUseCase_DraftTypeRouting validates correct API selection
-// This test will FAIL until type-based routing is implemented
+// TestPublishDraftUseCase_DraftTypeRouting validates each draft type reaches the right API
 func TestPublishDraftUseCase_DraftTypeRouting(t *testing.T) {
-	ctx := context.Background()
-	_ = ctx
-
 	tests := []struct {
-		name           string
-		draftType      string
-		expectedAPI    string
-		expectedMethod string
-		wantErr        bool
+		name        string
+		draftType   entities.DraftType
+		expectedAPI string
 	}{
 		{
-			name:           "route POST to UGC Posts API",
-			draftType:      "POST",
-			expectedAPI:    "https://api.linkedin.com/v2/ugcPosts",
-			expectedMethod: "POST",
-			wantErr:        false,
-		},
-		{
-			name:           "route ARTICLE to Articles API",
-			draftType:      "ARTICLE",
-			expectedAPI:    "https://api.linkedin.com/v2/articles",
-			expectedMethod: "POST",
-			wantErr:        false,
+			name:        "route POST to PublishPost",
+			draftType:   entities.DraftTypePost,
+			expectedAPI: "post",
 		},
 		{
-			name:        "error on unknown draft type",
-			draftType:   "UNKNOWN",
-			expectedAPI: "",
-			wantErr:     true,
+			name:        "route ARTICLE to PublishArticle",
+			draftType:   entities.DraftTypeArticle,
+			expectedAPI: "article",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Will fail: Type-based routing doesn't exist yet
-			t.Fatal("PublishDraftUseCase type-based routing not implemented yet - TDD Red phase")
+			f := newPublishFixture(tt.draftType)
+
+			var called, receivedTitle, receivedToken string
+			f.linkedIn.PublishPostFunc = func(ctx context.Context, content string, accessToken string) (*interfaces.LinkedInPostResponse, error) {
+				called, receivedToken = "post", accessToken
+				return &interfaces.LinkedInPostResponse{ID: "urn:li:share:1"}, nil
+			}
+			f.linkedIn.PublishArticleFunc = func(ctx context.Context, title string, content string, accessToken string) (*interfaces.LinkedInPostResponse, error) {
+				called, receivedTitle, receivedToken = "article", title, accessToken
+				return &interfaces.LinkedInPostResponse{ID: "urn:li:article:1"}, nil
+			}
+
+			if _, err := f.execute(context.Background()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if called != tt.expectedAPI {
+				t.Errorf("expected %s API to be called, got %q", tt.expectedAPI, called)
+			}
+			if receivedToken != f.user.LinkedInToken {
+				t.Errorf("expected user token to be used, got %q", receivedToken)
+			}
+			if tt.draftType == entities.DraftTypeArticle && receivedTitle != f.draft.Title {
+				t.Errorf("expected article title %q, got %q", f.draft.Title, receivedTitle)
+			}
 		})
 	}
 }
+
+// TestPublishDraftUseCase_TokenRefresh validates transparent LinkedIn token refresh
+func TestPublishDraftUseCase_TokenRefresh(t *testing.T) {
+	freshTokens := func(refreshToken string) (*interfaces.LinkedInTokenResponse, error) {
+		return &interfaces.LinkedInTokenResponse{AccessToken: "fresh-token", ExpiresIn: 3600}, nil
+	}
+
+	newRefreshFixture := func() (*publishFixture, *[]*entities.User, *[]map[string]interface{}) {
+		f := newPublishFixture(entities.DraftTypePost)
+		f.user.LinkedInRefreshToken = "refresh-token"
+		f.auth = &fakeLinkedInAuth{refreshFn: freshTokens}
+
+		var saved []*entities.User
+		var userUpdates []map[string]interface{}
+		f.userRepo.UpdateLinkedInCredentialsFunc = func(ctx context.Context, user *entities.User) error {
+			saved = append(saved, user)
+			return nil
+		}
+		f.userRepo.UpdateFunc = func(ctx context.Context, userID string, updates map[string]interface{}) error {
+			userUpdates = append(userUpdates, updates)
+			return nil
+		}
+		return f, &saved, &userUpdates
+	}
+
+	t.Run("refreshes and retries once when LinkedIn rejects the token", func(t *testing.T) {
+		f, saved, _ := newRefreshFixture()
+		var tokens []string
+		f.linkedIn.PublishPostFunc = func(ctx context.Context, content string, accessToken string) (*interfaces.LinkedInPostResponse, error) {
+			tokens = append(tokens, accessToken)
+			if accessToken != "fresh-token" {
+				return nil, linkedInFailure(domainErrors.LinkedInReasonTokenInvalid, 401)
+			}
+			return &interfaces.LinkedInPostResponse{ID: "urn:li:share:12345"}, nil
+		}
+
+		draft, err := f.execute(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if draft.Status != entities.DraftStatusPublished {
+			t.Errorf("expected PUBLISHED status, got %s", draft.Status)
+		}
+		if len(tokens) != 2 || tokens[1] != "fresh-token" {
+			t.Errorf("expected a retry with the fresh token, got %v", tokens)
+		}
+		if len(*saved) != 1 || (*saved)[0].LinkedInTokenExpiresAt == nil {
+			t.Errorf("expected refreshed credentials to be persisted, got %v", *saved)
+		}
+	})
+
+	t.Run("refreshes an expired token before publishing", func(t *testing.T) {
+		f, _, _ := newRefreshFixture()
+		expired := time.Now().Add(-time.Minute)
+		f.user.LinkedInTokenExpiresAt = &expired
+		f.linkedIn.PublishPostFunc = func(ctx context.Context, content string, accessToken string) (*interfaces.LinkedInPostResponse, error) {
+			if accessToken != "fresh-token" {
+				t.Errorf("expected the refreshed token, got %q", accessToken)
+			}
+			return &interfaces.LinkedInPostResponse{ID: "urn:li:share:12345"}, nil
+		}
+
+		if _, err := f.execute(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if f.auth.refreshCalls != 1 {
+			t.Errorf("expected one refresh, got %d", f.auth.refreshCalls)
+		}
+	})
+
+	t.Run("marks the user when the refresh token is rejected", func(t *testing.T) {
+		f, saved, userUpdates := newRefreshFixture()
+		f.auth.refreshFn = func(refreshToken string) (*interfaces.LinkedInTokenResponse, error) {
+			return nil, domainErrors.NewLinkedInAPIError("refresh_token", domainErrors.LinkedInReasonBadRequest, 400, "invalid_grant", nil)
+		}
+		f.linkedIn.PublishPostFunc = func(ctx context.Context, content string, accessToken string) (*interfaces.LinkedInPostResponse, error) {
+			return nil, linkedInFailure(domainErrors.LinkedInReasonTokenInvalid, 401)
+		}
+
+		_, err := f.execute(context.Background())
+
+		var apiErr *domainErrors.LinkedInAPIError
+		if !errors.As(err, &apiErr) || apiErr.Reason != domainErrors.LinkedInReasonTokenInvalid {
+			t.Fatalf("expected token_invalid error, got %v", err)
+		}
+		if len(*saved) != 0 {
+			t.Error("credentials must not be persisted when refresh fails")
+		}
+		if len(*userUpdates) != 1 || (*userUpdates)[0]["linkedin_token_invalid_reason"] == "" {
+			t.Errorf("expected the user to be marked unrecoverable, got %v", *userUpdates)
+		}
+		if f.user.CanPublish() {
+			t.Error("expected user to be unable to publish")
+		}
+		if reason := f.user.PublishBlockedReason(); !strings.Contains(reason, "reconnected") {
+			t.Errorf("expected a reconnect reason, got %q", reason)
+		}
+	})
+
+	t.Run("rejects an expired token that cannot be refreshed", func(t *testing.T) {
+		f, _, _ := newRefreshFixture()
+		f.user.LinkedInRefreshToken = ""
+		expired := time.Now().Add(-time.Minute)
+		f.user.LinkedInTokenExpiresAt = &expired
+		f.linkedIn.PublishPostFunc = func(ctx context.Context, content string, accessToken string) (*interfaces.LinkedInPostResponse, error) {
+			t.Error("LinkedIn must not be called with an expired token")
+			return nil, nil
+		}
+
+		_, err := f.execute(context.Background())
+
+		var credentialsErr *domainErrors.ErrInvalidUserCredentials
+		if !errors.As(err, &credentialsErr) || !strings.Contains(credentialsErr.Reason, "expired") {
+			t.Fatalf("expected expired credentials error, got %v", err)
+		}
+		if f.auth.refreshCalls != 0 {
+			t.Errorf("expected no refresh attempt, got %d", f.auth.refreshCalls)
+		}
+	})
+}
@@ -0,0 +1,115 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/linkgen-ai/backend/src/application/usecases"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/fakellm"
+)
+
+const streamTestPost = "Un borrador sobre automatizar la publicación en LinkedIn para equipos pequeños"
+
+// brokenStreamLLMService streams one chunk and then fails, like a connection dropped mid-stream
+type brokenStreamLLMService struct {
+	MockLLMService
+}
+
+func (s *brokenStreamLLMService) StreamRequest(ctx context.Context, prompt string, onChunk interfaces.LLMStreamHandler) (string, error) {
+	return "", errors.New("not used")
+}
+
+func (s *brokenStreamLLMService) RefineDraftStream(ctx context.Context, draft string, userPrompt string, history []string, onChunk interfaces.LLMStreamHandler) (string, error) {
+	if err := onChunk("Versión "); err != nil {
+		return "", err
+	}
+	return "", errors.New("unexpected EOF")
+}
+
+// newStreamRefineUseCase returns a refine use case over one post draft, counting saves
+func newStreamRefineUseCase(llmService interfaces.LLMService, saves *int) *usecases.RefineDraftUseCase {
+	drafts := &MockDraftRepository{
+		FindByIDFunc: func(ctx context.Context, draftID string) (*entities.Draft, error) {
+			return &entities.Draft{ID: draftID, UserID: publishTestUserID, Type: entities.DraftTypePost, Status: entities.DraftStatusDraft, Content: streamTestPost}, nil
+		},
+		UpdateFunc: func(ctx context.Context, draftID string, updates map[string]interface{}) error {
+			*saves++
+			return nil
+		},
+	}
+	return usecases.NewRefineDraftUseCase(drafts, llmService)
+}
+
+// TestRefineDraftUseCase_ExecuteStream validates streamed refinement and when it is saved
+func TestRefineDraftUseCase_ExecuteStream(t *testing.T) {
+	input := usecases.RefineDraftInput{DraftID: publishTestDraftID, UserPrompt: "Hazlo más corto y directo"}
+
+	t.Run("streams from the LLM and saves the result", func(t *testing.T) {
+		server := fakellm.NewTestServer(fakellm.Options{Rules: fakellm.DefaultRules()})
+		defer server.Close()
+		client, err := llm.NewLLMHTTPClient(server.ClientConfig())
+		if err != nil {
+			t.Fatalf("failed to create LLM client: %v", err)
+		}
+
+		saves := 0
+		var chunks []string
+		draft, err := newStreamRefineUseCase(client, &saves).ExecuteStream(context.Background(), input, func(chunk string) error {
+			chunks = append(chunks, chunk)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(chunks) < 2 || strings.Join(chunks, "") != draft.Content {
+			t.Errorf("expected the content in several chunks, got %q for %q", chunks, draft.Content)
+		}
+		if saves != 1 || len(draft.RefinementHistory) != 1 || draft.Status != entities.DraftStatusRefined {
+			t.Errorf("expected one saved refinement, got %d saves and %+v", saves, draft.RefinementHistory)
+		}
+		if requests := server.Requests(); len(requests) != 1 || !requests[0].Stream || requests[0].ResponseFormat != nil {
+			t.Errorf("expected one plain streamed request, got %+v", requests)
+		}
+	})
+
+	t.Run("nothing is saved when the stream fails", func(t *testing.T) {
+		saves := 0
+		var chunks []string
+		_, err := newStreamRefineUseCase(&brokenStreamLLMService{}, &saves).ExecuteStream(context.Background(), input, func(chunk string) error {
+			chunks = append(chunks, chunk)
+			return nil
+		})
+		if err == nil || !strings.Contains(err.Error(), "unexpected EOF") {
+			t.Fatalf("expected the stream error, got %v", err)
+		}
+		if saves != 0 || len(chunks) != 1 {
+			t.Errorf("expected no save after 1 chunk, got %d saves and %d chunks", saves, len(chunks))
+		}
+	})
+
+	t.Run("services that can't stream answer in one chunk", func(t *testing.T) {
+		saves := 0
+		var chunks []string
+		llmService := &MockLLMService{
+			RefineDraftFunc: func(ctx context.Context, draft string, userPrompt string, history []string) (string, error) {
+				return "Versión más corta del borrador para LinkedIn", nil
+			},
+		}
+		draft, err := newStreamRefineUseCase(llmService, &saves).ExecuteStream(context.Background(), input, func(chunk string) error {
+			chunks = append(chunks, chunk)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(chunks) != 1 || chunks[0] != draft.Content || saves != 1 {
+			t.Errorf("expected a single chunk and a save, got %q and %d saves", chunks, saves)
+		}
+	})
+}
@@ -0,0 +1,161 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/application/usecases"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/infrastructure/database"
+)
+
+func newScheduleTestDraft(status entities.DraftStatus) *entities.Draft {
+	return &entities.Draft{
+		ID:      publishTestDraftID,
+		UserID:  publishTestUserID,
+		Type:    entities.DraftTypePost,
+		Content: "Clean Architecture keeps business rules independent of frameworks.",
+		Status:  status,
+	}
+}
+
+// TestScheduleDraftUseCase_Success validates scheduling and rescheduling drafts
+func TestScheduleDraftUseCase_Success(t *testing.T) {
+	tests := []struct {
+		name   string
+		status entities.DraftStatus
+	}{
+		{name: "schedule DRAFT status", status: entities.DraftStatusDraft},
+		{name: "schedule REFINED status", status: entities.DraftStatusRefined},
+		{name: "reschedule SCHEDULED status", status: entities.DraftStatusScheduled},
+		{name: "schedule retry of FAILED status", status: entities.DraftStatusFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			draft := newScheduleTestDraft(tt.status)
+			draft.PublishError = &entities.PublishError{Reason: "rate_limit"}
+
+			var saved map[string]interface{}
+			repo := &MockDraftRepository{
+				FindByIDFunc: func(ctx context.Context, draftID string) (*entities.Draft, error) {
+					return draft, nil
+				},
+				UpdateFunc: func(ctx context.Context, draftID string, updates map[string]interface{}) error {
+					saved = updates
+					return nil
+				},
+			}
+
+			publishAt := time.Now().Add(2 * time.Hour)
+			result, err := usecases.NewScheduleDraftUseCase(repo).Execute(context.Background(), usecases.ScheduleDraftInput{
+				DraftID:   publishTestDraftID,
+				UserID:    publishTestUserID,
+				PublishAt: publishAt,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if result.Status != entities.DraftStatusScheduled {
+				t.Errorf("expected status SCHEDULED, got %s", result.Status)
+			}
+			if result.ScheduledAt == nil || !result.ScheduledAt.Equal(publishAt) {
+				t.Errorf("expected scheduled at %v, got %v", publishAt, result.ScheduledAt)
+			}
+			if result.PublishError != nil {
+				t.Error("expected previous publish error to be cleared")
+			}
+			if saved["status"] != entities.DraftStatusScheduled {
+				t.Errorf("expected SCHEDULED status to be persisted, got %v", saved["status"])
+			}
+			if _, ok := saved["scheduled_at"].(time.Time); !ok {
+				t.Errorf("expected scheduled_at to be persisted, got %v", saved["scheduled_at"])
+			}
+		})
+	}
+}
+
+// TestScheduleDraftUseCase_Errors validates scheduling rejections
+func TestScheduleDraftUseCase_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		draft     *entities.Draft
+		findErr   error
+		userID    string
+		publishAt time.Time
+		errMsg    string
+	}{
+		{
+			name:      "error when draft does not exist",
+			findErr:   database.ErrEntityNotFound,
+			userID:    publishTestUserID,
+			publishAt: time.Now().Add(time.Hour),
+			errMsg:    "draft not found",
+		},
+		{
+			name:      "error when user does not own draft",
+			draft:     newScheduleTestDraft(entities.DraftStatusDraft),
+			userID:    "675337baf901e2d790aabbff",
+			publishAt: time.Now().Add(time.Hour),
+			errMsg:    "is not authorized",
+		},
+		{
+			name:      "error when draft is already published",
+			draft:     newScheduleTestDraft(entities.DraftStatusPublished),
+			userID:    publishTestUserID,
+			publishAt: time.Now().Add(time.Hour),
+			errMsg:    "is already published",
+		},
+		{
+			name:      "error when publish time is in the past",
+			draft:     newScheduleTestDraft(entities.DraftStatusDraft),
+			userID:    publishTestUserID,
+			publishAt: time.Now().Add(-time.Minute),
+			errMsg:    "publish time must be in the future",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := false
+			repo := &MockDraftRepository{
+				FindByIDFunc: func(ctx context.Context, draftID string) (*entities.Draft, error) {
+					return tt.draft, tt.findErr
+				},
+				UpdateFunc: func(ctx context.Context, draftID string, updates map[string]interface{}) error {
+					updated = true
+					return nil
+				},
+			}
+
+			_, err := usecases.NewScheduleDraftUseCase(repo).Execute(context.Background(), usecases.ScheduleDraftInput{
+				DraftID:   publishTestDraftID,
+				UserID:    tt.userID,
+				PublishAt: tt.publishAt,
+			})
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Fatalf("expected error containing %q, got %v", tt.errMsg, err)
+			}
+			if updated {
+				t.Error("draft must not be updated when scheduling is rejected")
+			}
+		})
+	}
+
+	t.Run("error on empty draft ID", func(t *testing.T) {
+		_, err := usecases.NewScheduleDraftUseCase(&MockDraftRepository{}).Execute(context.Background(), usecases.ScheduleDraftInput{
+			UserID:    publishTestUserID,
+			PublishAt: time.Now().Add(time.Hour),
+		})
+
+		var validationErr *domainErrors.ErrValidation
+		if !errors.As(err, &validationErr) {
+			t.Fatalf("expected validation error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,277 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/application/usecases"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/infrastructure/database"
+)
+
+// memoryScheduleRepository keeps schedules in memory
+type memoryScheduleRepository struct {
+	schedules map[string]*entities.Schedule
+}
+
+func newMemoryScheduleRepository() *memoryScheduleRepository {
+	return &memoryScheduleRepository{schedules: map[string]*entities.Schedule{}}
+}
+
+func (r *memoryScheduleRepository) Create(ctx context.Context, schedule *entities.Schedule) (string, error) {
+	if err := schedule.Validate(); err != nil {
+		return "", err
+	}
+	copied := *schedule
+	r.schedules[schedule.ID] = &copied
+	return schedule.ID, nil
+}
+
+func (r *memoryScheduleRepository) FindByID(ctx context.Context, scheduleID string) (*entities.Schedule, error) {
+	schedule, ok := r.schedules[scheduleID]
+	if !ok {
+		return nil, database.ErrEntityNotFound
+	}
+	copied := *schedule
+	return &copied, nil
+}
+
+func (r *memoryScheduleRepository) ListByUserID(ctx context.Context, userID string) ([]*entities.Schedule, error) {
+	var schedules []*entities.Schedule
+	for _, schedule := range r.schedules {
+		if schedule.UserID == userID {
+			schedules = append(schedules, schedule)
+		}
+	}
+	return schedules, nil
+}
+
+func (r *memoryScheduleRepository) Update(ctx context.Context, schedule *entities.Schedule) error {
+	if _, ok := r.schedules[schedule.ID]; !ok {
+		return database.ErrEntityNotFound
+	}
+	copied := *schedule
+	r.schedules[schedule.ID] = &copied
+	return nil
+}
+
+func (r *memoryScheduleRepository) Delete(ctx context.Context, scheduleID string) error {
+	if _, ok := r.schedules[scheduleID]; !ok {
+		return database.ErrEntityNotFound
+	}
+	delete(r.schedules, scheduleID)
+	return nil
+}
+
+func (r *memoryScheduleRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*entities.Schedule, error) {
+	return nil, nil
+}
+
+func (r *memoryScheduleRepository) MarkFired(ctx context.Context, scheduleID string, expectedNextRun, firedAt, nextRun time.Time) (bool, error) {
+	return false, nil
+}
+
+func newScheduleTestUsers() *MockUserRepository {
+	return &MockUserRepository{
+		FindByIDFunc: func(ctx context.Context, userID string) (*entities.User, error) {
+			if userID != publishTestUserID {
+				return nil, database.ErrEntityNotFound
+			}
+			return &entities.User{ID: publishTestUserID}, nil
+		},
+	}
+}
+
+// TestManageSchedulesUseCase_Lifecycle validates create, update and delete of a schedule
+func TestManageSchedulesUseCase_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemoryScheduleRepository()
+	uc := usecases.NewManageSchedulesUseCase(repo, newScheduleTestUsers())
+
+	schedule, err := uc.Create(ctx, usecases.ScheduleInput{
+		UserID:         publishTestUserID,
+		Name:           "Weekday mornings",
+		CronExpression: "0 9 * * 1-5",
+		TimeZone:       "Europe/Madrid",
+		Actions:        []entities.ScheduleAction{entities.ScheduleActionGenerateIdeas},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !schedule.Active {
+		t.Error("expected new schedules to be active")
+	}
+	if schedule.NextRunAt == nil || !schedule.NextRunAt.After(time.Now()) {
+		t.Fatalf("expected a future next run, got %v", schedule.NextRunAt)
+	}
+	madrid, _ := time.LoadLocation("Europe/Madrid")
+	if local := schedule.NextRunAt.In(madrid); local.Hour() != 9 || local.Minute() != 0 {
+		t.Errorf("expected next run at 09:00 Madrid time, got %v", local)
+	}
+
+	inactive := false
+	updated, err := uc.Update(ctx, schedule.ID, usecases.ScheduleInput{
+		UserID:         publishTestUserID,
+		CronExpression: "@daily",
+		Actions:        []entities.ScheduleAction{entities.ScheduleActionPublishNext},
+		Active:         &inactive,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.TimeZone != entities.DefaultScheduleZone {
+		t.Errorf("expected default time zone, got %q", updated.TimeZone)
+	}
+	if updated.NextRunAt != nil {
+		t.Errorf("expected inactive schedule to have no next run, got %v", updated.NextRunAt)
+	}
+
+	schedules, err := uc.List(ctx, publishTestUserID)
+	if err != nil || len(schedules) != 1 {
+		t.Fatalf("expected one schedule, got %d (%v)", len(schedules), err)
+	}
+
+	if err := uc.Delete(ctx, publishTestUserID, schedule.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var notFound *domainErrors.ErrScheduleNotFound
+	if _, err := uc.Get(ctx, publishTestUserID, schedule.ID); !errors.As(err, &notFound) {
+		t.Errorf("expected ErrScheduleNotFound after delete, got %v", err)
+	}
+}
+
+// TestManageSchedulesUseCase_Errors validates domain errors returned to handlers
+func TestManageSchedulesUseCase_Errors(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemoryScheduleRepository()
+	uc := usecases.NewManageSchedulesUseCase(repo, newScheduleTestUsers())
+
+	valid := usecases.ScheduleInput{
+		UserID:         publishTestUserID,
+		CronExpression: "0 9 * * *",
+		Actions:        []entities.ScheduleAction{entities.ScheduleActionGenerateIdeas},
+	}
+
+	var validationErr *domainErrors.ErrValidation
+	for name, mutate := range map[string]func(*usecases.ScheduleInput){
+		"invalid cron":      func(in *usecases.ScheduleInput) { in.CronExpression = "61 * * * *" },
+		"unknown time zone": func(in *usecases.ScheduleInput) { in.TimeZone = "Mars/Olympus" },
+		"no actions":        func(in *usecases.ScheduleInput) { in.Actions = nil },
+		"unknown action":    func(in *usecases.ScheduleInput) { in.Actions = []entities.ScheduleAction{"post-everything"} },
+		"never fires":       func(in *usecases.ScheduleInput) { in.CronExpression = "0 0 30 2 *" },
+	} {
+		input := valid
+		mutate(&input)
+		if _, err := uc.Create(ctx, input); !errors.As(err, &validationErr) {
+			t.Errorf("%s: expected validation error, got %v", name, err)
+		}
+	}
+
+	var userNotFound *domainErrors.ErrUserNotFound
+	input := valid
+	input.UserID = "675337baf901e2d790aabbff"
+	if _, err := uc.Create(ctx, input); !errors.As(err, &userNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+
+	schedule, err := uc.Create(ctx, valid)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var unauthorized *domainErrors.ErrUnauthorizedAccess
+	if err := uc.Delete(ctx, "675337baf901e2d790aabbff", schedule.ID); !errors.As(err, &unauthorized) {
+		t.Errorf("expected ErrUnauthorizedAccess, got %v", err)
+	}
+}
+
+// fakeScheduledGenerators records calls made by scheduled actions
+type fakeScheduledGenerators struct {
+	ideasFor    []string
+	draftsFor   []string
+	publishedID []string
+}
+
+func (f *fakeScheduledGenerators) GenerateIdeasForUser(ctx context.Context, userID string, count int) ([]*entities.Idea, error) {
+	f.ideasFor = append(f.ideasFor, userID)
+	return nil, nil
+}
+
+type fakeDraftsGenerator struct{ f *fakeScheduledGenerators }
+
+func (g fakeDraftsGenerator) Execute(ctx context.Context, input usecases.GenerateDraftsInput) ([]*entities.Draft, error) {
+	g.f.draftsFor = append(g.f.draftsFor, input.IdeaID)
+	return nil, nil
+}
+
+type fakeDraftPublisher struct{ f *fakeScheduledGenerators }
+
+func (p fakeDraftPublisher) Execute(ctx context.Context, input usecases.PublishDraftInput) (*entities.Draft, error) {
+	p.f.publishedID = append(p.f.publishedID, input.DraftID)
+	return nil, nil
+}
+
+// TestRunScheduledActionUseCase validates how each scheduled action picks its work
+func TestRunScheduledActionUseCase(t *testing.T) {
+	ctx := context.Background()
+	score := func(v float64) *float64 { return &v }
+	now := time.Now()
+
+	ideas := &MockIdeasRepository{
+		ListByUserIDFunc: func(ctx context.Context, userID string, topicID string, limit int) ([]*entities.Idea, error) {
+			return []*entities.Idea{
+				{ID: "used", QualityScore: score(0.99), Used: true, CreatedAt: now},
+				{ID: "unscored", CreatedAt: now},
+				{ID: "best-old", QualityScore: score(0.9), CreatedAt: now.Add(-time.Hour)},
+				{ID: "best-new", QualityScore: score(0.9), CreatedAt: now},
+				{ID: "weak", QualityScore: score(0.4), CreatedAt: now},
+			}, nil
+		},
+	}
+	drafts := &MockDraftRepository{
+		FindReadyForPublishingFunc: func(ctx context.Context, userID string) ([]*entities.Draft, error) {
+			return []*entities.Draft{
+				{ID: "plain", Status: entities.DraftStatusDraft},
+				{ID: "approved", Status: entities.DraftStatusRefined},
+				{ID: "approved-later", Status: entities.DraftStatusRefined},
+			}, nil
+		},
+	}
+
+	calls := &fakeScheduledGenerators{}
+	uc := usecases.NewRunScheduledActionUseCase(ideas, drafts, calls, fakeDraftsGenerator{calls}, fakeDraftPublisher{calls})
+
+	for _, action := range []entities.ScheduleAction{
+		entities.ScheduleActionGenerateIdeas,
+		entities.ScheduleActionGenerateDrafts,
+		entities.ScheduleActionPublishNext,
+	} {
+		err := uc.Execute(ctx, usecases.RunScheduledActionInput{UserID: publishTestUserID, Action: action})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", action, err)
+		}
+	}
+
+	if len(calls.ideasFor) != 1 || calls.ideasFor[0] != publishTestUserID {
+		t.Errorf("expected ideas generated for the user, got %v", calls.ideasFor)
+	}
+	if len(calls.draftsFor) != 1 || calls.draftsFor[0] != "best-new" {
+		t.Errorf("expected drafts for the newest best idea, got %v", calls.draftsFor)
+	}
+	if len(calls.publishedID) != 1 || calls.publishedID[0] != "approved" {
+		t.Errorf("expected the oldest refined draft to be published, got %v", calls.publishedID)
+	}
+
+	empty := usecases.NewRunScheduledActionUseCase(&MockIdeasRepository{}, &MockDraftRepository{}, calls, fakeDraftsGenerator{calls}, fakeDraftPublisher{calls})
+	for _, action := range []entities.ScheduleAction{entities.ScheduleActionGenerateDrafts, entities.ScheduleActionPublishNext} {
+		err := empty.Execute(ctx, usecases.RunScheduledActionInput{UserID: publishTestUserID, Action: action})
+		if !errors.Is(err, usecases.ErrNothingScheduled) {
+			t.Errorf("%s: expected ErrNothingScheduled, got %v", action, err)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/linkgen-ai/backend/src/application/usecases"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/fakellm"
+)
+
+// TestGenerateDraftsUseCase_RepairsInvalidJSON validates the schema request and the repair round-trip end to end
+func TestGenerateDraftsUseCase_RepairsInvalidJSON(t *testing.T) {
+	tests := []struct {
+		name         string
+		times        int
+		disabled     bool
+		wantRequests int
+		wantErr      bool
+	}{
+		{name: "repaired on the second call", times: 1, wantRequests: 2},
+		{name: "fails after one repair", times: 2, wantRequests: 2, wantErr: true},
+		{name: "no response_format when disabled", times: 1, disabled: true, wantRequests: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules := append([]fakellm.Rule{{
+				Name:     "missing-articles",
+				Pattern:  regexp.MustCompile(`"posts"`),
+				Response: fakellm.Response{Content: `{"posts": ["solo un post"]}`},
+				Times:    tt.times,
+			}}, fakellm.DefaultRules()...)
+			server := fakellm.NewTestServer(fakellm.Options{Rules: rules})
+			defer server.Close()
+
+			config := server.ClientConfig()
+			config.DisableStructuredOutput = tt.disabled
+			client, err := llm.NewLLMHTTPClient(config)
+			if err != nil {
+				t.Fatalf("failed to create LLM client: %v", err)
+			}
+
+			input := usecases.GenerateDraftsInput{UserID: publishTestUserID, IdeaID: fakeLLMIdeaID}
+			_, err = newReplayDraftsUseCase(defaultPromptsRepository{}, client).Execute(context.Background(), input)
+
+			requests := server.Requests()
+			if len(requests) != tt.wantRequests {
+				t.Fatalf("expected %d requests, got %d", tt.wantRequests, len(requests))
+			}
+			if !strings.Contains(requests[1].Prompt, `missing required property "articles"`) {
+				t.Errorf("expected the repair prompt to explain the problem, got %q", requests[1].Prompt)
+			}
+
+			format := requests[0].ResponseFormat
+			if tt.disabled {
+				if format != nil {
+					t.Errorf("expected no response_format, got %+v", format)
+				}
+			} else if format == nil || format.Type != "json_schema" || format.JSONSchema.Name != "drafts" || !format.JSONSchema.Strict {
+				t.Errorf("expected a strict drafts json_schema, got %+v", format)
+			}
+
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("expected the repaired response to be used, got %v", err)
+				}
+				return
+			}
+			var llmErr *domainErrors.LLMResponseError
+			if !errors.As(err, &llmErr) || llmErr.Operation != "drafts_schema" {
+				t.Fatalf("expected an LLMResponseError for the drafts schema, got %v", err)
+			}
+			if !strings.Contains(err.Error(), "failed to parse LLM response") {
+				t.Errorf("unexpected error message: %v", err)
+			}
+		})
+	}
+}
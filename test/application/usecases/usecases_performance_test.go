@@ -570,7 +570,7 @@ func TestUseCases_StressTest(t *testing.T) {
 	ctx := context.Background()
 	_ = ctx
 	var wg sync.WaitGroup
-	_ = wg
+	_ = &wg
 
 	tests := []struct {
 		name        string
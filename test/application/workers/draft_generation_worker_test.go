@@ -288,7 +288,7 @@ func TestDraftGenerationWorkerContextCancellation(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ctx, cancel := context.WithTimeout(context.Background(), tt.contextTimeout)
+			_, cancel := context.WithTimeout(context.Background(), tt.contextTimeout)
 			defer cancel()
 
 			// Will fail: Context cancellation doesn't exist yet
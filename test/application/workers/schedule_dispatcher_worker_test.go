@@ -0,0 +1,334 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/application/workers"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+)
+
+// memoryScheduleRepository is an in-memory ScheduleRepository whose MarkFired is a
+// compare-and-set like the MongoDB one
+type memoryScheduleRepository struct {
+	mu        sync.Mutex
+	schedules map[string]*entities.Schedule
+}
+
+func newMemoryScheduleRepository() *memoryScheduleRepository {
+	return &memoryScheduleRepository{schedules: make(map[string]*entities.Schedule)}
+}
+
+func (r *memoryScheduleRepository) add(id string, nextRun time.Time, actions ...entities.ScheduleAction) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.schedules[id] = &entities.Schedule{
+		ID:             id,
+		UserID:         "user-" + id,
+		CronExpression: "0 * * * *",
+		TimeZone:       "UTC",
+		Actions:        actions,
+		Active:         true,
+		NextRunAt:      &nextRun,
+	}
+}
+
+func (r *memoryScheduleRepository) get(id string) entities.Schedule {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return *r.schedules[id]
+}
+
+func (r *memoryScheduleRepository) Create(ctx context.Context, schedule *entities.Schedule) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+func (r *memoryScheduleRepository) FindByID(ctx context.Context, scheduleID string) (*entities.Schedule, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *memoryScheduleRepository) ListByUserID(ctx context.Context, userID string) ([]*entities.Schedule, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *memoryScheduleRepository) Update(ctx context.Context, schedule *entities.Schedule) error {
+	return errors.New("not implemented")
+}
+
+func (r *memoryScheduleRepository) Delete(ctx context.Context, scheduleID string) error {
+	return errors.New("not implemented")
+}
+
+func (r *memoryScheduleRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*entities.Schedule, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var due []*entities.Schedule
+	for _, schedule := range r.schedules {
+		if schedule.IsDue(now) {
+			copied := *schedule
+			due = append(due, &copied)
+		}
+	}
+	return due, nil
+}
+
+func (r *memoryScheduleRepository) MarkFired(ctx context.Context, scheduleID string, expectedNextRun, firedAt, nextRun time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	schedule, ok := r.schedules[scheduleID]
+	if !ok || !schedule.Active || schedule.NextRunAt == nil || !schedule.NextRunAt.Equal(expectedNextRun) {
+		return false, nil
+	}
+
+	schedule.NextRunAt = &nextRun
+	schedule.LastRunAt = &firedAt
+	return true, nil
+}
+
+// memoryLeaseRepository is an in-memory LeaseRepository
+type memoryLeaseRepository struct {
+	mu      sync.Mutex
+	holders map[string]string
+	expires map[string]time.Time
+}
+
+func newMemoryLeaseRepository() *memoryLeaseRepository {
+	return &memoryLeaseRepository{holders: map[string]string{}, expires: map[string]time.Time{}}
+}
+
+func (r *memoryLeaseRepository) Acquire(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	if current, ok := r.holders[name]; ok && current != holder && r.expires[name].After(now) {
+		return false, nil
+	}
+
+	r.holders[name] = holder
+	r.expires[name] = now.Add(ttl)
+	return true, nil
+}
+
+func (r *memoryLeaseRepository) Release(ctx context.Context, name, holder string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.holders[name] == holder {
+		delete(r.holders, name)
+		delete(r.expires, name)
+	}
+	return nil
+}
+
+func (r *memoryLeaseRepository) heldBy(name, holder string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.holders[name] == holder
+}
+
+// recordingActionPublisher records enqueued scheduled actions, failing while err is set
+type recordingActionPublisher struct {
+	mu       sync.Mutex
+	messages []workers.ScheduledActionMessage
+	err      error
+}
+
+func (p *recordingActionPublisher) Publish(ctx context.Context, data interface{}) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	p.messages = append(p.messages, data.(workers.ScheduledActionMessage))
+	return nil
+}
+
+func (p *recordingActionPublisher) fail(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.err = err
+}
+
+func (p *recordingActionPublisher) published() []workers.ScheduledActionMessage {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]workers.ScheduledActionMessage(nil), p.messages...)
+}
+
+func newTestDispatcher(t *testing.T, holder string, schedules *memoryScheduleRepository, leases *memoryLeaseRepository, publisher *recordingActionPublisher, now time.Time) *workers.ScheduleDispatcherWorker {
+	t.Helper()
+
+	worker, err := workers.NewScheduleDispatcherWorker(workers.ScheduleDispatcherConfig{
+		ScheduleRepo: schedules,
+		LeaseRepo:    leases,
+		Publisher:    publisher,
+		Interval:     time.Hour,
+		Holder:       holder,
+		Now:          func() time.Time { return now },
+	})
+	if err != nil {
+		t.Fatalf("failed to create dispatcher: %v", err)
+	}
+	return worker
+}
+
+func TestScheduleDispatcherWorkerCreation(t *testing.T) {
+	if _, err := workers.NewScheduleDispatcherWorker(workers.ScheduleDispatcherConfig{}); !errors.Is(err, workers.ErrNilScheduleRepository) {
+		t.Errorf("expected ErrNilScheduleRepository, got %v", err)
+	}
+
+	_, err := workers.NewScheduleDispatcherWorker(workers.ScheduleDispatcherConfig{
+		ScheduleRepo: newMemoryScheduleRepository(),
+		LeaseRepo:    newMemoryLeaseRepository(),
+	})
+	if !errors.Is(err, workers.ErrNilPublisher) {
+		t.Errorf("expected ErrNilPublisher, got %v", err)
+	}
+}
+
+func TestScheduleDispatcherWorkerFiresDueSchedules(t *testing.T) {
+	now := time.Date(2026, 5, 4, 10, 0, 30, 0, time.UTC)
+	schedules := newMemoryScheduleRepository()
+	schedules.add("due", now.Add(-30*time.Second), entities.ScheduleActionGenerateIdeas, entities.ScheduleActionPublishNext)
+	schedules.add("later", now.Add(time.Hour), entities.ScheduleActionGenerateIdeas)
+
+	publisher := &recordingActionPublisher{}
+	worker := newTestDispatcher(t, "replica-a", schedules, newMemoryLeaseRepository(), publisher, now)
+
+	if fired := worker.DispatchDue(context.Background()); fired != 1 {
+		t.Fatalf("expected 1 schedule fired, got %d", fired)
+	}
+
+	messages := publisher.published()
+	if len(messages) != 2 {
+		t.Fatalf("expected one message per action, got %d", len(messages))
+	}
+	if messages[0].Action != string(entities.ScheduleActionGenerateIdeas) || messages[1].Action != string(entities.ScheduleActionPublishNext) {
+		t.Errorf("unexpected actions enqueued: %+v", messages)
+	}
+	if messages[0].UserID != "user-due" || messages[0].ScheduleID != "due" {
+		t.Errorf("unexpected message target: %+v", messages[0])
+	}
+
+	fired := schedules.get("due")
+	if want := time.Date(2026, 5, 4, 11, 0, 0, 0, time.UTC); !fired.NextRunAt.Equal(want) {
+		t.Errorf("expected next run %v, got %v", want, fired.NextRunAt)
+	}
+	if fired.LastRunAt == nil || !fired.LastRunAt.Equal(now) {
+		t.Errorf("expected last run %v, got %v", now, fired.LastRunAt)
+	}
+
+	// Already advanced, so a second tick does nothing
+	if fired := worker.DispatchDue(context.Background()); fired != 0 {
+		t.Errorf("expected nothing to fire on the second tick, got %d", fired)
+	}
+}
+
+func TestScheduleDispatcherWorkerRetriesWhenEnqueueFails(t *testing.T) {
+	now := time.Date(2026, 5, 4, 10, 0, 30, 0, time.UTC)
+	dueAt := now.Add(-30 * time.Second)
+	schedules := newMemoryScheduleRepository()
+	schedules.add("due", dueAt, entities.ScheduleActionGenerateIdeas)
+
+	publisher := &recordingActionPublisher{}
+	publisher.fail(errors.New("nats: connection closed"))
+	worker := newTestDispatcher(t, "replica-a", schedules, newMemoryLeaseRepository(), publisher, now)
+
+	if fired := worker.DispatchDue(context.Background()); fired != 0 {
+		t.Fatalf("expected nothing to fire while the queue is down, got %d", fired)
+	}
+	if got := schedules.get("due"); !got.NextRunAt.Equal(dueAt) || got.LastRunAt != nil {
+		t.Fatalf("expected the schedule to stay due, got next run %v and last run %v", got.NextRunAt, got.LastRunAt)
+	}
+	if errs := worker.GetMetrics()["publish_errors_total"]; errs != 1 {
+		t.Errorf("expected 1 publish error, got %d", errs)
+	}
+
+	// Once the queue is back the activation is enqueued on the next tick
+	publisher.fail(nil)
+	if fired := worker.DispatchDue(context.Background()); fired != 1 {
+		t.Fatalf("expected the schedule to fire once the queue is back, got %d", fired)
+	}
+	if got := len(publisher.published()); got != 1 {
+		t.Errorf("expected 1 enqueued action, got %d", got)
+	}
+	if want := time.Date(2026, 5, 4, 11, 0, 0, 0, time.UTC); !schedules.get("due").NextRunAt.Equal(want) {
+		t.Errorf("expected next run %v, got %v", want, schedules.get("due").NextRunAt)
+	}
+}
+
+func TestScheduleDispatcherWorkerSingleLeaseHolder(t *testing.T) {
+	now := time.Now()
+	schedules := newMemoryScheduleRepository()
+	for _, id := range []string{"a", "b", "c"} {
+		schedules.add(id, now.Add(-time.Minute), entities.ScheduleActionGenerateIdeas)
+	}
+
+	leases := newMemoryLeaseRepository()
+	publisher := &recordingActionPublisher{}
+	replicas := []*workers.ScheduleDispatcherWorker{
+		newTestDispatcher(t, "replica-a", schedules, leases, publisher, now),
+		newTestDispatcher(t, "replica-b", schedules, leases, publisher, now),
+	}
+
+	var wg sync.WaitGroup
+	for _, replica := range replicas {
+		wg.Add(1)
+		go func(w *workers.ScheduleDispatcherWorker) {
+			defer wg.Done()
+			w.DispatchDue(context.Background())
+		}(replica)
+	}
+	wg.Wait()
+
+	if got := len(publisher.published()); got != 3 {
+		t.Errorf("expected each schedule to fire exactly once, got %d messages", got)
+	}
+
+	misses := replicas[0].GetMetrics()["lease_misses_total"] + replicas[1].GetMetrics()["lease_misses_total"]
+	if misses != 1 {
+		t.Errorf("expected exactly one replica to miss the lease, got %d", misses)
+	}
+}
+
+func TestScheduleDispatcherWorkerReleasesLeaseOnStop(t *testing.T) {
+	now := time.Now()
+	schedules := newMemoryScheduleRepository()
+	leases := newMemoryLeaseRepository()
+	publisher := &recordingActionPublisher{}
+
+	first := newTestDispatcher(t, "replica-a", schedules, leases, publisher, now)
+	if err := first.Start(context.Background()); err != nil {
+		t.Fatalf("failed to start: %v", err)
+	}
+	if err := first.Start(context.Background()); !errors.Is(err, workers.ErrAlreadyRunning) {
+		t.Errorf("expected ErrAlreadyRunning, got %v", err)
+	}
+
+	// The first tick happens immediately on start and takes the lease
+	deadline := time.Now().Add(time.Second)
+	for !leases.heldBy(workers.ScheduleDispatcherLease, "replica-a") {
+		if time.Now().After(deadline) {
+			t.Fatal("expected the started replica to take the lease")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if err := first.Stop(time.Second); err != nil {
+		t.Fatalf("failed to stop: %v", err)
+	}
+
+	// The stopped replica handed the lease over, so another one takes it immediately
+	schedules.add("due", now.Add(-time.Minute), entities.ScheduleActionGenerateIdeas)
+	second := newTestDispatcher(t, "replica-b", schedules, leases, publisher, now)
+	if fired := second.DispatchDue(context.Background()); fired != 1 {
+		t.Errorf("expected the next replica to take over the lease, fired %d", fired)
+	}
+}
@@ -0,0 +1,485 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/application/workers"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+)
+
+// memoryDraftRepository is an in-memory DraftRepository whose claim is atomic like the MongoDB one
+type memoryDraftRepository struct {
+	mu      sync.Mutex
+	drafts  map[string]*entities.Draft
+	leases  map[string]time.Time
+	updates map[string]map[string]interface{}
+}
+
+func newMemoryDraftRepository() *memoryDraftRepository {
+	return &memoryDraftRepository{
+		drafts:  make(map[string]*entities.Draft),
+		leases:  make(map[string]time.Time),
+		updates: make(map[string]map[string]interface{}),
+	}
+}
+
+func (r *memoryDraftRepository) addScheduled(id string, scheduledAt time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.drafts[id] = &entities.Draft{
+		ID:          id,
+		UserID:      "user-" + id,
+		Type:        entities.DraftTypePost,
+		Content:     "Scheduled post about Clean Architecture",
+		Status:      entities.DraftStatusScheduled,
+		ScheduledAt: &scheduledAt,
+	}
+}
+
+// addPublishing adds a draft a publisher claimed until leaseUntil, without a schedule
+func (r *memoryDraftRepository) addPublishing(id string, leaseUntil time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.drafts[id] = &entities.Draft{
+		ID:      id,
+		UserID:  "user-" + id,
+		Type:    entities.DraftTypePost,
+		Content: "Manually published post about Clean Architecture",
+		Status:  entities.DraftStatusPublishing,
+	}
+	r.leases[id] = leaseUntil
+}
+
+func (r *memoryDraftRepository) isDue(draft *entities.Draft, now time.Time) bool {
+	if !draft.IsDueForPublishing(now) && draft.Status != entities.DraftStatusPublishing {
+		return false
+	}
+	lease, leased := r.leases[draft.ID]
+	return !leased || !lease.After(now)
+}
+
+func (r *memoryDraftRepository) Create(ctx context.Context, draft *entities.Draft) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+func (r *memoryDraftRepository) FindByID(ctx context.Context, draftID string) (*entities.Draft, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	draft, ok := r.drafts[draftID]
+	if !ok {
+		return nil, errors.New("draft not found")
+	}
+	copied := *draft
+	return &copied, nil
+}
+
+func (r *memoryDraftRepository) Update(ctx context.Context, draftID string, updates map[string]interface{}) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	draft, ok := r.drafts[draftID]
+	if !ok {
+		return errors.New("draft not found")
+	}
+	if status, ok := updates["status"].(entities.DraftStatus); ok {
+		draft.Status = status
+	}
+	r.updates[draftID] = updates
+	return nil
+}
+
+func (r *memoryDraftRepository) UpdateIfStatus(ctx context.Context, draftID string, expected entities.DraftStatus, updates map[string]interface{}) (bool, error) {
+	r.mu.Lock()
+	draft, ok := r.drafts[draftID]
+	matches := ok && draft.Status == expected
+	r.mu.Unlock()
+
+	if !matches {
+		return false, nil
+	}
+	return true, r.Update(ctx, draftID, updates)
+}
+
+func (r *memoryDraftRepository) Delete(ctx context.Context, draftID string) error {
+	return nil
+}
+
+func (r *memoryDraftRepository) ListByUserID(ctx context.Context, userID string, status entities.DraftStatus, draftType entities.DraftType) ([]*entities.Draft, error) {
+	return nil, nil
+}
+
+func (r *memoryDraftRepository) UpdateStatus(ctx context.Context, draftID string, status entities.DraftStatus) error {
+	return r.Update(ctx, draftID, map[string]interface{}{"status": status})
+}
+
+func (r *memoryDraftRepository) AppendRefinement(ctx context.Context, draftID string, entry entities.RefinementEntry) error {
+	return nil
+}
+
+func (r *memoryDraftRepository) FindReadyForPublishing(ctx context.Context, userID string) ([]*entities.Draft, error) {
+	return nil, nil
+}
+
+func (r *memoryDraftRepository) FindDueForPublishing(ctx context.Context, now time.Time, limit int) ([]*entities.Draft, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	due := make([]*entities.Draft, 0)
+	for _, draft := range r.drafts {
+		if r.isDue(draft, now) {
+			copied := *draft
+			due = append(due, &copied)
+		}
+	}
+
+	// Like MongoDB, a missing schedule sorts first
+	sort.Slice(due, func(i, j int) bool {
+		if due[i].ScheduledAt == nil || due[j].ScheduledAt == nil {
+			return due[i].ScheduledAt == nil && due[j].ScheduledAt != nil
+		}
+		return due[i].ScheduledAt.Before(*due[j].ScheduledAt)
+	})
+
+	if limit > 0 && len(due) > limit {
+		due = due[:limit]
+	}
+	return due, nil
+}
+
+func (r *memoryDraftRepository) ClaimForPublishing(ctx context.Context, draftID string, now time.Time, leaseUntil time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	draft, ok := r.drafts[draftID]
+	if !ok || !r.isDue(draft, now) {
+		return false, nil
+	}
+	if draft.Status == entities.DraftStatusPublishing {
+		draft.Status = entities.DraftStatusScheduled
+		if draft.ScheduledAt == nil {
+			scheduledAt := now
+			draft.ScheduledAt = &scheduledAt
+		}
+	}
+	r.leases[draftID] = leaseUntil
+	return true, nil
+}
+
+func (r *memoryDraftRepository) status(draftID string) entities.DraftStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.drafts[draftID].Status
+}
+
+// recordingPublisher publishes drafts against a memoryDraftRepository and counts calls per draft
+type recordingPublisher struct {
+	repo  *memoryDraftRepository
+	err   error
+	delay time.Duration
+
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func newRecordingPublisher(repo *memoryDraftRepository) *recordingPublisher {
+	return &recordingPublisher{repo: repo, calls: make(map[string]int)}
+}
+
+func (p *recordingPublisher) Execute(ctx context.Context, input workers.PublishDraftInput) error {
+	p.mu.Lock()
+	p.calls[input.DraftID]++
+	p.mu.Unlock()
+
+	if p.delay > 0 {
+		time.Sleep(p.delay)
+	}
+	if p.err != nil {
+		return p.err
+	}
+	return p.repo.UpdateStatus(ctx, input.DraftID, entities.DraftStatusPublished)
+}
+
+func (p *recordingPublisher) totalCalls() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	total := 0
+	for _, count := range p.calls {
+		total += count
+	}
+	return total
+}
+
+func newTestPublisherWorker(t *testing.T, repo *memoryDraftRepository, useCase workers.PublishDraftUseCase, batchSize int) *workers.ScheduledPublisherWorker {
+	t.Helper()
+
+	worker, err := workers.NewScheduledPublisherWorker(workers.ScheduledPublisherConfig{
+		DraftRepo: repo,
+		UseCase:   useCase,
+		Interval:  time.Hour,
+		BatchSize: batchSize,
+	})
+	if err != nil {
+		t.Fatalf("failed to create worker: %v", err)
+	}
+	return worker
+}
+
+// TestScheduledPublisherWorkerCreation validates worker initialization
+func TestScheduledPublisherWorkerCreation(t *testing.T) {
+	repo := newMemoryDraftRepository()
+
+	if _, err := workers.NewScheduledPublisherWorker(workers.ScheduledPublisherConfig{DraftRepo: repo}); !errors.Is(err, workers.ErrNilUseCase) {
+		t.Errorf("expected ErrNilUseCase, got %v", err)
+	}
+
+	if _, err := workers.NewScheduledPublisherWorker(workers.ScheduledPublisherConfig{UseCase: newRecordingPublisher(repo)}); !errors.Is(err, workers.ErrNilDraftRepository) {
+		t.Errorf("expected ErrNilDraftRepository, got %v", err)
+	}
+}
+
+// TestScheduledPublisherWorkerPublishesDueDrafts validates only due drafts are published
+func TestScheduledPublisherWorkerPublishesDueDrafts(t *testing.T) {
+	repo := newMemoryDraftRepository()
+	now := time.Now()
+	repo.addScheduled("due", now.Add(-time.Minute))
+	repo.addScheduled("future", now.Add(time.Hour))
+
+	publisher := newRecordingPublisher(repo)
+	worker := newTestPublisherWorker(t, repo, publisher, 10)
+
+	if published := worker.PublishDue(context.Background()); published != 1 {
+		t.Fatalf("expected 1 published draft, got %d", published)
+	}
+
+	if repo.status("due") != entities.DraftStatusPublished {
+		t.Errorf("expected due draft to be published, got %s", repo.status("due"))
+	}
+	if repo.status("future") != entities.DraftStatusScheduled {
+		t.Errorf("expected future draft to stay scheduled, got %s", repo.status("future"))
+	}
+}
+
+// TestScheduledPublisherWorkerReclaimsAbandonedPublishes validates a PUBLISHING draft is
+// published again once the lease of its publisher expired
+func TestScheduledPublisherWorkerReclaimsAbandonedPublishes(t *testing.T) {
+	repo := newMemoryDraftRepository()
+	now := time.Now()
+	repo.addPublishing("abandoned", now.Add(-time.Second))
+	repo.addPublishing("in-progress", now.Add(time.Minute))
+
+	publisher := newRecordingPublisher(repo)
+	worker := newTestPublisherWorker(t, repo, publisher, 10)
+
+	if published := worker.PublishDue(context.Background()); published != 1 {
+		t.Fatalf("expected 1 published draft, got %d", published)
+	}
+
+	if repo.status("abandoned") != entities.DraftStatusPublished {
+		t.Errorf("expected abandoned draft to be published, got %s", repo.status("abandoned"))
+	}
+	if repo.status("in-progress") != entities.DraftStatusPublishing {
+		t.Errorf("expected draft with a live lease to stay PUBLISHING, got %s", repo.status("in-progress"))
+	}
+	if publisher.calls["in-progress"] != 0 {
+		t.Errorf("expected draft with a live lease not to be published, got %d calls", publisher.calls["in-progress"])
+	}
+}
+
+// TestScheduledPublisherWorkerRespectsBatchSize validates one tick never exceeds the batch size
+func TestScheduledPublisherWorkerRespectsBatchSize(t *testing.T) {
+	repo := newMemoryDraftRepository()
+	now := time.Now()
+	for i := 0; i < 5; i++ {
+		repo.addScheduled(fmt.Sprintf("draft-%d", i), now.Add(-time.Duration(i+1)*time.Minute))
+	}
+
+	publisher := newRecordingPublisher(repo)
+	worker := newTestPublisherWorker(t, repo, publisher, 2)
+
+	if published := worker.PublishDue(context.Background()); published != 2 {
+		t.Fatalf("expected 2 published drafts, got %d", published)
+	}
+
+	// Oldest schedules go first
+	if repo.status("draft-4") != entities.DraftStatusPublished || repo.status("draft-3") != entities.DraftStatusPublished {
+		t.Error("expected the two oldest scheduled drafts to be published first")
+	}
+}
+
+// TestScheduledPublisherWorkerNoDoublePublish validates concurrent instances publish each draft once
+func TestScheduledPublisherWorkerNoDoublePublish(t *testing.T) {
+	repo := newMemoryDraftRepository()
+	now := time.Now()
+	for i := 0; i < 20; i++ {
+		repo.addScheduled(fmt.Sprintf("draft-%d", i), now.Add(-time.Minute))
+	}
+
+	publisher := newRecordingPublisher(repo)
+	publisher.delay = time.Millisecond
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		worker := newTestPublisherWorker(t, repo, publisher, 20)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			worker.PublishDue(context.Background())
+		}()
+	}
+	wg.Wait()
+
+	for draftID, count := range publisher.calls {
+		if count != 1 {
+			t.Errorf("draft %s published %d times", draftID, count)
+		}
+	}
+	if publisher.totalCalls() != 20 {
+		t.Errorf("expected 20 publish calls, got %d", publisher.totalCalls())
+	}
+}
+
+// TestScheduledPublisherWorkerStartPicksUpOverdueDrafts validates drafts scheduled before a restart are published on start
+func TestScheduledPublisherWorkerStartPicksUpOverdueDrafts(t *testing.T) {
+	repo := newMemoryDraftRepository()
+	repo.addScheduled("overdue", time.Now().Add(-time.Hour))
+
+	publisher := newRecordingPublisher(repo)
+	worker := newTestPublisherWorker(t, repo, publisher, 10)
+
+	if err := worker.Start(context.Background()); err != nil {
+		t.Fatalf("failed to start worker: %v", err)
+	}
+	if err := worker.Start(context.Background()); !errors.Is(err, workers.ErrAlreadyRunning) {
+		t.Errorf("expected ErrAlreadyRunning, got %v", err)
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for repo.status("overdue") != entities.DraftStatusPublished && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if err := worker.Stop(time.Second); err != nil {
+		t.Fatalf("failed to stop worker: %v", err)
+	}
+	if worker.IsRunning() {
+		t.Error("expected worker to be stopped")
+	}
+	if repo.status("overdue") != entities.DraftStatusPublished {
+		t.Errorf("expected overdue draft to be published, got %s", repo.status("overdue"))
+	}
+}
+
+// TestScheduledPublisherWorkerRecordsRejections validates drafts that cannot be published are marked as failed
+func TestScheduledPublisherWorkerRecordsRejections(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus entities.DraftStatus
+		expectedReason string
+	}{
+		{
+			name:           "missing credentials fail the draft",
+			err:            domainErrors.NewInvalidUserCredentials("user", "LinkedIn access token not configured"),
+			expectedStatus: entities.DraftStatusFailed,
+			expectedReason: "credentials_invalid",
+		},
+		{
+			name:           "invalid draft fails the draft",
+			err:            domainErrors.NewValidationError("draft", "post content too short"),
+			expectedStatus: entities.DraftStatusFailed,
+			expectedReason: "rejected",
+		},
+		{
+			name:           "transient errors keep the draft scheduled",
+			err:            errors.New("database unavailable"),
+			expectedStatus: entities.DraftStatusScheduled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMemoryDraftRepository()
+			repo.addScheduled("draft", time.Now().Add(-time.Minute))
+
+			publisher := newRecordingPublisher(repo)
+			publisher.err = tt.err
+			worker := newTestPublisherWorker(t, repo, publisher, 10)
+
+			worker.PublishDue(context.Background())
+
+			if repo.status("draft") != tt.expectedStatus {
+				t.Fatalf("expected status %s, got %s", tt.expectedStatus, repo.status("draft"))
+			}
+			if tt.expectedReason == "" {
+				return
+			}
+
+			publishErr, ok := repo.updates["draft"]["publish_error"].(*entities.PublishError)
+			if !ok || publishErr.Reason != tt.expectedReason {
+				t.Errorf("expected failure reason %s, got %v", tt.expectedReason, repo.updates["draft"]["publish_error"])
+			}
+			if worker.GetMetrics()["failures_total"] != 1 {
+				t.Errorf("expected 1 failure, got %d", worker.GetMetrics()["failures_total"])
+			}
+		})
+	}
+}
+
+// publishFunc adapts a function to workers.PublishDraftUseCase
+type publishFunc func(ctx context.Context, input workers.PublishDraftInput) error
+
+func (f publishFunc) Execute(ctx context.Context, input workers.PublishDraftInput) error {
+	return f(ctx, input)
+}
+
+// TestScheduledPublisherWorkerYieldsToManualPublish validates a draft taken by a manual publish is left alone
+func TestScheduledPublisherWorkerYieldsToManualPublish(t *testing.T) {
+	t.Run("lost claim is a conflict, not a failure", func(t *testing.T) {
+		repo := newMemoryDraftRepository()
+		repo.addScheduled("draft", time.Now().Add(-time.Minute))
+
+		publisher := newRecordingPublisher(repo)
+		publisher.err = domainErrors.NewDraftPublishInProgress("draft")
+		worker := newTestPublisherWorker(t, repo, publisher, 10)
+
+		worker.PublishDue(context.Background())
+
+		metrics := worker.GetMetrics()
+		if metrics["failures_total"] != 0 {
+			t.Errorf("expected no failures, got %d", metrics["failures_total"])
+		}
+		if metrics["claim_conflict_total"] != 1 {
+			t.Errorf("expected 1 claim conflict, got %d", metrics["claim_conflict_total"])
+		}
+	})
+
+	t.Run("rejection does not overwrite a draft taken meanwhile", func(t *testing.T) {
+		repo := newMemoryDraftRepository()
+		repo.addScheduled("draft", time.Now().Add(-time.Minute))
+
+		publisher := publishFunc(func(ctx context.Context, input workers.PublishDraftInput) error {
+			if err := repo.UpdateStatus(ctx, input.DraftID, entities.DraftStatusPublishing); err != nil {
+				return err
+			}
+			return domainErrors.NewValidationError("draft", "post content too short")
+		})
+		worker := newTestPublisherWorker(t, repo, publisher, 10)
+
+		worker.PublishDue(context.Background())
+
+		if repo.status("draft") != entities.DraftStatusPublishing {
+			t.Errorf("expected status PUBLISHING, got %s", repo.status("draft"))
+		}
+	})
+}
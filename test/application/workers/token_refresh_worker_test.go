@@ -0,0 +1,266 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/application/workers"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+)
+
+// memoryUserRepository is an in-memory UserRepository tracking LinkedIn token expiry
+type memoryUserRepository struct {
+	mu    sync.Mutex
+	users map[string]*entities.User
+}
+
+func newMemoryUserRepository() *memoryUserRepository {
+	return &memoryUserRepository{users: make(map[string]*entities.User)}
+}
+
+func (r *memoryUserRepository) addUser(id string, expiresAt time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.users[id] = &entities.User{
+		ID:                     id,
+		Active:                 true,
+		LinkedInToken:          "token-" + id,
+		LinkedInRefreshToken:   "refresh-" + id,
+		LinkedInTokenExpiresAt: &expiresAt,
+	}
+}
+
+func (r *memoryUserRepository) Create(ctx context.Context, user *entities.User) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+func (r *memoryUserRepository) FindByID(ctx context.Context, userID string) (*entities.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.users[userID]
+	if !ok {
+		return nil, errors.New("user not found")
+	}
+	copied := *user
+	return &copied, nil
+}
+
+func (r *memoryUserRepository) FindByEmail(ctx context.Context, email string) (*entities.User, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *memoryUserRepository) Update(ctx context.Context, userID string, updates map[string]interface{}) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.users[userID]
+	if !ok {
+		return errors.New("user not found")
+	}
+	if reason, ok := updates["linkedin_token_invalid_reason"].(string); ok {
+		user.LinkedInTokenInvalidReason = reason
+	}
+	return nil
+}
+
+func (r *memoryUserRepository) UpdateLinkedInToken(ctx context.Context, userID string, token string) error {
+	return errors.New("not implemented")
+}
+
+func (r *memoryUserRepository) UpdateLinkedInCredentials(ctx context.Context, user *entities.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	copied := *user
+	r.users[user.ID] = &copied
+	return nil
+}
+
+func (r *memoryUserRepository) FindWithExpiringLinkedInToken(ctx context.Context, before time.Time, limit int) ([]*entities.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	expiring := make([]*entities.User, 0)
+	for _, user := range r.users {
+		if user.Active && user.LinkedInTokenInvalidReason == "" &&
+			user.LinkedInTokenExpiresAt != nil && !user.LinkedInTokenExpiresAt.After(before) {
+			copied := *user
+			expiring = append(expiring, &copied)
+		}
+	}
+	sort.Slice(expiring, func(i, j int) bool {
+		return expiring[i].LinkedInTokenExpiresAt.Before(*expiring[j].LinkedInTokenExpiresAt)
+	})
+	if len(expiring) > limit {
+		expiring = expiring[:limit]
+	}
+	return expiring, nil
+}
+
+func (r *memoryUserRepository) Delete(ctx context.Context, userID string) error {
+	return errors.New("not implemented")
+}
+
+// refreshingUseCase emulates the refresh use case against the in-memory repository
+type refreshingUseCase struct {
+	repo     *memoryUserRepository
+	now      time.Time
+	rejected map[string]bool
+	failing  map[string]bool
+}
+
+func (u *refreshingUseCase) Execute(ctx context.Context, input workers.RefreshLinkedInTokenInput) error {
+	user, err := u.repo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return err
+	}
+
+	if u.failing[input.UserID] {
+		return domainErrors.NewLinkedInAPIError("refresh_token", domainErrors.LinkedInReasonServerError, 503, "unavailable", nil)
+	}
+
+	if u.rejected[input.UserID] {
+		user.MarkLinkedInTokenUnrecoverable("refresh token was rejected by LinkedIn")
+		_ = u.repo.Update(ctx, user.ID, map[string]interface{}{"linkedin_token_invalid_reason": user.LinkedInTokenInvalidReason})
+		return domainErrors.NewInvalidUserCredentials(user.ID, user.PublishBlockedReason())
+	}
+
+	if err := user.SetLinkedInCredentials("fresh-"+user.ID, "", u.now.Add(60*24*time.Hour)); err != nil {
+		return err
+	}
+	return u.repo.UpdateLinkedInCredentials(ctx, user)
+}
+
+func newTestTokenRefreshWorker(t *testing.T, repo *memoryUserRepository, useCase workers.RefreshLinkedInTokenUseCase, now time.Time) *workers.TokenRefreshWorker {
+	t.Helper()
+
+	worker, err := workers.NewTokenRefreshWorker(workers.TokenRefreshConfig{
+		UserRepo: repo,
+		UseCase:  useCase,
+		Window:   24 * time.Hour,
+		Now:      func() time.Time { return now },
+	})
+	if err != nil {
+		t.Fatalf("failed to create worker: %v", err)
+	}
+	return worker
+}
+
+// TestTokenRefreshWorker_RefreshesExpiringTokens validates only tokens inside the window are refreshed
+func TestTokenRefreshWorker_RefreshesExpiringTokens(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	repo := newMemoryUserRepository()
+	repo.addUser("expired", now.Add(-time.Hour))
+	repo.addUser("expiring", now.Add(2*time.Hour))
+	repo.addUser("healthy", now.Add(30*24*time.Hour))
+
+	worker := newTestTokenRefreshWorker(t, repo, &refreshingUseCase{repo: repo, now: now}, now)
+
+	if refreshed := worker.RefreshExpiring(context.Background()); refreshed != 2 {
+		t.Fatalf("expected 2 tokens refreshed, got %d", refreshed)
+	}
+
+	for _, id := range []string{"expired", "expiring"} {
+		user, _ := repo.FindByID(context.Background(), id)
+		if user.LinkedInToken != "fresh-"+id {
+			t.Errorf("expected %s token to be refreshed, got %q", id, user.LinkedInToken)
+		}
+		if user.LinkedInRefreshToken != "refresh-"+id {
+			t.Errorf("expected %s refresh token to be kept, got %q", id, user.LinkedInRefreshToken)
+		}
+	}
+
+	healthy, _ := repo.FindByID(context.Background(), "healthy")
+	if healthy.LinkedInToken != "token-healthy" {
+		t.Error("tokens outside the refresh window must not be refreshed")
+	}
+
+	// Refreshed tokens leave the window, so the next poll has nothing to do
+	if refreshed := worker.RefreshExpiring(context.Background()); refreshed != 0 {
+		t.Errorf("expected nothing left to refresh, got %d", refreshed)
+	}
+
+	if metrics := worker.GetMetrics(); metrics["refreshed_total"] != 2 {
+		t.Errorf("expected refreshed_total 2, got %d", metrics["refreshed_total"])
+	}
+}
+
+// TestTokenRefreshWorker_Failures validates unrecoverable and transient refresh failures
+func TestTokenRefreshWorker_Failures(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	repo := newMemoryUserRepository()
+	repo.addUser("revoked", now.Add(time.Hour))
+	repo.addUser("flaky", now.Add(time.Hour))
+
+	useCase := &refreshingUseCase{
+		repo:     repo,
+		now:      now,
+		rejected: map[string]bool{"revoked": true},
+		failing:  map[string]bool{"flaky": true},
+	}
+	worker := newTestTokenRefreshWorker(t, repo, useCase, now)
+
+	if refreshed := worker.RefreshExpiring(context.Background()); refreshed != 0 {
+		t.Fatalf("expected no tokens refreshed, got %d", refreshed)
+	}
+
+	revoked, _ := repo.FindByID(context.Background(), "revoked")
+	if revoked.CanPublish() || revoked.PublishBlockedReason() == "" {
+		t.Error("expected revoked user to be blocked from publishing with a reason")
+	}
+
+	// The unrecoverable user is skipped while the transient failure is retried
+	useCase.failing = nil
+	if refreshed := worker.RefreshExpiring(context.Background()); refreshed != 1 {
+		t.Errorf("expected the flaky user to be refreshed on retry, got %d", refreshed)
+	}
+
+	metrics := worker.GetMetrics()
+	if metrics["unrecoverable_total"] != 1 || metrics["failures_total"] != 1 || metrics["refreshed_total"] != 1 {
+		t.Errorf("unexpected metrics: %v", metrics)
+	}
+}
+
+// TestTokenRefreshWorker_Lifecycle validates start and stop behaviour
+func TestTokenRefreshWorker_Lifecycle(t *testing.T) {
+	if _, err := workers.NewTokenRefreshWorker(workers.TokenRefreshConfig{UserRepo: newMemoryUserRepository()}); !errors.Is(err, workers.ErrNilUseCase) {
+		t.Errorf("expected ErrNilUseCase, got %v", err)
+	}
+
+	now := time.Now()
+	repo := newMemoryUserRepository()
+	repo.addUser("expiring", now.Add(time.Hour))
+	worker := newTestTokenRefreshWorker(t, repo, &refreshingUseCase{repo: repo, now: now}, now)
+
+	if err := worker.Start(context.Background()); err != nil {
+		t.Fatalf("unexpected start error: %v", err)
+	}
+	if err := worker.Start(context.Background()); !errors.Is(err, workers.ErrAlreadyRunning) {
+		t.Errorf("expected ErrAlreadyRunning, got %v", err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for worker.GetMetrics()["refreshed_total"] == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if worker.GetMetrics()["refreshed_total"] != 1 {
+		t.Error("expected the first poll to run immediately on start")
+	}
+
+	if err := worker.Stop(time.Second); err != nil {
+		t.Fatalf("unexpected stop error: %v", err)
+	}
+	if worker.IsRunning() {
+		t.Error("expected worker to be stopped")
+	}
+	if err := worker.Stop(time.Second); !errors.Is(err, workers.ErrNotRunning) {
+		t.Errorf("expected ErrNotRunning, got %v", err)
+	}
+}
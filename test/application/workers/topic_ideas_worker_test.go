@@ -0,0 +1,170 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/application/workers"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"go.uber.org/zap"
+)
+
+// blockingIdeasGenerator records the topics it generates ideas for and blocks until released
+type blockingIdeasGenerator struct {
+	mu       sync.Mutex
+	topics   []string
+	inFlight int
+	peak     int
+	release  chan struct{}
+	failing  map[string]bool
+}
+
+func newBlockingIdeasGenerator() *blockingIdeasGenerator {
+	return &blockingIdeasGenerator{release: make(chan struct{})}
+}
+
+func (g *blockingIdeasGenerator) GenerateIdeasForTopic(ctx context.Context, topicID string) ([]*entities.Idea, error) {
+	g.mu.Lock()
+	g.inFlight++
+	if g.inFlight > g.peak {
+		g.peak = g.inFlight
+	}
+	g.mu.Unlock()
+
+	defer func() {
+		g.mu.Lock()
+		g.inFlight--
+		g.topics = append(g.topics, topicID)
+		g.mu.Unlock()
+	}()
+
+	select {
+	case <-g.release:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if g.failing[topicID] {
+		return nil, errors.New("llm unavailable")
+	}
+	return []*entities.Idea{{TopicID: topicID}}, nil
+}
+
+func (g *blockingIdeasGenerator) stats() (generated, peak int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return len(g.topics), g.peak
+}
+
+// TestTopicIdeasWorker_BoundsWork validates the queue and worker limits
+func TestTopicIdeasWorker_BoundsWork(t *testing.T) {
+	generator := newBlockingIdeasGenerator()
+	generator.failing = map[string]bool{"topic-3": true}
+
+	worker, err := workers.NewTopicIdeasWorker(workers.TopicIdeasConfig{
+		Generator: generator,
+		Workers:   2,
+		QueueSize: 2,
+		Logger:    zap.NewNop(),
+	})
+	if err != nil {
+		t.Fatalf("failed to create worker: %v", err)
+	}
+
+	// Before the worker starts only the queue holds topics
+	for _, topicID := range []string{"topic-1", "topic-2"} {
+		if err := worker.Enqueue(topicID); err != nil {
+			t.Fatalf("unexpected enqueue error for %s: %v", topicID, err)
+		}
+	}
+	if err := worker.Enqueue("topic-3"); !errors.Is(err, workers.ErrQueueFull) {
+		t.Fatalf("expected ErrQueueFull, got %v", err)
+	}
+
+	if err := worker.Start(context.Background()); err != nil {
+		t.Fatalf("unexpected start error: %v", err)
+	}
+	defer worker.Stop(time.Second)
+
+	// Both workers pick a topic, which frees the queue for two more
+	deadline := time.Now().Add(time.Second)
+	for worker.GetMetrics()["queue_depth"] != 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	for _, topicID := range []string{"topic-3", "topic-4"} {
+		if err := worker.Enqueue(topicID); err != nil {
+			t.Fatalf("unexpected enqueue error for %s: %v", topicID, err)
+		}
+	}
+
+	close(generator.release)
+
+	deadline = time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if generated, _ := generator.stats(); generated == 4 {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	generated, peak := generator.stats()
+	if generated != 4 {
+		t.Fatalf("expected ideas for 4 topics, got %d", generated)
+	}
+	if peak > 2 {
+		t.Errorf("expected at most 2 topics at a time, got %d", peak)
+	}
+
+	metrics := worker.GetMetrics()
+	if metrics["enqueued_total"] != 4 || metrics["rejected_total"] != 1 {
+		t.Errorf("unexpected queue metrics: %v", metrics)
+	}
+	if metrics["generated_total"] != 3 || metrics["failures_total"] != 1 {
+		t.Errorf("unexpected generation metrics: %v", metrics)
+	}
+}
+
+// TestTopicIdeasWorker_Lifecycle validates start and stop behaviour
+func TestTopicIdeasWorker_Lifecycle(t *testing.T) {
+	if _, err := workers.NewTopicIdeasWorker(workers.TopicIdeasConfig{}); !errors.Is(err, workers.ErrNilTopicIdeasGenerator) {
+		t.Errorf("expected ErrNilTopicIdeasGenerator, got %v", err)
+	}
+
+	generator := newBlockingIdeasGenerator()
+	worker, err := workers.NewTopicIdeasWorker(workers.TopicIdeasConfig{
+		Generator: generator,
+		Logger:    zap.NewNop(),
+	})
+	if err != nil {
+		t.Fatalf("failed to create worker: %v", err)
+	}
+
+	if err := worker.Start(context.Background()); err != nil {
+		t.Fatalf("unexpected start error: %v", err)
+	}
+	if err := worker.Start(context.Background()); !errors.Is(err, workers.ErrAlreadyRunning) {
+		t.Errorf("expected ErrAlreadyRunning, got %v", err)
+	}
+
+	// An in-flight topic is cancelled on stop instead of holding up shutdown
+	if err := worker.Enqueue("topic-1"); err != nil {
+		t.Fatalf("unexpected enqueue error: %v", err)
+	}
+	deadline := time.Now().Add(time.Second)
+	for worker.GetMetrics()["queue_depth"] != 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if err := worker.Stop(time.Second); err != nil {
+		t.Fatalf("unexpected stop error: %v", err)
+	}
+	if worker.IsRunning() {
+		t.Error("expected worker to be stopped")
+	}
+	if err := worker.Stop(time.Second); !errors.Is(err, workers.ErrNotRunning) {
+		t.Errorf("expected ErrNotRunning, got %v", err)
+	}
+}
@@ -418,7 +418,7 @@ func TestWorkerRaceConditions(t *testing.T) {
 			var wg sync.WaitGroup
 
 			_ = ctx // used in actual implementation
-			_ = wg  // used in actual implementation
+			_ = &wg // used in actual implementation
 
 			// Will fail: Race condition prevention doesn't exist yet
 			t.Fatal("Race condition prevention not implemented yet - TDD Red phase")
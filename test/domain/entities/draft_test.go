@@ -3,9 +3,6 @@ package entities_test
 import (
 	"testing"
 	"time"
-
-	"github.com/linkgen-ai/backend/domain/entities"
-	"github.com/linkgen-ai/backend/domain/factories"
 )
 
 // TestDraftEntity_Creation validates Draft entity creation
@@ -3,9 +3,6 @@ package entities_test
 import (
 	"testing"
 	"time"
-
-	"github.com/linkgen-ai/backend/domain/entities"
-	"github.com/linkgen-ai/backend/domain/factories"
 )
 
 // TestUserEntity validates User entity structure and behavior
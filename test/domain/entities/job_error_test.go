@@ -5,7 +5,9 @@ import (
 	"testing"
 	"time"
 
-	"github.com/linkgen-ai/backend/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestJobError_Creation(t *testing.T) {
@@ -1,7 +1,7 @@
 package entities_test
 
 import (
-	_ "github.com/linkgen-ai/backend/domain/entities"
+	_ "github.com/linkgen-ai/backend/src/domain/entities"
 )
 
 // This package contains unit tests for domain entities.
@@ -1,3 +1,7 @@
+//go:build legacy
+
+// Not built by default: written against APIs src doesn't provide.
+
 package entities
 
 import (
@@ -0,0 +1,155 @@
+package valueobjects_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/valueobjects"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone %s not available: %v", name, err)
+	}
+	return loc
+}
+
+// TestParseCronExpression validates accepted and rejected expressions
+func TestParseCronExpression(t *testing.T) {
+	valid := []string{
+		"* * * * *",
+		"0 9 * * 1-5",
+		"*/15 8-18 * * MON-FRI",
+		"5/20 * 1,15 jan,jul ?",
+		"0 0 * * 7",
+		"@daily",
+		"@HOURLY",
+	}
+	for _, expr := range valid {
+		if _, err := valueobjects.ParseCronExpression(expr); err != nil {
+			t.Errorf("expected %q to be valid, got %v", expr, err)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"10-5 * * * *",
+		"1,,2 * * * *",
+		"@every 5m",
+	}
+	for _, expr := range invalid {
+		if _, err := valueobjects.ParseCronExpression(expr); err == nil {
+			t.Errorf("expected %q to be rejected", expr)
+		}
+	}
+}
+
+// TestCronExpression_Next validates activation times
+func TestCronExpression_Next(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		{
+			name: "every minute is strictly after",
+			expr: "* * * * *",
+			from: time.Date(2026, 3, 2, 10, 15, 30, 0, time.UTC),
+			want: time.Date(2026, 3, 2, 10, 16, 0, 0, time.UTC),
+		},
+		{
+			name: "weekday mornings skip the weekend",
+			expr: "0 9 * * 1-5",
+			from: time.Date(2026, 3, 6, 9, 0, 0, 0, time.UTC), // Friday
+			want: time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC), // Monday
+		},
+		{
+			name: "steps with an offset",
+			expr: "5/20 * * * *",
+			from: time.Date(2026, 3, 2, 10, 26, 0, 0, time.UTC),
+			want: time.Date(2026, 3, 2, 10, 45, 0, 0, time.UTC),
+		},
+		{
+			name: "restricted day fields match either",
+			expr: "0 0 13 * 5",
+			from: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC), // Friday before the 13th
+		},
+		{
+			name: "month rollover",
+			expr: "@monthly",
+			from: time.Date(2026, 12, 15, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "leap day",
+			expr: "0 12 29 2 *",
+			from: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2028, 2, 29, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cron, err := valueobjects.ParseCronExpression(tt.expr)
+			if err != nil {
+				t.Fatalf("unexpected parse error: %v", err)
+			}
+			if got := cron.Next(tt.from); !got.Equal(tt.want) {
+				t.Errorf("Next(%v) = %v, want %v", tt.from, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestCronExpression_NextInTimeZone validates evaluation in the schedule's zone
+func TestCronExpression_NextInTimeZone(t *testing.T) {
+	madrid := mustLoadLocation(t, "Europe/Madrid")
+
+	cron, err := valueobjects.ParseCronExpression("0 9 * * *")
+	if err != nil {
+		t.Fatalf("unexpected parse error: %v", err)
+	}
+
+	got := cron.Next(time.Date(2026, 7, 1, 10, 0, 0, 0, madrid))
+	want := time.Date(2026, 7, 2, 7, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("expected 09:00 Madrid summer time (%v), got %v", want, got.UTC())
+	}
+
+	// 02:30 does not exist on the spring-forward day and is skipped
+	cron, _ = valueobjects.ParseCronExpression("30 2 * * *")
+	got = cron.Next(time.Date(2026, 3, 28, 12, 0, 0, 0, madrid))
+	if got.Day() != 30 || got.Hour() != 2 || got.Minute() != 30 {
+		t.Errorf("expected the skipped 02:30 to move to the next day, got %v", got)
+	}
+
+	// 02:30 happens twice on the fall-back day but fires once
+	first := cron.Next(time.Date(2026, 10, 25, 0, 0, 0, 0, madrid))
+	second := cron.Next(first)
+	if second.Day() != 26 {
+		t.Errorf("expected the repeated 02:30 to fire once, got %v then %v", first, second)
+	}
+}
+
+// TestCronExpression_NeverFires validates impossible expressions terminate
+func TestCronExpression_NeverFires(t *testing.T) {
+	cron, err := valueobjects.ParseCronExpression("0 0 30 2 *")
+	if err != nil {
+		t.Fatalf("unexpected parse error: %v", err)
+	}
+	if got := cron.Next(time.Now()); !got.IsZero() {
+		t.Errorf("expected zero time for 30 February, got %v", got)
+	}
+}
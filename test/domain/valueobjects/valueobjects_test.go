@@ -208,10 +208,10 @@ func TestDraftStatus_StateTransitions(t *testing.T) {
 			wantAllowed: true,
 		},
 		{
-			name:        "REFINED to DRAFT - allowed (re-edit)",
+			name:        "REFINED to DRAFT - not allowed",
 			fromStatus:  "REFINED",
 			toStatus:    "DRAFT",
-			wantAllowed: true,
+			wantAllowed: false,
 		},
 		{
 			name:        "PUBLISHED to DRAFT - not allowed",
@@ -543,7 +543,7 @@ func TestStressTest(t *testing.T) {
 				func() { /* read config */ },
 			}
 
-			_ = wg
+			_ = &wg
 			_ = done
 			_ = operations
 
@@ -1,7 +1,10 @@
 package config
 
 import (
+	"errors"
 	"testing"
+
+	"github.com/linkgen-ai/backend/src/infrastructure/config"
 )
 
 // TestLoadSecrets validates loading secrets from environment or secret store
@@ -483,3 +486,47 @@ func TestValidateSecretStrength(t *testing.T) {
 		})
 	}
 }
+
+// TestNewSecretStoreWithKey validates that a passphrase-derived key survives process restarts
+func TestNewSecretStoreWithKey(t *testing.T) {
+	const passphrase = "a-sufficiently-long-token-encryption-passphrase"
+
+	writer, err := config.NewSecretStoreWithKey(passphrase)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ciphertext, err := writer.EncryptSecret("linkedin-access-token")
+	if err != nil {
+		t.Fatalf("unexpected encryption error: %v", err)
+	}
+	if ciphertext == "linkedin-access-token" {
+		t.Fatal("expected ciphertext to differ from plaintext")
+	}
+
+	// A second store built from the same passphrase stands in for a restarted process
+	reader, err := config.NewSecretStoreWithKey(passphrase)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	plaintext, err := reader.DecryptSecret(ciphertext)
+	if err != nil {
+		t.Fatalf("unexpected decryption error: %v", err)
+	}
+	if plaintext != "linkedin-access-token" {
+		t.Errorf("expected original token, got %q", plaintext)
+	}
+
+	other, err := config.NewSecretStoreWithKey(passphrase + "-rotated")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := other.DecryptSecret(ciphertext); !errors.Is(err, config.ErrDecryptionFailed) {
+		t.Errorf("expected decryption failure with a different key, got %v", err)
+	}
+
+	if _, err := config.NewSecretStoreWithKey("too-short"); !errors.Is(err, config.ErrWeakSecret) {
+		t.Errorf("expected weak secret error, got %v", err)
+	}
+}
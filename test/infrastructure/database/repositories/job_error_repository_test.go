@@ -9,16 +9,12 @@ import (
 	"github.com/linkgen-ai/backend/src/domain/entities"
 	"github.com/linkgen-ai/backend/src/infrastructure/database/repositories"
 	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/require"
-	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
-	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
 )
 
 func TestJobErrorRepositoryCreate(t *testing.T) {
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
-	defer mt.Close()
 
 	mt.Run("successfully creates job error", func(mt *mtest.T) {
 		repo := repositories.NewJobErrorRepository(mt.Coll)
@@ -33,7 +29,6 @@ func TestJobErrorRepositoryCreate(t *testing.T) {
 			CreatedAt: time.Now(),
 		}
 
-		insertedID := primitive.NewObjectID()
 		mt.AddMockResponses(mtest.CreateSuccessResponse())
 
 		id, err := repo.Create(ctx, jobError)
@@ -192,7 +187,6 @@ func TestJobErrorRepositoryCreate(t *testing.T) {
 
 func TestJobErrorRepository_TruncateLongFields(t *testing.T) {
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
-	defer mt.Close()
 
 	mt.Run("truncates very long error messages", func(mt *mtest.T) {
 		repo := repositories.NewJobErrorRepository(mt.Coll)
@@ -288,7 +282,6 @@ func TestJobErrorRepository_TruncateLongFields(t *testing.T) {
 
 func TestJobErrorRepository_DocumentConversion(t *testing.T) {
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
-	defer mt.Close()
 
 	mt.Run("converts entity to document with nil idea ID", func(mt *mtest.T) {
 		repo := repositories.NewJobErrorRepository(mt.Coll)
@@ -386,7 +379,6 @@ func TestJobErrorRepository_DocumentConversion(t *testing.T) {
 
 func TestJobErrorRepository_StageConversion(t *testing.T) {
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
-	defer mt.Close()
 
 	mt.Run("converts draft generation stage correctly", func(mt *mtest.T) {
 		repo := repositories.NewJobErrorRepository(mt.Coll)
@@ -412,7 +404,6 @@ func TestJobErrorRepository_StageConversion(t *testing.T) {
 
 func TestJobErrorRepository_Concurrency(t *testing.T) {
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
-	defer mt.Close()
 
 	mt.Run("handles concurrent creates", func(mt *mtest.T) {
 		repo := repositories.NewJobErrorRepository(mt.Coll)
@@ -451,7 +442,6 @@ func TestJobErrorRepository_Concurrency(t *testing.T) {
 
 func TestJobErrorRepository_Integration(t *testing.T) {
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
-	defer mt.Close()
 
 	mt.Run("creates job error and retrieves ID", func(mt *mtest.T) {
 		repo := repositories.NewJobErrorRepository(mt.Coll)
@@ -506,7 +496,6 @@ func TestJobErrorRepository_Integration(t *testing.T) {
 
 func TestJobErrorRepository_PerformanceValidation(t *testing.T) {
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
-	defer mt.Close()
 
 	mt.Run("handles large metadata efficiently", func(mt *mtest.T) {
 		repo := repositories.NewJobErrorRepository(mt.Coll)
@@ -538,7 +527,6 @@ func TestJobErrorRepository_PerformanceValidation(t *testing.T) {
 
 func TestJobErrorRepository_EdgeCases(t *testing.T) {
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
-	defer mt.Close()
 
 	mt.Run("handles zero attempt number", func(mt *mtest.T) {
 		repo := repositories.NewJobErrorRepository(mt.Coll)
@@ -1,14 +1,7 @@
 package repositories
 
 import (
-	"context"
 	"testing"
-	"time"
-
-	"github.com/linkgen-ai/backend/src/domain/entities"
-	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/mock"
-	"github.com/stretchr/testify/require"
 )
 
 // TestPromptRepositorySync tests synchronization between seed and database
@@ -0,0 +1,147 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/database/repositories"
+)
+
+// memoryPromptsRepository keeps prompts in memory by ID
+type memoryPromptsRepository struct {
+	interfaces.PromptsRepository
+	prompts map[string]entities.Prompt
+}
+
+func (r *memoryPromptsRepository) Create(ctx context.Context, prompt *entities.Prompt) (string, error) {
+	id := fmt.Sprintf("prompt-%d", len(r.prompts)+1)
+	stored := *prompt
+	stored.ID = id
+	r.prompts[id] = stored
+	return id, nil
+}
+
+func (r *memoryPromptsRepository) FindByID(ctx context.Context, id string) (*entities.Prompt, error) {
+	prompt, ok := r.prompts[id]
+	if !ok {
+		return nil, nil
+	}
+	return &prompt, nil
+}
+
+func (r *memoryPromptsRepository) Update(ctx context.Context, prompt *entities.Prompt) error {
+	r.prompts[prompt.ID] = *prompt
+	return nil
+}
+
+// memoryPromptVersionsRepository keeps prompt versions in memory
+type memoryPromptVersionsRepository struct {
+	versions []*entities.PromptVersion
+}
+
+func (r *memoryPromptVersionsRepository) Create(ctx context.Context, version *entities.PromptVersion) (string, error) {
+	if err := version.Validate(); err != nil {
+		return "", err
+	}
+	for _, existing := range r.versions {
+		if existing.PromptID == version.PromptID && existing.Version == version.Version {
+			return "", fmt.Errorf("prompt %s already has version %d", version.PromptID, version.Version)
+		}
+	}
+	r.versions = append(r.versions, version)
+	return fmt.Sprintf("version-%d", len(r.versions)), nil
+}
+
+func (r *memoryPromptVersionsRepository) FindByPromptIDAndVersion(ctx context.Context, promptID string, version int) (*entities.PromptVersion, error) {
+	for _, existing := range r.versions {
+		if existing.PromptID == promptID && existing.Version == version {
+			return existing, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryPromptVersionsRepository) ListByPromptID(ctx context.Context, promptID string) ([]*entities.PromptVersion, error) {
+	var versions []*entities.PromptVersion
+	for i := len(r.versions) - 1; i >= 0; i-- {
+		if r.versions[i].PromptID == promptID {
+			versions = append(versions, r.versions[i])
+		}
+	}
+	return versions, nil
+}
+
+// TestVersionedPromptsRepository validates that content changes record immutable versions
+func TestVersionedPromptsRepository(t *testing.T) {
+	ctx := context.Background()
+	prompts := &memoryPromptsRepository{prompts: map[string]entities.Prompt{}}
+	versions := &memoryPromptVersionsRepository{}
+	repo := repositories.NewVersionedPromptsRepository(prompts, versions)
+
+	prompt := &entities.Prompt{UserID: "user-1", Name: "base1", Type: entities.PromptTypeDrafts, PromptTemplate: "v1 {content}", Active: true}
+	id, err := repo.Create(ctx, prompt)
+	if err != nil {
+		t.Fatalf("failed to create prompt: %v", err)
+	}
+	if prompt.Version != 1 || len(versions.versions) != 1 {
+		t.Fatalf("expected version 1 to be recorded, got version %d and %d versions", prompt.Version, len(versions.versions))
+	}
+
+	// Toggling Active doesn't change what is sent to the LLM
+	prompt.Active = false
+	if err := repo.Update(ctx, prompt); err != nil {
+		t.Fatalf("failed to update prompt: %v", err)
+	}
+	if prompt.Version != 1 || len(versions.versions) != 1 {
+		t.Errorf("expected no new version, got version %d and %d versions", prompt.Version, len(versions.versions))
+	}
+
+	prompt.PromptTemplate = "v2 {content}"
+	if err := repo.Update(ctx, prompt); err != nil {
+		t.Fatalf("failed to update prompt: %v", err)
+	}
+	if prompt.Version != 2 {
+		t.Errorf("expected version 2, got %d", prompt.Version)
+	}
+
+	first, _ := versions.FindByPromptIDAndVersion(ctx, id, 1)
+	if first == nil || first.PromptTemplate != "v1 {content}" {
+		t.Fatalf("expected version 1 to keep its template, got %+v", first)
+	}
+
+	prompt.Restore(first)
+	if err := repo.Update(ctx, prompt); err != nil {
+		t.Fatalf("failed to roll back prompt: %v", err)
+	}
+	list, _ := versions.ListByPromptID(ctx, id)
+	if prompt.Version != 3 || len(list) != 3 || list[0].PromptTemplate != "v1 {content}" {
+		t.Errorf("expected the rollback to record version 3, got version %d and %d versions", prompt.Version, len(list))
+	}
+}
+
+// TestVersionedPromptsRepository_UnversionedPrompt validates that prompts saved before versioning keep their content
+func TestVersionedPromptsRepository_UnversionedPrompt(t *testing.T) {
+	ctx := context.Background()
+	prompts := &memoryPromptsRepository{prompts: map[string]entities.Prompt{
+		"legacy": {ID: "legacy", UserID: "user-1", Name: "base1", Type: entities.PromptTypeIdeas, PromptTemplate: "old"},
+	}}
+	versions := &memoryPromptVersionsRepository{}
+	repo := repositories.NewVersionedPromptsRepository(prompts, versions)
+
+	prompt, _ := prompts.FindByID(ctx, "legacy")
+	prompt.PromptTemplate = "new"
+	if err := repo.Update(ctx, prompt); err != nil {
+		t.Fatalf("failed to update prompt: %v", err)
+	}
+
+	if prompt.Version != 2 {
+		t.Errorf("expected version 2, got %d", prompt.Version)
+	}
+	old, _ := versions.FindByPromptIDAndVersion(ctx, "legacy", 1)
+	if old == nil || old.PromptTemplate != "old" {
+		t.Errorf("expected the original template as version 1, got %+v", old)
+	}
+}
@@ -0,0 +1,178 @@
+package fakelinkedin
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/linkedin"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/linkedin/fakelinkedin"
+)
+
+const testRedirectURI = "http://localhost:8000/v1/auth/linkedin/callback"
+
+func newTestClient(t *testing.T, fake *fakelinkedin.TestServer) *linkedin.LinkedInAPIClient {
+	t.Helper()
+
+	client, err := linkedin.NewLinkedInAPIClient(fake.ClientConfig())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return client
+}
+
+// TestFakeLinkedIn_AuthorizationCodeFlow validates the PKCE flow against the real client
+func TestFakeLinkedIn_AuthorizationCodeFlow(t *testing.T) {
+	fake := fakelinkedin.NewTestServer(fakelinkedin.Options{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURI:  testRedirectURI,
+		MemberID:     "member-7",
+	})
+	defer fake.Close()
+	client := newTestClient(t, fake)
+
+	verifier := "a-sufficiently-long-pkce-code-verifier-value-for-tests"
+	sum := sha256.Sum256([]byte(verifier))
+	authURL, err := client.AuthorizationURL("state-1", base64.RawURLEncoding.EncodeToString(sum[:]))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	noRedirect := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	resp, err := noRedirect.Get(authURL)
+	if err != nil {
+		t.Fatalf("authorization request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	callback, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil || resp.StatusCode != http.StatusFound {
+		t.Fatalf("expected a redirect to the callback, got %d %q", resp.StatusCode, resp.Header.Get("Location"))
+	}
+	if callback.Query().Get("state") != "state-1" {
+		t.Errorf("expected state to round-trip, got %q", callback.Query().Get("state"))
+	}
+
+	code := callback.Query().Get("code")
+	if _, err := client.ExchangeAuthorizationCode(context.Background(), code, "wrong-verifier"); err == nil {
+		t.Fatal("expected a mismatched code verifier to be rejected")
+	}
+
+	// Codes are single use, even after a failed exchange
+	resp, _ = noRedirect.Get(authURL)
+	resp.Body.Close()
+	callback, _ = url.Parse(resp.Header.Get("Location"))
+
+	tokens, err := client.ExchangeAuthorizationCode(context.Background(), callback.Query().Get("code"), verifier)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tokens.ExpiresIn <= 0 || tokens.RefreshToken == "" {
+		t.Errorf("expected a full token pair, got %+v", tokens)
+	}
+
+	refreshed, err := client.RefreshTokenPair(context.Background(), tokens.RefreshToken)
+	if err != nil {
+		t.Fatalf("unexpected refresh error: %v", err)
+	}
+	if refreshed.AccessToken == tokens.AccessToken || refreshed.RefreshToken != tokens.RefreshToken {
+		t.Errorf("expected a new access token and the same refresh token, got %+v", refreshed)
+	}
+
+	if ok, err := client.ValidateToken(context.Background(), refreshed.AccessToken); !ok || err != nil {
+		t.Errorf("expected the refreshed token to be valid, got %v (%v)", ok, err)
+	}
+}
+
+// TestFakeLinkedIn_Introspection validates token introspection statuses
+func TestFakeLinkedIn_Introspection(t *testing.T) {
+	fake := fakelinkedin.NewTestServer(fakelinkedin.Options{})
+	defer fake.Close()
+
+	active := fake.IssueToken("member-1")
+	expired := fake.IssueToken("member-1")
+	fake.ExpireToken(expired.AccessToken)
+	revoked := fake.IssueToken("member-1")
+	fake.RevokeToken(revoked.AccessToken)
+
+	for token, want := range map[string]string{
+		active.AccessToken:  "active",
+		expired.AccessToken: "expired",
+		revoked.AccessToken: "revoked",
+		"unknown":           "",
+	} {
+		resp, err := http.PostForm(fake.URL()+fakelinkedin.IntrospectPath, url.Values{"token": {token}})
+		if err != nil {
+			t.Fatalf("introspection failed: %v", err)
+		}
+
+		var body struct {
+			Active bool   `json:"active"`
+			Status string `json:"status"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatalf("failed to decode introspection: %v", err)
+		}
+
+		if body.Status != want || body.Active != (want == "active") {
+			t.Errorf("expected status %q, got %+v", want, body)
+		}
+	}
+}
+
+// TestFakeLinkedIn_AdminFaults validates faults injected over HTTP
+func TestFakeLinkedIn_AdminFaults(t *testing.T) {
+	fake := fakelinkedin.NewTestServer(fakelinkedin.Options{})
+	defer fake.Close()
+	client := newTestClient(t, fake)
+	token := fake.IssueToken("member-1")
+
+	resp, err := http.Post(fake.URL()+fakelinkedin.AdminPrefix+"/faults", "application/json",
+		strings.NewReader(`{"path":"/v2/me","status":429,"retry_after_seconds":7,"times":1}`))
+	if err != nil || resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("failed to inject fault: %v", err)
+	}
+	resp.Body.Close()
+
+	_, err = client.PublishPost(context.Background(), "Hello from the fake LinkedIn API", token.AccessToken)
+	var apiErr *domainErrors.LinkedInAPIError
+	if !errors.As(err, &apiErr) || apiErr.Reason != domainErrors.LinkedInReasonRateLimit || apiErr.RetryAfter != 7*time.Second {
+		t.Fatalf("expected a rate limit with Retry-After 7s, got %v", err)
+	}
+
+	published, err := client.PublishPost(context.Background(), "Hello from the fake LinkedIn API", token.AccessToken)
+	if err != nil {
+		t.Fatalf("expected the one-shot fault to clear, got %v", err)
+	}
+
+	resp, err = http.Get(fake.URL() + fakelinkedin.AdminPrefix + "/posts")
+	if err != nil {
+		t.Fatalf("failed to list posts: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var listed struct {
+		Posts []fakelinkedin.Post `json:"posts"`
+		Count int                 `json:"count"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&listed); err != nil {
+		t.Fatalf("failed to decode posts: %v", err)
+	}
+	if listed.Count != 1 || listed.Posts[0].ID != published.ID {
+		t.Errorf("expected the published post to be listed, got %+v", listed)
+	}
+	if got := fake.Requests(fakelinkedin.APIPrefix + "/me"); got != 2 {
+		t.Errorf("expected the faulted request to be counted, got %d", got)
+	}
+}
@@ -0,0 +1,161 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/cache"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/structured"
+)
+
+// TestMemoryStore_EvictsLeastRecentlyUsed validates the LRU order and the TTL
+func TestMemoryStore_EvictsLeastRecentlyUsed(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	store := cache.NewMemoryStore(cache.MemoryConfig{Capacity: 2, TTL: time.Minute, Now: func() time.Time { return now }})
+
+	store.Set(ctx, "a", "1")
+	store.Set(ctx, "b", "2")
+	store.Get(ctx, "a")
+	store.Set(ctx, "c", "3")
+
+	if _, ok, _ := store.Get(ctx, "b"); ok {
+		t.Error("expected the least recently used entry to be evicted")
+	}
+	if got, ok, _ := store.Get(ctx, "a"); !ok || got != "1" {
+		t.Errorf("expected a recently read entry to survive, got %q %v", got, ok)
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok, _ := store.Get(ctx, "c"); ok || store.Len() != 1 {
+		t.Errorf("expected expired entries to be dropped, %d left", store.Len())
+	}
+}
+
+// failingStore fails every operation
+type failingStore struct{}
+
+func (failingStore) Get(ctx context.Context, key string) (string, bool, error) {
+	return "", false, errors.New("no reachable servers")
+}
+
+func (failingStore) Set(ctx context.Context, key string, response string) error {
+	return errors.New("no reachable servers")
+}
+
+// TestTieredStore_BackfillsFasterTiers validates read-through and failing tiers
+func TestTieredStore_BackfillsFasterTiers(t *testing.T) {
+	ctx := context.Background()
+	memory := cache.NewMemoryStore(cache.MemoryConfig{})
+	shared := cache.NewMemoryStore(cache.MemoryConfig{})
+	shared.Set(ctx, "key", "response")
+
+	store := cache.NewTieredStore(memory, failingStore{}, shared)
+	if got, ok, err := store.Get(ctx, "key"); !ok || got != "response" || err != nil {
+		t.Fatalf("expected a hit past the failing tier, got %q %v (%v)", got, ok, err)
+	}
+	if got, ok, _ := memory.Get(ctx, "key"); !ok || got != "response" {
+		t.Error("expected the hit to be copied into the memory tier")
+	}
+
+	if _, ok, err := store.Get(ctx, "missing"); ok || err == nil {
+		t.Errorf("expected a miss reporting the failing tier, got %v (%v)", ok, err)
+	}
+}
+
+// countingService answers prompt requests with a fixed response, counting calls and
+// reporting model as the model that answered when set
+type countingService struct {
+	interfaces.LLMService
+	response string
+	model    string
+	calls    int
+}
+
+func (s *countingService) SendPromptRequest(ctx context.Context, req interfaces.PromptRequest) (string, error) {
+	s.calls++
+	if s.model != "" {
+		interfaces.NotifyLLMModel(ctx, "openai", s.model)
+	}
+	return s.response, nil
+}
+
+// TestClient_CachesGoodResponsesPerJob validates the job scope and that invalid responses aren't reused
+func TestClient_CachesGoodResponsesPerJob(t *testing.T) {
+	ctx := interfaces.WithLLMCallInfo(context.Background(), interfaces.LLMCallInfo{JobID: "job-1"})
+	request := func(prompt string) interfaces.PromptRequest {
+		return interfaces.PromptRequest{Prompt: prompt, Schema: structured.RefinementSchema.Response()}
+	}
+
+	service := &countingService{response: `{"refined": "texto"}`}
+	client, err := cache.NewClient(service, cache.NewMemoryStore(cache.MemoryConfig{}), cache.Config{Model: "gpt-4o"})
+	if err != nil {
+		t.Fatalf("failed to create cache client: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if got, err := client.SendPromptRequest(ctx, request("prompt")); err != nil || got != service.response {
+			t.Fatalf("unexpected response %q (%v)", got, err)
+		}
+	}
+	if service.calls != 1 {
+		t.Errorf("expected the second call to hit the cache, got %d calls", service.calls)
+	}
+
+	// Another temperature is another request
+	temperature := 0.0
+	colder := request("prompt")
+	colder.Parameters = entities.PromptParameters{Temperature: &temperature}
+	client.SendPromptRequest(ctx, colder)
+	if service.calls != 2 {
+		t.Errorf("expected different parameters to miss, got %d calls", service.calls)
+	}
+
+	// Responses that don't match the schema would need the same repair again
+	service.response = "Claro, aquí tienes"
+	client.SendPromptRequest(ctx, request("otro prompt"))
+	client.SendPromptRequest(ctx, request("otro prompt"))
+	if service.calls != 4 {
+		t.Errorf("expected invalid responses not to be cached, got %d calls", service.calls)
+	}
+}
+
+// TestClient_SkipsResponsesFromAnotherModel validates that a fallback backend's answer isn't
+// cached as the primary model's
+func TestClient_SkipsResponsesFromAnotherModel(t *testing.T) {
+	ctx := interfaces.WithLLMCallInfo(context.Background(), interfaces.LLMCallInfo{JobID: "job-1"})
+	request := interfaces.PromptRequest{Prompt: "prompt"}
+
+	service := &countingService{response: "respuesta", model: "llama3"}
+	client, err := cache.NewClient(service, cache.NewMemoryStore(cache.MemoryConfig{}), cache.Config{Model: "gpt-4o"})
+	if err != nil {
+		t.Fatalf("failed to create cache client: %v", err)
+	}
+
+	client.SendPromptRequest(ctx, request)
+	client.SendPromptRequest(ctx, request)
+	if service.calls != 2 {
+		t.Errorf("expected the fallback's answers not to be cached, got %d calls", service.calls)
+	}
+
+	// Once the primary answers again its response is reused
+	service.model = "gpt-4o"
+	client.SendPromptRequest(ctx, request)
+	client.SendPromptRequest(ctx, request)
+	if service.calls != 3 {
+		t.Errorf("expected the primary's answer to be cached, got %d calls", service.calls)
+	}
+
+	// A prompt that picks its own model is answered by it
+	request.Parameters.Model = "gpt-4o-mini"
+	service.model = "gpt-4o-mini"
+	client.SendPromptRequest(ctx, request)
+	client.SendPromptRequest(ctx, request)
+	if service.calls != 4 {
+		t.Errorf("expected the prompt model's answer to be cached, got %d calls", service.calls)
+	}
+}
@@ -0,0 +1,173 @@
+package dispatch
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/dispatch"
+)
+
+// gatedService blocks every call until released, recording the order calls start in
+type gatedService struct {
+	interfaces.LLMService
+	mu      sync.Mutex
+	started []string
+	gate    chan struct{}
+}
+
+func newGatedService() *gatedService {
+	return &gatedService{gate: make(chan struct{})}
+}
+
+func (s *gatedService) call(name string) {
+	s.mu.Lock()
+	s.started = append(s.started, name)
+	s.mu.Unlock()
+	<-s.gate
+}
+
+func (s *gatedService) order() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.started...)
+}
+
+func (s *gatedService) SendRequest(ctx context.Context, prompt string) (string, error) {
+	s.call(prompt)
+	return "{}", nil
+}
+
+func (s *gatedService) RefineDraft(ctx context.Context, draft string, userPrompt string, history []string) (string, error) {
+	s.call(userPrompt)
+	return draft, nil
+}
+
+// waitFor polls until condition holds
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the dispatcher")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// TestClient_RefinementsOvertakeBackgroundWork validates the in-flight bound and priorities
+func TestClient_RefinementsOvertakeBackgroundWork(t *testing.T) {
+	ctx := context.Background()
+	service := newGatedService()
+	client, err := dispatch.NewClient(service, dispatch.Config{MaxInFlight: 1})
+	if err != nil {
+		t.Fatalf("failed to create dispatcher: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	run := func(fn func()) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fn()
+		}()
+	}
+
+	run(func() { client.SendRequest(ctx, "ideas 1") })
+	waitFor(t, func() bool { return client.Stats().InFlight == 1 })
+	run(func() { client.SendRequest(ctx, "ideas 2") })
+	waitFor(t, func() bool { return client.Stats().QueuedBackground == 1 })
+	run(func() { client.RefineDraft(ctx, "borrador", "más corto", nil) })
+	waitFor(t, func() bool { return client.Stats().QueuedInteractive == 1 })
+
+	for i := 0; i < 3; i++ {
+		service.gate <- struct{}{}
+	}
+	wg.Wait()
+
+	order := service.order()
+	if len(order) != 3 || order[1] != "más corto" || order[2] != "ideas 2" {
+		t.Errorf("expected the refinement to run before queued background work, got %q", order)
+	}
+	if stats := client.Stats(); stats.InFlight != 0 || stats.QueuedBackground != 0 || stats.QueuedInteractive != 0 {
+		t.Errorf("expected an idle dispatcher, got %+v", stats)
+	}
+}
+
+// TestClient_CancelledWaitLeavesQueue validates that callers stop waiting with their context
+func TestClient_CancelledWaitLeavesQueue(t *testing.T) {
+	service := newGatedService()
+	client, err := dispatch.NewClient(service, dispatch.Config{MaxInFlight: 1})
+	if err != nil {
+		t.Fatalf("failed to create dispatcher: %v", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		client.SendRequest(context.Background(), "running")
+	}()
+	waitFor(t, func() bool { return client.Stats().InFlight == 1 })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := client.SendRequest(ctx, "queued"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the deadline error, got %v", err)
+	}
+	if stats := client.Stats(); stats.QueuedBackground != 0 {
+		t.Errorf("expected the cancelled caller to leave the queue, got %+v", stats)
+	}
+
+	service.gate <- struct{}{}
+	<-done
+	if order := service.order(); len(order) != 1 {
+		t.Errorf("expected the cancelled request never to run, got %q", order)
+	}
+}
+
+// instantService answers immediately
+type instantService struct {
+	interfaces.LLMService
+}
+
+func (instantService) SendPromptRequest(ctx context.Context, req interfaces.PromptRequest) (string, error) {
+	return "{}", nil
+}
+
+// TestClient_TokensPerMinute validates that requests wait for the token budget to refill
+func TestClient_TokensPerMinute(t *testing.T) {
+	client, err := dispatch.NewClient(instantService{}, dispatch.Config{TokensPerMinute: 60_000})
+	if err != nil {
+		t.Fatalf("failed to create dispatcher: %v", err)
+	}
+
+	// The first request spends the whole minute's budget
+	budget := interfaces.PromptRequest{
+		Prompt:     "p",
+		Parameters: entities.PromptParameters{MaxTokens: 60_000},
+	}
+	if _, err := client.SendPromptRequest(context.Background(), budget); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 100 tokens refill in 100ms at 1000 tokens per second
+	small := interfaces.PromptRequest{
+		Prompt:     "p",
+		Parameters: entities.PromptParameters{MaxTokens: 99},
+	}
+	start := time.Now()
+	if _, err := client.SendPromptRequest(context.Background(), small); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if waited := time.Since(start); waited < 80*time.Millisecond {
+		t.Errorf("expected to wait for the budget to refill, waited %s", waited)
+	}
+
+	if _, err := dispatch.NewClient(instantService{}, dispatch.Config{MaxInFlight: -1}); err == nil {
+		t.Error("expected negative limits to be rejected")
+	}
+}
@@ -0,0 +1,143 @@
+package fakellm
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/fakellm"
+)
+
+func newTestClient(t *testing.T, server *fakellm.TestServer, timeout time.Duration) *llm.LLMHTTPClient {
+	t.Helper()
+
+	config := server.ClientConfig()
+	if timeout > 0 {
+		config.Timeout = timeout
+	}
+
+	client, err := llm.NewLLMHTTPClient(config)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.SetRetryConfig(fakellm.FastRetryConfig())
+	return client
+}
+
+// TestFakeLLMMatching validates regex, prompt hash and one-shot rules
+func TestFakeLLMMatching(t *testing.T) {
+	server := fakellm.NewTestServer(fakellm.Options{})
+	defer server.Close()
+	client := newTestClient(t, server, 0)
+	ctx := context.Background()
+
+	exactPrompt := "summarise this exactly"
+	server.AddRule(fakellm.Rule{PromptHash: fakellm.PromptHash(exactPrompt), Response: fakellm.Response{Content: "by hash"}})
+	server.AddRule(fakellm.Rule{Pattern: regexp.MustCompile(`(?i)summarise`), Response: fakellm.Response{Content: "first"}, Times: 1})
+	server.Respond(`(?i)summarise`, "afterwards")
+
+	if got, err := client.SendRequest(ctx, exactPrompt); err != nil || got != "by hash" {
+		t.Errorf("expected the hash rule to answer, got %q (%v)", got, err)
+	}
+	if got, err := client.SendRequest(ctx, "Summarise the report"); err != nil || got != "first" {
+		t.Errorf("expected the one-shot rule to answer, got %q (%v)", got, err)
+	}
+	if got, err := client.SendRequest(ctx, "Summarise the report"); err != nil || got != "afterwards" {
+		t.Errorf("expected the one-shot rule to be used up, got %q (%v)", got, err)
+	}
+
+	_, err := client.SendRequest(ctx, "translate this")
+	if err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("expected an unmatched prompt to fail with 404, got %v", err)
+	}
+
+	requests := server.Requests()
+	if len(requests) != 4 {
+		t.Fatalf("expected 4 recorded requests, got %d", len(requests))
+	}
+	if requests[0].Model != "fake-model" || requests[0].Prompt != exactPrompt {
+		t.Errorf("unexpected recorded request: %+v", requests[0])
+	}
+	if requests[1].Rule != "pattern:(?i)summarise" || requests[3].Rule != "" {
+		t.Errorf("unexpected rule names: %q, %q", requests[1].Rule, requests[3].Rule)
+	}
+}
+
+// TestFakeLLMFailures validates scripted 429s and timeouts through the client retries
+func TestFakeLLMFailures(t *testing.T) {
+	server := fakellm.NewTestServer(fakellm.Options{})
+	defer server.Close()
+	ctx := context.Background()
+
+	server.AddRule(fakellm.Rule{
+		Pattern:  regexp.MustCompile("busy"),
+		Response: fakellm.Response{Status: 429, RetryAfter: time.Second},
+		Times:    2,
+	})
+	server.Respond("busy", "recovered")
+
+	client := newTestClient(t, server, 0)
+	if got, err := client.SendRequest(ctx, "busy"); err != nil || got != "recovered" {
+		t.Errorf("expected the client to retry past two 429s, got %q (%v)", got, err)
+	}
+
+	server.AddRule(fakellm.Rule{Pattern: regexp.MustCompile("throttled"), Response: fakellm.Response{Status: 429}})
+	if _, err := client.SendRequest(ctx, "throttled"); err == nil || !strings.Contains(err.Error(), "429") {
+		t.Errorf("expected a 429 error once retries are exhausted, got %v", err)
+	}
+
+	server.AddRule(fakellm.Rule{Pattern: regexp.MustCompile("slow"), Response: fakellm.Response{Content: "late", Latency: 200 * time.Millisecond}})
+	slowClient := newTestClient(t, server, 20*time.Millisecond)
+	if _, err := slowClient.SendRequest(ctx, "slow"); err == nil {
+		t.Error("expected a timeout error")
+	}
+}
+
+// TestFakeLLMLoadScript validates script files with content files and code fences
+func TestFakeLLMLoadScript(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "ideas.json"), []byte(fakellm.IdeasJSON("one", "two")), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	script := `{"rules": [
+		{"name": "ideas", "match": "ideas", "content_file": "ideas.json", "code_fenced": true},
+		{"match": "broken", "content": "` + strings.ReplaceAll(fakellm.MalformedJSON, `"`, `\"`) + `"}
+	]}`
+	path := filepath.Join(dir, "script.json")
+	if err := os.WriteFile(path, []byte(script), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	rules, err := fakellm.LoadScript(path)
+	if err != nil {
+		t.Fatalf("failed to load script: %v", err)
+	}
+
+	server := fakellm.NewTestServer(fakellm.Options{Rules: rules})
+	defer server.Close()
+	client := newTestClient(t, server, 0)
+
+	got, err := client.SendRequest(context.Background(), "give me ideas")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(got, "```json") || !strings.Contains(got, `"one"`) {
+		t.Errorf("expected code-fenced ideas from the content file, got %q", got)
+	}
+
+	if got, err := client.SendRequest(context.Background(), "broken"); err != nil || got != fakellm.MalformedJSON {
+		t.Errorf("expected malformed JSON back verbatim, got %q (%v)", got, err)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"rules": [{"match": "("}]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fakellm.LoadScript(path); err == nil {
+		t.Error("expected an invalid pattern to be rejected")
+	}
+}
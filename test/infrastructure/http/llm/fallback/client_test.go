@@ -0,0 +1,263 @@
+package fallback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/fallback"
+)
+
+// scriptedService answers SendRequest with the next scripted reply, counting calls
+type scriptedService struct {
+	replies []func() (string, error)
+	calls   int
+}
+
+func (s *scriptedService) SendRequest(ctx context.Context, prompt string) (string, error) {
+	reply := s.replies[len(s.replies)-1]
+	if s.calls < len(s.replies) {
+		reply = s.replies[s.calls]
+	}
+	s.calls++
+	return reply()
+}
+
+func (s *scriptedService) SendPromptRequest(ctx context.Context, req interfaces.PromptRequest) (string, error) {
+	return s.SendRequest(ctx, req.Prompt)
+}
+
+func (s *scriptedService) GenerateIdeas(ctx context.Context, topic string, count int) ([]string, error) {
+	_, err := s.SendRequest(ctx, topic)
+	return []string{topic}, err
+}
+
+func (s *scriptedService) GenerateDrafts(ctx context.Context, idea string, userContext string) (interfaces.DraftSet, error) {
+	_, err := s.SendRequest(ctx, idea)
+	return interfaces.DraftSet{}, err
+}
+
+func (s *scriptedService) RefineDraft(ctx context.Context, draft string, userPrompt string, history []string) (string, error) {
+	return s.SendRequest(ctx, draft)
+}
+
+func ok(response string) func() (string, error) {
+	return func() (string, error) { return response, nil }
+}
+
+func fail(err error) func() (string, error) {
+	return func() (string, error) { return "", err }
+}
+
+// fakeClock is a manually advanced clock for breaker cooldowns
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func newFallbackClient(t *testing.T, clock *fakeClock, services ...*scriptedService) *fallback.Client {
+	t.Helper()
+
+	backends := make([]fallback.Backend, 0, len(services))
+	for i, service := range services {
+		backends = append(backends, fallback.Backend{Name: fmt.Sprintf("model-%d", i+1), Service: service})
+	}
+
+	client, err := fallback.NewClient(backends, fallback.Config{
+		Breaker:     fallback.BreakerConfig{FailureThreshold: 2, Cooldown: time.Minute, Now: clock.Now},
+		RequireJSON: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to create fallback client: %v", err)
+	}
+	return client
+}
+
+// TestFallbackClient_FailsOverInOrder validates that failures move on to the next backend
+func TestFallbackClient_FailsOverInOrder(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Now()}
+
+	primary := &scriptedService{replies: []func() (string, error){fail(errors.New("503 from upstream"))}}
+	secondary := &scriptedService{replies: []func() (string, error){ok(`{"ideas":["a"]}`)}}
+	client := newFallbackClient(t, clock, primary, secondary)
+
+	got, err := client.SendRequest(ctx, "prompt")
+	if err != nil || got != `{"ideas":["a"]}` {
+		t.Fatalf("expected the secondary response, got %q (%v)", got, err)
+	}
+	if primary.calls != 1 || secondary.calls != 1 {
+		t.Errorf("expected one call per backend, got %d and %d", primary.calls, secondary.calls)
+	}
+
+	if _, err := client.GenerateIdeas(ctx, "go", 1); err != nil {
+		t.Errorf("expected typed methods to fail over too, got %v", err)
+	}
+
+	status := client.Status()
+	if status[0].Backend != "model-1" || status[0].State != fallback.StateOpen || status[0].ConsecutiveFailures != 2 {
+		t.Errorf("expected the primary breaker to open after 2 failures, got %+v", status[0])
+	}
+	if status[1].State != fallback.StateClosed {
+		t.Errorf("expected the secondary breaker to stay closed, got %+v", status[1])
+	}
+}
+
+// TestFallbackClient_InvalidJSONCountsAsFailure validates that models returning prose trip their breaker
+func TestFallbackClient_InvalidJSONCountsAsFailure(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Now()}
+
+	chatty := &scriptedService{replies: []func() (string, error){ok("Claro, aquí tienes tus ideas: ...")}}
+	strict := &scriptedService{replies: []func() (string, error){ok("```json\n{\"posts\":[]}\n```")}}
+	client := newFallbackClient(t, clock, chatty, strict)
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.SendRequest(ctx, "prompt"); err != nil {
+			t.Fatalf("expected code-fenced JSON to be accepted, got %v", err)
+		}
+	}
+
+	status := client.Status()
+	if status[0].State != fallback.StateOpen || !strings.Contains(status[0].LastError, fallback.ErrInvalidJSON.Error()) {
+		t.Errorf("expected invalid JSON to open the breaker, got %+v", status[0])
+	}
+
+	// The open breaker skips the chatty model entirely
+	if _, err := client.SendRequest(ctx, "prompt"); err != nil || chatty.calls != 2 {
+		t.Errorf("expected the open backend to be skipped, got %d calls (%v)", chatty.calls, err)
+	}
+}
+
+// TestFallbackClient_HalfOpen validates that an open breaker lets one trial through after the cooldown
+func TestFallbackClient_HalfOpen(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Now()}
+
+	outage := errors.New("connection refused")
+	flaky := &scriptedService{replies: []func() (string, error){fail(outage), fail(outage), fail(outage), ok("{}")}}
+	client := newFallbackClient(t, clock, flaky)
+
+	for i := 0; i < 2; i++ {
+		client.SendRequest(ctx, "prompt")
+	}
+
+	// Every backend is open: fail fast without calling anything
+	_, err := client.SendRequest(ctx, "prompt")
+	if !errors.Is(err, fallback.ErrAllBackendsUnavailable) || flaky.calls != 2 {
+		t.Fatalf("expected ErrAllBackendsUnavailable without a call, got %v after %d calls", err, flaky.calls)
+	}
+
+	// A failed trial reopens the breaker for another cooldown
+	clock.now = clock.now.Add(time.Minute)
+	_, err = client.SendRequest(ctx, "prompt")
+	if !errors.Is(err, outage) || client.Status()[0].State != fallback.StateOpen {
+		t.Fatalf("expected the failed trial to reopen the breaker, got %v (%+v)", err, client.Status()[0])
+	}
+	if _, err := client.SendRequest(ctx, "prompt"); flaky.calls != 3 {
+		t.Fatalf("expected no call during the new cooldown, got %d calls (%v)", flaky.calls, err)
+	}
+
+	// A successful trial closes it
+	clock.now = clock.now.Add(time.Minute)
+	if _, err := client.SendRequest(ctx, "prompt"); err != nil {
+		t.Fatalf("expected the trial to succeed, got %v", err)
+	}
+	if status := client.Status()[0]; status.State != fallback.StateClosed || status.ConsecutiveFailures != 0 {
+		t.Errorf("expected the breaker to close, got %+v", status)
+	}
+}
+
+// TestFallbackClient_CallerErrorsDoNotTrip validates that invalid requests and cancellations don't count
+func TestFallbackClient_CallerErrorsDoNotTrip(t *testing.T) {
+	clock := &fakeClock{now: time.Now()}
+
+	invalid := fmt.Errorf("%w: topic cannot be empty", llm.ErrInvalidRequest)
+	primary := &scriptedService{replies: []func() (string, error){fail(invalid)}}
+	secondary := &scriptedService{replies: []func() (string, error){ok("{}")}}
+	client := newFallbackClient(t, clock, primary, secondary)
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.GenerateIdeas(context.Background(), "", 1); !errors.Is(err, llm.ErrInvalidRequest) {
+			t.Fatalf("expected ErrInvalidRequest, got %v", err)
+		}
+	}
+	if secondary.calls != 0 || client.Status()[0].State != fallback.StateClosed {
+		t.Errorf("expected invalid requests to neither fail over nor trip, got %d calls (%+v)", secondary.calls, client.Status()[0])
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	primary.replies = []func() (string, error){fail(context.Canceled)}
+	for i := 0; i < 3; i++ {
+		client.SendRequest(ctx, "prompt")
+	}
+	if secondary.calls != 0 || client.Status()[0].ConsecutiveFailures != 0 {
+		t.Errorf("expected cancellations to neither fail over nor count, got %d calls (%+v)", secondary.calls, client.Status()[0])
+	}
+
+	if _, err := fallback.NewClient(nil, fallback.Config{}); !errors.Is(err, fallback.ErrNoBackends) {
+		t.Errorf("expected ErrNoBackends, got %v", err)
+	}
+}
+
+// midStreamService streams one chunk and then fails
+type midStreamService struct {
+	scriptedService
+}
+
+func (s *midStreamService) StreamRequest(ctx context.Context, prompt string, onChunk interfaces.LLMStreamHandler) (string, error) {
+	s.calls++
+	if err := onChunk("partial "); err != nil {
+		return "", err
+	}
+	return "", errors.New("stream reset by peer")
+}
+
+func (s *midStreamService) RefineDraftStream(ctx context.Context, draft string, userPrompt string, history []string, onChunk interfaces.LLMStreamHandler) (string, error) {
+	return s.StreamRequest(ctx, draft, onChunk)
+}
+
+// TestFallbackClient_StreamsFailOverUntilFirstChunk validates that streams only fail over before any chunk is delivered
+func TestFallbackClient_StreamsFailOverUntilFirstChunk(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Now()}
+
+	var chunks []string
+	collect := func(chunk string) error {
+		chunks = append(chunks, chunk)
+		return nil
+	}
+
+	// Nothing streamed yet: the next backend answers, in one chunk since it can't stream
+	down := &scriptedService{replies: []func() (string, error){fail(errors.New("503 from upstream"))}}
+	up := &scriptedService{replies: []func() (string, error){ok("texto refinado")}}
+	got, err := newFallbackClient(t, clock, down, up).RefineDraftStream(ctx, "borrador", "más corto", nil, collect)
+	if err != nil || got != "texto refinado" || len(chunks) != 1 || chunks[0] != got {
+		t.Fatalf("expected the secondary response in one chunk, got %q %q (%v)", got, chunks, err)
+	}
+
+	// A chunk was delivered: the failure is returned and counted
+	chunks = nil
+	broken := &midStreamService{}
+	spare := &scriptedService{replies: []func() (string, error){ok("no debería usarse")}}
+	client, err := fallback.NewClient([]fallback.Backend{{Name: "broken", Service: broken}, {Name: "spare", Service: spare}}, fallback.Config{})
+	if err != nil {
+		t.Fatalf("failed to create fallback client: %v", err)
+	}
+	_, err = client.StreamRequest(ctx, "prompt", collect)
+	if err == nil || errors.Is(err, fallback.ErrAllBackendsUnavailable) || spare.calls != 0 {
+		t.Fatalf("expected the mid-stream error without failing over, got %v after %d spare calls", err, spare.calls)
+	}
+	if len(chunks) != 1 || client.Status()[0].ConsecutiveFailures != 1 {
+		t.Errorf("expected 1 chunk and a recorded failure, got %q (%+v)", chunks, client.Status()[0])
+	}
+}
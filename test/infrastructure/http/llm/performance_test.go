@@ -405,7 +405,7 @@ func BenchmarkConcurrentRequests(b *testing.B) {
 			go func() {
 				defer wg.Done()
 				// Will fail: Concurrent benchmark not implemented yet
-				b.Fatal("Concurrent requests benchmark not implemented yet - TDD Red phase")
+				b.Error("Concurrent requests benchmark not implemented yet - TDD Red phase")
 			}()
 		}
 
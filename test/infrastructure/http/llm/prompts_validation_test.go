@@ -1,11 +1,7 @@
 package llm
 
 import (
-	"context"
 	"testing"
-
-	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/require"
 )
 
 // TestHardcodedPromptsValidation validates that there are no hardcoded prompts
@@ -0,0 +1,265 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	llmclient "github.com/linkgen-ai/backend/src/infrastructure/http/llm"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/structured"
+)
+
+// providerFake describes how a provider's API expects to be called and how it answers
+type providerFake struct {
+	provider   string
+	path       string
+	authHeader string
+	authValue  string
+	success    string
+	failure    string
+	errType    string
+}
+
+var providerFakes = []providerFake{
+	{
+		provider:   llmclient.ProviderOpenAI,
+		path:       "/v1/chat/completions",
+		authHeader: "Authorization",
+		authValue:  "Bearer test-key",
+		success:    `{"choices":[{"message":{"role":"assistant","content":"hola"},"finish_reason":"stop"}]}`,
+		failure:    `{"error":{"message":"Rate limit reached","type":"requests","code":"rate_limit_exceeded"}}`,
+		errType:    "requests",
+	},
+	{
+		provider:   llmclient.ProviderAnthropic,
+		path:       "/v1/messages",
+		authHeader: "x-api-key",
+		authValue:  "test-key",
+		success:    `{"type":"message","role":"assistant","content":[{"type":"text","text":"hola"}],"stop_reason":"end_turn"}`,
+		failure:    `{"type":"error","error":{"type":"rate_limit_error","message":"Rate limit reached"}}`,
+		errType:    "rate_limit_error",
+	},
+	{
+		provider:   llmclient.ProviderOllama,
+		path:       "/api/chat",
+		authHeader: "Authorization",
+		authValue:  "Bearer test-key",
+		success:    `{"model":"llama3","message":{"role":"assistant","content":"hola"},"done":true,"done_reason":"stop"}`,
+		failure:    `{"error":"Rate limit reached"}`,
+	},
+}
+
+// newProviderServer serves a provider's wire format, failing with status until failures run out
+func newProviderServer(t *testing.T, fake providerFake, status int, failures int) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != fake.path {
+			t.Errorf("%s: expected path %s, got %s", fake.provider, fake.path, r.URL.Path)
+		}
+		if got := r.Header.Get(fake.authHeader); got != fake.authValue {
+			t.Errorf("%s: expected %s %q, got %q", fake.provider, fake.authHeader, fake.authValue, got)
+		}
+		if fake.provider == llmclient.ProviderAnthropic && r.Header.Get("anthropic-version") != llmclient.AnthropicVersion {
+			t.Errorf("anthropic: missing anthropic-version header")
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("%s: invalid request body: %v", fake.provider, err)
+		}
+		if body["model"] != "test-model" {
+			t.Errorf("%s: expected model test-model, got %v", fake.provider, body["model"])
+		}
+		if fake.provider == llmclient.ProviderOllama && body["stream"] != false {
+			t.Errorf("ollama: expected stream false, got %v", body["stream"])
+		}
+		if fake.provider == llmclient.ProviderAnthropic && body["max_tokens"] == nil {
+			t.Errorf("anthropic: max_tokens is required")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if failures > 0 {
+			failures--
+			w.WriteHeader(status)
+			io.WriteString(w, fake.failure)
+			return
+		}
+		io.WriteString(w, fake.success)
+	}))
+}
+
+func newProviderClient(t *testing.T, provider, baseURL string) *llmclient.LLMHTTPClient {
+	t.Helper()
+
+	client, err := llmclient.NewLLMHTTPClient(llmclient.Config{
+		BaseURL:  baseURL,
+		Timeout:  5 * time.Second,
+		Model:    "test-model",
+		Provider: provider,
+		APIKey:   "test-key",
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	retry := llmclient.DefaultRetryConfig()
+	retry.InitialDelay = time.Millisecond
+	retry.MaxDelay = time.Millisecond
+	client.SetRetryConfig(retry)
+	return client
+}
+
+// TestProviderAdapters validates wire format, auth headers and error mapping of every provider
+func TestProviderAdapters(t *testing.T) {
+	for _, fake := range providerFakes {
+		t.Run(fake.provider, func(t *testing.T) {
+			ctx := context.Background()
+
+			server := newProviderServer(t, fake, http.StatusServiceUnavailable, 1)
+			defer server.Close()
+
+			// The first 503 is retried
+			got, err := newProviderClient(t, fake.provider, server.URL+"/").SendRequest(ctx, "saluda")
+			if err != nil || got != "hola" {
+				t.Fatalf("expected hola, got %q (%v)", got, err)
+			}
+
+			badRequest := newProviderServer(t, fake, http.StatusBadRequest, 1)
+			defer badRequest.Close()
+
+			_, err = newProviderClient(t, fake.provider, badRequest.URL).SendRequest(ctx, "saluda")
+			var apiErr *llmclient.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected APIError, got %v", err)
+			}
+			if apiErr.StatusCode != http.StatusBadRequest || apiErr.Message != "Rate limit reached" || apiErr.Type != fake.errType {
+				t.Errorf("unexpected API error: %+v", apiErr)
+			}
+
+			// Errors survive exhausted retries
+			throttled := newProviderServer(t, fake, http.StatusTooManyRequests, 10)
+			defer throttled.Close()
+
+			_, err = newProviderClient(t, fake.provider, throttled.URL).SendRequest(ctx, "saluda")
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests {
+				t.Errorf("expected 429 APIError after retries, got %v", err)
+			}
+		})
+	}
+}
+
+// TestProviderRequestShapes validates provider-specific request details
+func TestProviderRequestShapes(t *testing.T) {
+	temperature := 0.3
+	request := llmclient.LLMRequest{
+		Model: "test-model",
+		Messages: []llmclient.Message{
+			{Role: "system", Content: "Eres un experto"},
+			{Role: "user", Content: "saluda"},
+		},
+		Temperature: &temperature,
+		MaxTokens:   100,
+		Stop:        []string{"###"},
+	}
+
+	anthropic, err := llmclient.NewProvider(llmclient.ProviderAnthropic, "https://api.anthropic.com/", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req, err := anthropic.NewRequest(context.Background(), request, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.URL.String() != "https://api.anthropic.com/v1/messages" || req.Header.Get("x-api-key") != "" {
+		t.Errorf("unexpected anthropic request: %s %v", req.URL, req.Header)
+	}
+
+	var body struct {
+		System        string              `json:"system"`
+		Messages      []llmclient.Message `json:"messages"`
+		StopSequences []string            `json:"stop_sequences"`
+	}
+	json.NewDecoder(req.Body).Decode(&body)
+	if body.System != "Eres un experto" || len(body.Messages) != 1 || body.Messages[0].Role != "user" {
+		t.Errorf("expected the system message to move to the system field, got %+v", body)
+	}
+	if len(body.StopSequences) != 1 || body.StopSequences[0] != "###" {
+		t.Errorf("expected stop sequences in stop_sequences, got %+v", body.StopSequences)
+	}
+
+	// A per-call key replaces the configured one
+	req, _ = anthropic.NewRequest(context.Background(), request, "sk-user")
+	if req.Header.Get("x-api-key") != "sk-user" {
+		t.Errorf("expected the per-call key in x-api-key, got %q", req.Header.Get("x-api-key"))
+	}
+
+	ollama, _ := llmclient.NewProvider(llmclient.ProviderOllama, "http://localhost:11434", "")
+	req, _ = ollama.NewRequest(context.Background(), request, "")
+	raw, _ := io.ReadAll(req.Body)
+	if !strings.Contains(string(raw), `"options":{"temperature":0.3,"num_predict":100,"stop":["###"]}`) {
+		t.Errorf("expected model parameters in options, got %s", raw)
+	}
+
+	if _, err := llmclient.NewProvider("bard", "http://localhost", ""); err == nil {
+		t.Error("expected an unknown provider to be rejected")
+	}
+	if _, err := llmclient.NewLLMHTTPClient(llmclient.Config{BaseURL: "http://localhost", Timeout: time.Second, Provider: llmclient.ProviderOllama}); err == nil {
+		t.Error("expected a model to be required outside OpenAI")
+	}
+}
+
+// TestSendPromptRequest validates that a prompt request's system message, parameters and
+// schema reach the wire, and that raw prompts use the client's settings
+func TestSendPromptRequest(t *testing.T) {
+	var bodies []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		bodies = append(bodies, body)
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, providerFakes[0].success)
+	}))
+	defer server.Close()
+
+	client := newProviderClient(t, llmclient.ProviderOpenAI, server.URL)
+	temperature := 0.2
+	_, err := client.SendPromptRequest(context.Background(), interfaces.PromptRequest{
+		Prompt:     "saluda",
+		System:     "Eres un experto",
+		Parameters: entities.PromptParameters{Temperature: &temperature, MaxTokens: 50},
+		Schema:     structured.IdeasSchema.Response(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.SendRequest(context.Background(), "saluda"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	prompted := bodies[0]
+	messages, _ := prompted["messages"].([]interface{})
+	if len(messages) != 2 || messages[0].(map[string]interface{})["content"] != "Eres un experto" {
+		t.Errorf("expected the system message ahead of the prompt, got %v", prompted["messages"])
+	}
+	if prompted["temperature"] != 0.2 || prompted["max_tokens"] != 50.0 {
+		t.Errorf("expected the prompt's parameters, got temperature %v and max_tokens %v", prompted["temperature"], prompted["max_tokens"])
+	}
+	format, _ := prompted["response_format"].(map[string]interface{})
+	if schema, _ := format["json_schema"].(map[string]interface{}); schema["name"] != "ideas" {
+		t.Errorf("expected the ideas schema in response_format, got %v", prompted["response_format"])
+	}
+
+	raw := bodies[1]
+	if raw["temperature"] != llmclient.DefaultTemperature || raw["max_tokens"] != float64(llmclient.DefaultMaxTokens) || raw["response_format"] != nil {
+		t.Errorf("expected the client's settings for a raw prompt, got %v", raw)
+	}
+}
@@ -0,0 +1,154 @@
+package structured
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm/structured"
+)
+
+// TestExtract validates the tolerant extraction of JSON from model responses
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		want     string
+		wantErr  error
+	}{
+		{name: "plain", response: `{"ideas":["a"]}`, want: `{"ideas":["a"]}`},
+		{name: "code fence", response: "```json\n{\"ideas\":[\"a\"]}\n```", want: `{"ideas":["a"]}`},
+		{name: "prose around", response: "Claro, aquí tienes:\n{\"ideas\":[\"a\"]}\n¡Espero que te sirva!", want: `{"ideas":["a"]}`},
+		{name: "trailing commas", response: "{\"ideas\":[\"a\",\"b\",],}", want: `{"ideas":["a","b"]}`},
+		{name: "commas and brackets inside strings", response: `{"refined":"uno, } dos,]",}`, want: `{"refined":"uno, } dos,]"}`},
+		{name: "no JSON", response: "Lo siento, no puedo ayudarte con eso.", wantErr: structured.ErrNoJSON},
+		{name: "truncated", response: `{"posts": ["El primer post quedó a medias`, wantErr: structured.ErrInvalidJSON},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := structured.Extract(tt.response)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %q (%v)", tt.wantErr, got, err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("expected %q, got %q (%v)", tt.want, got, err)
+			}
+		})
+	}
+}
+
+// TestSchemaValidate validates the error paths reported for mismatched shapes
+func TestSchemaValidate(t *testing.T) {
+	tests := []struct {
+		response string
+		wantErr  string
+	}{
+		{response: `{"posts":["a"],"articles":["b"]}`},
+		{response: `{"posts":["a"]}`, wantErr: `$: missing required property "articles"`},
+		{response: `{"posts":["a",2],"articles":[]}`, wantErr: `$.posts[1]: expected a string, got a number`},
+		{response: `{"posts":"a","articles":[]}`, wantErr: `$.posts: expected an array, got a string`},
+		{response: `{"posts":[],"articles":[],"notes":"x"}`, wantErr: `$: unexpected property "notes"`},
+		{response: `["a"]`, wantErr: `$: expected an object, got an array`},
+	}
+
+	for _, tt := range tests {
+		err := structured.Decode(tt.response, structured.DraftsSchema, nil)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", tt.response, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: expected error containing %q, got %v", tt.response, tt.wantErr, err)
+		}
+	}
+}
+
+// scriptedLLM answers prompt requests with the next scripted response and records the requests
+type scriptedLLM struct {
+	responses []string
+	prompts   []string
+	requests  []interfaces.PromptRequest
+}
+
+func (s *scriptedLLM) SendRequest(ctx context.Context, prompt string) (string, error) {
+	return s.SendPromptRequest(ctx, interfaces.PromptRequest{Prompt: prompt})
+}
+
+func (s *scriptedLLM) SendPromptRequest(ctx context.Context, req interfaces.PromptRequest) (string, error) {
+	s.prompts = append(s.prompts, req.Prompt)
+	s.requests = append(s.requests, req)
+	response := s.responses[0]
+	if len(s.responses) > 1 {
+		s.responses = s.responses[1:]
+	}
+	return response, nil
+}
+
+func (s *scriptedLLM) GenerateIdeas(ctx context.Context, topic string, count int) ([]string, error) {
+	return nil, nil
+}
+
+func (s *scriptedLLM) GenerateDrafts(ctx context.Context, idea string, userContext string) (interfaces.DraftSet, error) {
+	return interfaces.DraftSet{}, nil
+}
+
+func (s *scriptedLLM) RefineDraft(ctx context.Context, draft string, userPrompt string, history []string) (string, error) {
+	return "", nil
+}
+
+// TestComplete_RepairsOnce validates the repair round-trip and the error once it fails
+func TestComplete_RepairsOnce(t *testing.T) {
+	ctx := context.Background()
+
+	// Tolerant extraction needs no repair
+	service := &scriptedLLM{responses: []string{"Aquí está:\n{\"ideas\":[\"a\",],}"}}
+	var ideas struct {
+		Ideas []string `json:"ideas"`
+	}
+	if _, err := structured.Complete(ctx, service, interfaces.PromptRequest{Prompt: "ideas"}, structured.IdeasSchema, &ideas); err != nil || len(ideas.Ideas) != 1 {
+		t.Fatalf("expected the response to be cleaned up, got %+v (%v)", ideas, err)
+	}
+	if len(service.prompts) != 1 || service.requests[0].Schema == nil || service.requests[0].Schema.Name != structured.IdeasSchema.Name {
+		t.Errorf("expected one call carrying the schema, got %d calls", len(service.prompts))
+	}
+
+	// A wrong shape is sent back with the problem and the schema
+	service = &scriptedLLM{responses: []string{`{"refinado":"texto"}`, `{"refined":"texto"}`}}
+	var refinement struct {
+		Refined string `json:"refined"`
+	}
+	response, err := structured.Complete(ctx, service, interfaces.PromptRequest{Prompt: "refine", System: "Eres editor"}, structured.RefinementSchema, &refinement)
+	if err != nil || refinement.Refined != "texto" || response != `{"refined":"texto"}` {
+		t.Fatalf("expected the repaired response, got %q (%v)", response, err)
+	}
+	repair := service.prompts[1]
+	if service.requests[1].System != "Eres editor" || service.requests[1].Schema == nil {
+		t.Errorf("expected the repair to keep the system message and schema, got %+v", service.requests[1])
+	}
+	if !strings.Contains(repair, `missing required property \"refined\"`) && !strings.Contains(repair, `missing required property "refined"`) {
+		t.Errorf("expected the repair prompt to explain the problem, got %q", repair)
+	}
+	if !strings.Contains(repair, `{"refinado":"texto"}`) || !strings.Contains(repair, `"required":["refined"]`) {
+		t.Errorf("expected the repair prompt to include the response and the schema, got %q", repair)
+	}
+
+	// Only one repair is attempted
+	service = &scriptedLLM{responses: []string{"no es JSON"}}
+	_, err = structured.Complete(ctx, service, interfaces.PromptRequest{Prompt: "drafts"}, structured.DraftsSchema, nil)
+	var llmErr *domainErrors.LLMResponseError
+	if !errors.As(err, &llmErr) || llmErr.Operation != "drafts_schema" || llmErr.Prompt != "drafts" {
+		t.Fatalf("expected an LLMResponseError for drafts, got %v", err)
+	}
+	if !errors.Is(err, structured.ErrNoJSON) || len(service.prompts) != 2 {
+		t.Errorf("expected ErrNoJSON after 2 calls, got %v after %d calls", err, len(service.prompts))
+	}
+}
@@ -259,7 +259,7 @@ func TestNATSContextCancellationIntegration(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ctx, cancel := context.WithCancel(context.Background())
+			_, cancel := context.WithCancel(context.Background())
 			if tt.cancelAfter > 0 {
 				time.AfterFunc(tt.cancelAfter, cancel)
 			} else {
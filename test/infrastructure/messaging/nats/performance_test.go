@@ -1,7 +1,6 @@
 package nats
 
 import (
-	"context"
 	"testing"
 	"time"
 )
@@ -354,8 +353,6 @@ func TestNATSReconnectionPerformance(t *testing.T) {
 
 // BenchmarkNATSPublish benchmarks publish operations
 func BenchmarkNATSPublish(b *testing.B) {
-	ctx := context.Background()
-
 	b.Run("single-message", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
 			// Will fail: Publish benchmark doesn't exist yet
@@ -373,8 +370,6 @@ func BenchmarkNATSPublish(b *testing.B) {
 
 // BenchmarkNATSConsume benchmarks consume operations
 func BenchmarkNATSConsume(b *testing.B) {
-	ctx := context.Background()
-
 	b.Run("single-message", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
 			// Will fail: Consume benchmark doesn't exist yet
@@ -394,8 +389,6 @@ func BenchmarkNATSConsume(b *testing.B) {
 
 // BenchmarkNATSRoundTrip benchmarks full roundtrip (publish + consume)
 func BenchmarkNATSRoundTrip(b *testing.B) {
-	ctx := context.Background()
-
 	b.Run("roundtrip", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
 			// Will fail: Roundtrip benchmark doesn't exist yet
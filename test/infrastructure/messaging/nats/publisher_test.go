@@ -220,7 +220,7 @@ func TestPublishContextCancellation(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ctx, cancel := context.WithTimeout(context.Background(), tt.contextTimeout)
+			_, cancel := context.WithTimeout(context.Background(), tt.contextTimeout)
 			defer cancel()
 
 			// Will fail: Context handling doesn't exist yet
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/infrastructure/services"
+)
+
+// TestDiffLines validates the line diff between two templates
+func TestDiffLines(t *testing.T) {
+	got := services.DiffLines("Genera ideas\nsobre {name}\nen JSON", "Genera ideas\nsobre {name} y {related_topics}\nen JSON")
+	want := []services.DiffLine{
+		{Op: services.DiffOpEqual, Text: "Genera ideas"},
+		{Op: services.DiffOpInsert, Text: "sobre {name} y {related_topics}"},
+		{Op: services.DiffOpDelete, Text: "sobre {name}"},
+		{Op: services.DiffOpEqual, Text: "en JSON"},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	if got := services.DiffLines("", "a"); !slices.Equal(got, []services.DiffLine{{Op: services.DiffOpInsert, Text: "a"}}) {
+		t.Errorf("expected a single insert, got %v", got)
+	}
+}
+
+// TestDiffPromptVersions validates that parameter changes are listed alongside the text diff
+func TestDiffPromptVersions(t *testing.T) {
+	temperature := 0.3
+	from := &entities.PromptVersion{PromptID: "p1", Version: 1, PromptTemplate: "{content}", Parameters: entities.PromptParameters{Model: "a"}}
+	to := &entities.PromptVersion{PromptID: "p1", Version: 2, PromptTemplate: "{content}", Parameters: entities.PromptParameters{Model: "a", Temperature: &temperature}}
+
+	diff := services.DiffPromptVersions(from, to)
+	if diff.From != 1 || diff.To != 2 {
+		t.Errorf("expected versions 1 to 2, got %d to %d", diff.From, diff.To)
+	}
+	want := []services.PromptParameterChange{{Name: "temperature", From: "", To: "0.3"}}
+	if !slices.Equal(diff.Parameters, want) {
+		t.Errorf("expected %v, got %v", want, diff.Parameters)
+	}
+	if len(diff.Template) != 1 || diff.Template[0].Op != services.DiffOpEqual {
+		t.Errorf("expected an unchanged template, got %v", diff.Template)
+	}
+}
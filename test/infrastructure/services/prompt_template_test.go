@@ -0,0 +1,113 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/infrastructure/services"
+)
+
+// TestRenderPromptTemplate validates conditionals, loops, filters and the legacy placeholders
+func TestRenderPromptTemplate(t *testing.T) {
+	topic := &entities.Topic{Name: "Go", Ideas: 3, RelatedTopics: []string{"Concurrencia", "Testing"}}
+	idea := &entities.Idea{Content: "Canales sin buffer", TopicName: "Go"}
+	user := &entities.User{
+		Language:      "es",
+		Configuration: map[string]interface{}{"tone_preference": "cercano", "llm_api_key": "sk-secret"},
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		data     services.PromptTemplateData
+		want     string
+	}{
+		{
+			name:     "legacy placeholders",
+			template: "Genera {ideas} ideas sobre {name}\nTemas relacionados: {[related_topics]}",
+			data:     services.PromptTemplateData{Topic: topic},
+			want:     "Genera 3 ideas sobre Go\nTemas relacionados: Concurrencia, Testing",
+		},
+		{
+			name:     "legacy related topics line dropped when empty",
+			template: "Tema: {name}\nTemas relacionados: {[related_topics]}",
+			data:     services.PromptTemplateData{Topic: &entities.Topic{Name: "Go"}},
+			want:     "Tema: Go\n",
+		},
+		{
+			name:     "conditionals and loops",
+			template: `{{if .topic.related_topics}}{{range $i, $t := .topic.related_topics}}{{if $i}};{{end}}{{$t}}{{end}}{{else}}ninguno{{end}}`,
+			data:     services.PromptTemplateData{Topic: topic},
+			want:     "Concurrencia;Testing",
+		},
+		{
+			name:     "filters",
+			template: `{{.idea.content | upper}} {{.topic.description | default "sin descripción"}} {{.idea.content | truncate 7}}`,
+			data:     services.PromptTemplateData{Topic: topic, Idea: idea},
+			want:     "CANALES SIN BUFFER sin descripción Canales",
+		},
+		{
+			name:     "bullets",
+			template: `{{bullets .topic.related_topics}}`,
+			data:     services.PromptTemplateData{Topic: topic},
+			want:     "- Concurrencia\n- Testing",
+		},
+		{
+			name:     "user configuration",
+			template: `{{index .user.config "tone_preference" | default "profesional"}} {{.user.language}} {{.user.context}}`,
+			data:     services.PromptTemplateData{User: user, UserContext: "Perfil"},
+			want:     "cercano es Perfil",
+		},
+		{
+			name:     "draft",
+			template: `{{.draft.type}}: {{.draft.content}}`,
+			data:     services.PromptTemplateData{Draft: &entities.Draft{Type: entities.DraftTypePost, Content: "Hola"}},
+			want:     "POST: Hola",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := services.RenderPromptTemplate(tt.template, tt.data)
+			if err != nil {
+				t.Fatalf("failed to render template: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestRenderPromptTemplate_Sandbox validates that templates fail on unknown fields and runaway output
+func TestRenderPromptTemplate_Sandbox(t *testing.T) {
+	if err := services.ValidatePromptTemplate("{{.topic.nmae}}"); err == nil {
+		t.Error("expected a misspelt field to fail validation")
+	}
+	if err := services.ValidatePromptTemplate("{{if .topic.name}}sin cerrar"); err == nil {
+		t.Error("expected an unclosed action to fail validation")
+	}
+	if err := services.ValidatePromptTemplate(`{{.user.Configuration}}`); err == nil {
+		t.Error("expected the raw user entity to be unreachable")
+	}
+
+	user := &entities.User{
+		APIKeys:       map[string]string{"openai": "sk-secret"},
+		Configuration: map[string]interface{}{"llm_api_key": "sk-secret"},
+	}
+	got, err := services.RenderPromptTemplate(`{{.user}}`, services.PromptTemplateData{User: user})
+	if err != nil || strings.Contains(got, "sk-secret") {
+		t.Errorf("expected API keys to never be exposed, got %q (%v)", got, err)
+	}
+
+	topic := &entities.Topic{RelatedTopics: make([]string, 1000)}
+	for i := range topic.RelatedTopics {
+		topic.RelatedTopics[i] = strings.Repeat("x", 100)
+	}
+	_, err = services.RenderPromptTemplate(`{{range .topic.related_topics}}{{.}}{{end}}`, services.PromptTemplateData{Topic: topic})
+	if !errors.Is(err, services.ErrPromptTooLong) {
+		t.Errorf("expected ErrPromptTooLong, got %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+package services
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	"github.com/linkgen-ai/backend/src/infrastructure/services"
+)
+
+// TestValidatePromptVariables validates templates against the variables of their prompt type
+func TestValidatePromptVariables(t *testing.T) {
+	tests := []struct {
+		name       string
+		template   string
+		promptType entities.PromptType
+		wantErr    error
+	}{
+		{
+			name:       "legacy ideas prompt",
+			template:   "Genera {ideas} ideas sobre {name}\nTemas relacionados: {[related_topics]}\n{\"ideas\": []}",
+			promptType: entities.PromptTypeIdeas,
+		},
+		{
+			name:       "template drafts prompt",
+			template:   `{{with .idea}}{{.content}}{{end}} {{index .user.config "tone_preference" | default "profesional"}}`,
+			promptType: entities.PromptTypeDrafts,
+		},
+		{
+			name:       "draft variables in a drafts prompt",
+			template:   `Reescribe este {{.draft.type | lower}} sobre {{.idea.content}}: {{.draft.title}} {{.draft.content}}`,
+			promptType: entities.PromptTypeDrafts,
+		},
+		{
+			name:       "draft variables in an ideas prompt",
+			template:   "{{.topic.name}} {{.topic.ideas}} {{.draft.content}}",
+			promptType: entities.PromptTypeIdeas,
+			wantErr:    services.ErrUnknownPromptVariable,
+		},
+		{
+			name:       "misspelt legacy placeholder",
+			template:   "Genera {idaes} ideas sobre {name}",
+			promptType: entities.PromptTypeIdeas,
+			wantErr:    services.ErrUnresolvedPlaceholder,
+		},
+		{
+			name:       "variable of another prompt type",
+			template:   "{name} {ideas} {content}",
+			promptType: entities.PromptTypeIdeas,
+			wantErr:    services.ErrUnknownPromptVariable,
+		},
+		{
+			name:       "undefined variable",
+			template:   "{{.topic.name}} {{.topic.ideas}} {{.topic.keywords}}",
+			promptType: entities.PromptTypeIdeas,
+			wantErr:    services.ErrUnknownPromptVariable,
+		},
+		{
+			name:       "missing required variable",
+			template:   "Genera ideas sobre {name}",
+			promptType: entities.PromptTypeIdeas,
+			wantErr:    services.ErrMissingPromptVariable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := services.ValidatePromptVariables(tt.template, tt.promptType)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("expected a valid template, got %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// TestPromptTemplateVariables validates which fields count as variables
+func TestPromptTemplateVariables(t *testing.T) {
+	got, err := services.PromptTemplateVariables(`{{.topic.name}} {{range .topic.related_topics}}{{.}}{{end}} {{$.user.context}} {user_context}`)
+	if err != nil {
+		t.Fatalf("failed to read variables: %v", err)
+	}
+	want := []string{"topic.name", "topic.related_topics", "user.context"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+// TestRenderPromptTemplate_FailsOnUnresolvedVariables validates that rendering fails instead of sending placeholders
+func TestRenderPromptTemplate_FailsOnUnresolvedVariables(t *testing.T) {
+	data := services.PromptTemplateData{Topic: &entities.Topic{Name: "Go", Ideas: 3}, Type: entities.PromptTypeIdeas}
+
+	if _, err := services.RenderPromptTemplate("Genera {idaes} ideas sobre {name}", data); !errors.Is(err, services.ErrUnresolvedPlaceholder) {
+		t.Errorf("expected ErrUnresolvedPlaceholder, got %v", err)
+	}
+	if _, err := services.RenderPromptTemplate("{name}: {content}", data); !errors.Is(err, services.ErrUnknownPromptVariable) {
+		t.Errorf("expected ErrUnknownPromptVariable, got %v", err)
+	}
+
+	// Braces in the rendered values are content, not placeholders
+	data.Topic.Name = "{name}"
+	if got, err := services.RenderPromptTemplate("Tema: {name}", data); err != nil || got != "Tema: {name}" {
+		t.Errorf("expected the topic name verbatim, got %q (%v)", got, err)
+	}
+}
+
+// TestProcessTemplate_DraftVariables validates that drafts templates read the draft passed to ProcessTemplate
+func TestProcessTemplate_DraftVariables(t *testing.T) {
+	engine := services.NewPromptEngine(nil, nil)
+	template := `{{.draft.type}} "{{.draft.title}}": {{.draft.content}} ({{.idea.content}})`
+
+	got, err := engine.ProcessTemplate(template, nil, map[string]interface{}{
+		"content": "Clean Architecture en Go",
+		"draft": &entities.Draft{
+			Type:    entities.DraftTypeArticle,
+			Title:   "Capas sin dependencias",
+			Content: "Las reglas de negocio no conocen la base de datos.",
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to process template: %v", err)
+	}
+
+	want := `ARTICLE "Capas sin dependencias": Las reglas de negocio no conocen la base de datos. (Clean Architecture en Go)`
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+
+	// Without a draft its variables render empty, like those of any missing entity
+	got, err = engine.ProcessTemplate(template, nil, map[string]interface{}{"content": "Clean Architecture en Go"})
+	if err != nil {
+		t.Fatalf("failed to process template without a draft: %v", err)
+	}
+	if want := ` "":  (Clean Architecture en Go)`; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
@@ -1,328 +1,696 @@
 package integration
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/linkgen-ai/backend/src/application/usecases"
+	"github.com/linkgen-ai/backend/src/domain/entities"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
+	"github.com/linkgen-ai/backend/src/infrastructure/config"
+	"github.com/linkgen-ai/backend/src/infrastructure/database/repositories"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/linkedin"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/linkedin/fakelinkedin"
+	"github.com/linkgen-ai/backend/src/infrastructure/http/llm"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-// TestPublishFlow_EndToEnd validates complete publishing flow
-// This test will FAIL until publish flow is implemented
-func TestPublishFlow_EndToEnd(t *testing.T) {
-	t.Run("complete flow from draft creation to LinkedIn publish", func(t *testing.T) {
-		// Steps:
-		// 1. Create user with LinkedIn token
-		// 2. Generate ideas for user
-		// 3. Generate drafts from idea
-		// 4. Publish draft to LinkedIn
-		// 5. Verify draft status is PUBLISHED
-		// 6. Verify published_at is set
-		// 7. Verify linkedin_post_id is set
-
-		// Will fail: Full publish flow doesn't exist yet
-		t.Fatal("Publish flow integration not implemented yet - TDD Red phase")
-	})
+const publishFlowPostContent = "Shipping a local LinkedIn stand-in made our whole publishing path testable end to end."
+
+// fakeLinkedInService drives the fake LinkedIn API through its admin endpoints.
+// It talks to the fake-linkedin service of docker-compose.test.yml when
+// LINKGEN_LINKEDIN_API_URL is set, and to an in-process fake otherwise.
+type fakeLinkedInService struct {
+	baseURL string
+	config  linkedin.Config
+	http    *http.Client
 }
 
-// TestPublishFlow_WithRefinement validates publish after refinement
-// This test will FAIL until refinement + publish flow is implemented
-func TestPublishFlow_WithRefinement(t *testing.T) {
-	t.Run("refine draft then publish to LinkedIn", func(t *testing.T) {
-		// Steps:
-		// 1. Create draft
-		// 2. Refine draft with user prompt
-		// 3. Verify status is REFINED
-		// 4. Publish refined draft
-		// 5. Verify status is PUBLISHED
-		// 6. Verify refinement history is preserved
-
-		// Will fail: Refinement + publish flow doesn't exist yet
-		t.Fatal("Refinement + publish flow not implemented yet - TDD Red phase")
-	})
+func newFakeLinkedInService(t *testing.T) *fakeLinkedInService {
+	t.Helper()
+
+	service := &fakeLinkedInService{http: &http.Client{Timeout: 5 * time.Second}}
+
+	if apiURL := os.Getenv("LINKGEN_LINKEDIN_API_URL"); apiURL != "" {
+		service.baseURL = strings.TrimSuffix(apiURL, fakelinkedin.APIPrefix)
+		service.config = linkedin.Config{
+			APIURL:       apiURL,
+			TokenURL:     os.Getenv("LINKGEN_LINKEDIN_TOKEN_URL"),
+			AuthURL:      os.Getenv("LINKGEN_LINKEDIN_AUTH_URL"),
+			ClientID:     "integration-client",
+			ClientSecret: "integration-secret",
+			Timeout:      5 * time.Second,
+		}
+	} else {
+		fake := fakelinkedin.NewTestServer(fakelinkedin.Options{
+			ClientID:     "integration-client",
+			ClientSecret: "integration-secret",
+		})
+		t.Cleanup(fake.Close)
+
+		service.baseURL = fake.URL()
+		service.config = fake.ClientConfig()
+	}
+
+	// Faults are global to the fake, so never leave one behind for the next test
+	t.Cleanup(func() { service.clearFaults(t) })
+
+	return service
 }
 
-// TestPublishFlow_TokenExpired validates expired token handling
-// This test will FAIL until token validation is implemented
-func TestPublishFlow_TokenExpired(t *testing.T) {
-	t.Run("error when LinkedIn token is expired", func(t *testing.T) {
-		// Steps:
-		// 1. Create user with expired LinkedIn token
-		// 2. Create draft
-		// 3. Attempt to publish
-		// 4. Verify error about expired token
-		// 5. Verify draft status is not changed
-
-		// Will fail: Token expiration handling doesn't exist yet
-		t.Fatal("Token expiration handling not implemented yet - TDD Red phase")
-	})
+// admin sends a request to an admin endpoint of the fake and decodes the response into out
+func (s *fakeLinkedInService) admin(t *testing.T, method, path string, body, out interface{}) {
+	t.Helper()
+
+	var payload bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&payload).Encode(body); err != nil {
+			t.Fatalf("failed to encode %s %s body: %v", method, path, err)
+		}
+	}
+
+	req, err := http.NewRequest(method, s.baseURL+fakelinkedin.AdminPrefix+path, &payload)
+	if err != nil {
+		t.Fatalf("failed to build %s %s request: %v", method, path, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.http.Do(req)
+	if err != nil {
+		t.Fatalf("fake LinkedIn %s %s failed: %v", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		t.Fatalf("fake LinkedIn %s %s returned %d", method, path, resp.StatusCode)
+	}
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			t.Fatalf("failed to decode %s %s response: %v", method, path, err)
+		}
+	}
 }
 
-// TestPublishFlow_NoToken validates missing token handling
-// This test will FAIL until token requirement is implemented
-func TestPublishFlow_NoToken(t *testing.T) {
-	t.Run("error when user has no LinkedIn token", func(t *testing.T) {
-		// Steps:
-		// 1. Create user without LinkedIn token
-		// 2. Create draft
-		// 3. Attempt to publish
-		// 4. Verify error about missing token
-		// 5. Verify draft status is not changed
-
-		// Will fail: Token requirement doesn't exist yet
-		t.Fatal("Token requirement not implemented yet - TDD Red phase")
-	})
+func (s *fakeLinkedInService) issueToken(t *testing.T, memberID string) linkedin.TokenResponse {
+	t.Helper()
+
+	var token linkedin.TokenResponse
+	s.admin(t, http.MethodPost, "/tokens", map[string]interface{}{"member_id": memberID}, &token)
+	return token
 }
 
-// TestPublishFlow_LinkedInAPIError validates LinkedIn API error handling
-// This test will FAIL until LinkedIn API error handling is implemented
-func TestPublishFlow_LinkedInAPIError(t *testing.T) {
-	tests := []struct {
-		name          string
-		apiError      string
-		expectedError string
-		draftStatus   string
-	}{
-		{
-			name:          "handle 401 unauthorized",
-			apiError:      "unauthorized",
-			expectedError: "token_invalid",
-			draftStatus:   "FAILED",
-		},
-		{
-			name:          "handle 403 forbidden",
-			apiError:      "forbidden",
-			expectedError: "token_invalid",
-			draftStatus:   "FAILED",
-		},
-		{
-			name:          "handle 429 rate limit",
-			apiError:      "rate_limit",
-			expectedError: "rate_limit",
-			draftStatus:   "FAILED",
-		},
-		{
-			name:          "handle network error",
-			apiError:      "network_error",
-			expectedError: "service_unavailable",
-			draftStatus:   "FAILED",
-		},
+func (s *fakeLinkedInService) revokeToken(t *testing.T, accessToken string) {
+	t.Helper()
+	s.admin(t, http.MethodDelete, "/tokens/"+accessToken, nil, nil)
+}
+
+func (s *fakeLinkedInService) injectFault(t *testing.T, path string, status int) {
+	t.Helper()
+	s.admin(t, http.MethodPost, "/faults", map[string]interface{}{
+		"path":                path,
+		"status":              status,
+		"retry_after_seconds": 60,
+	}, nil)
+}
+
+func (s *fakeLinkedInService) clearFaults(t *testing.T) {
+	t.Helper()
+	s.admin(t, http.MethodDelete, "/faults", nil, nil)
+}
+
+// posts returns the posts published on behalf of a member
+func (s *fakeLinkedInService) posts(t *testing.T, memberID string) []fakelinkedin.Post {
+	t.Helper()
+
+	var response struct {
+		Posts []fakelinkedin.Post `json:"posts"`
 	}
+	s.admin(t, http.MethodGet, "/posts", nil, &response)
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			// Will fail: LinkedIn API error handling doesn't exist yet
-			t.Fatal("LinkedIn API error handling not implemented yet - TDD Red phase")
-		})
+	var posts []fakelinkedin.Post
+	for _, post := range response.Posts {
+		if post.Author == "urn:li:person:"+memberID {
+			posts = append(posts, post)
+		}
 	}
+	return posts
 }
 
-// TestPublishFlow_AlreadyPublished validates double publish prevention
-// This test will FAIL until publish status check is implemented
-func TestPublishFlow_AlreadyPublished(t *testing.T) {
-	t.Run("error when attempting to publish already published draft", func(t *testing.T) {
-		// Steps:
-		// 1. Create and publish draft successfully
-		// 2. Attempt to publish same draft again
-		// 3. Verify error about already published
-		// 4. Verify LinkedIn API not called second time
-
-		// Will fail: Already published check doesn't exist yet
-		t.Fatal("Already published check not implemented yet - TDD Red phase")
-	})
+// publishFlow wires the MongoDB repositories, the real LinkedIn client and the
+// publish use case to the fake LinkedIn API
+type publishFlow struct {
+	linkedIn *fakeLinkedInService
+	memberID string
+	token    linkedin.TokenResponse
+	user     *entities.User
+	drafts   interfaces.DraftRepository
+	users    interfaces.UserRepository
+	ideas    interfaces.IdeasRepository
+	uc       *usecases.PublishDraftUseCase
 }
 
-// TestPublishFlow_MultipleSequential validates sequential publishing
-// This test will FAIL until sequential publish is implemented
-func TestPublishFlow_MultipleSequential(t *testing.T) {
-	t.Run("publish multiple drafts sequentially", func(t *testing.T) {
-		// Steps:
-		// 1. Create 3 drafts
-		// 2. Publish draft 1
-		// 3. Publish draft 2
-		// 4. Publish draft 3
-		// 5. Verify all 3 are PUBLISHED
-		// 6. Verify each has unique linkedin_post_id
-
-		// Will fail: Sequential publishing doesn't exist yet
-		t.Fatal("Sequential publishing not implemented yet - TDD Red phase")
+func newPublishFlow(t *testing.T) *publishFlow {
+	t.Helper()
+
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	mongoURI := os.Getenv("MONGO_URI")
+	if mongoURI == "" {
+		t.Skip("MONGO_URI not set; run with docker-compose.test.yml")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(mongoURI))
+	if err != nil {
+		t.Fatalf("failed to connect to MongoDB: %v", err)
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		t.Fatalf("failed to ping MongoDB: %v", err)
+	}
+
+	dbName := os.Getenv("MONGO_DATABASE")
+	if dbName == "" {
+		dbName = "linkgenai_test"
+	}
+	db := client.Database(dbName + "_publish_flow_" + uuid.New().String()[:8])
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = db.Drop(ctx)
+		_ = client.Disconnect(ctx)
+	})
+
+	fake := newFakeLinkedInService(t)
+	linkedInClient, err := linkedin.NewLinkedInAPIClient(fake.config)
+	if err != nil {
+		t.Fatalf("failed to create LinkedIn client: %v", err)
+	}
+	linkedInClient.SetRetryConfig(llm.RetryConfig{
+		MaxRetries:      1,
+		InitialDelay:    10 * time.Millisecond,
+		MaxDelay:        10 * time.Millisecond,
+		BackoffFactor:   1,
+		RetryableStatus: map[int]bool{http.StatusServiceUnavailable: true},
 	})
+
+	flow := &publishFlow{
+		linkedIn: fake,
+		memberID: "member-" + uuid.New().String(),
+		drafts:   repositories.NewDraftRepository(db.Collection("drafts")),
+		users:    repositories.NewUserRepository(db.Collection("users"), config.NewSecretStore()),
+		ideas:    repositories.NewIdeasRepository(db.Collection("ideas")),
+	}
+	flow.uc = usecases.NewPublishDraftUseCase(flow.drafts, flow.users, flow.ideas, linkedInClient, linkedInClient)
+
+	flow.token = fake.issueToken(t, flow.memberID)
+	expiresAt := time.Now().Add(time.Duration(flow.token.ExpiresIn) * time.Second)
+	flow.user = &entities.User{
+		ID:                     primitive.NewObjectID().Hex(),
+		Email:                  "publisher-" + uuid.New().String()[:8] + "@example.com",
+		LinkedInToken:          flow.token.AccessToken,
+		LinkedInRefreshToken:   flow.token.RefreshToken,
+		LinkedInTokenExpiresAt: &expiresAt,
+		Active:                 true,
+		CreatedAt:              time.Now(),
+		UpdatedAt:              time.Now(),
+	}
+
+	return flow
+}
+
+// createUser stores the flow user after the test adjusted it
+func (f *publishFlow) createUser(t *testing.T) {
+	t.Helper()
+
+	if _, err := f.users.Create(context.Background(), f.user); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+}
+
+// createDraft stores a draft of the flow user
+func (f *publishFlow) createDraft(t *testing.T, draftType entities.DraftType, ideaID *string) string {
+	t.Helper()
+
+	draft := &entities.Draft{
+		ID:        primitive.NewObjectID().Hex(),
+		UserID:    f.user.ID,
+		IdeaID:    ideaID,
+		Type:      draftType,
+		Content:   publishFlowPostContent,
+		Status:    entities.DraftStatusDraft,
+		Metadata:  map[string]interface{}{"source": "integration"},
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if draftType == entities.DraftTypeArticle {
+		draft.Title = "Testing LinkedIn publishing locally"
+		draft.Content = strings.Repeat(publishFlowPostContent+" ", 3)
+	}
+
+	id, err := f.drafts.Create(context.Background(), draft)
+	if err != nil {
+		t.Fatalf("failed to create draft: %v", err)
+	}
+	return id
+}
+
+// createIdea stores an unused idea of the flow user
+func (f *publishFlow) createIdea(t *testing.T) string {
+	t.Helper()
+
+	idea := &entities.Idea{
+		ID:        primitive.NewObjectID().Hex(),
+		UserID:    f.user.ID,
+		TopicID:   primitive.NewObjectID().Hex(),
+		TopicName: "Testing",
+		Content:   "Test the publishing path against a local LinkedIn",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if err := f.ideas.CreateBatch(context.Background(), []*entities.Idea{idea}); err != nil {
+		t.Fatalf("failed to create idea: %v", err)
+	}
+	return idea.ID
+}
+
+func (f *publishFlow) publish(ctx context.Context, draftID string) (*entities.Draft, error) {
+	return f.uc.Execute(ctx, usecases.PublishDraftInput{DraftID: draftID, UserID: f.user.ID})
 }
 
-// TestPublishFlow_PostVsArticle validates type-specific publishing
-// This test will FAIL until type-based routing is implemented
+func (f *publishFlow) storedDraft(t *testing.T, draftID string) *entities.Draft {
+	t.Helper()
+
+	draft, err := f.drafts.FindByID(context.Background(), draftID)
+	if err != nil {
+		t.Fatalf("failed to load draft: %v", err)
+	}
+	return draft
+}
+
+func (f *publishFlow) storedUser(t *testing.T) *entities.User {
+	t.Helper()
+
+	user, err := f.users.FindByID(context.Background(), f.user.ID)
+	if err != nil {
+		t.Fatalf("failed to load user: %v", err)
+	}
+	return user
+}
+
+// TestPublishFlow_EndToEnd validates a stored draft is published to LinkedIn and recorded
+func TestPublishFlow_EndToEnd(t *testing.T) {
+	flow := newPublishFlow(t)
+	flow.createUser(t)
+	ideaID := flow.createIdea(t)
+	draftID := flow.createDraft(t, entities.DraftTypePost, &ideaID)
+
+	published, err := flow.publish(context.Background(), draftID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	stored := flow.storedDraft(t, draftID)
+	if stored.Status != entities.DraftStatusPublished {
+		t.Errorf("expected status %s, got %s", entities.DraftStatusPublished, stored.Status)
+	}
+	if stored.PublishedAt == nil {
+		t.Error("expected published_at to be stored")
+	}
+	if stored.LinkedInPostID == "" || stored.LinkedInPostID != published.LinkedInPostID {
+		t.Errorf("expected post ID %q to be stored, got %q", published.LinkedInPostID, stored.LinkedInPostID)
+	}
+	if stored.Metadata["source"] != "integration" {
+		t.Errorf("expected metadata to be preserved, got %v", stored.Metadata)
+	}
+
+	posts := flow.linkedIn.posts(t, flow.memberID)
+	if len(posts) != 1 {
+		t.Fatalf("expected 1 post on LinkedIn, got %d", len(posts))
+	}
+	if posts[0].ID != stored.LinkedInPostID {
+		t.Errorf("expected post ID %q, got %q", stored.LinkedInPostID, posts[0].ID)
+	}
+	if posts[0].Text != publishFlowPostContent {
+		t.Errorf("expected the draft content, got %q", posts[0].Text)
+	}
+
+	idea, err := flow.ideas.FindByID(context.Background(), ideaID)
+	if err != nil {
+		t.Fatalf("failed to load idea: %v", err)
+	}
+	if !idea.Used || idea.PublishedDraftID != draftID {
+		t.Errorf("expected the idea to be marked as published by %s, got used=%v draft=%q", draftID, idea.Used, idea.PublishedDraftID)
+	}
+}
+
+// TestPublishFlow_PostVsArticle validates both draft types reach LinkedIn
 func TestPublishFlow_PostVsArticle(t *testing.T) {
 	tests := []struct {
-		name        string
-		draftType   string
-		apiEndpoint string
+		name      string
+		draftType entities.DraftType
+		postType  string
 	}{
-		{
-			name:        "publish POST type to UGC Posts API",
-			draftType:   "POST",
-			apiEndpoint: "/v2/ugcPosts",
-		},
-		{
-			name:        "publish ARTICLE type to Articles API",
-			draftType:   "ARTICLE",
-			apiEndpoint: "/v2/articles",
-		},
+		{name: "post", draftType: entities.DraftTypePost, postType: fakelinkedin.PostTypePost},
+		{name: "article", draftType: entities.DraftTypeArticle, postType: fakelinkedin.PostTypeArticle},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Will fail: Type-based routing doesn't exist yet
-			t.Fatal("Type-based routing not implemented yet - TDD Red phase")
+			flow := newPublishFlow(t)
+			flow.createUser(t)
+			draftID := flow.createDraft(t, tt.draftType, nil)
+
+			if _, err := flow.publish(context.Background(), draftID); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			posts := flow.linkedIn.posts(t, flow.memberID)
+			if len(posts) != 1 {
+				t.Fatalf("expected 1 post on LinkedIn, got %d", len(posts))
+			}
+			if posts[0].Type != tt.postType {
+				t.Errorf("expected post type %s, got %s", tt.postType, posts[0].Type)
+			}
 		})
 	}
 }
 
-// TestPublishFlow_RateLimitRecovery validates rate limit handling
-// This test will FAIL until rate limit handling is implemented
-func TestPublishFlow_RateLimitRecovery(t *testing.T) {
-	t.Run("handle rate limit and mark as failed", func(t *testing.T) {
-		// Steps:
-		// 1. Mock LinkedIn to return 429 rate limit
-		// 2. Attempt to publish draft
-		// 3. Verify draft status is FAILED
-		// 4. Verify error message includes "rate_limit"
-
-		// Will fail: Rate limit handling doesn't exist yet
-		t.Fatal("Rate limit handling not implemented yet - TDD Red phase")
-	})
+// TestPublishFlow_MultipleSequential validates several drafts of a user are published
+func TestPublishFlow_MultipleSequential(t *testing.T) {
+	flow := newPublishFlow(t)
+	flow.createUser(t)
+
+	postIDs := make(map[string]bool)
+	for i := 0; i < 3; i++ {
+		draftID := flow.createDraft(t, entities.DraftTypePost, nil)
+
+		published, err := flow.publish(context.Background(), draftID)
+		if err != nil {
+			t.Fatalf("unexpected error publishing draft %d: %v", i, err)
+		}
+		postIDs[published.LinkedInPostID] = true
+	}
+
+	if len(postIDs) != 3 {
+		t.Errorf("expected 3 distinct post IDs, got %d", len(postIDs))
+	}
+	if got := len(flow.linkedIn.posts(t, flow.memberID)); got != 3 {
+		t.Errorf("expected 3 posts on LinkedIn, got %d", got)
+	}
 }
 
-// TestPublishFlow_ContextTimeout validates timeout handling
-// This test will FAIL until timeout handling is implemented
-func TestPublishFlow_ContextTimeout(t *testing.T) {
-	t.Run("handle context timeout during LinkedIn API call", func(t *testing.T) {
-		// Steps:
-		// 1. Create context with short timeout
-		// 2. Mock LinkedIn to delay response
-		// 3. Attempt to publish
-		// 4. Verify timeout error
-		// 5. Verify draft status not changed to PUBLISHED
-
-		// Will fail: Timeout handling doesn't exist yet
-		t.Fatal("Timeout handling not implemented yet - TDD Red phase")
+// TestPublishFlow_TokenExpired validates expired tokens are refreshed and stored
+func TestPublishFlow_TokenExpired(t *testing.T) {
+	t.Run("refresh an expired token before publishing", func(t *testing.T) {
+		flow := newPublishFlow(t)
+		expired := time.Now().Add(-time.Minute)
+		flow.user.LinkedInTokenExpiresAt = &expired
+		flow.createUser(t)
+		draftID := flow.createDraft(t, entities.DraftTypePost, nil)
+
+		if _, err := flow.publish(context.Background(), draftID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		user := flow.storedUser(t)
+		if user.LinkedInToken == flow.token.AccessToken {
+			t.Error("expected the refreshed token to be stored")
+		}
+		if user.LinkedInTokenExpiresAt == nil || !user.LinkedInTokenExpiresAt.After(time.Now()) {
+			t.Errorf("expected a future token expiry, got %v", user.LinkedInTokenExpiresAt)
+		}
+		if status := flow.storedDraft(t, draftID).Status; status != entities.DraftStatusPublished {
+			t.Errorf("expected status %s, got %s", entities.DraftStatusPublished, status)
+		}
 	})
-}
 
-// TestPublishFlow_TransactionRollback validates rollback on errors
-// This test will FAIL until transaction handling is implemented
-func TestPublishFlow_TransactionRollback(t *testing.T) {
-	t.Run("rollback when repository update fails after LinkedIn success", func(t *testing.T) {
-		// Steps:
-		// 1. Mock LinkedIn API to succeed
-		// 2. Mock repository Update to fail
-		// 3. Attempt to publish
-		// 4. Verify error returned
-		// 5. Verify draft still in original status
-		// 6. Verify published_at not set
-
-		// Will fail: Transaction rollback doesn't exist yet
-		t.Fatal("Transaction rollback not implemented yet - TDD Red phase")
+	t.Run("refresh and retry when LinkedIn rejects a token early", func(t *testing.T) {
+		flow := newPublishFlow(t)
+		flow.createUser(t)
+		draftID := flow.createDraft(t, entities.DraftTypePost, nil)
+		flow.linkedIn.revokeToken(t, flow.token.AccessToken)
+
+		if _, err := flow.publish(context.Background(), draftID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got := len(flow.linkedIn.posts(t, flow.memberID)); got != 1 {
+			t.Errorf("expected 1 post on LinkedIn, got %d", got)
+		}
 	})
-}
 
-// TestPublishFlow_ConcurrentPublish validates concurrent publishing
-// This test will FAIL until concurrent handling is implemented
-func TestPublishFlow_ConcurrentPublish(t *testing.T) {
-	t.Run("prevent concurrent publish of same draft", func(t *testing.T) {
-		// Steps:
-		// 1. Create draft
-		// 2. Attempt to publish from 2 goroutines concurrently
-		// 3. Verify only one succeeds
-		// 4. Verify other gets "already published" error
-
-		// Will fail: Concurrent handling doesn't exist yet
-		t.Fatal("Concurrent publish handling not implemented yet - TDD Red phase")
+	t.Run("error when the expired token cannot be refreshed", func(t *testing.T) {
+		flow := newPublishFlow(t)
+		expired := time.Now().Add(-time.Minute)
+		flow.user.LinkedInTokenExpiresAt = &expired
+		flow.user.LinkedInRefreshToken = ""
+		flow.createUser(t)
+		draftID := flow.createDraft(t, entities.DraftTypePost, nil)
+
+		_, err := flow.publish(context.Background(), draftID)
+
+		var credentialsErr *domainErrors.ErrInvalidUserCredentials
+		if !errors.As(err, &credentialsErr) {
+			t.Fatalf("expected ErrInvalidUserCredentials, got %v", err)
+		}
+		if status := flow.storedDraft(t, draftID).Status; status != entities.DraftStatusDraft {
+			t.Errorf("expected the draft to stay %s, got %s", entities.DraftStatusDraft, status)
+		}
+		if got := len(flow.linkedIn.posts(t, flow.memberID)); got != 0 {
+			t.Errorf("expected no post on LinkedIn, got %d", got)
+		}
 	})
 }
 
-// TestPublishFlow_ResponseValidation validates LinkedIn response parsing
-// This test will FAIL until response parsing is implemented
-func TestPublishFlow_ResponseValidation(t *testing.T) {
+// TestPublishFlow_NoToken validates users without a token never reach LinkedIn
+func TestPublishFlow_NoToken(t *testing.T) {
+	flow := newPublishFlow(t)
+	flow.user.LinkedInToken = ""
+	flow.user.LinkedInRefreshToken = ""
+	flow.user.LinkedInTokenExpiresAt = nil
+	flow.createUser(t)
+	draftID := flow.createDraft(t, entities.DraftTypePost, nil)
+
+	_, err := flow.publish(context.Background(), draftID)
+
+	var credentialsErr *domainErrors.ErrInvalidUserCredentials
+	if !errors.As(err, &credentialsErr) {
+		t.Fatalf("expected ErrInvalidUserCredentials, got %v", err)
+	}
+	if status := flow.storedDraft(t, draftID).Status; status != entities.DraftStatusDraft {
+		t.Errorf("expected the draft to stay %s, got %s", entities.DraftStatusDraft, status)
+	}
+}
+
+// TestPublishFlow_LinkedInAPIError validates LinkedIn failures are recorded on the stored draft
+func TestPublishFlow_LinkedInAPIError(t *testing.T) {
 	tests := []struct {
-		name            string
-		linkedInResponse string
-		expectedPostID  string
-		wantErr         bool
+		name   string
+		fault  func(t *testing.T, flow *publishFlow)
+		reason domainErrors.LinkedInErrorReason
 	}{
 		{
-			name:            "parse valid LinkedIn post response",
-			linkedInResponse: `{"id": "urn:li:share:12345"}`,
-			expectedPostID:  "urn:li:share:12345",
-			wantErr:         false,
+			name:   "unauthorized",
+			fault:  func(t *testing.T, flow *publishFlow) { flow.linkedIn.revokeToken(t, flow.token.AccessToken) },
+			reason: domainErrors.LinkedInReasonTokenInvalid,
 		},
 		{
-			name:            "parse valid LinkedIn article response",
-			linkedInResponse: `{"id": "urn:li:article:67890"}`,
-			expectedPostID:  "urn:li:article:67890",
-			wantErr:         false,
+			name: "forbidden",
+			fault: func(t *testing.T, flow *publishFlow) {
+				flow.linkedIn.injectFault(t, fakelinkedin.APIPrefix+"/ugcPosts", http.StatusForbidden)
+			},
+			reason: domainErrors.LinkedInReasonTokenInvalid,
 		},
 		{
-			name:            "error on invalid JSON response",
-			linkedInResponse: `invalid json`,
-			expectedPostID:  "",
-			wantErr:         true,
+			name: "rate limit",
+			fault: func(t *testing.T, flow *publishFlow) {
+				flow.linkedIn.injectFault(t, fakelinkedin.APIPrefix+"/ugcPosts", http.StatusTooManyRequests)
+			},
+			reason: domainErrors.LinkedInReasonRateLimit,
 		},
 		{
-			name:            "error on missing id field",
-			linkedInResponse: `{"status": "success"}`,
-			expectedPostID:  "",
-			wantErr:         true,
+			name: "unavailable",
+			fault: func(t *testing.T, flow *publishFlow) {
+				flow.linkedIn.injectFault(t, fakelinkedin.APIPrefix+"/ugcPosts", http.StatusServiceUnavailable)
+			},
+			reason: domainErrors.LinkedInReasonUnavailable,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Will fail: Response parsing doesn't exist yet
-			t.Fatal("LinkedIn response parsing not implemented yet - TDD Red phase")
+			flow := newPublishFlow(t)
+			// Without a refresh token a rejected token is not retried
+			flow.user.LinkedInRefreshToken = ""
+			flow.createUser(t)
+			draftID := flow.createDraft(t, entities.DraftTypePost, nil)
+			tt.fault(t, flow)
+
+			_, err := flow.publish(context.Background(), draftID)
+
+			var apiErr *domainErrors.LinkedInAPIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected a LinkedInAPIError, got %v", err)
+			}
+			if apiErr.Reason != tt.reason {
+				t.Errorf("expected reason %s, got %s", tt.reason, apiErr.Reason)
+			}
+
+			stored := flow.storedDraft(t, draftID)
+			if stored.Status != entities.DraftStatusFailed {
+				t.Errorf("expected status %s, got %s", entities.DraftStatusFailed, stored.Status)
+			}
+			if stored.PublishError == nil || stored.PublishError.Reason != string(tt.reason) {
+				t.Errorf("expected publish error %s to be recorded, got %+v", tt.reason, stored.PublishError)
+			}
+			if got := len(flow.linkedIn.posts(t, flow.memberID)); got != 0 {
+				t.Errorf("expected no post on LinkedIn, got %d", got)
+			}
 		})
 	}
 }
 
-// TestPublishFlow_MetadataTracking validates metadata preservation
-// This test will FAIL until metadata tracking is implemented
-func TestPublishFlow_MetadataTracking(t *testing.T) {
-	t.Run("preserve metadata during publish", func(t *testing.T) {
-		// Steps:
-		// 1. Create draft with metadata
-		// 2. Publish draft
-		// 3. Verify metadata is preserved
-		// 4. Verify published_at is added
-		// 5. Verify linkedin_post_id is added
-
-		// Will fail: Metadata preservation doesn't exist yet
-		t.Fatal("Metadata preservation not implemented yet - TDD Red phase")
-	})
+// TestPublishFlow_AlreadyPublished validates a draft is never posted twice
+func TestPublishFlow_AlreadyPublished(t *testing.T) {
+	flow := newPublishFlow(t)
+	flow.createUser(t)
+	draftID := flow.createDraft(t, entities.DraftTypePost, nil)
+
+	if _, err := flow.publish(context.Background(), draftID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err := flow.publish(context.Background(), draftID)
+
+	var alreadyPublished *domainErrors.ErrDraftAlreadyPublished
+	if !errors.As(err, &alreadyPublished) {
+		t.Fatalf("expected ErrDraftAlreadyPublished, got %v", err)
+	}
+	if got := len(flow.linkedIn.posts(t, flow.memberID)); got != 1 {
+		t.Errorf("expected 1 post on LinkedIn, got %d", got)
+	}
 }
 
-// TestPublishFlow_AuditLog validates audit logging
-// This test will FAIL until audit logging is implemented
-func TestPublishFlow_AuditLog(t *testing.T) {
-	t.Run("log publish attempt and result", func(t *testing.T) {
-		// Steps:
-		// 1. Publish draft
-		// 2. Verify audit log entry created
-		// 3. Verify log includes: user_id, draft_id, timestamp, result
-
-		// Will fail: Audit logging doesn't exist yet
-		t.Fatal("Audit logging not implemented yet - TDD Red phase")
-	})
+// TestPublishFlow_ConcurrentPublish validates concurrent requests publish a draft once
+func TestPublishFlow_ConcurrentPublish(t *testing.T) {
+	flow := newPublishFlow(t)
+	flow.createUser(t)
+	draftID := flow.createDraft(t, entities.DraftTypePost, nil)
+
+	const attempts = 5
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+		errs      []error
+	)
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := flow.publish(context.Background(), draftID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err == nil {
+				succeeded++
+				return
+			}
+			errs = append(errs, err)
+		}()
+	}
+	wg.Wait()
+
+	if succeeded != 1 {
+		t.Errorf("expected exactly 1 successful publish, got %d", succeeded)
+	}
+	for _, err := range errs {
+		var inProgress *domainErrors.ErrDraftPublishInProgress
+		var alreadyPublished *domainErrors.ErrDraftAlreadyPublished
+		if !errors.As(err, &inProgress) && !errors.As(err, &alreadyPublished) {
+			t.Errorf("expected an in-progress or already-published error, got %v", err)
+		}
+	}
+	if got := len(flow.linkedIn.posts(t, flow.memberID)); got != 1 {
+		t.Errorf("expected 1 post on LinkedIn, got %d", got)
+	}
+	if status := flow.storedDraft(t, draftID).Status; status != entities.DraftStatusPublished {
+		t.Errorf("expected status %s, got %s", entities.DraftStatusPublished, status)
+	}
 }
 
-// TestPublishFlow_DatabaseConsistency validates data consistency
-// This test will FAIL until consistency checks are implemented
-func TestPublishFlow_DatabaseConsistency(t *testing.T) {
-	t.Run("ensure draft updates are atomic", func(t *testing.T) {
-		// Steps:
-		// 1. Publish draft
-		// 2. Verify all fields updated together:
-		//    - status = PUBLISHED
-		//    - published_at set
-		//    - linkedin_post_id set
-		// 3. No partial updates allowed
-
-		// Will fail: Atomic updates don't exist yet
-		t.Fatal("Atomic update consistency not implemented yet - TDD Red phase")
-	})
+// TestPublishFlow_ReclaimAbandonedPublish validates a draft left PUBLISHING by a publisher
+// that stopped is taken back once its lease expires and can then be published
+func TestPublishFlow_ReclaimAbandonedPublish(t *testing.T) {
+	flow := newPublishFlow(t)
+	flow.createUser(t)
+	ctx := context.Background()
+
+	abandonedID := flow.createDraft(t, entities.DraftTypePost, nil)
+	inProgressID := flow.createDraft(t, entities.DraftTypePost, nil)
+	now := time.Now()
+	for draftID, leaseUntil := range map[string]time.Time{abandonedID: now.Add(-time.Second), inProgressID: now.Add(time.Minute)} {
+		claimed, err := flow.drafts.UpdateIfStatus(ctx, draftID, entities.DraftStatusDraft, map[string]interface{}{
+			"status":              entities.DraftStatusPublishing,
+			"publish_lease_until": leaseUntil,
+		})
+		if err != nil || !claimed {
+			t.Fatalf("failed to claim draft %s: %v", draftID, err)
+		}
+	}
+
+	// Until the scheduled publisher takes it back, the draft can't be published
+	_, err := flow.publish(ctx, abandonedID)
+	var inProgress *domainErrors.ErrDraftPublishInProgress
+	if !errors.As(err, &inProgress) {
+		t.Fatalf("expected ErrDraftPublishInProgress, got %v", err)
+	}
+
+	due, err := flow.drafts.FindDueForPublishing(ctx, now, 0)
+	if err != nil {
+		t.Fatalf("failed to find due drafts: %v", err)
+	}
+	if len(due) != 1 || due[0].ID != abandonedID {
+		t.Fatalf("expected only the abandoned draft to be due, got %d drafts", len(due))
+	}
+
+	claimed, err := flow.drafts.ClaimForPublishing(ctx, abandonedID, now, now.Add(time.Minute))
+	if err != nil || !claimed {
+		t.Fatalf("expected the abandoned draft to be claimed, got %v (%v)", claimed, err)
+	}
+	if claimed, _ := flow.drafts.ClaimForPublishing(ctx, inProgressID, now, now.Add(time.Minute)); claimed {
+		t.Error("expected a draft with a live lease not to be claimed")
+	}
+
+	reclaimed := flow.storedDraft(t, abandonedID)
+	if reclaimed.Status != entities.DraftStatusScheduled || reclaimed.ScheduledAt == nil {
+		t.Fatalf("expected the draft to be handed back as due SCHEDULED, got %s at %v", reclaimed.Status, reclaimed.ScheduledAt)
+	}
+
+	published, err := flow.publish(ctx, abandonedID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if published.Status != entities.DraftStatusPublished {
+		t.Errorf("expected status %s, got %s", entities.DraftStatusPublished, published.Status)
+	}
+	if got := len(flow.linkedIn.posts(t, flow.memberID)); got != 1 {
+		t.Errorf("expected 1 post on LinkedIn, got %d", got)
+	}
 }
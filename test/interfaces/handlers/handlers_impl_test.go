@@ -7,11 +7,13 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/linkgen-ai/backend/src/application/usecases"
 	"github.com/linkgen-ai/backend/src/domain/entities"
-	"github.com/linkgen-ai/backend/src/domain/valueobjects"
+	domainErrors "github.com/linkgen-ai/backend/src/domain/errors"
+	"github.com/linkgen-ai/backend/src/domain/interfaces"
 	"github.com/linkgen-ai/backend/src/interfaces/handlers"
 	"go.uber.org/zap"
 )
@@ -19,6 +21,7 @@ import (
 // Mock repositories and services for testing
 
 type mockIdeasRepository struct {
+	interfaces.IdeasRepository
 	ideas map[string][]*entities.Idea
 }
 
@@ -74,6 +77,7 @@ func (m *mockIdeasRepository) ClearByUserID(ctx context.Context, userID string)
 }
 
 type mockUserRepository struct {
+	interfaces.UserRepository
 	users map[string]*entities.User
 }
 
@@ -110,6 +114,7 @@ func (m *mockUserRepository) FindByEmail(ctx context.Context, email string) (*en
 }
 
 type mockDraftRepository struct {
+	interfaces.DraftRepository
 	drafts map[string]*entities.Draft
 }
 
@@ -141,16 +146,16 @@ func (m *mockDraftRepository) Delete(ctx context.Context, draftID string) error
 	return nil
 }
 
-func (m *mockDraftRepository) ListByUserID(ctx context.Context, userID string, status valueobjects.DraftStatus, draftType valueobjects.DraftType) ([]*entities.Draft, error) {
+func (m *mockDraftRepository) ListByUserID(ctx context.Context, userID string, status entities.DraftStatus, draftType entities.DraftType) ([]*entities.Draft, error) {
 	result := make([]*entities.Draft, 0)
 	for _, draft := range m.drafts {
 		if draft.UserID != userID {
 			continue
 		}
-		if status != "" && draft.Status != entities.DraftStatus(status) {
+		if status != "" && draft.Status != status {
 			continue
 		}
-		if draftType != "" && draft.Type != entities.DraftType(draftType) {
+		if draftType != "" && draft.Type != draftType {
 			continue
 		}
 		result = append(result, draft)
@@ -170,6 +175,27 @@ func (m *mockDraftRepository) FindReadyForPublishing(ctx context.Context, userID
 	return []*entities.Draft{}, nil
 }
 
+type mockJobRepository struct {
+	interfaces.JobRepository
+	jobs map[string]*entities.Job
+}
+
+func newMockJobRepository() *mockJobRepository {
+	return &mockJobRepository{
+		jobs: make(map[string]*entities.Job),
+	}
+}
+
+func (m *mockJobRepository) Create(ctx context.Context, job *entities.Job) (string, error) {
+	m.jobs[job.ID] = job
+	return job.ID, nil
+}
+
+func (m *mockJobRepository) Update(ctx context.Context, job *entities.Job) error {
+	m.jobs[job.ID] = job
+	return nil
+}
+
 type mockNATSPublisher struct {
 	messages []interface{}
 }
@@ -185,7 +211,42 @@ func (m *mockNATSPublisher) Publish(ctx context.Context, data interface{}) error
 	return nil
 }
 
-type mockLLMService struct{}
+type mockTopicRepository struct {
+	interfaces.TopicRepository
+	topics map[string]*entities.Topic
+}
+
+func newMockTopicRepository() *mockTopicRepository {
+	return &mockTopicRepository{
+		topics: make(map[string]*entities.Topic),
+	}
+}
+
+func (m *mockTopicRepository) Create(ctx context.Context, topic *entities.Topic) (string, error) {
+	m.topics[topic.ID] = topic
+	return topic.ID, nil
+}
+
+type mockTopicIdeasQueue struct {
+	topicIDs []string
+}
+
+func (m *mockTopicIdeasQueue) Enqueue(topicID string) error {
+	m.topicIDs = append(m.topicIDs, topicID)
+	return nil
+}
+
+type mockQuotaChecker struct {
+	err error
+}
+
+func (m *mockQuotaChecker) CheckLLMQuota(ctx context.Context, userID string) error {
+	return m.err
+}
+
+type mockLLMService struct {
+	interfaces.LLMService
+}
 
 func (m *mockLLMService) RefineDraft(ctx context.Context, content, prompt string, history []string) (string, error) {
 	return content + " [refined]", nil
@@ -291,12 +352,24 @@ func TestHandlers_DraftsGenerateDrafts_Success(t *testing.T) {
 	// Setup
 	logger, _ := zap.NewDevelopment()
 	draftRepo := newMockDraftRepository()
+	jobRepo := newMockJobRepository()
+	ideasRepo := newMockIdeasRepository()
 	publisher := newMockNATSPublisher()
 	llmService := &mockLLMService{}
 
+	// Add the idea the drafts are generated from
+	ideasRepo.CreateBatch(context.Background(), []*entities.Idea{
+		{
+			ID:      "675337baf901e2d790aabbdd",
+			UserID:  "675337baf901e2d790aabbcc",
+			TopicID: "topic1",
+			Content: "Test idea",
+		},
+	})
+
 	// Create use case and handler
 	refineUseCase := usecases.NewRefineDraftUseCase(draftRepo, llmService)
-	handler := handlers.NewDraftsHandler(refineUseCase, draftRepo, publisher, logger)
+	handler := handlers.NewDraftsHandler(refineUseCase, nil, nil, draftRepo, jobRepo, ideasRepo, publisher, logger)
 
 	// Create router
 	router := mux.NewRouter()
@@ -333,6 +406,141 @@ func TestHandlers_DraftsGenerateDrafts_Success(t *testing.T) {
 	}
 }
 
+// assertQuotaExceeded checks a 429 response that reports the remaining LLM budget
+func assertQuotaExceeded(t *testing.T, w *httptest.ResponseRecorder) {
+	t.Helper()
+
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("Expected status 429, got %d: %s", w.Code, w.Body.String())
+	}
+	if got := w.Header().Get("X-Quota-Remaining-Requests"); got != "0" {
+		t.Errorf("Expected X-Quota-Remaining-Requests 0, got %q", got)
+	}
+	if got := w.Header().Get("X-Quota-Remaining-Tokens"); got != "1500" {
+		t.Errorf("Expected X-Quota-Remaining-Tokens 1500, got %q", got)
+	}
+	if w.Header().Get("X-Quota-Reset") == "" || w.Header().Get("Retry-After") == "" {
+		t.Error("Expected X-Quota-Reset and Retry-After headers")
+	}
+
+	var response handlers.ErrorResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if response.Error.Code != handlers.ErrorCodeQuotaExceeded {
+		t.Errorf("Expected error code %s, got %s", handlers.ErrorCodeQuotaExceeded, response.Error.Code)
+	}
+	if response.Error.Details["period"] != "daily" || response.Error.Details["resource"] != "requests" {
+		t.Errorf("Expected the exhausted quota in the details, got %v", response.Error.Details)
+	}
+}
+
+// newQuotaExceeded returns a used up daily request quota with tokens left
+func newQuotaExceeded(userID string) error {
+	err := domainErrors.NewQuotaExceeded(userID, "daily", "requests", 50, 50, time.Now().Add(time.Hour))
+	err.RemainingRequests = 0
+	err.RemainingTokens = 1500
+	return err
+}
+
+func TestHandlers_DraftsGenerateDrafts_QuotaExceeded(t *testing.T) {
+	// Setup
+	logger := zap.NewNop()
+	draftRepo := newMockDraftRepository()
+	jobRepo := newMockJobRepository()
+	ideasRepo := newMockIdeasRepository()
+	publisher := newMockNATSPublisher()
+
+	userID := "675337baf901e2d790aabbcc"
+	ideasRepo.CreateBatch(context.Background(), []*entities.Idea{
+		{
+			ID:      "675337baf901e2d790aabbdd",
+			UserID:  userID,
+			TopicID: "topic1",
+			Content: "Test idea",
+		},
+	})
+
+	refineUseCase := usecases.NewRefineDraftUseCase(draftRepo, &mockLLMService{})
+	handler := handlers.NewDraftsHandler(refineUseCase, nil, nil, draftRepo, jobRepo, ideasRepo, publisher, logger)
+	handler.SetQuotaChecker(&mockQuotaChecker{err: newQuotaExceeded(userID)})
+
+	router := mux.NewRouter()
+	handler.RegisterRoutes(router)
+
+	// Test request
+	bodyBytes, _ := json.Marshal(map[string]interface{}{
+		"user_id": userID,
+		"idea_id": "675337baf901e2d790aabbdd",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/v1/drafts/generate", bytes.NewReader(bodyBytes))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	// Assertions
+	assertQuotaExceeded(t, w)
+	if len(jobRepo.jobs) != 0 {
+		t.Errorf("Expected no job to be created, got %d", len(jobRepo.jobs))
+	}
+	if len(publisher.messages) != 0 {
+		t.Errorf("Expected nothing to be published to NATS, got %d messages", len(publisher.messages))
+	}
+}
+
+func TestHandlers_TopicsCreateTopic_QuotaExceeded(t *testing.T) {
+	// Setup
+	logger := zap.NewNop()
+	topicRepo := newMockTopicRepository()
+	userRepo := newMockUserRepository()
+	queue := &mockTopicIdeasQueue{}
+
+	userID := "675337baf901e2d790aabbcc"
+	userRepo.users[userID] = &entities.User{ID: userID, Email: "test@example.com"}
+
+	handler := handlers.NewTopicsHandler(topicRepo, userRepo, nil, newMockIdeasRepository(), queue, logger)
+	handler.SetQuotaChecker(&mockQuotaChecker{err: newQuotaExceeded(userID)})
+
+	router := mux.NewRouter()
+	handler.RegisterRoutes(router)
+
+	// Test request
+	bodyBytes, _ := json.Marshal(map[string]interface{}{
+		"user_id": userID,
+		"name":    "Go concurrency",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/v1/topics", bytes.NewReader(bodyBytes))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	// Assertions
+	assertQuotaExceeded(t, w)
+	if len(topicRepo.topics) != 0 {
+		t.Errorf("Expected no topic to be created, got %d", len(topicRepo.topics))
+	}
+	if len(queue.topicIDs) != 0 {
+		t.Errorf("Expected no idea generation to be queued, got %v", queue.topicIDs)
+	}
+
+	// Within the quota the topic is created and its ideas are queued
+	handler.SetQuotaChecker(&mockQuotaChecker{})
+	req = httptest.NewRequest(http.MethodPost, "/v1/topics", bytes.NewReader(bodyBytes))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("Expected status 201, got %d: %s", w.Code, w.Body.String())
+	}
+	if len(topicRepo.topics) != 1 || len(queue.topicIDs) != 1 {
+		t.Errorf("Expected one topic created and queued, got %d topics and %v", len(topicRepo.topics), queue.topicIDs)
+	}
+}
+
 func TestHandlers_ValidationObjectID(t *testing.T) {
 	// Setup
 	logger, _ := zap.NewDevelopment()
@@ -415,6 +415,10 @@ func TestRouterStaticConfiguration(t *testing.T) {
 
 		w1 := httptest.NewRecorder()
 		w2 := httptest.NewRecorder()
+		_ = req1
+		_ = req2
+		_ = w1
+		_ = w2
 
 		// Expected:
 		// - Both should work or both should redirect
@@ -434,6 +438,10 @@ func TestRouterStaticConfiguration(t *testing.T) {
 
 		w1 := httptest.NewRecorder()
 		w2 := httptest.NewRecorder()
+		_ = req1
+		_ = req2
+		_ = w1
+		_ = w2
 
 		// Expected:
 		// - Routes are case-sensitive